@@ -0,0 +1,261 @@
+package pfrest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/danielmichaels/go-pfrest/pkg/client/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/core"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+)
+
+const (
+	minApplyPollDelay = 250 * time.Millisecond
+	maxApplyPollDelay = 5 * time.Second
+)
+
+// ApplyTimeoutError is returned by [ApplyAndWait] and
+// [ChangeSet.CommitAndWait] when the context expires before pfSense
+// reports the changes as applied.
+type ApplyTimeoutError struct {
+	// Subsystem is the subsystem that was being waited on.
+	Subsystem Subsystem
+	// Pending is what was still pending when the last status was read:
+	// the firewall's pending subsystems, the interface's pending
+	// interfaces, or the subsystem itself for endpoints that give no
+	// more detail.
+	Pending []string
+	// Remaining are the subsystems of a change set that were not yet
+	// applied after Subsystem. It is empty for ApplyAndWait.
+	Remaining []Subsystem
+	// Err is the context's error.
+	Err error
+	// LastErr is the error of the last status request if it failed
+	// transiently, e.g. with a 503 while pfSense reloaded, and nil if the
+	// last status was read.
+	LastErr error
+}
+
+func (e *ApplyTimeoutError) Error() string {
+	message := fmt.Sprintf("pfrest: timed out waiting for %s to apply (pending: %s)", e.Subsystem, strings.Join(e.Pending, ", "))
+	if len(e.Remaining) > 0 {
+		remaining := make([]string, len(e.Remaining))
+		for i, subsystem := range e.Remaining {
+			remaining[i] = string(subsystem)
+		}
+		message += fmt.Sprintf(" (not applied: %s)", strings.Join(remaining, ", "))
+	}
+	message += ": " + e.Err.Error()
+	if e.LastErr != nil {
+		message += " (last error: " + e.LastErr.Error() + ")"
+	}
+	return message
+}
+
+func (e *ApplyTimeoutError) Unwrap() []error {
+	if e.LastErr != nil {
+		return []error{e.Err, e.LastErr}
+	}
+	return []error{e.Err}
+}
+
+// ApplyStatus reports whether the subsystem's changes have been applied
+// and, if not, what is still pending. It returns an error if the status
+// has no applied field, so that callers don't wait on it forever.
+func ApplyStatus(ctx context.Context, c *client.Client, subsystem Subsystem, opts ...option.RequestOption) (bool, []string, error) {
+	var (
+		applied *bool
+		pending []string
+	)
+	switch subsystem {
+	case SubsystemInterface:
+		response, err := c.Interface.GetInterfaceApplyEndpoint(ctx, opts...)
+		if err != nil {
+			return false, nil, err
+		}
+		if response.Data != nil {
+			applied, pending = response.Data.Applied, response.Data.PendingInterfaces
+		}
+	case SubsystemVirtualIP:
+		response, err := c.Firewall.GetFirewallVirtualIPApplyEndpoint(ctx, opts...)
+		if err != nil {
+			return false, nil, err
+		}
+		if response.Data != nil {
+			applied = response.Data.Applied
+		}
+	case SubsystemRouting:
+		response, err := c.Routing.GetRoutingApplyEndpoint(ctx, opts...)
+		if err != nil {
+			return false, nil, err
+		}
+		if response.Data != nil {
+			applied = response.Data.Applied
+		}
+	case SubsystemIPsec:
+		response, err := c.Vpn.GetVpniPsecApplyEndpoint(ctx, opts...)
+		if err != nil {
+			return false, nil, err
+		}
+		if response.Data != nil {
+			applied = response.Data.Applied
+		}
+	case SubsystemWireGuard:
+		response, err := c.Vpn.GetVpnWireGuardApplyEndpoint(ctx, opts...)
+		if err != nil {
+			return false, nil, err
+		}
+		if response.Data != nil {
+			applied = response.Data.Applied
+		}
+	case SubsystemFirewall:
+		response, err := c.Firewall.GetFirewallApplyEndpoint(ctx, opts...)
+		if err != nil {
+			return false, nil, err
+		}
+		if response.Data != nil {
+			applied, pending = response.Data.Applied, response.Data.PendingSubsystems
+		}
+	case SubsystemDHCPServer:
+		response, err := c.Services.GetServicesDhcpServerApplyEndpoint(ctx, opts...)
+		if err != nil {
+			return false, nil, err
+		}
+		if response.Data != nil {
+			applied = response.Data.Applied
+		}
+	case SubsystemDNSResolver:
+		response, err := c.Services.GetServicesDNSResolverApplyEndpoint(ctx, opts...)
+		if err != nil {
+			return false, nil, err
+		}
+		if response.Data != nil {
+			applied = response.Data.Applied
+		}
+	case SubsystemDNSForwarder:
+		response, err := c.Services.GetServicesDNSForwarderApplyEndpoint(ctx, opts...)
+		if err != nil {
+			return false, nil, err
+		}
+		if response.Data != nil {
+			applied = response.Data.Applied
+		}
+	case SubsystemHAProxy:
+		response, err := c.Services.GetServicesHaProxyApplyEndpoint(ctx, opts...)
+		if err != nil {
+			return false, nil, err
+		}
+		if response.Data != nil {
+			applied = response.Data.Applied
+		}
+	default:
+		return false, nil, fmt.Errorf("unknown subsystem %q", subsystem)
+	}
+	if applied == nil {
+		// Waiting on a status that never says "applied" would never end.
+		return false, nil, fmt.Errorf("pfrest: %s apply status doesn't report whether it is applied", subsystem)
+	}
+	if *applied {
+		return true, nil, nil
+	}
+	if len(pending) == 0 {
+		pending = []string{string(subsystem)}
+	}
+	return false, pending, nil
+}
+
+// ApplyAndWait calls the apply endpoint of the subsystem, then polls its
+// status with exponential backoff until pfSense reports the changes as
+// applied. Status requests that fail transiently, with a transport error
+// or a response the default retry policy retries, are polled again; other
+// errors end the wait. If ctx expires first, it returns an
+// [*ApplyTimeoutError] listing what is still pending and the last
+// transient error.
+func ApplyAndWait(ctx context.Context, c *client.Client, subsystem Subsystem, opts ...option.RequestOption) error {
+	if err := Apply(ctx, c, subsystem, opts...); err != nil {
+		if ctx.Err() != nil {
+			return &ApplyTimeoutError{Subsystem: subsystem, Pending: []string{string(subsystem)}, Err: ctx.Err()}
+		}
+		return err
+	}
+	return waitApplied(ctx, c, subsystem, opts...)
+}
+
+// waitApplied polls the subsystem's apply status until it is applied or
+// ctx expires.
+func waitApplied(ctx context.Context, c *client.Client, subsystem Subsystem, opts ...option.RequestOption) error {
+	pending := []string{string(subsystem)}
+	delay := minApplyPollDelay
+	var lastErr error
+	for {
+		applied, status, err := ApplyStatus(ctx, c, subsystem, opts...)
+		switch {
+		case ctx.Err() != nil:
+			return &ApplyTimeoutError{Subsystem: subsystem, Pending: pending, Err: ctx.Err(), LastErr: lastErr}
+		case err != nil && !transientError(err):
+			return err
+		case err != nil:
+			lastErr = err
+		case applied:
+			return nil
+		default:
+			pending, lastErr = status, nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return &ApplyTimeoutError{Subsystem: subsystem, Pending: pending, Err: ctx.Err(), LastErr: lastErr}
+		case <-timer.C:
+		}
+		delay = min(2*delay, maxApplyPollDelay)
+	}
+}
+
+// transientError reports whether a status request that failed with err
+// may succeed if it is sent again: the circuit breaker is open, or the
+// default retry policy retries the response or transport error.
+func transientError(err error) bool {
+	var (
+		apiErr       *core.APIError
+		transportErr *url.Error
+	)
+	switch {
+	case errors.Is(err, core.ErrCircuitOpen):
+		return true
+	case errors.As(err, &apiErr):
+		return core.DefaultRetryPolicy.ShouldRetry(http.MethodGet, apiErr.StatusCode, nil)
+	case errors.As(err, &transportErr):
+		return core.DefaultRetryPolicy.ShouldRetry(http.MethodGet, 0, err)
+	}
+	return false
+}
+
+// CommitAndWait is like [ChangeSet.Commit], but waits for each subsystem's
+// changes to be applied before applying the next, so that, for example,
+// the filter is not reloaded until the interfaces it refers to are up.
+// If ctx expires, the [*ApplyTimeoutError] lists the subsystems that were
+// not applied.
+func (s *ChangeSet) CommitAndWait(ctx context.Context, opts ...option.RequestOption) error {
+	pending := s.Pending()
+	for i, subsystem := range pending {
+		if err := ApplyAndWait(ctx, s.client, subsystem, opts...); err != nil {
+			var timeout *ApplyTimeoutError
+			if errors.As(err, &timeout) {
+				timeout.Remaining = pending[i+1:]
+				return timeout
+			}
+			return fmt.Errorf("apply %s: %w", subsystem, err)
+		}
+		s.mu.Lock()
+		delete(s.pending, subsystem)
+		s.mu.Unlock()
+	}
+	return nil
+}
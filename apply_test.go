@@ -0,0 +1,195 @@
+package pfrest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// applyServer serves the apply endpoints of the subsystems, and answers
+// other requests with empty data.
+type applyServer struct {
+	mu sync.Mutex
+	// statuses are the successive status data of each apply endpoint,
+	// keyed by its path; the last one repeats. An int is answered as an
+	// error response with that status code. Subsystems without any are
+	// applied.
+	statuses map[string][]any
+	// failures are the paths whose requests fail.
+	failures map[string]bool
+	requests []string
+}
+
+func (s *applyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.RequestURI())
+
+	var data any = map[string]any{}
+	if strings.HasSuffix(r.URL.Path, "/apply") {
+		data = map[string]any{"applied": true}
+	}
+	switch {
+	case s.failures[r.URL.Path]:
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = json.NewEncoder(w).Encode(map[string]any{"code": 500, "status": "server error", "message": "failed"})
+		return
+	case r.Method == http.MethodGet && len(s.statuses[r.URL.Path]) > 0:
+		statuses := s.statuses[r.URL.Path]
+		data = statuses[0]
+		if len(statuses) > 1 {
+			s.statuses[r.URL.Path] = statuses[1:]
+		}
+		if code, ok := data.(int); ok {
+			w.WriteHeader(code)
+			_ = json.NewEncoder(w).Encode(map[string]any{"code": code, "status": http.StatusText(code), "message": "unavailable"})
+			return
+		}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": 200, "status": "ok", "data": data})
+}
+
+func (s *applyServer) calls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func newApplyServer(t *testing.T, statuses map[string][]any) (*applyServer, *client.Client) {
+	t.Helper()
+	api := &applyServer{statuses: statuses, failures: make(map[string]bool)}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return api, client.NewClient(option.WithBaseURL(server.URL), option.WithMaxAttempts(1))
+}
+
+func TestApplyAndWaitPolls(t *testing.T) {
+	api, c := newApplyServer(t, map[string][]any{
+		"/api/v2/firewall/apply": {
+			map[string]any{"applied": false, "pending_subsystems": []string{"filter"}},
+			map[string]any{"applied": true},
+		},
+	})
+
+	require.NoError(t, ApplyAndWait(context.Background(), c, SubsystemFirewall))
+	assert.Equal(t, []string{
+		"POST /api/v2/firewall/apply",
+		"GET /api/v2/firewall/apply",
+		"GET /api/v2/firewall/apply",
+	}, api.calls())
+}
+
+func TestApplyTimeoutErrorPending(t *testing.T) {
+	for _, test := range []struct {
+		subsystem Subsystem
+		status    any
+		pending   []string
+	}{
+		{SubsystemFirewall, map[string]any{"applied": false, "pending_subsystems": []string{"filter", "nat"}}, []string{"filter", "nat"}},
+		{SubsystemInterface, map[string]any{"applied": false, "pending_interfaces": []string{"wan"}}, []string{"wan"}},
+		{SubsystemRouting, map[string]any{"applied": false}, []string{"routing"}},
+	} {
+		_, c := newApplyServer(t, map[string][]any{
+			"/api/v2/" + strings.ReplaceAll(string(test.subsystem), "_", "/") + "/apply": {test.status},
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		err := ApplyAndWait(ctx, c, test.subsystem)
+		cancel()
+
+		var timeout *ApplyTimeoutError
+		require.True(t, errors.As(err, &timeout), "%s: %v", test.subsystem, err)
+		assert.Equal(t, test.subsystem, timeout.Subsystem)
+		assert.Equal(t, test.pending, timeout.Pending, test.subsystem)
+		assert.Empty(t, timeout.Remaining)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	}
+}
+
+func TestApplyAndWaitTransientErrors(t *testing.T) {
+	api, c := newApplyServer(t, map[string][]any{
+		"/api/v2/firewall/apply": {
+			http.StatusServiceUnavailable,
+			http.StatusBadGateway,
+			map[string]any{"applied": true},
+		},
+	})
+	// The failed attempt doesn't wait out the retrier's back-off.
+	fast := option.WithRetryBaseDelay(time.Millisecond)
+	require.NoError(t, ApplyAndWait(context.Background(), c, SubsystemFirewall, fast))
+	assert.Len(t, api.calls(), 4, "the status is polled again after each transient error")
+
+	_, c = newApplyServer(t, map[string][]any{
+		"/api/v2/firewall/apply": {
+			map[string]any{"applied": false, "pending_subsystems": []string{"filter"}},
+			http.StatusServiceUnavailable,
+		},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	err := ApplyAndWait(ctx, c, SubsystemFirewall, fast)
+	var timeout *ApplyTimeoutError
+	require.True(t, errors.As(err, &timeout), "%v", err)
+	assert.Equal(t, []string{"filter"}, timeout.Pending, "the last status that was read")
+	var apiErr *pkgclient.Error
+	require.True(t, errors.As(err, &apiErr), "%v", err)
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	api, c = newApplyServer(t, map[string][]any{"/api/v2/firewall/apply": {http.StatusNotFound}})
+	err = ApplyAndWait(context.Background(), c, SubsystemFirewall, fast)
+	assert.ErrorIs(t, err, pkgclient.ErrNotFound)
+	assert.False(t, errors.As(err, &timeout), "other errors end the wait")
+	assert.Len(t, api.calls(), 2)
+}
+
+func TestApplyStatusWithoutApplied(t *testing.T) {
+	for _, status := range []any{nil, map[string]any{}, map[string]any{"pending_subsystems": []string{"filter"}}} {
+		api, c := newApplyServer(t, map[string][]any{"/api/v2/firewall/apply": {status}})
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		err := ApplyAndWait(ctx, c, SubsystemFirewall)
+		cancel()
+
+		assert.EqualError(t, err, "pfrest: firewall apply status doesn't report whether it is applied", "%v", status)
+		assert.Len(t, api.calls(), 2, "the status is read once")
+	}
+}
+
+func TestCommitAndWaitRemaining(t *testing.T) {
+	api, c := newApplyServer(t, map[string][]any{
+		"/api/v2/firewall/apply": {map[string]any{"applied": false, "pending_subsystems": []string{"filter"}}},
+	})
+	changes := NewChangeSet(c)
+	changes.Mark(SubsystemDHCPServer, SubsystemFirewall, SubsystemInterface)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := changes.CommitAndWait(ctx)
+
+	var timeout *ApplyTimeoutError
+	require.True(t, errors.As(err, &timeout), "%v", err)
+	assert.Equal(t, SubsystemFirewall, timeout.Subsystem)
+	assert.Equal(t, []string{"filter"}, timeout.Pending)
+	assert.Equal(t, []Subsystem{SubsystemDHCPServer}, timeout.Remaining)
+	assert.Contains(t, err.Error(), "(not applied: dhcp_server)")
+
+	assert.Equal(t, []Subsystem{SubsystemFirewall, SubsystemDHCPServer}, changes.Pending(),
+		"the interfaces were applied; the rest can be committed again")
+	assert.Equal(t, []string{
+		"POST /api/v2/interface/apply",
+		"GET /api/v2/interface/apply",
+		"POST /api/v2/firewall/apply",
+		"GET /api/v2/firewall/apply",
+	}, api.calls())
+}
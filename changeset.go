@@ -0,0 +1,208 @@
+package pfrest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	pfclientapi "github.com/danielmichaels/go-pfrest/pkg/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/core"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+)
+
+// Subsystem is a part of the pfSense configuration whose pending changes
+// are applied together by its own apply endpoint.
+type Subsystem string
+
+// Subsystems with an apply endpoint, in the order [ChangeSet.Commit]
+// applies them.
+const (
+	SubsystemInterface    Subsystem = "interface"
+	SubsystemVirtualIP    Subsystem = "virtual_ip"
+	SubsystemRouting      Subsystem = "routing"
+	SubsystemIPsec        Subsystem = "ipsec"
+	SubsystemWireGuard    Subsystem = "wireguard"
+	SubsystemFirewall     Subsystem = "firewall"
+	SubsystemDHCPServer   Subsystem = "dhcp_server"
+	SubsystemDNSResolver  Subsystem = "dns_resolver"
+	SubsystemDNSForwarder Subsystem = "dns_forwarder"
+	SubsystemHAProxy      Subsystem = "haproxy"
+)
+
+// applyOrder is the order in which subsystems are applied: interfaces
+// first, since everything else refers to them, then the addresses,
+// gateways and VPN tunnels that firewall rules and NAT may refer to, then
+// the filter, and finally the services that listen on all of the above.
+var applyOrder = []Subsystem{
+	SubsystemInterface,
+	SubsystemVirtualIP,
+	SubsystemRouting,
+	SubsystemIPsec,
+	SubsystemWireGuard,
+	SubsystemFirewall,
+	SubsystemDHCPServer,
+	SubsystemDNSResolver,
+	SubsystemDNSForwarder,
+	SubsystemHAProxy,
+}
+
+// subsystemPaths maps endpoint path prefixes to their subsystem. The
+// longest matching prefix wins, so virtual IPs are not part of the
+// firewall subsystem.
+var subsystemPaths = map[string]Subsystem{
+	"/api/v2/interface":               SubsystemInterface,
+	"/api/v2/firewall/virtual_ip":     SubsystemVirtualIP,
+	"/api/v2/routing/":                SubsystemRouting,
+	"/api/v2/vpn/ipsec/":              SubsystemIPsec,
+	"/api/v2/vpn/wireguard/":          SubsystemWireGuard,
+	"/api/v2/firewall/":               SubsystemFirewall,
+	"/api/v2/services/dhcp_server":    SubsystemDHCPServer,
+	"/api/v2/services/dns_resolver/":  SubsystemDNSResolver,
+	"/api/v2/services/dns_forwarder/": SubsystemDNSForwarder,
+	"/api/v2/services/haproxy/":       SubsystemHAProxy,
+}
+
+// SubsystemOf returns the subsystem that applies changes made through the
+// endpoint with the given path, if any.
+func SubsystemOf(path string) (Subsystem, bool) {
+	var (
+		match  string
+		result Subsystem
+	)
+	for prefix, subsystem := range subsystemPaths {
+		if strings.HasPrefix(path, prefix) && len(prefix) > len(match) {
+			match, result = prefix, subsystem
+		}
+	}
+	return result, match != ""
+}
+
+// ChangeSet defers applying changes until all of them have been made, e.g.
+//
+//	changes := pfrest.NewChangeSet(c)
+//	for _, alias := range aliases {
+//		if _, err := c.Firewall.PatchFirewallAliasEndpoint(ctx, alias, changes.Option()); err != nil {
+//			return err
+//		}
+//	}
+//	// The filter is reloaded once, not once per alias.
+//	return changes.Commit(ctx)
+//
+// Calls made with [ChangeSet.Option] never apply their changes, and those
+// that pfSense defers mark their subsystem as pending. [ChangeSet.Commit]
+// then calls the apply endpoint of each pending subsystem once.
+//
+// A ChangeSet is safe for concurrent use.
+type ChangeSet struct {
+	client *client.Client
+
+	mu      sync.Mutex
+	pending map[Subsystem]bool
+}
+
+// NewChangeSet returns an empty ChangeSet that applies changes through c.
+func NewChangeSet(c *client.Client) *ChangeSet {
+	return &ChangeSet{
+		client:  c,
+		pending: make(map[Subsystem]bool),
+	}
+}
+
+// Option returns the request option that makes a call part of the change
+// set.
+func (s *ChangeSet) Option() option.RequestOption {
+	return option.WithMiddleware(s.middleware)
+}
+
+func (s *ChangeSet) middleware(next core.HTTPClient) core.HTTPClient {
+	return core.HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+		if query := request.URL.Query(); query.Has("apply") {
+			query.Set("apply", "false")
+			request.URL.RawQuery = query.Encode()
+		}
+		response, err := next.Do(request)
+		if err != nil || response.StatusCode < 200 || response.StatusCode >= 300 {
+			return response, err
+		}
+		endpoint, _ := core.EndpointFromContext(request.Context())
+		if !endpoint.Deferred {
+			return response, nil
+		}
+		if subsystem, ok := SubsystemOf(endpoint.Path); ok {
+			s.Mark(subsystem)
+		}
+		return response, nil
+	})
+}
+
+// Mark adds subsystems to the pending set, e.g. for changes made outside
+// the change set.
+func (s *ChangeSet) Mark(subsystems ...Subsystem) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, subsystem := range subsystems {
+		s.pending[subsystem] = true
+	}
+}
+
+// Pending returns the subsystems with changes to apply, in the order
+// Commit applies them.
+func (s *ChangeSet) Pending() []Subsystem {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var pending []Subsystem
+	for _, subsystem := range applyOrder {
+		if s.pending[subsystem] {
+			pending = append(pending, subsystem)
+		}
+	}
+	return pending
+}
+
+// Commit calls the apply endpoint of every pending subsystem once, in a
+// safe order. It stops at the first failure; the failed subsystem and
+// those after it stay pending, so Commit can be called again.
+func (s *ChangeSet) Commit(ctx context.Context, opts ...option.RequestOption) error {
+	for _, subsystem := range s.Pending() {
+		if err := Apply(ctx, s.client, subsystem, opts...); err != nil {
+			return fmt.Errorf("apply %s: %w", subsystem, err)
+		}
+		s.mu.Lock()
+		delete(s.pending, subsystem)
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// Apply calls the apply endpoint of the subsystem.
+func Apply(ctx context.Context, c *client.Client, subsystem Subsystem, opts ...option.RequestOption) error {
+	var err error
+	switch subsystem {
+	case SubsystemInterface:
+		_, err = c.Interface.PostInterfaceApplyEndpoint(ctx, &pfclientapi.PostInterfaceApplyEndpointRequest{}, opts...)
+	case SubsystemVirtualIP:
+		_, err = c.Firewall.PostFirewallVirtualIPApplyEndpoint(ctx, &pfclientapi.PostFirewallVirtualIPApplyEndpointRequest{}, opts...)
+	case SubsystemRouting:
+		_, err = c.Routing.PostRoutingApplyEndpoint(ctx, &pfclientapi.PostRoutingApplyEndpointRequest{}, opts...)
+	case SubsystemIPsec:
+		_, err = c.Vpn.PostVpniPsecApplyEndpoint(ctx, &pfclientapi.PostVpniPsecApplyEndpointRequest{}, opts...)
+	case SubsystemWireGuard:
+		_, err = c.Vpn.PostVpnWireGuardApplyEndpoint(ctx, &pfclientapi.PostVpnWireGuardApplyEndpointRequest{}, opts...)
+	case SubsystemFirewall:
+		_, err = c.Firewall.PostFirewallApplyEndpoint(ctx, &pfclientapi.PostFirewallApplyEndpointRequest{}, opts...)
+	case SubsystemDHCPServer:
+		_, err = c.Services.PostServicesDhcpServerApplyEndpoint(ctx, &pfclientapi.PostServicesDhcpServerApplyEndpointRequest{}, opts...)
+	case SubsystemDNSResolver:
+		_, err = c.Services.PostServicesDNSResolverApplyEndpoint(ctx, &pfclientapi.PostServicesDNSResolverApplyEndpointRequest{}, opts...)
+	case SubsystemDNSForwarder:
+		_, err = c.Services.PostServicesDNSForwarderApplyEndpoint(ctx, &pfclientapi.PostServicesDNSForwarderApplyEndpointRequest{}, opts...)
+	case SubsystemHAProxy:
+		_, err = c.Services.PostServicesHaProxyApplyEndpoint(ctx, &pfclientapi.PostServicesHaProxyApplyEndpointRequest{}, opts...)
+	default:
+		return fmt.Errorf("unknown subsystem %q", subsystem)
+	}
+	return err
+}
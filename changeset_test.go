@@ -0,0 +1,103 @@
+package pfrest
+
+import (
+	"context"
+	"testing"
+
+	pfclientapi "github.com/danielmichaels/go-pfrest/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubsystemOf(t *testing.T) {
+	for path, want := range map[string]Subsystem{
+		"/api/v2/interface":                           SubsystemInterface,
+		"/api/v2/interface/vlan":                      SubsystemInterface,
+		"/api/v2/firewall/rule":                       SubsystemFirewall,
+		"/api/v2/firewall/virtual_ip":                 SubsystemVirtualIP,
+		"/api/v2/firewall/virtual_ip/apply":           SubsystemVirtualIP,
+		"/api/v2/routing/gateway":                     SubsystemRouting,
+		"/api/v2/services/dhcp_server":                SubsystemDHCPServer,
+		"/api/v2/services/dhcp_server/static_mapping": SubsystemDHCPServer,
+		"/api/v2/services/dns_resolver/host_override": SubsystemDNSResolver,
+		"/api/v2/vpn/wireguard/peer":                  SubsystemWireGuard,
+	} {
+		subsystem, ok := SubsystemOf(path)
+		assert.True(t, ok, path)
+		assert.Equal(t, want, subsystem, path)
+	}
+
+	for _, path := range []string{"/api/v2/status/system", "/api/v2/routing", "/api/v2/services/ntp/settings"} {
+		_, ok := SubsystemOf(path)
+		assert.False(t, ok, path)
+	}
+}
+
+func TestChangeSetOption(t *testing.T) {
+	api, c := newApplyServer(t, nil)
+	api.failures["/api/v2/routing/gateway"] = true
+	changes := NewChangeSet(c)
+	ctx := context.Background()
+
+	_, err := c.Firewall.DeleteFirewallAliasEndpoint(ctx, &pfclientapi.DeleteFirewallAliasEndpointRequest{
+		ID:    pfclientapi.String("1"),
+		Apply: pfclientapi.Bool(true),
+	}, changes.Option())
+	require.NoError(t, err)
+	_, err = c.Firewall.GetFirewallAliasEndpoint(ctx, &pfclientapi.GetFirewallAliasEndpointRequest{ID: pfclientapi.String("1")}, changes.Option())
+	require.NoError(t, err)
+	_, err = c.Routing.DeleteRoutingGatewayEndpoint(ctx, &pfclientapi.DeleteRoutingGatewayEndpointRequest{ID: pfclientapi.String("0")}, changes.Option())
+	require.Error(t, err)
+
+	assert.Equal(t, []Subsystem{SubsystemFirewall}, changes.Pending(),
+		"only successful calls to deferred endpoints mark their subsystem")
+	assert.Equal(t, []string{
+		"DELETE /api/v2/firewall/alias?apply=false&id=1",
+		"GET /api/v2/firewall/alias?id=1",
+		"DELETE /api/v2/routing/gateway?id=0",
+	}, api.calls())
+
+	_, err = c.Services.PatchServicesDhcpServerEndpoint(ctx, &pfclientapi.PatchServicesDhcpServerEndpointRequest{}, changes.Option())
+	require.NoError(t, err)
+	assert.Equal(t, []Subsystem{SubsystemFirewall, SubsystemDHCPServer}, changes.Pending())
+}
+
+func TestChangeSetCommit(t *testing.T) {
+	api, c := newApplyServer(t, nil)
+	changes := NewChangeSet(c)
+	changes.Mark(SubsystemHAProxy, SubsystemFirewall, SubsystemInterface, SubsystemRouting, SubsystemFirewall)
+	assert.Equal(t, []Subsystem{SubsystemInterface, SubsystemRouting, SubsystemFirewall, SubsystemHAProxy}, changes.Pending())
+
+	require.NoError(t, changes.Commit(context.Background()))
+	assert.Empty(t, changes.Pending())
+	assert.Equal(t, []string{
+		"POST /api/v2/interface/apply",
+		"POST /api/v2/routing/apply",
+		"POST /api/v2/firewall/apply",
+		"POST /api/v2/services/haproxy/apply",
+	}, api.calls(), "each subsystem is applied once, in order")
+}
+
+func TestChangeSetCommitRetry(t *testing.T) {
+	api, c := newApplyServer(t, nil)
+	api.failures["/api/v2/firewall/apply"] = true
+	changes := NewChangeSet(c)
+	changes.Mark(SubsystemInterface, SubsystemFirewall, SubsystemHAProxy)
+
+	err := changes.Commit(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "apply firewall: ")
+	assert.Equal(t, []Subsystem{SubsystemFirewall, SubsystemHAProxy}, changes.Pending(),
+		"the failed subsystem and those after it stay pending")
+
+	api.mu.Lock()
+	api.failures = map[string]bool{}
+	api.requests = nil
+	api.mu.Unlock()
+	require.NoError(t, changes.Commit(context.Background()))
+	assert.Empty(t, changes.Pending())
+	assert.Equal(t, []string{
+		"POST /api/v2/firewall/apply",
+		"POST /api/v2/services/haproxy/apply",
+	}, api.calls(), "a retry applies only what is still pending")
+}
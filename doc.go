@@ -15,6 +15,19 @@
 //
 //	rules, err := c.Firewall.GetFirewallRulesEndpoint(ctx, &pfclientapi.GetFirewallRulesEndpointRequest{})
 //
-// This root package provides [TLSClient] for the common pfSense self-signed
-// certificate scenario. Pass the result to option.WithHTTPClient.
+// This root package provides HTTP clients for the common pfSense self-signed
+// certificate scenario: [PinnedTLSClient] pins the certificate fingerprint,
+// [KnownHostsTLSClient] trusts it on first use, and [TLSClient] skips
+// verification entirely. Pass the result to option.WithHTTPClient.
+//
+// Connection details for many firewalls can be kept as named profiles in a
+// config file and loaded with [LoadProfile], or read from PFREST_*
+// environment variables with [NewClientFromEnv].
+//
+// Many changes only take effect once their subsystem's apply endpoint is
+// called. A [ChangeSet] batches them and applies each subsystem once, and
+// [ApplyAndWait] blocks until pfSense reports the changes as live.
+//
+// [Resource] gives every model the same List, Get, Create, Update, Delete
+// and ReplaceAll methods; [Resources] returns the adapters of all of them.
 package pfrest
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	pfrest "github.com/danielmichaels/go-pfrest"
+	pfapi "github.com/danielmichaels/go-pfrest/pkg/client"
+	client "github.com/danielmichaels/go-pfrest/pkg/client/client"
+	"github.com/danielmichaels/go-pfrest/reconcile"
+	"gopkg.in/yaml.v3"
+)
+
+// aliasFile is the YAML layout of the desired aliases, e.g.
+//
+//	aliases:
+//	  - name: web_servers
+//	    type: host
+//	    descr: Public web servers
+//	    address: [10.0.0.10, 10.0.0.11]
+type aliasFile struct {
+	Aliases []struct {
+		Name    string   `yaml:"name"`
+		Type    string   `yaml:"type"`
+		Descr   string   `yaml:"descr"`
+		Address []string `yaml:"address"`
+		Detail  []string `yaml:"detail"`
+	} `yaml:"aliases"`
+}
+
+func main() {
+	file := flag.String("file", "aliases.yaml", "YAML file with the desired aliases")
+	profile := flag.String("profile", "", "Profile name in the pfrest config file (default: configure from PFREST_* environment variables)")
+	prune := flag.Bool("prune", false, "Delete aliases that are not in the file")
+	dryRun := flag.Bool("dry-run", false, "Print the plan without applying it")
+	flag.Parse()
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var config aliasFile
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		log.Fatalf("%s: %v", *file, err)
+	}
+	var desired []*pfapi.FirewallAlias
+	for _, alias := range config.Aliases {
+		aliasType, err := pfapi.NewFirewallAliasTypeFromString(alias.Type)
+		if err != nil {
+			log.Fatalf("alias %q: %v", alias.Name, err)
+		}
+		desired = append(desired, &pfapi.FirewallAlias{
+			Name:    pfapi.String(alias.Name),
+			Type:    &aliasType,
+			Descr:   pfapi.String(alias.Descr),
+			Address: alias.Address,
+			Detail:  alias.Detail,
+		})
+	}
+
+	var c *client.Client
+	if *profile != "" {
+		p, loadErr := pfrest.LoadProfile(*profile)
+		if loadErr != nil {
+			log.Fatal(loadErr)
+		}
+		c, err = p.NewClient()
+	} else {
+		c, err = pfrest.NewClientFromEnv()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	opts := reconcile.Options{DryRun: *dryRun, Wait: true}
+	if *prune {
+		opts.Policy = reconcile.Prune
+	}
+	if _, err := reconcile.Aliases(context.Background(), c, desired, opts); err != nil {
+		log.Fatal(err)
+	}
+}
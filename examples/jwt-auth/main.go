@@ -5,11 +5,9 @@ import (
 	"flag"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 
 	pfrest "github.com/danielmichaels/go-pfrest"
-	pfclientapi "github.com/danielmichaels/go-pfrest/pkg/client"
 	client "github.com/danielmichaels/go-pfrest/pkg/client/client"
 	"github.com/danielmichaels/go-pfrest/pkg/client/option"
 )
@@ -27,30 +25,13 @@ func main() {
 	}
 
 	ctx := context.Background()
-	httpClient := pfrest.TLSClient(*insecure)
-
-	basicClient := client.NewClient(
-		option.WithBaseURL(*url),
-		option.WithBasicAuth(*user, *pass),
-		option.WithHTTPClient(httpClient),
-	)
-
-	tokenResp, err := basicClient.Auth.PostAuthJwtEndpoint(ctx, &pfclientapi.PostAuthJwtEndpointRequest{})
-	if err != nil {
-		log.Fatal(err)
-	}
-	if tokenResp.Data == nil || tokenResp.Data.Token == nil {
-		log.Fatal("no JWT token in response")
-	}
-	token := *tokenResp.Data.Token
-	fmt.Printf("JWT token obtained (length=%d)\n", len(token))
 
+	// The JWT is obtained from /api/v2/auth/jwt on the first call, cached,
+	// and refreshed before it expires.
 	jwtClient := client.NewClient(
 		option.WithBaseURL(*url),
-		option.WithHTTPClient(httpClient),
-		option.WithHTTPHeader(http.Header{
-			"Authorization": []string{"Bearer " + token},
-		}),
+		option.WithHTTPClient(pfrest.TLSClient(*insecure)),
+		option.WithJWTAuth(*user, *pass),
 	)
 
 	versionResp, err := jwtClient.System.GetSystemVersionEndpoint(ctx)
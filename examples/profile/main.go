@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	pfrest "github.com/danielmichaels/go-pfrest"
+	client "github.com/danielmichaels/go-pfrest/pkg/client/client"
+)
+
+func main() {
+	profile := flag.String("profile", "", "Profile name in the pfrest config file (default: configure from PFREST_* environment variables)")
+	flag.Parse()
+
+	var (
+		c   *client.Client
+		err error
+	)
+	if *profile != "" {
+		p, loadErr := pfrest.LoadProfile(*profile)
+		if loadErr != nil {
+			log.Fatal(loadErr)
+		}
+		c, err = p.NewClient()
+	} else {
+		c, err = pfrest.NewClientFromEnv()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	resp, err := c.System.GetSystemVersionEndpoint(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.Data != nil && resp.Data.Version != nil {
+		fmt.Printf("pfSense version: %s\n", *resp.Data.Version)
+	}
+}
@@ -87,11 +87,11 @@ func (r *RestapiKey) UnmarshalJSON(data []byte) error {
 
 func (r *RestapiKey) String() string {
 	if len(r._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(r._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(r._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(r); err == nil {
+	if value, err := core.StringifyRedactedJSON(r, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", r)
@@ -143,11 +143,11 @@ func (r *Restapijwt) UnmarshalJSON(data []byte) error {
 
 func (r *Restapijwt) String() string {
 	if len(r._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(r._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(r._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(r); err == nil {
+	if value, err := core.StringifyRedactedJSON(r, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", r)
@@ -239,11 +239,11 @@ func (d *DeleteAuthKeyEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (d *DeleteAuthKeyEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -280,11 +280,11 @@ func (d *DeleteAuthKeyEndpointResponseData) UnmarshalJSON(data []byte) error {
 
 func (d *DeleteAuthKeyEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -319,11 +319,11 @@ func (d *DeleteAuthKeysEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (d *DeleteAuthKeysEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -360,11 +360,11 @@ func (d *DeleteAuthKeysEndpointResponseDataItem) UnmarshalJSON(data []byte) erro
 
 func (d *DeleteAuthKeysEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -455,11 +455,11 @@ func (g *GetAuthKeysEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (g *GetAuthKeysEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -496,11 +496,11 @@ func (g *GetAuthKeysEndpointResponseDataItem) UnmarshalJSON(data []byte) error {
 
 func (g *GetAuthKeysEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -535,11 +535,11 @@ func (p *PostAuthJwtEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (p *PostAuthJwtEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -574,11 +574,11 @@ func (p *PostAuthKeyEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (p *PostAuthKeyEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -615,11 +615,11 @@ func (p *PostAuthKeyEndpointResponseData) UnmarshalJSON(data []byte) error {
 
 func (p *PostAuthKeyEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6,7 +6,6 @@ import (
 	bytes "bytes"
 	context "context"
 	json "encoding/json"
-	errors "errors"
 	fmt "fmt"
 	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
 	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
@@ -17,22 +16,27 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	caller *core.Caller
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
 	options := core.NewRequestOptions(opts...)
 	return &Client{
-		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
 				Client:      options.HTTPClient,
 				MaxAttempts: options.MaxAttempts,
+				Options:     options,
 			},
 		),
-		header: options.ToHeader(),
+	}
+}
+
+// NewClientWithCaller returns a client that issues its calls through the
+// given caller, sharing its options with every other client that does.
+func NewClientWithCaller(caller *core.Caller) *Client {
+	return &Client{
+		caller: caller,
 	}
 }
 
@@ -44,23 +48,20 @@ func (c *Client) PostAuthJwtEndpoint(
 ) (*pkgclient.PostAuthJwtEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/auth/jwt"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -155,9 +156,11 @@ func (c *Client) PostAuthJwtEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Auth", Name: "PostAuthJwtEndpoint", Path: "/api/v2/auth/jwt"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -178,23 +181,20 @@ func (c *Client) PostAuthKeyEndpoint(
 ) (*pkgclient.PostAuthKeyEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/auth/key"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -289,9 +289,11 @@ func (c *Client) PostAuthKeyEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Auth", Name: "PostAuthKeyEndpoint", Path: "/api/v2/auth/key"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -312,10 +314,7 @@ func (c *Client) DeleteAuthKeyEndpoint(
 ) (*pkgclient.DeleteAuthKeyEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -329,14 +328,14 @@ func (c *Client) DeleteAuthKeyEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -431,9 +430,11 @@ func (c *Client) DeleteAuthKeyEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Auth", Name: "DeleteAuthKeyEndpoint", Path: "/api/v2/auth/key"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -453,10 +454,7 @@ func (c *Client) GetAuthKeysEndpoint(
 ) (*pkgclient.GetAuthKeysEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -479,20 +477,22 @@ func (c *Client) GetAuthKeysEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -587,9 +587,11 @@ func (c *Client) GetAuthKeysEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Auth", Name: "GetAuthKeysEndpoint", Path: "/api/v2/auth/keys"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -609,10 +611,7 @@ func (c *Client) DeleteAuthKeysEndpoint(
 ) (*pkgclient.DeleteAuthKeysEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -626,20 +625,22 @@ func (c *Client) DeleteAuthKeysEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -734,9 +735,11 @@ func (c *Client) DeleteAuthKeysEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Auth", Name: "DeleteAuthKeysEndpoint", Path: "/api/v2/auth/keys"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3,6 +3,7 @@
 package client
 
 import (
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
 	auth "github.com/danielmichaels/go-pfrest/pkg/client/auth"
 	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
 	diagnostics "github.com/danielmichaels/go-pfrest/pkg/client/diagnostics"
@@ -16,13 +17,10 @@ import (
 	system "github.com/danielmichaels/go-pfrest/pkg/client/system"
 	user "github.com/danielmichaels/go-pfrest/pkg/client/user"
 	vpn "github.com/danielmichaels/go-pfrest/pkg/client/vpn"
-	http "net/http"
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	caller *core.Caller
 
 	Auth        *auth.Client
 	Diagnostics *diagnostics.Client
@@ -39,25 +37,26 @@ type Client struct {
 
 func NewClient(opts ...option.RequestOption) *Client {
 	options := core.NewRequestOptions(opts...)
+	caller := core.NewCaller(
+		&core.CallerParams{
+			Client:       options.HTTPClient,
+			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
+			ErrorDecoder: pkgclient.DecodeError,
+		},
+	)
 	return &Client{
-		baseURL: options.BaseURL,
-		caller: core.NewCaller(
-			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
-			},
-		),
-		header:      options.ToHeader(),
-		Auth:        auth.NewClient(opts...),
-		Diagnostics: diagnostics.NewClient(opts...),
-		Firewall:    firewall.NewClient(opts...),
-		Graphql:     graphql.NewClient(opts...),
-		Interface:   interface_.NewClient(opts...),
-		Routing:     routing.NewClient(opts...),
-		Services:    services.NewClient(opts...),
-		Status:      status.NewClient(opts...),
-		System:      system.NewClient(opts...),
-		User:        user.NewClient(opts...),
-		Vpn:         vpn.NewClient(opts...),
+		caller:      caller,
+		Auth:        auth.NewClientWithCaller(caller),
+		Diagnostics: diagnostics.NewClientWithCaller(caller),
+		Firewall:    firewall.NewClientWithCaller(caller),
+		Graphql:     graphql.NewClientWithCaller(caller),
+		Interface:   interface_.NewClientWithCaller(caller),
+		Routing:     routing.NewClientWithCaller(caller),
+		Services:    services.NewClientWithCaller(caller),
+		Status:      status.NewClientWithCaller(caller),
+		System:      system.NewClientWithCaller(caller),
+		User:        user.NewClientWithCaller(caller),
+		Vpn:         vpn.NewClientWithCaller(caller),
 	}
 }
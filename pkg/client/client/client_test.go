@@ -13,14 +13,14 @@ import (
 func TestNewClient(t *testing.T) {
 	t.Run("default", func(t *testing.T) {
 		c := NewClient()
-		assert.Empty(t, c.baseURL)
+		assert.Empty(t, c.caller.BaseURL())
 	})
 
 	t.Run("base url", func(t *testing.T) {
 		c := NewClient(
 			option.WithBaseURL("test.co"),
 		)
-		assert.Equal(t, "test.co", c.baseURL)
+		assert.Equal(t, "test.co", c.caller.BaseURL())
 	})
 
 	t.Run("http client", func(t *testing.T) {
@@ -30,7 +30,7 @@ func TestNewClient(t *testing.T) {
 		c := NewClient(
 			option.WithHTTPClient(httpClient),
 		)
-		assert.Empty(t, c.baseURL)
+		assert.Empty(t, c.caller.BaseURL())
 	})
 
 	t.Run("http header", func(t *testing.T) {
@@ -39,7 +39,7 @@ func TestNewClient(t *testing.T) {
 		c := NewClient(
 			option.WithHTTPHeader(header),
 		)
-		assert.Empty(t, c.baseURL)
-		assert.Equal(t, "test", c.header.Get("X-API-Tenancy"))
+		assert.Empty(t, c.caller.BaseURL())
+		assert.Equal(t, "test", c.caller.Header().Get("X-API-Tenancy"))
 	})
 }
@@ -0,0 +1,17 @@
+package client
+
+import (
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+)
+
+// Apply applies the given options to the client and all of its services at
+// once, e.g. to rotate credentials or to point the client at another
+// firewall:
+//
+//	c.Apply(option.WithAPIKey(newKey))
+//
+// It is safe to call while requests are in flight; those requests keep the
+// options they started with.
+func (c *Client) Apply(opts ...option.RequestOption) {
+	c.caller.Apply(opts...)
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	errors "errors"
+	fmt "fmt"
+	http "net/http"
+	httptest "net/http/httptest"
+	testing "testing"
+
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestClientApply(t *testing.T) {
+	serve := func(keys *[]string) *httptest.Server {
+		return httptest.NewServer(
+			http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					*keys = append(*keys, r.Header.Get("x-api-key"))
+					_, _ = w.Write([]byte(`{"data":{}}`))
+				},
+			),
+		)
+	}
+	var oldKeys, newKeys []string
+	oldServer, newServer := serve(&oldKeys), serve(&newKeys)
+	defer oldServer.Close()
+	defer newServer.Close()
+
+	c := NewClient(option.WithBaseURL(oldServer.URL), option.WithAPIKey("old"))
+	_, err := c.System.GetSystemVersionEndpoint(context.Background())
+	require.NoError(t, err)
+
+	c.Apply(option.WithBaseURL(newServer.URL), option.WithAPIKey("new"))
+	_, err = c.System.GetSystemVersionEndpoint(context.Background())
+	require.NoError(t, err)
+	_, err = c.Firewall.GetFirewallAdvancedSettingsEndpoint(context.Background())
+	require.NoError(t, err)
+	_, err = c.Status.GetStatusSystemEndpoint(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"old"}, oldKeys)
+	assert.Equal(t, []string{"new", "new", "new"}, newKeys)
+}
+
+func TestClientApplyRetargetsJWTAuth(t *testing.T) {
+	serve := func(name string, bearers *[]string) *httptest.Server {
+		return httptest.NewServer(
+			http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path == "/api/v2/auth/jwt" {
+						payload := base64.RawURLEncoding.EncodeToString([]byte(`{"exp":4102444800}`))
+						_, _ = fmt.Fprintf(w, `{"data":{"token":"%s.%s.sig"}}`, name, payload)
+						return
+					}
+					*bearers = append(*bearers, r.Header.Get("Authorization"))
+					_, _ = w.Write([]byte(`{"data":{}}`))
+				},
+			),
+		)
+	}
+	var oldBearers, newBearers []string
+	oldServer, newServer := serve("old", &oldBearers), serve("new", &newBearers)
+	defer oldServer.Close()
+	defer newServer.Close()
+
+	c := NewClient(option.WithBaseURL(oldServer.URL), option.WithJWTAuth("admin", "pfsense"))
+	_, err := c.System.GetSystemVersionEndpoint(context.Background())
+	require.NoError(t, err)
+
+	c.Apply(option.WithBaseURL(newServer.URL))
+	_, err = c.System.GetSystemVersionEndpoint(context.Background())
+	require.NoError(t, err)
+	_, err = c.Status.GetStatusSystemEndpoint(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, oldBearers, 1)
+	assert.Contains(t, oldBearers[0], "Bearer old.")
+	require.Len(t, newBearers, 2, "the new firewall is called with its own token, not rejected")
+	for _, bearer := range newBearers {
+		assert.Contains(t, bearer, "Bearer new.")
+	}
+}
+
+func TestClientJWTAuthErrors(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"code":401,"status":"unauthorized","response_id":"AUTH_AUTHENTICATION_FAILED","message":"Authentication failed."}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	c := NewClient(option.WithBaseURL(server.URL), option.WithJWTAuth("admin", "wrong"), option.WithMaxAttempts(1))
+	_, err := c.System.GetSystemVersionEndpoint(context.Background())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, pkgclient.ErrUnauthorized), "%v", err)
+	var apiError *pkgclient.Error
+	require.True(t, errors.As(err, &apiError), "%v", err)
+	assert.Equal(t, "AUTH_AUTHENTICATION_FAILED", apiError.ResponseID)
+}
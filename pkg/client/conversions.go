@@ -0,0 +1,13593 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package pfclientapi
+
+// ToPost returns a request that creates a copy of a. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with a.
+func (a *AcmeAccountKey) ToPost() *PostServicesAcmeAccountKeyEndpointRequest {
+	if a == nil {
+		return &PostServicesAcmeAccountKeyEndpointRequest{}
+	}
+	return &PostServicesAcmeAccountKeyEndpointRequest{
+		Name:       a.Name,
+		Descr:      a.Descr,
+		Email:      a.Email,
+		Acmeserver: a.Acmeserver,
+		Accountkey: a.Accountkey,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with a. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with a.
+func (a *AcmeAccountKey) ToPatch(id int) *PatchServicesAcmeAccountKeyEndpointRequest {
+	if a == nil {
+		return &PatchServicesAcmeAccountKeyEndpointRequest{ID: id}
+	}
+	return &PatchServicesAcmeAccountKeyEndpointRequest{
+		ID:         id,
+		Name:       a.Name,
+		Descr:      a.Descr,
+		Email:      a.Email,
+		Acmeserver: a.Acmeserver,
+		Accountkey: a.Accountkey,
+	}
+}
+
+// ToPutItem returns a as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with a.
+func (a *AcmeAccountKey) ToPutItem() *PutServicesAcmeAccountKeysEndpointRequestItem {
+	if a == nil {
+		return nil
+	}
+	return &PutServicesAcmeAccountKeysEndpointRequestItem{
+		Name:       a.Name,
+		Descr:      a.Descr,
+		Email:      a.Email,
+		Acmeserver: a.Acmeserver,
+		Accountkey: a.Accountkey,
+	}
+}
+
+// ToModel copies g into a new AcmeAccountKey, sharing its slices and pointers.
+func (g *GetServicesAcmeAccountKeyEndpointResponseData) ToModel() *AcmeAccountKey {
+	if g == nil {
+		return nil
+	}
+	return &AcmeAccountKey{
+		Name:       g.Name,
+		Descr:      g.Descr,
+		Email:      g.Email,
+		Acmeserver: g.Acmeserver,
+		Accountkey: g.Accountkey,
+	}
+}
+
+// ToModel copies p into a new AcmeAccountKey, sharing its slices and pointers.
+func (p *PostServicesAcmeAccountKeyEndpointResponseData) ToModel() *AcmeAccountKey {
+	if p == nil {
+		return nil
+	}
+	return &AcmeAccountKey{
+		Name:       p.Name,
+		Descr:      p.Descr,
+		Email:      p.Email,
+		Acmeserver: p.Acmeserver,
+		Accountkey: p.Accountkey,
+	}
+}
+
+// ToModel copies p into a new AcmeAccountKey, sharing its slices and pointers.
+func (p *PatchServicesAcmeAccountKeyEndpointResponseData) ToModel() *AcmeAccountKey {
+	if p == nil {
+		return nil
+	}
+	return &AcmeAccountKey{
+		Name:       p.Name,
+		Descr:      p.Descr,
+		Email:      p.Email,
+		Acmeserver: p.Acmeserver,
+		Accountkey: p.Accountkey,
+	}
+}
+
+// ToModel copies g into a new AcmeAccountKey, sharing its slices and pointers.
+func (g *GetServicesAcmeAccountKeysEndpointResponseDataItem) ToModel() *AcmeAccountKey {
+	if g == nil {
+		return nil
+	}
+	return &AcmeAccountKey{
+		Name:       g.Name,
+		Descr:      g.Descr,
+		Email:      g.Email,
+		Acmeserver: g.Acmeserver,
+		Accountkey: g.Accountkey,
+	}
+}
+
+// ToPost returns a request that creates a copy of a. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with a.
+func (a *AcmeCertificateAction) ToPost(parentID int) *PostServicesAcmeCertificateActionEndpointRequest {
+	if a == nil {
+		return &PostServicesAcmeCertificateActionEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesAcmeCertificateActionEndpointRequest{
+		ParentID: parentID,
+		Status:   a.Status,
+		Command:  a.Command,
+		Method:   a.Method,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with a. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with a.
+func (a *AcmeCertificateAction) ToPatch(parentID, id int) *PatchServicesAcmeCertificateActionEndpointRequest {
+	if a == nil {
+		return &PatchServicesAcmeCertificateActionEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesAcmeCertificateActionEndpointRequest{
+		ParentID: parentID,
+		ID:       id,
+		Status:   a.Status,
+		Command:  a.Command,
+		Method:   a.Method,
+	}
+}
+
+// ToModel copies g into a new AcmeCertificateAction, sharing its slices and pointers.
+func (g *GetServicesAcmeCertificateActionEndpointResponseData) ToModel() *AcmeCertificateAction {
+	if g == nil {
+		return nil
+	}
+	return &AcmeCertificateAction{
+		Status:  g.Status,
+		Command: g.Command,
+		Method:  g.Method,
+	}
+}
+
+// ToModel copies p into a new AcmeCertificateAction, sharing its slices and pointers.
+func (p *PostServicesAcmeCertificateActionEndpointResponseData) ToModel() *AcmeCertificateAction {
+	if p == nil {
+		return nil
+	}
+	return &AcmeCertificateAction{
+		Status:  p.Status,
+		Command: p.Command,
+		Method:  p.Method,
+	}
+}
+
+// ToModel copies p into a new AcmeCertificateAction, sharing its slices and pointers.
+func (p *PatchServicesAcmeCertificateActionEndpointResponseData) ToModel() *AcmeCertificateAction {
+	if p == nil {
+		return nil
+	}
+	return &AcmeCertificateAction{
+		Status:  p.Status,
+		Command: p.Command,
+		Method:  p.Method,
+	}
+}
+
+// ToPost returns a request that creates a copy of a. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with a.
+func (a *AcmeCertificateDomain) ToPost(parentID int) *PostServicesAcmeCertificateDomainEndpointRequest {
+	if a == nil {
+		return &PostServicesAcmeCertificateDomainEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesAcmeCertificateDomainEndpointRequest{
+		ParentID:                 parentID,
+		Name:                     a.Name,
+		Status:                   a.Status,
+		Method:                   a.Method,
+		Webrootfolder:            a.Webrootfolder,
+		Webrootftpftpserver:      a.Webrootftpftpserver,
+		Webrootftpusername:       a.Webrootftpusername,
+		Webrootftppassword:       a.Webrootftppassword,
+		Webrootftpfolder:         a.Webrootftpfolder,
+		Standaloneport:           a.Standaloneport,
+		Standaloneipv6:           a.Standaloneipv6,
+		Standalonetlsport:        a.Standalonetlsport,
+		NsupdateServer:           a.NsupdateServer,
+		NsupdateKeyname:          a.NsupdateKeyname,
+		NsupdateKeyalgo:          a.NsupdateKeyalgo,
+		NsupdateKey:              a.NsupdateKey,
+		NsupdateZone:             a.NsupdateZone,
+		One984HostingUsername:    a.One984HostingUsername,
+		One984HostingPassword:    a.One984HostingPassword,
+		AcmeproxyEndpoint:        a.AcmeproxyEndpoint,
+		AcmeproxyUsername:        a.AcmeproxyUsername,
+		AcmeproxyPassword:        a.AcmeproxyPassword,
+		AcmednsUsername:          a.AcmednsUsername,
+		AcmednsPassword:          a.AcmednsPassword,
+		AcmednsSubdomain:         a.AcmednsSubdomain,
+		AcmednsUpdateURL:         a.AcmednsUpdateURL,
+		Active24Token:            a.Active24Token,
+		AkamaiHost:               a.AkamaiHost,
+		AkamaiAccessToken:        a.AkamaiAccessToken,
+		AkamaiClientToken:        a.AkamaiClientToken,
+		AkamaiClientSecret:       a.AkamaiClientSecret,
+		AliKey:                   a.AliKey,
+		AliSecret:                a.AliSecret,
+		KasLogin:                 a.KasLogin,
+		KasAuthtype:              a.KasAuthtype,
+		KasAuthdata:              a.KasAuthdata,
+		AdAPIKey:                 a.AdAPIKey,
+		AnxToken:                 a.AnxToken,
+		AfAPIUsername:            a.AfAPIUsername,
+		AfAPIPassword:            a.AfAPIPassword,
+		ArvanToken:               a.ArvanToken,
+		AuroraKey:                a.AuroraKey,
+		AuroraSecret:             a.AuroraSecret,
+		AutodnsUser:              a.AutodnsUser,
+		AutodnsPassword:          a.AutodnsPassword,
+		AutodnsContext:           a.AutodnsContext,
+		AwsAccessKeyID:           a.AwsAccessKeyID,
+		AwsSecretAccessKey:       a.AwsSecretAccessKey,
+		AwsDNSSlowrate:           a.AwsDNSSlowrate,
+		AzionEmail:               a.AzionEmail,
+		AzionPassword:            a.AzionPassword,
+		AzurednsSubscriptionid:   a.AzurednsSubscriptionid,
+		AzurednsTenantid:         a.AzurednsTenantid,
+		AzurednsAppid:            a.AzurednsAppid,
+		AzurednsClientsecret:     a.AzurednsClientsecret,
+		BookmynameUsername:       a.BookmynameUsername,
+		BookmynamePassword:       a.BookmynamePassword,
+		BunnyAPIKey:              a.BunnyAPIKey,
+		ClouddnsEmail:            a.ClouddnsEmail,
+		ClouddnsClientID:         a.ClouddnsClientID,
+		ClouddnsPassword:         a.ClouddnsPassword,
+		CloudnsAuthID:            a.CloudnsAuthID,
+		CloudnsSubAuthID:         a.CloudnsSubAuthID,
+		CloudnsAuthPassword:      a.CloudnsAuthPassword,
+		CfKey:                    a.CfKey,
+		CfEmail:                  a.CfEmail,
+		CfToken:                  a.CfToken,
+		CfAccountID:              a.CfAccountID,
+		CfZoneID:                 a.CfZoneID,
+		ConohaUsername:           a.ConohaUsername,
+		ConohaPassword:           a.ConohaPassword,
+		ConohaTenantid:           a.ConohaTenantid,
+		ConohaIdentityserviceapi: a.ConohaIdentityserviceapi,
+		ConstellixKey:            a.ConstellixKey,
+		ConstellixSecret:         a.ConstellixSecret,
+		CpanelUsername:           a.CpanelUsername,
+		CpanelApitoken:           a.CpanelApitoken,
+		CpanelHostname:           a.CpanelHostname,
+		CnUser:                   a.CnUser,
+		CnPassword:               a.CnPassword,
+		CuranetAuthclientid:      a.CuranetAuthclientid,
+		CuranetAuthsecret:        a.CuranetAuthsecret,
+		CyUsername:               a.CyUsername,
+		CyPassword:               a.CyPassword,
+		DdnssToken:               a.DdnssToken,
+		DedynToken:               a.DedynToken,
+		DedynName:                a.DedynName,
+		DoAPIKey:                 a.DoAPIKey,
+		DaAPI:                    a.DaAPI,
+		DaAPIInsecure:            a.DaAPIInsecure,
+		DnsexitAPIKey:            a.DnsexitAPIKey,
+		DnsexitAuthUser:          a.DnsexitAuthUser,
+		DnsexitAuthPass:          a.DnsexitAuthPass,
+		DnshomeSubdomain:         a.DnshomeSubdomain,
+		DnshomeSubdomainpassword: a.DnshomeSubdomainpassword,
+		DnsimpleOauthToken:       a.DnsimpleOauthToken,
+		MeKey:                    a.MeKey,
+		MeSecret:                 a.MeSecret,
+		DnsservicesUsername:      a.DnsservicesUsername,
+		DnsservicesPassword:      a.DnsservicesPassword,
+		DoLetoken:                a.DoLetoken,
+		DoPid:                    a.DoPid,
+		DoPw:                     a.DoPw,
+		DomeneshopToken:          a.DomeneshopToken,
+		DomeneshopSecret:         a.DomeneshopSecret,
+		DpID:                     a.DpID,
+		DpKey:                    a.DpKey,
+		DpiID:                    a.DpiID,
+		DpiKey:                   a.DpiKey,
+		DhAPIKey:                 a.DhAPIKey,
+		DuckdnsToken:             a.DuckdnsToken,
+		DdAPIUser:                a.DdAPIUser,
+		DdAPIKey:                 a.DdAPIKey,
+		DynCustomer:              a.DynCustomer,
+		DynUsername:              a.DynUsername,
+		DynPassword:              a.DynPassword,
+		DfUser:                   a.DfUser,
+		DfPassword:               a.DfPassword,
+		DynuClientid:             a.DynuClientid,
+		DynuSecret:               a.DynuSecret,
+		EasydnsKey:               a.EasydnsKey,
+		EasydnsToken:             a.EasydnsToken,
+		EuservUsername:           a.EuservUsername,
+		EuservPassword:           a.EuservPassword,
+		ExoscaleAPIKey:           a.ExoscaleAPIKey,
+		ExoscaleSecretKey:        a.ExoscaleSecretKey,
+		FornexAPIKey:             a.FornexAPIKey,
+		FreednsUser:              a.FreednsUser,
+		FreednsPassword:          a.FreednsPassword,
+		GandiLivednsKey:          a.GandiLivednsKey,
+		GcoreKey:                 a.GcoreKey,
+		GeoscalingUsername:       a.GeoscalingUsername,
+		GeoscalingPassword:       a.GeoscalingPassword,
+		GdKey:                    a.GdKey,
+		GdSecret:                 a.GdSecret,
+		GoogledomainsAccessToken: a.GoogledomainsAccessToken,
+		GoogledomainsZone:        a.GoogledomainsZone,
+		HetznerToken:             a.HetznerToken,
+		HexonetLogin:             a.HexonetLogin,
+		HexonetPassword:          a.HexonetPassword,
+		HuaweicloudUsername:      a.HuaweicloudUsername,
+		HuaweicloudPassword:      a.HuaweicloudPassword,
+		HuaweicloudDomainname:    a.HuaweicloudDomainname,
+		HeUsername:               a.HeUsername,
+		HePassword:               a.HePassword,
+		HostingdeApikey:          a.HostingdeApikey,
+		HostingdeEndpoint:        a.HostingdeEndpoint,
+		InfobloxCreds:            a.InfobloxCreds,
+		InfobloxServer:           a.InfobloxServer,
+		InfobloxView:             a.InfobloxView,
+		InfomaniakAPIToken:       a.InfomaniakAPIToken,
+		DefaultInfomaniakApiUrl:  a.DefaultInfomaniakApiUrl,
+		InfomaniakTTL:            a.InfomaniakTTL,
+		IonosPrefix:              a.IonosPrefix,
+		IonosSecret:              a.IonosSecret,
+		Ipv64Token:               a.Ipv64Token,
+		InternetbsAPIKey:         a.InternetbsAPIKey,
+		InternetbsAPIPassword:    a.InternetbsAPIPassword,
+		InwxUsername:             a.InwxUsername,
+		InwxPassword:             a.InwxPassword,
+		InwxSharedSecret:         a.InwxSharedSecret,
+		IspcUser:                 a.IspcUser,
+		IspcPassword:             a.IspcPassword,
+		IspcAPI:                  a.IspcAPI,
+		IspcAPIInsecure:          a.IspcAPIInsecure,
+		JdAccessKeyID:            a.JdAccessKeyID,
+		JdAccessKeySecret:        a.JdAccessKeySecret,
+		JdRegion:                 a.JdRegion,
+		JokerUsername:            a.JokerUsername,
+		JokerPassword:            a.JokerPassword,
+		KappernetdnsKey:          a.KappernetdnsKey,
+		KappernetdnsSecret:       a.KappernetdnsSecret,
+		KinghostUsername:         a.KinghostUsername,
+		KinghostPassword:         a.KinghostPassword,
+		KnotServer:               a.KnotServer,
+		KnotKey:                  a.KnotKey,
+		LaID:                     a.LaID,
+		LaKey:                    a.LaKey,
+		LswKey:                   a.LswKey,
+		LimacityApikey:           a.LimacityApikey,
+		LinodeAPIKey:             a.LinodeAPIKey,
+		LinodeV4APIKey:           a.LinodeV4APIKey,
+		LoopiaUser:               a.LoopiaUser,
+		LoopiaPassword:           a.LoopiaPassword,
+		LuaKey:                   a.LuaKey,
+		LuaEmail:                 a.LuaEmail,
+		MiabUsername:             a.MiabUsername,
+		MiabPassword:             a.MiabPassword,
+		MiabServer:               a.MiabServer,
+		MisakaKey:                a.MisakaKey,
+		MydnsjpMasterid:          a.MydnsjpMasterid,
+		MydnsjpPassword:          a.MydnsjpPassword,
+		MbAk:                     a.MbAk,
+		MbAs:                     a.MbAs,
+		NamecomUsername:          a.NamecomUsername,
+		NamecomToken:             a.NamecomToken,
+		NamecheapAPIKey:          a.NamecheapAPIKey,
+		NamecheapUsername:        a.NamecheapUsername,
+		NmUser:                   a.NmUser,
+		NmSha256:                 a.NmSha256,
+		NaneloToken:              a.NaneloToken,
+		NederhostKey:             a.NederhostKey,
+		NamesiloKey:              a.NamesiloKey,
+		NeodigitAPIToken:         a.NeodigitAPIToken,
+		NcApikey:                 a.NcApikey,
+		NcApipw:                  a.NcApipw,
+		NcCid:                    a.NcCid,
+		NetlifyAccessToken:       a.NetlifyAccessToken,
+		NicClientid:              a.NicClientid,
+		NicClientsecret:          a.NicClientsecret,
+		NicUsername:              a.NicUsername,
+		NicPassword:              a.NicPassword,
+		Ns1Key:                   a.Ns1Key,
+		NwAPIToken:               a.NwAPIToken,
+		NwAPIEndpoint:            a.NwAPIEndpoint,
+		OnecomUser:               a.OnecomUser,
+		OnecomPassword:           a.OnecomPassword,
+		OnlineAPIKey:             a.OnlineAPIKey,
+		OciCliTenancy:            a.OciCliTenancy,
+		OciCliUser:               a.OciCliUser,
+		OciCliRegion:             a.OciCliRegion,
+		OciCliKey:                a.OciCliKey,
+		OpenproviderUser:         a.OpenproviderUser,
+		OpenproviderPasswordhash: a.OpenproviderPasswordhash,
+		OvhAk:                    a.OvhAk,
+		OvhAs:                    a.OvhAs,
+		OvhCk:                    a.OvhCk,
+		OvhEndPoint:              a.OvhEndPoint,
+		PleskxmlUser:             a.PleskxmlUser,
+		PleskxmlPass:             a.PleskxmlPass,
+		PleskxmlURI:              a.PleskxmlURI,
+		PointhqKey:               a.PointhqKey,
+		PointhqEmail:             a.PointhqEmail,
+		PorkbunAPIKey:            a.PorkbunAPIKey,
+		PorkbunSecretAPIKey:      a.PorkbunSecretAPIKey,
+		PdnsURL:                  a.PdnsURL,
+		PdnsServerid:             a.PdnsServerid,
+		PdnsToken:                a.PdnsToken,
+		PdnsTTL:                  a.PdnsTTL,
+		RackcorpApiuuid:          a.RackcorpApiuuid,
+		RackcorpApisecret:        a.RackcorpApisecret,
+		RackspaceUsername:        a.RackspaceUsername,
+		RackspaceApikey:          a.RackspaceApikey,
+		Rage4Username:            a.Rage4Username,
+		Rage4Token:               a.Rage4Token,
+		Rcode0APIToken:           a.Rcode0APIToken,
+		Rcode0URL:                a.Rcode0URL,
+		Rcode0TTL:                a.Rcode0TTL,
+		RegruAPIUsername:         a.RegruAPIUsername,
+		RegruAPIPassword:         a.RegruAPIPassword,
+		ScalewayAPIToken:         a.ScalewayAPIToken,
+		SchlundtechUser:          a.SchlundtechUser,
+		SchlundtechPassword:      a.SchlundtechPassword,
+		SlKey:                    a.SlKey,
+		SelfhostdnsUsername:      a.SelfhostdnsUsername,
+		SelfhostdnsPassword:      a.SelfhostdnsPassword,
+		SelfhostdnsMap:           a.SelfhostdnsMap,
+		ServercowAPIUsername:     a.ServercowAPIUsername,
+		ServercowAPIPassword:     a.ServercowAPIPassword,
+		SimplyAccountname:        a.SimplyAccountname,
+		SimplyApikey:             a.SimplyApikey,
+		SimplyAPI:                a.SimplyAPI,
+		Tele3Key:                 a.Tele3Key,
+		Tele3Secret:              a.Tele3Secret,
+		TencentSecretid:          a.TencentSecretid,
+		TencentSecretkey:         a.TencentSecretkey,
+		UdrUser:                  a.UdrUser,
+		UdrPass:                  a.UdrPass,
+		UltraUsr:                 a.UltraUsr,
+		UltraPwd:                 a.UltraPwd,
+		UnoUser:                  a.UnoUser,
+		UnoKey:                   a.UnoKey,
+		VariomediaAPIToken:       a.VariomediaAPIToken,
+		VeespUser:                a.VeespUser,
+		VeespPassword:            a.VeespPassword,
+		VercelToken:              a.VercelToken,
+		VscaleAPIKey:             a.VscaleAPIKey,
+		VultrAPIKey:              a.VultrAPIKey,
+		WsApikey:                 a.WsApikey,
+		WsApisecret:              a.WsApisecret,
+		WestUsername:             a.WestUsername,
+		WestKey:                  a.WestKey,
+		World4YouUsername:        a.World4YouUsername,
+		World4YouPassword:        a.World4YouPassword,
+		PddToken:                 a.PddToken,
+		YcZoneID:                 a.YcZoneID,
+		YcFolderID:               a.YcFolderID,
+		YcSaID:                   a.YcSaID,
+		YcSaKeyID:                a.YcSaKeyID,
+		YcSaKeyFilePemB64:        a.YcSaKeyFilePemB64,
+		ZmKey:                    a.ZmKey,
+		ZoneUsername:             a.ZoneUsername,
+		ZoneKey:                  a.ZoneKey,
+		ZiloreKey:                a.ZiloreKey,
+		Anydnschallengealias:     a.Anydnschallengealias,
+		Anydnschallengedomain:    a.Anydnschallengedomain,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with a. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with a.
+func (a *AcmeCertificateDomain) ToPatch(parentID, id int) *PatchServicesAcmeCertificateDomainEndpointRequest {
+	if a == nil {
+		return &PatchServicesAcmeCertificateDomainEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesAcmeCertificateDomainEndpointRequest{
+		ParentID:                 parentID,
+		ID:                       id,
+		Name:                     a.Name,
+		Status:                   a.Status,
+		Method:                   a.Method,
+		Webrootfolder:            a.Webrootfolder,
+		Webrootftpftpserver:      a.Webrootftpftpserver,
+		Webrootftpusername:       a.Webrootftpusername,
+		Webrootftppassword:       a.Webrootftppassword,
+		Webrootftpfolder:         a.Webrootftpfolder,
+		Standaloneport:           a.Standaloneport,
+		Standaloneipv6:           a.Standaloneipv6,
+		Standalonetlsport:        a.Standalonetlsport,
+		NsupdateServer:           a.NsupdateServer,
+		NsupdateKeyname:          a.NsupdateKeyname,
+		NsupdateKeyalgo:          a.NsupdateKeyalgo,
+		NsupdateKey:              a.NsupdateKey,
+		NsupdateZone:             a.NsupdateZone,
+		One984HostingUsername:    a.One984HostingUsername,
+		One984HostingPassword:    a.One984HostingPassword,
+		AcmeproxyEndpoint:        a.AcmeproxyEndpoint,
+		AcmeproxyUsername:        a.AcmeproxyUsername,
+		AcmeproxyPassword:        a.AcmeproxyPassword,
+		AcmednsUsername:          a.AcmednsUsername,
+		AcmednsPassword:          a.AcmednsPassword,
+		AcmednsSubdomain:         a.AcmednsSubdomain,
+		AcmednsUpdateURL:         a.AcmednsUpdateURL,
+		Active24Token:            a.Active24Token,
+		AkamaiHost:               a.AkamaiHost,
+		AkamaiAccessToken:        a.AkamaiAccessToken,
+		AkamaiClientToken:        a.AkamaiClientToken,
+		AkamaiClientSecret:       a.AkamaiClientSecret,
+		AliKey:                   a.AliKey,
+		AliSecret:                a.AliSecret,
+		KasLogin:                 a.KasLogin,
+		KasAuthtype:              a.KasAuthtype,
+		KasAuthdata:              a.KasAuthdata,
+		AdAPIKey:                 a.AdAPIKey,
+		AnxToken:                 a.AnxToken,
+		AfAPIUsername:            a.AfAPIUsername,
+		AfAPIPassword:            a.AfAPIPassword,
+		ArvanToken:               a.ArvanToken,
+		AuroraKey:                a.AuroraKey,
+		AuroraSecret:             a.AuroraSecret,
+		AutodnsUser:              a.AutodnsUser,
+		AutodnsPassword:          a.AutodnsPassword,
+		AutodnsContext:           a.AutodnsContext,
+		AwsAccessKeyID:           a.AwsAccessKeyID,
+		AwsSecretAccessKey:       a.AwsSecretAccessKey,
+		AwsDNSSlowrate:           a.AwsDNSSlowrate,
+		AzionEmail:               a.AzionEmail,
+		AzionPassword:            a.AzionPassword,
+		AzurednsSubscriptionid:   a.AzurednsSubscriptionid,
+		AzurednsTenantid:         a.AzurednsTenantid,
+		AzurednsAppid:            a.AzurednsAppid,
+		AzurednsClientsecret:     a.AzurednsClientsecret,
+		BookmynameUsername:       a.BookmynameUsername,
+		BookmynamePassword:       a.BookmynamePassword,
+		BunnyAPIKey:              a.BunnyAPIKey,
+		ClouddnsEmail:            a.ClouddnsEmail,
+		ClouddnsClientID:         a.ClouddnsClientID,
+		ClouddnsPassword:         a.ClouddnsPassword,
+		CloudnsAuthID:            a.CloudnsAuthID,
+		CloudnsSubAuthID:         a.CloudnsSubAuthID,
+		CloudnsAuthPassword:      a.CloudnsAuthPassword,
+		CfKey:                    a.CfKey,
+		CfEmail:                  a.CfEmail,
+		CfToken:                  a.CfToken,
+		CfAccountID:              a.CfAccountID,
+		CfZoneID:                 a.CfZoneID,
+		ConohaUsername:           a.ConohaUsername,
+		ConohaPassword:           a.ConohaPassword,
+		ConohaTenantid:           a.ConohaTenantid,
+		ConohaIdentityserviceapi: a.ConohaIdentityserviceapi,
+		ConstellixKey:            a.ConstellixKey,
+		ConstellixSecret:         a.ConstellixSecret,
+		CpanelUsername:           a.CpanelUsername,
+		CpanelApitoken:           a.CpanelApitoken,
+		CpanelHostname:           a.CpanelHostname,
+		CnUser:                   a.CnUser,
+		CnPassword:               a.CnPassword,
+		CuranetAuthclientid:      a.CuranetAuthclientid,
+		CuranetAuthsecret:        a.CuranetAuthsecret,
+		CyUsername:               a.CyUsername,
+		CyPassword:               a.CyPassword,
+		DdnssToken:               a.DdnssToken,
+		DedynToken:               a.DedynToken,
+		DedynName:                a.DedynName,
+		DoAPIKey:                 a.DoAPIKey,
+		DaAPI:                    a.DaAPI,
+		DaAPIInsecure:            a.DaAPIInsecure,
+		DnsexitAPIKey:            a.DnsexitAPIKey,
+		DnsexitAuthUser:          a.DnsexitAuthUser,
+		DnsexitAuthPass:          a.DnsexitAuthPass,
+		DnshomeSubdomain:         a.DnshomeSubdomain,
+		DnshomeSubdomainpassword: a.DnshomeSubdomainpassword,
+		DnsimpleOauthToken:       a.DnsimpleOauthToken,
+		MeKey:                    a.MeKey,
+		MeSecret:                 a.MeSecret,
+		DnsservicesUsername:      a.DnsservicesUsername,
+		DnsservicesPassword:      a.DnsservicesPassword,
+		DoLetoken:                a.DoLetoken,
+		DoPid:                    a.DoPid,
+		DoPw:                     a.DoPw,
+		DomeneshopToken:          a.DomeneshopToken,
+		DomeneshopSecret:         a.DomeneshopSecret,
+		DpID:                     a.DpID,
+		DpKey:                    a.DpKey,
+		DpiID:                    a.DpiID,
+		DpiKey:                   a.DpiKey,
+		DhAPIKey:                 a.DhAPIKey,
+		DuckdnsToken:             a.DuckdnsToken,
+		DdAPIUser:                a.DdAPIUser,
+		DdAPIKey:                 a.DdAPIKey,
+		DynCustomer:              a.DynCustomer,
+		DynUsername:              a.DynUsername,
+		DynPassword:              a.DynPassword,
+		DfUser:                   a.DfUser,
+		DfPassword:               a.DfPassword,
+		DynuClientid:             a.DynuClientid,
+		DynuSecret:               a.DynuSecret,
+		EasydnsKey:               a.EasydnsKey,
+		EasydnsToken:             a.EasydnsToken,
+		EuservUsername:           a.EuservUsername,
+		EuservPassword:           a.EuservPassword,
+		ExoscaleAPIKey:           a.ExoscaleAPIKey,
+		ExoscaleSecretKey:        a.ExoscaleSecretKey,
+		FornexAPIKey:             a.FornexAPIKey,
+		FreednsUser:              a.FreednsUser,
+		FreednsPassword:          a.FreednsPassword,
+		GandiLivednsKey:          a.GandiLivednsKey,
+		GcoreKey:                 a.GcoreKey,
+		GeoscalingUsername:       a.GeoscalingUsername,
+		GeoscalingPassword:       a.GeoscalingPassword,
+		GdKey:                    a.GdKey,
+		GdSecret:                 a.GdSecret,
+		GoogledomainsAccessToken: a.GoogledomainsAccessToken,
+		GoogledomainsZone:        a.GoogledomainsZone,
+		HetznerToken:             a.HetznerToken,
+		HexonetLogin:             a.HexonetLogin,
+		HexonetPassword:          a.HexonetPassword,
+		HuaweicloudUsername:      a.HuaweicloudUsername,
+		HuaweicloudPassword:      a.HuaweicloudPassword,
+		HuaweicloudDomainname:    a.HuaweicloudDomainname,
+		HeUsername:               a.HeUsername,
+		HePassword:               a.HePassword,
+		HostingdeApikey:          a.HostingdeApikey,
+		HostingdeEndpoint:        a.HostingdeEndpoint,
+		InfobloxCreds:            a.InfobloxCreds,
+		InfobloxServer:           a.InfobloxServer,
+		InfobloxView:             a.InfobloxView,
+		InfomaniakAPIToken:       a.InfomaniakAPIToken,
+		DefaultInfomaniakApiUrl:  a.DefaultInfomaniakApiUrl,
+		InfomaniakTTL:            a.InfomaniakTTL,
+		IonosPrefix:              a.IonosPrefix,
+		IonosSecret:              a.IonosSecret,
+		Ipv64Token:               a.Ipv64Token,
+		InternetbsAPIKey:         a.InternetbsAPIKey,
+		InternetbsAPIPassword:    a.InternetbsAPIPassword,
+		InwxUsername:             a.InwxUsername,
+		InwxPassword:             a.InwxPassword,
+		InwxSharedSecret:         a.InwxSharedSecret,
+		IspcUser:                 a.IspcUser,
+		IspcPassword:             a.IspcPassword,
+		IspcAPI:                  a.IspcAPI,
+		IspcAPIInsecure:          a.IspcAPIInsecure,
+		JdAccessKeyID:            a.JdAccessKeyID,
+		JdAccessKeySecret:        a.JdAccessKeySecret,
+		JdRegion:                 a.JdRegion,
+		JokerUsername:            a.JokerUsername,
+		JokerPassword:            a.JokerPassword,
+		KappernetdnsKey:          a.KappernetdnsKey,
+		KappernetdnsSecret:       a.KappernetdnsSecret,
+		KinghostUsername:         a.KinghostUsername,
+		KinghostPassword:         a.KinghostPassword,
+		KnotServer:               a.KnotServer,
+		KnotKey:                  a.KnotKey,
+		LaID:                     a.LaID,
+		LaKey:                    a.LaKey,
+		LswKey:                   a.LswKey,
+		LimacityApikey:           a.LimacityApikey,
+		LinodeAPIKey:             a.LinodeAPIKey,
+		LinodeV4APIKey:           a.LinodeV4APIKey,
+		LoopiaUser:               a.LoopiaUser,
+		LoopiaPassword:           a.LoopiaPassword,
+		LuaKey:                   a.LuaKey,
+		LuaEmail:                 a.LuaEmail,
+		MiabUsername:             a.MiabUsername,
+		MiabPassword:             a.MiabPassword,
+		MiabServer:               a.MiabServer,
+		MisakaKey:                a.MisakaKey,
+		MydnsjpMasterid:          a.MydnsjpMasterid,
+		MydnsjpPassword:          a.MydnsjpPassword,
+		MbAk:                     a.MbAk,
+		MbAs:                     a.MbAs,
+		NamecomUsername:          a.NamecomUsername,
+		NamecomToken:             a.NamecomToken,
+		NamecheapAPIKey:          a.NamecheapAPIKey,
+		NamecheapUsername:        a.NamecheapUsername,
+		NmUser:                   a.NmUser,
+		NmSha256:                 a.NmSha256,
+		NaneloToken:              a.NaneloToken,
+		NederhostKey:             a.NederhostKey,
+		NamesiloKey:              a.NamesiloKey,
+		NeodigitAPIToken:         a.NeodigitAPIToken,
+		NcApikey:                 a.NcApikey,
+		NcApipw:                  a.NcApipw,
+		NcCid:                    a.NcCid,
+		NetlifyAccessToken:       a.NetlifyAccessToken,
+		NicClientid:              a.NicClientid,
+		NicClientsecret:          a.NicClientsecret,
+		NicUsername:              a.NicUsername,
+		NicPassword:              a.NicPassword,
+		Ns1Key:                   a.Ns1Key,
+		NwAPIToken:               a.NwAPIToken,
+		NwAPIEndpoint:            a.NwAPIEndpoint,
+		OnecomUser:               a.OnecomUser,
+		OnecomPassword:           a.OnecomPassword,
+		OnlineAPIKey:             a.OnlineAPIKey,
+		OciCliTenancy:            a.OciCliTenancy,
+		OciCliUser:               a.OciCliUser,
+		OciCliRegion:             a.OciCliRegion,
+		OciCliKey:                a.OciCliKey,
+		OpenproviderUser:         a.OpenproviderUser,
+		OpenproviderPasswordhash: a.OpenproviderPasswordhash,
+		OvhAk:                    a.OvhAk,
+		OvhAs:                    a.OvhAs,
+		OvhCk:                    a.OvhCk,
+		OvhEndPoint:              a.OvhEndPoint,
+		PleskxmlUser:             a.PleskxmlUser,
+		PleskxmlPass:             a.PleskxmlPass,
+		PleskxmlURI:              a.PleskxmlURI,
+		PointhqKey:               a.PointhqKey,
+		PointhqEmail:             a.PointhqEmail,
+		PorkbunAPIKey:            a.PorkbunAPIKey,
+		PorkbunSecretAPIKey:      a.PorkbunSecretAPIKey,
+		PdnsURL:                  a.PdnsURL,
+		PdnsServerid:             a.PdnsServerid,
+		PdnsToken:                a.PdnsToken,
+		PdnsTTL:                  a.PdnsTTL,
+		RackcorpApiuuid:          a.RackcorpApiuuid,
+		RackcorpApisecret:        a.RackcorpApisecret,
+		RackspaceUsername:        a.RackspaceUsername,
+		RackspaceApikey:          a.RackspaceApikey,
+		Rage4Username:            a.Rage4Username,
+		Rage4Token:               a.Rage4Token,
+		Rcode0APIToken:           a.Rcode0APIToken,
+		Rcode0URL:                a.Rcode0URL,
+		Rcode0TTL:                a.Rcode0TTL,
+		RegruAPIUsername:         a.RegruAPIUsername,
+		RegruAPIPassword:         a.RegruAPIPassword,
+		ScalewayAPIToken:         a.ScalewayAPIToken,
+		SchlundtechUser:          a.SchlundtechUser,
+		SchlundtechPassword:      a.SchlundtechPassword,
+		SlKey:                    a.SlKey,
+		SelfhostdnsUsername:      a.SelfhostdnsUsername,
+		SelfhostdnsPassword:      a.SelfhostdnsPassword,
+		SelfhostdnsMap:           a.SelfhostdnsMap,
+		ServercowAPIUsername:     a.ServercowAPIUsername,
+		ServercowAPIPassword:     a.ServercowAPIPassword,
+		SimplyAccountname:        a.SimplyAccountname,
+		SimplyApikey:             a.SimplyApikey,
+		SimplyAPI:                a.SimplyAPI,
+		Tele3Key:                 a.Tele3Key,
+		Tele3Secret:              a.Tele3Secret,
+		TencentSecretid:          a.TencentSecretid,
+		TencentSecretkey:         a.TencentSecretkey,
+		UdrUser:                  a.UdrUser,
+		UdrPass:                  a.UdrPass,
+		UltraUsr:                 a.UltraUsr,
+		UltraPwd:                 a.UltraPwd,
+		UnoUser:                  a.UnoUser,
+		UnoKey:                   a.UnoKey,
+		VariomediaAPIToken:       a.VariomediaAPIToken,
+		VeespUser:                a.VeespUser,
+		VeespPassword:            a.VeespPassword,
+		VercelToken:              a.VercelToken,
+		VscaleAPIKey:             a.VscaleAPIKey,
+		VultrAPIKey:              a.VultrAPIKey,
+		WsApikey:                 a.WsApikey,
+		WsApisecret:              a.WsApisecret,
+		WestUsername:             a.WestUsername,
+		WestKey:                  a.WestKey,
+		World4YouUsername:        a.World4YouUsername,
+		World4YouPassword:        a.World4YouPassword,
+		PddToken:                 a.PddToken,
+		YcZoneID:                 a.YcZoneID,
+		YcFolderID:               a.YcFolderID,
+		YcSaID:                   a.YcSaID,
+		YcSaKeyID:                a.YcSaKeyID,
+		YcSaKeyFilePemB64:        a.YcSaKeyFilePemB64,
+		ZmKey:                    a.ZmKey,
+		ZoneUsername:             a.ZoneUsername,
+		ZoneKey:                  a.ZoneKey,
+		ZiloreKey:                a.ZiloreKey,
+		Anydnschallengealias:     a.Anydnschallengealias,
+		Anydnschallengedomain:    a.Anydnschallengedomain,
+	}
+}
+
+// ToModel copies g into a new AcmeCertificateDomain, sharing its slices and pointers.
+func (g *GetServicesAcmeCertificateDomainEndpointResponseData) ToModel() *AcmeCertificateDomain {
+	if g == nil {
+		return nil
+	}
+	return &AcmeCertificateDomain{
+		Name:                     g.Name,
+		Status:                   g.Status,
+		Method:                   g.Method,
+		Webrootfolder:            g.Webrootfolder,
+		Webrootftpftpserver:      g.Webrootftpftpserver,
+		Webrootftpusername:       g.Webrootftpusername,
+		Webrootftppassword:       g.Webrootftppassword,
+		Webrootftpfolder:         g.Webrootftpfolder,
+		Standaloneport:           g.Standaloneport,
+		Standaloneipv6:           g.Standaloneipv6,
+		Standalonetlsport:        g.Standalonetlsport,
+		NsupdateServer:           g.NsupdateServer,
+		NsupdateKeyname:          g.NsupdateKeyname,
+		NsupdateKeyalgo:          g.NsupdateKeyalgo,
+		NsupdateKey:              g.NsupdateKey,
+		NsupdateZone:             g.NsupdateZone,
+		One984HostingUsername:    g.One984HostingUsername,
+		One984HostingPassword:    g.One984HostingPassword,
+		AcmeproxyEndpoint:        g.AcmeproxyEndpoint,
+		AcmeproxyUsername:        g.AcmeproxyUsername,
+		AcmeproxyPassword:        g.AcmeproxyPassword,
+		AcmednsUsername:          g.AcmednsUsername,
+		AcmednsPassword:          g.AcmednsPassword,
+		AcmednsSubdomain:         g.AcmednsSubdomain,
+		AcmednsUpdateURL:         g.AcmednsUpdateURL,
+		Active24Token:            g.Active24Token,
+		AkamaiHost:               g.AkamaiHost,
+		AkamaiAccessToken:        g.AkamaiAccessToken,
+		AkamaiClientToken:        g.AkamaiClientToken,
+		AkamaiClientSecret:       g.AkamaiClientSecret,
+		AliKey:                   g.AliKey,
+		AliSecret:                g.AliSecret,
+		KasLogin:                 g.KasLogin,
+		KasAuthtype:              g.KasAuthtype,
+		KasAuthdata:              g.KasAuthdata,
+		AdAPIKey:                 g.AdAPIKey,
+		AnxToken:                 g.AnxToken,
+		AfAPIUsername:            g.AfAPIUsername,
+		AfAPIPassword:            g.AfAPIPassword,
+		ArvanToken:               g.ArvanToken,
+		AuroraKey:                g.AuroraKey,
+		AuroraSecret:             g.AuroraSecret,
+		AutodnsUser:              g.AutodnsUser,
+		AutodnsPassword:          g.AutodnsPassword,
+		AutodnsContext:           g.AutodnsContext,
+		AwsAccessKeyID:           g.AwsAccessKeyID,
+		AwsSecretAccessKey:       g.AwsSecretAccessKey,
+		AwsDNSSlowrate:           g.AwsDNSSlowrate,
+		AzionEmail:               g.AzionEmail,
+		AzionPassword:            g.AzionPassword,
+		AzurednsSubscriptionid:   g.AzurednsSubscriptionid,
+		AzurednsTenantid:         g.AzurednsTenantid,
+		AzurednsAppid:            g.AzurednsAppid,
+		AzurednsClientsecret:     g.AzurednsClientsecret,
+		BookmynameUsername:       g.BookmynameUsername,
+		BookmynamePassword:       g.BookmynamePassword,
+		BunnyAPIKey:              g.BunnyAPIKey,
+		ClouddnsEmail:            g.ClouddnsEmail,
+		ClouddnsClientID:         g.ClouddnsClientID,
+		ClouddnsPassword:         g.ClouddnsPassword,
+		CloudnsAuthID:            g.CloudnsAuthID,
+		CloudnsSubAuthID:         g.CloudnsSubAuthID,
+		CloudnsAuthPassword:      g.CloudnsAuthPassword,
+		CfKey:                    g.CfKey,
+		CfEmail:                  g.CfEmail,
+		CfToken:                  g.CfToken,
+		CfAccountID:              g.CfAccountID,
+		CfZoneID:                 g.CfZoneID,
+		ConohaUsername:           g.ConohaUsername,
+		ConohaPassword:           g.ConohaPassword,
+		ConohaTenantid:           g.ConohaTenantid,
+		ConohaIdentityserviceapi: g.ConohaIdentityserviceapi,
+		ConstellixKey:            g.ConstellixKey,
+		ConstellixSecret:         g.ConstellixSecret,
+		CpanelUsername:           g.CpanelUsername,
+		CpanelApitoken:           g.CpanelApitoken,
+		CpanelHostname:           g.CpanelHostname,
+		CnUser:                   g.CnUser,
+		CnPassword:               g.CnPassword,
+		CuranetAuthclientid:      g.CuranetAuthclientid,
+		CuranetAuthsecret:        g.CuranetAuthsecret,
+		CyUsername:               g.CyUsername,
+		CyPassword:               g.CyPassword,
+		DdnssToken:               g.DdnssToken,
+		DedynToken:               g.DedynToken,
+		DedynName:                g.DedynName,
+		DoAPIKey:                 g.DoAPIKey,
+		DaAPI:                    g.DaAPI,
+		DaAPIInsecure:            g.DaAPIInsecure,
+		DnsexitAPIKey:            g.DnsexitAPIKey,
+		DnsexitAuthUser:          g.DnsexitAuthUser,
+		DnsexitAuthPass:          g.DnsexitAuthPass,
+		DnshomeSubdomain:         g.DnshomeSubdomain,
+		DnshomeSubdomainpassword: g.DnshomeSubdomainpassword,
+		DnsimpleOauthToken:       g.DnsimpleOauthToken,
+		MeKey:                    g.MeKey,
+		MeSecret:                 g.MeSecret,
+		DnsservicesUsername:      g.DnsservicesUsername,
+		DnsservicesPassword:      g.DnsservicesPassword,
+		DoLetoken:                g.DoLetoken,
+		DoPid:                    g.DoPid,
+		DoPw:                     g.DoPw,
+		DomeneshopToken:          g.DomeneshopToken,
+		DomeneshopSecret:         g.DomeneshopSecret,
+		DpID:                     g.DpID,
+		DpKey:                    g.DpKey,
+		DpiID:                    g.DpiID,
+		DpiKey:                   g.DpiKey,
+		DhAPIKey:                 g.DhAPIKey,
+		DuckdnsToken:             g.DuckdnsToken,
+		DdAPIUser:                g.DdAPIUser,
+		DdAPIKey:                 g.DdAPIKey,
+		DynCustomer:              g.DynCustomer,
+		DynUsername:              g.DynUsername,
+		DynPassword:              g.DynPassword,
+		DfUser:                   g.DfUser,
+		DfPassword:               g.DfPassword,
+		DynuClientid:             g.DynuClientid,
+		DynuSecret:               g.DynuSecret,
+		EasydnsKey:               g.EasydnsKey,
+		EasydnsToken:             g.EasydnsToken,
+		EuservUsername:           g.EuservUsername,
+		EuservPassword:           g.EuservPassword,
+		ExoscaleAPIKey:           g.ExoscaleAPIKey,
+		ExoscaleSecretKey:        g.ExoscaleSecretKey,
+		FornexAPIKey:             g.FornexAPIKey,
+		FreednsUser:              g.FreednsUser,
+		FreednsPassword:          g.FreednsPassword,
+		GandiLivednsKey:          g.GandiLivednsKey,
+		GcoreKey:                 g.GcoreKey,
+		GeoscalingUsername:       g.GeoscalingUsername,
+		GeoscalingPassword:       g.GeoscalingPassword,
+		GdKey:                    g.GdKey,
+		GdSecret:                 g.GdSecret,
+		GoogledomainsAccessToken: g.GoogledomainsAccessToken,
+		GoogledomainsZone:        g.GoogledomainsZone,
+		HetznerToken:             g.HetznerToken,
+		HexonetLogin:             g.HexonetLogin,
+		HexonetPassword:          g.HexonetPassword,
+		HuaweicloudUsername:      g.HuaweicloudUsername,
+		HuaweicloudPassword:      g.HuaweicloudPassword,
+		HuaweicloudDomainname:    g.HuaweicloudDomainname,
+		HeUsername:               g.HeUsername,
+		HePassword:               g.HePassword,
+		HostingdeApikey:          g.HostingdeApikey,
+		HostingdeEndpoint:        g.HostingdeEndpoint,
+		InfobloxCreds:            g.InfobloxCreds,
+		InfobloxServer:           g.InfobloxServer,
+		InfobloxView:             g.InfobloxView,
+		InfomaniakAPIToken:       g.InfomaniakAPIToken,
+		DefaultInfomaniakApiUrl:  g.DefaultInfomaniakApiUrl,
+		InfomaniakTTL:            g.InfomaniakTTL,
+		IonosPrefix:              g.IonosPrefix,
+		IonosSecret:              g.IonosSecret,
+		Ipv64Token:               g.Ipv64Token,
+		InternetbsAPIKey:         g.InternetbsAPIKey,
+		InternetbsAPIPassword:    g.InternetbsAPIPassword,
+		InwxUsername:             g.InwxUsername,
+		InwxPassword:             g.InwxPassword,
+		InwxSharedSecret:         g.InwxSharedSecret,
+		IspcUser:                 g.IspcUser,
+		IspcPassword:             g.IspcPassword,
+		IspcAPI:                  g.IspcAPI,
+		IspcAPIInsecure:          g.IspcAPIInsecure,
+		JdAccessKeyID:            g.JdAccessKeyID,
+		JdAccessKeySecret:        g.JdAccessKeySecret,
+		JdRegion:                 g.JdRegion,
+		JokerUsername:            g.JokerUsername,
+		JokerPassword:            g.JokerPassword,
+		KappernetdnsKey:          g.KappernetdnsKey,
+		KappernetdnsSecret:       g.KappernetdnsSecret,
+		KinghostUsername:         g.KinghostUsername,
+		KinghostPassword:         g.KinghostPassword,
+		KnotServer:               g.KnotServer,
+		KnotKey:                  g.KnotKey,
+		LaID:                     g.LaID,
+		LaKey:                    g.LaKey,
+		LswKey:                   g.LswKey,
+		LimacityApikey:           g.LimacityApikey,
+		LinodeAPIKey:             g.LinodeAPIKey,
+		LinodeV4APIKey:           g.LinodeV4APIKey,
+		LoopiaUser:               g.LoopiaUser,
+		LoopiaPassword:           g.LoopiaPassword,
+		LuaKey:                   g.LuaKey,
+		LuaEmail:                 g.LuaEmail,
+		MiabUsername:             g.MiabUsername,
+		MiabPassword:             g.MiabPassword,
+		MiabServer:               g.MiabServer,
+		MisakaKey:                g.MisakaKey,
+		MydnsjpMasterid:          g.MydnsjpMasterid,
+		MydnsjpPassword:          g.MydnsjpPassword,
+		MbAk:                     g.MbAk,
+		MbAs:                     g.MbAs,
+		NamecomUsername:          g.NamecomUsername,
+		NamecomToken:             g.NamecomToken,
+		NamecheapAPIKey:          g.NamecheapAPIKey,
+		NamecheapUsername:        g.NamecheapUsername,
+		NmUser:                   g.NmUser,
+		NmSha256:                 g.NmSha256,
+		NaneloToken:              g.NaneloToken,
+		NederhostKey:             g.NederhostKey,
+		NamesiloKey:              g.NamesiloKey,
+		NeodigitAPIToken:         g.NeodigitAPIToken,
+		NcApikey:                 g.NcApikey,
+		NcApipw:                  g.NcApipw,
+		NcCid:                    g.NcCid,
+		NetlifyAccessToken:       g.NetlifyAccessToken,
+		NicClientid:              g.NicClientid,
+		NicClientsecret:          g.NicClientsecret,
+		NicUsername:              g.NicUsername,
+		NicPassword:              g.NicPassword,
+		Ns1Key:                   g.Ns1Key,
+		NwAPIToken:               g.NwAPIToken,
+		NwAPIEndpoint:            g.NwAPIEndpoint,
+		OnecomUser:               g.OnecomUser,
+		OnecomPassword:           g.OnecomPassword,
+		OnlineAPIKey:             g.OnlineAPIKey,
+		OciCliTenancy:            g.OciCliTenancy,
+		OciCliUser:               g.OciCliUser,
+		OciCliRegion:             g.OciCliRegion,
+		OciCliKey:                g.OciCliKey,
+		OpenproviderUser:         g.OpenproviderUser,
+		OpenproviderPasswordhash: g.OpenproviderPasswordhash,
+		OvhAk:                    g.OvhAk,
+		OvhAs:                    g.OvhAs,
+		OvhCk:                    g.OvhCk,
+		OvhEndPoint:              g.OvhEndPoint,
+		PleskxmlUser:             g.PleskxmlUser,
+		PleskxmlPass:             g.PleskxmlPass,
+		PleskxmlURI:              g.PleskxmlURI,
+		PointhqKey:               g.PointhqKey,
+		PointhqEmail:             g.PointhqEmail,
+		PorkbunAPIKey:            g.PorkbunAPIKey,
+		PorkbunSecretAPIKey:      g.PorkbunSecretAPIKey,
+		PdnsURL:                  g.PdnsURL,
+		PdnsServerid:             g.PdnsServerid,
+		PdnsToken:                g.PdnsToken,
+		PdnsTTL:                  g.PdnsTTL,
+		RackcorpApiuuid:          g.RackcorpApiuuid,
+		RackcorpApisecret:        g.RackcorpApisecret,
+		RackspaceUsername:        g.RackspaceUsername,
+		RackspaceApikey:          g.RackspaceApikey,
+		Rage4Username:            g.Rage4Username,
+		Rage4Token:               g.Rage4Token,
+		Rcode0APIToken:           g.Rcode0APIToken,
+		Rcode0URL:                g.Rcode0URL,
+		Rcode0TTL:                g.Rcode0TTL,
+		RegruAPIUsername:         g.RegruAPIUsername,
+		RegruAPIPassword:         g.RegruAPIPassword,
+		ScalewayAPIToken:         g.ScalewayAPIToken,
+		SchlundtechUser:          g.SchlundtechUser,
+		SchlundtechPassword:      g.SchlundtechPassword,
+		SlKey:                    g.SlKey,
+		SelfhostdnsUsername:      g.SelfhostdnsUsername,
+		SelfhostdnsPassword:      g.SelfhostdnsPassword,
+		SelfhostdnsMap:           g.SelfhostdnsMap,
+		ServercowAPIUsername:     g.ServercowAPIUsername,
+		ServercowAPIPassword:     g.ServercowAPIPassword,
+		SimplyAccountname:        g.SimplyAccountname,
+		SimplyApikey:             g.SimplyApikey,
+		SimplyAPI:                g.SimplyAPI,
+		Tele3Key:                 g.Tele3Key,
+		Tele3Secret:              g.Tele3Secret,
+		TencentSecretid:          g.TencentSecretid,
+		TencentSecretkey:         g.TencentSecretkey,
+		UdrUser:                  g.UdrUser,
+		UdrPass:                  g.UdrPass,
+		UltraUsr:                 g.UltraUsr,
+		UltraPwd:                 g.UltraPwd,
+		UnoUser:                  g.UnoUser,
+		UnoKey:                   g.UnoKey,
+		VariomediaAPIToken:       g.VariomediaAPIToken,
+		VeespUser:                g.VeespUser,
+		VeespPassword:            g.VeespPassword,
+		VercelToken:              g.VercelToken,
+		VscaleAPIKey:             g.VscaleAPIKey,
+		VultrAPIKey:              g.VultrAPIKey,
+		WsApikey:                 g.WsApikey,
+		WsApisecret:              g.WsApisecret,
+		WestUsername:             g.WestUsername,
+		WestKey:                  g.WestKey,
+		World4YouUsername:        g.World4YouUsername,
+		World4YouPassword:        g.World4YouPassword,
+		PddToken:                 g.PddToken,
+		YcZoneID:                 g.YcZoneID,
+		YcFolderID:               g.YcFolderID,
+		YcSaID:                   g.YcSaID,
+		YcSaKeyID:                g.YcSaKeyID,
+		YcSaKeyFilePemB64:        g.YcSaKeyFilePemB64,
+		ZmKey:                    g.ZmKey,
+		ZoneUsername:             g.ZoneUsername,
+		ZoneKey:                  g.ZoneKey,
+		ZiloreKey:                g.ZiloreKey,
+		Anydnschallengealias:     g.Anydnschallengealias,
+		Anydnschallengedomain:    g.Anydnschallengedomain,
+	}
+}
+
+// ToModel copies p into a new AcmeCertificateDomain, sharing its slices and pointers.
+func (p *PostServicesAcmeCertificateDomainEndpointResponseData) ToModel() *AcmeCertificateDomain {
+	if p == nil {
+		return nil
+	}
+	return &AcmeCertificateDomain{
+		Name:                     p.Name,
+		Status:                   p.Status,
+		Method:                   p.Method,
+		Webrootfolder:            p.Webrootfolder,
+		Webrootftpftpserver:      p.Webrootftpftpserver,
+		Webrootftpusername:       p.Webrootftpusername,
+		Webrootftppassword:       p.Webrootftppassword,
+		Webrootftpfolder:         p.Webrootftpfolder,
+		Standaloneport:           p.Standaloneport,
+		Standaloneipv6:           p.Standaloneipv6,
+		Standalonetlsport:        p.Standalonetlsport,
+		NsupdateServer:           p.NsupdateServer,
+		NsupdateKeyname:          p.NsupdateKeyname,
+		NsupdateKeyalgo:          p.NsupdateKeyalgo,
+		NsupdateKey:              p.NsupdateKey,
+		NsupdateZone:             p.NsupdateZone,
+		One984HostingUsername:    p.One984HostingUsername,
+		One984HostingPassword:    p.One984HostingPassword,
+		AcmeproxyEndpoint:        p.AcmeproxyEndpoint,
+		AcmeproxyUsername:        p.AcmeproxyUsername,
+		AcmeproxyPassword:        p.AcmeproxyPassword,
+		AcmednsUsername:          p.AcmednsUsername,
+		AcmednsPassword:          p.AcmednsPassword,
+		AcmednsSubdomain:         p.AcmednsSubdomain,
+		AcmednsUpdateURL:         p.AcmednsUpdateURL,
+		Active24Token:            p.Active24Token,
+		AkamaiHost:               p.AkamaiHost,
+		AkamaiAccessToken:        p.AkamaiAccessToken,
+		AkamaiClientToken:        p.AkamaiClientToken,
+		AkamaiClientSecret:       p.AkamaiClientSecret,
+		AliKey:                   p.AliKey,
+		AliSecret:                p.AliSecret,
+		KasLogin:                 p.KasLogin,
+		KasAuthtype:              p.KasAuthtype,
+		KasAuthdata:              p.KasAuthdata,
+		AdAPIKey:                 p.AdAPIKey,
+		AnxToken:                 p.AnxToken,
+		AfAPIUsername:            p.AfAPIUsername,
+		AfAPIPassword:            p.AfAPIPassword,
+		ArvanToken:               p.ArvanToken,
+		AuroraKey:                p.AuroraKey,
+		AuroraSecret:             p.AuroraSecret,
+		AutodnsUser:              p.AutodnsUser,
+		AutodnsPassword:          p.AutodnsPassword,
+		AutodnsContext:           p.AutodnsContext,
+		AwsAccessKeyID:           p.AwsAccessKeyID,
+		AwsSecretAccessKey:       p.AwsSecretAccessKey,
+		AwsDNSSlowrate:           p.AwsDNSSlowrate,
+		AzionEmail:               p.AzionEmail,
+		AzionPassword:            p.AzionPassword,
+		AzurednsSubscriptionid:   p.AzurednsSubscriptionid,
+		AzurednsTenantid:         p.AzurednsTenantid,
+		AzurednsAppid:            p.AzurednsAppid,
+		AzurednsClientsecret:     p.AzurednsClientsecret,
+		BookmynameUsername:       p.BookmynameUsername,
+		BookmynamePassword:       p.BookmynamePassword,
+		BunnyAPIKey:              p.BunnyAPIKey,
+		ClouddnsEmail:            p.ClouddnsEmail,
+		ClouddnsClientID:         p.ClouddnsClientID,
+		ClouddnsPassword:         p.ClouddnsPassword,
+		CloudnsAuthID:            p.CloudnsAuthID,
+		CloudnsSubAuthID:         p.CloudnsSubAuthID,
+		CloudnsAuthPassword:      p.CloudnsAuthPassword,
+		CfKey:                    p.CfKey,
+		CfEmail:                  p.CfEmail,
+		CfToken:                  p.CfToken,
+		CfAccountID:              p.CfAccountID,
+		CfZoneID:                 p.CfZoneID,
+		ConohaUsername:           p.ConohaUsername,
+		ConohaPassword:           p.ConohaPassword,
+		ConohaTenantid:           p.ConohaTenantid,
+		ConohaIdentityserviceapi: p.ConohaIdentityserviceapi,
+		ConstellixKey:            p.ConstellixKey,
+		ConstellixSecret:         p.ConstellixSecret,
+		CpanelUsername:           p.CpanelUsername,
+		CpanelApitoken:           p.CpanelApitoken,
+		CpanelHostname:           p.CpanelHostname,
+		CnUser:                   p.CnUser,
+		CnPassword:               p.CnPassword,
+		CuranetAuthclientid:      p.CuranetAuthclientid,
+		CuranetAuthsecret:        p.CuranetAuthsecret,
+		CyUsername:               p.CyUsername,
+		CyPassword:               p.CyPassword,
+		DdnssToken:               p.DdnssToken,
+		DedynToken:               p.DedynToken,
+		DedynName:                p.DedynName,
+		DoAPIKey:                 p.DoAPIKey,
+		DaAPI:                    p.DaAPI,
+		DaAPIInsecure:            p.DaAPIInsecure,
+		DnsexitAPIKey:            p.DnsexitAPIKey,
+		DnsexitAuthUser:          p.DnsexitAuthUser,
+		DnsexitAuthPass:          p.DnsexitAuthPass,
+		DnshomeSubdomain:         p.DnshomeSubdomain,
+		DnshomeSubdomainpassword: p.DnshomeSubdomainpassword,
+		DnsimpleOauthToken:       p.DnsimpleOauthToken,
+		MeKey:                    p.MeKey,
+		MeSecret:                 p.MeSecret,
+		DnsservicesUsername:      p.DnsservicesUsername,
+		DnsservicesPassword:      p.DnsservicesPassword,
+		DoLetoken:                p.DoLetoken,
+		DoPid:                    p.DoPid,
+		DoPw:                     p.DoPw,
+		DomeneshopToken:          p.DomeneshopToken,
+		DomeneshopSecret:         p.DomeneshopSecret,
+		DpID:                     p.DpID,
+		DpKey:                    p.DpKey,
+		DpiID:                    p.DpiID,
+		DpiKey:                   p.DpiKey,
+		DhAPIKey:                 p.DhAPIKey,
+		DuckdnsToken:             p.DuckdnsToken,
+		DdAPIUser:                p.DdAPIUser,
+		DdAPIKey:                 p.DdAPIKey,
+		DynCustomer:              p.DynCustomer,
+		DynUsername:              p.DynUsername,
+		DynPassword:              p.DynPassword,
+		DfUser:                   p.DfUser,
+		DfPassword:               p.DfPassword,
+		DynuClientid:             p.DynuClientid,
+		DynuSecret:               p.DynuSecret,
+		EasydnsKey:               p.EasydnsKey,
+		EasydnsToken:             p.EasydnsToken,
+		EuservUsername:           p.EuservUsername,
+		EuservPassword:           p.EuservPassword,
+		ExoscaleAPIKey:           p.ExoscaleAPIKey,
+		ExoscaleSecretKey:        p.ExoscaleSecretKey,
+		FornexAPIKey:             p.FornexAPIKey,
+		FreednsUser:              p.FreednsUser,
+		FreednsPassword:          p.FreednsPassword,
+		GandiLivednsKey:          p.GandiLivednsKey,
+		GcoreKey:                 p.GcoreKey,
+		GeoscalingUsername:       p.GeoscalingUsername,
+		GeoscalingPassword:       p.GeoscalingPassword,
+		GdKey:                    p.GdKey,
+		GdSecret:                 p.GdSecret,
+		GoogledomainsAccessToken: p.GoogledomainsAccessToken,
+		GoogledomainsZone:        p.GoogledomainsZone,
+		HetznerToken:             p.HetznerToken,
+		HexonetLogin:             p.HexonetLogin,
+		HexonetPassword:          p.HexonetPassword,
+		HuaweicloudUsername:      p.HuaweicloudUsername,
+		HuaweicloudPassword:      p.HuaweicloudPassword,
+		HuaweicloudDomainname:    p.HuaweicloudDomainname,
+		HeUsername:               p.HeUsername,
+		HePassword:               p.HePassword,
+		HostingdeApikey:          p.HostingdeApikey,
+		HostingdeEndpoint:        p.HostingdeEndpoint,
+		InfobloxCreds:            p.InfobloxCreds,
+		InfobloxServer:           p.InfobloxServer,
+		InfobloxView:             p.InfobloxView,
+		InfomaniakAPIToken:       p.InfomaniakAPIToken,
+		DefaultInfomaniakApiUrl:  p.DefaultInfomaniakApiUrl,
+		InfomaniakTTL:            p.InfomaniakTTL,
+		IonosPrefix:              p.IonosPrefix,
+		IonosSecret:              p.IonosSecret,
+		Ipv64Token:               p.Ipv64Token,
+		InternetbsAPIKey:         p.InternetbsAPIKey,
+		InternetbsAPIPassword:    p.InternetbsAPIPassword,
+		InwxUsername:             p.InwxUsername,
+		InwxPassword:             p.InwxPassword,
+		InwxSharedSecret:         p.InwxSharedSecret,
+		IspcUser:                 p.IspcUser,
+		IspcPassword:             p.IspcPassword,
+		IspcAPI:                  p.IspcAPI,
+		IspcAPIInsecure:          p.IspcAPIInsecure,
+		JdAccessKeyID:            p.JdAccessKeyID,
+		JdAccessKeySecret:        p.JdAccessKeySecret,
+		JdRegion:                 p.JdRegion,
+		JokerUsername:            p.JokerUsername,
+		JokerPassword:            p.JokerPassword,
+		KappernetdnsKey:          p.KappernetdnsKey,
+		KappernetdnsSecret:       p.KappernetdnsSecret,
+		KinghostUsername:         p.KinghostUsername,
+		KinghostPassword:         p.KinghostPassword,
+		KnotServer:               p.KnotServer,
+		KnotKey:                  p.KnotKey,
+		LaID:                     p.LaID,
+		LaKey:                    p.LaKey,
+		LswKey:                   p.LswKey,
+		LimacityApikey:           p.LimacityApikey,
+		LinodeAPIKey:             p.LinodeAPIKey,
+		LinodeV4APIKey:           p.LinodeV4APIKey,
+		LoopiaUser:               p.LoopiaUser,
+		LoopiaPassword:           p.LoopiaPassword,
+		LuaKey:                   p.LuaKey,
+		LuaEmail:                 p.LuaEmail,
+		MiabUsername:             p.MiabUsername,
+		MiabPassword:             p.MiabPassword,
+		MiabServer:               p.MiabServer,
+		MisakaKey:                p.MisakaKey,
+		MydnsjpMasterid:          p.MydnsjpMasterid,
+		MydnsjpPassword:          p.MydnsjpPassword,
+		MbAk:                     p.MbAk,
+		MbAs:                     p.MbAs,
+		NamecomUsername:          p.NamecomUsername,
+		NamecomToken:             p.NamecomToken,
+		NamecheapAPIKey:          p.NamecheapAPIKey,
+		NamecheapUsername:        p.NamecheapUsername,
+		NmUser:                   p.NmUser,
+		NmSha256:                 p.NmSha256,
+		NaneloToken:              p.NaneloToken,
+		NederhostKey:             p.NederhostKey,
+		NamesiloKey:              p.NamesiloKey,
+		NeodigitAPIToken:         p.NeodigitAPIToken,
+		NcApikey:                 p.NcApikey,
+		NcApipw:                  p.NcApipw,
+		NcCid:                    p.NcCid,
+		NetlifyAccessToken:       p.NetlifyAccessToken,
+		NicClientid:              p.NicClientid,
+		NicClientsecret:          p.NicClientsecret,
+		NicUsername:              p.NicUsername,
+		NicPassword:              p.NicPassword,
+		Ns1Key:                   p.Ns1Key,
+		NwAPIToken:               p.NwAPIToken,
+		NwAPIEndpoint:            p.NwAPIEndpoint,
+		OnecomUser:               p.OnecomUser,
+		OnecomPassword:           p.OnecomPassword,
+		OnlineAPIKey:             p.OnlineAPIKey,
+		OciCliTenancy:            p.OciCliTenancy,
+		OciCliUser:               p.OciCliUser,
+		OciCliRegion:             p.OciCliRegion,
+		OciCliKey:                p.OciCliKey,
+		OpenproviderUser:         p.OpenproviderUser,
+		OpenproviderPasswordhash: p.OpenproviderPasswordhash,
+		OvhAk:                    p.OvhAk,
+		OvhAs:                    p.OvhAs,
+		OvhCk:                    p.OvhCk,
+		OvhEndPoint:              p.OvhEndPoint,
+		PleskxmlUser:             p.PleskxmlUser,
+		PleskxmlPass:             p.PleskxmlPass,
+		PleskxmlURI:              p.PleskxmlURI,
+		PointhqKey:               p.PointhqKey,
+		PointhqEmail:             p.PointhqEmail,
+		PorkbunAPIKey:            p.PorkbunAPIKey,
+		PorkbunSecretAPIKey:      p.PorkbunSecretAPIKey,
+		PdnsURL:                  p.PdnsURL,
+		PdnsServerid:             p.PdnsServerid,
+		PdnsToken:                p.PdnsToken,
+		PdnsTTL:                  p.PdnsTTL,
+		RackcorpApiuuid:          p.RackcorpApiuuid,
+		RackcorpApisecret:        p.RackcorpApisecret,
+		RackspaceUsername:        p.RackspaceUsername,
+		RackspaceApikey:          p.RackspaceApikey,
+		Rage4Username:            p.Rage4Username,
+		Rage4Token:               p.Rage4Token,
+		Rcode0APIToken:           p.Rcode0APIToken,
+		Rcode0URL:                p.Rcode0URL,
+		Rcode0TTL:                p.Rcode0TTL,
+		RegruAPIUsername:         p.RegruAPIUsername,
+		RegruAPIPassword:         p.RegruAPIPassword,
+		ScalewayAPIToken:         p.ScalewayAPIToken,
+		SchlundtechUser:          p.SchlundtechUser,
+		SchlundtechPassword:      p.SchlundtechPassword,
+		SlKey:                    p.SlKey,
+		SelfhostdnsUsername:      p.SelfhostdnsUsername,
+		SelfhostdnsPassword:      p.SelfhostdnsPassword,
+		SelfhostdnsMap:           p.SelfhostdnsMap,
+		ServercowAPIUsername:     p.ServercowAPIUsername,
+		ServercowAPIPassword:     p.ServercowAPIPassword,
+		SimplyAccountname:        p.SimplyAccountname,
+		SimplyApikey:             p.SimplyApikey,
+		SimplyAPI:                p.SimplyAPI,
+		Tele3Key:                 p.Tele3Key,
+		Tele3Secret:              p.Tele3Secret,
+		TencentSecretid:          p.TencentSecretid,
+		TencentSecretkey:         p.TencentSecretkey,
+		UdrUser:                  p.UdrUser,
+		UdrPass:                  p.UdrPass,
+		UltraUsr:                 p.UltraUsr,
+		UltraPwd:                 p.UltraPwd,
+		UnoUser:                  p.UnoUser,
+		UnoKey:                   p.UnoKey,
+		VariomediaAPIToken:       p.VariomediaAPIToken,
+		VeespUser:                p.VeespUser,
+		VeespPassword:            p.VeespPassword,
+		VercelToken:              p.VercelToken,
+		VscaleAPIKey:             p.VscaleAPIKey,
+		VultrAPIKey:              p.VultrAPIKey,
+		WsApikey:                 p.WsApikey,
+		WsApisecret:              p.WsApisecret,
+		WestUsername:             p.WestUsername,
+		WestKey:                  p.WestKey,
+		World4YouUsername:        p.World4YouUsername,
+		World4YouPassword:        p.World4YouPassword,
+		PddToken:                 p.PddToken,
+		YcZoneID:                 p.YcZoneID,
+		YcFolderID:               p.YcFolderID,
+		YcSaID:                   p.YcSaID,
+		YcSaKeyID:                p.YcSaKeyID,
+		YcSaKeyFilePemB64:        p.YcSaKeyFilePemB64,
+		ZmKey:                    p.ZmKey,
+		ZoneUsername:             p.ZoneUsername,
+		ZoneKey:                  p.ZoneKey,
+		ZiloreKey:                p.ZiloreKey,
+		Anydnschallengealias:     p.Anydnschallengealias,
+		Anydnschallengedomain:    p.Anydnschallengedomain,
+	}
+}
+
+// ToModel copies p into a new AcmeCertificateDomain, sharing its slices and pointers.
+func (p *PatchServicesAcmeCertificateDomainEndpointResponseData) ToModel() *AcmeCertificateDomain {
+	if p == nil {
+		return nil
+	}
+	return &AcmeCertificateDomain{
+		Name:                     p.Name,
+		Status:                   p.Status,
+		Method:                   p.Method,
+		Webrootfolder:            p.Webrootfolder,
+		Webrootftpftpserver:      p.Webrootftpftpserver,
+		Webrootftpusername:       p.Webrootftpusername,
+		Webrootftppassword:       p.Webrootftppassword,
+		Webrootftpfolder:         p.Webrootftpfolder,
+		Standaloneport:           p.Standaloneport,
+		Standaloneipv6:           p.Standaloneipv6,
+		Standalonetlsport:        p.Standalonetlsport,
+		NsupdateServer:           p.NsupdateServer,
+		NsupdateKeyname:          p.NsupdateKeyname,
+		NsupdateKeyalgo:          p.NsupdateKeyalgo,
+		NsupdateKey:              p.NsupdateKey,
+		NsupdateZone:             p.NsupdateZone,
+		One984HostingUsername:    p.One984HostingUsername,
+		One984HostingPassword:    p.One984HostingPassword,
+		AcmeproxyEndpoint:        p.AcmeproxyEndpoint,
+		AcmeproxyUsername:        p.AcmeproxyUsername,
+		AcmeproxyPassword:        p.AcmeproxyPassword,
+		AcmednsUsername:          p.AcmednsUsername,
+		AcmednsPassword:          p.AcmednsPassword,
+		AcmednsSubdomain:         p.AcmednsSubdomain,
+		AcmednsUpdateURL:         p.AcmednsUpdateURL,
+		Active24Token:            p.Active24Token,
+		AkamaiHost:               p.AkamaiHost,
+		AkamaiAccessToken:        p.AkamaiAccessToken,
+		AkamaiClientToken:        p.AkamaiClientToken,
+		AkamaiClientSecret:       p.AkamaiClientSecret,
+		AliKey:                   p.AliKey,
+		AliSecret:                p.AliSecret,
+		KasLogin:                 p.KasLogin,
+		KasAuthtype:              p.KasAuthtype,
+		KasAuthdata:              p.KasAuthdata,
+		AdAPIKey:                 p.AdAPIKey,
+		AnxToken:                 p.AnxToken,
+		AfAPIUsername:            p.AfAPIUsername,
+		AfAPIPassword:            p.AfAPIPassword,
+		ArvanToken:               p.ArvanToken,
+		AuroraKey:                p.AuroraKey,
+		AuroraSecret:             p.AuroraSecret,
+		AutodnsUser:              p.AutodnsUser,
+		AutodnsPassword:          p.AutodnsPassword,
+		AutodnsContext:           p.AutodnsContext,
+		AwsAccessKeyID:           p.AwsAccessKeyID,
+		AwsSecretAccessKey:       p.AwsSecretAccessKey,
+		AwsDNSSlowrate:           p.AwsDNSSlowrate,
+		AzionEmail:               p.AzionEmail,
+		AzionPassword:            p.AzionPassword,
+		AzurednsSubscriptionid:   p.AzurednsSubscriptionid,
+		AzurednsTenantid:         p.AzurednsTenantid,
+		AzurednsAppid:            p.AzurednsAppid,
+		AzurednsClientsecret:     p.AzurednsClientsecret,
+		BookmynameUsername:       p.BookmynameUsername,
+		BookmynamePassword:       p.BookmynamePassword,
+		BunnyAPIKey:              p.BunnyAPIKey,
+		ClouddnsEmail:            p.ClouddnsEmail,
+		ClouddnsClientID:         p.ClouddnsClientID,
+		ClouddnsPassword:         p.ClouddnsPassword,
+		CloudnsAuthID:            p.CloudnsAuthID,
+		CloudnsSubAuthID:         p.CloudnsSubAuthID,
+		CloudnsAuthPassword:      p.CloudnsAuthPassword,
+		CfKey:                    p.CfKey,
+		CfEmail:                  p.CfEmail,
+		CfToken:                  p.CfToken,
+		CfAccountID:              p.CfAccountID,
+		CfZoneID:                 p.CfZoneID,
+		ConohaUsername:           p.ConohaUsername,
+		ConohaPassword:           p.ConohaPassword,
+		ConohaTenantid:           p.ConohaTenantid,
+		ConohaIdentityserviceapi: p.ConohaIdentityserviceapi,
+		ConstellixKey:            p.ConstellixKey,
+		ConstellixSecret:         p.ConstellixSecret,
+		CpanelUsername:           p.CpanelUsername,
+		CpanelApitoken:           p.CpanelApitoken,
+		CpanelHostname:           p.CpanelHostname,
+		CnUser:                   p.CnUser,
+		CnPassword:               p.CnPassword,
+		CuranetAuthclientid:      p.CuranetAuthclientid,
+		CuranetAuthsecret:        p.CuranetAuthsecret,
+		CyUsername:               p.CyUsername,
+		CyPassword:               p.CyPassword,
+		DdnssToken:               p.DdnssToken,
+		DedynToken:               p.DedynToken,
+		DedynName:                p.DedynName,
+		DoAPIKey:                 p.DoAPIKey,
+		DaAPI:                    p.DaAPI,
+		DaAPIInsecure:            p.DaAPIInsecure,
+		DnsexitAPIKey:            p.DnsexitAPIKey,
+		DnsexitAuthUser:          p.DnsexitAuthUser,
+		DnsexitAuthPass:          p.DnsexitAuthPass,
+		DnshomeSubdomain:         p.DnshomeSubdomain,
+		DnshomeSubdomainpassword: p.DnshomeSubdomainpassword,
+		DnsimpleOauthToken:       p.DnsimpleOauthToken,
+		MeKey:                    p.MeKey,
+		MeSecret:                 p.MeSecret,
+		DnsservicesUsername:      p.DnsservicesUsername,
+		DnsservicesPassword:      p.DnsservicesPassword,
+		DoLetoken:                p.DoLetoken,
+		DoPid:                    p.DoPid,
+		DoPw:                     p.DoPw,
+		DomeneshopToken:          p.DomeneshopToken,
+		DomeneshopSecret:         p.DomeneshopSecret,
+		DpID:                     p.DpID,
+		DpKey:                    p.DpKey,
+		DpiID:                    p.DpiID,
+		DpiKey:                   p.DpiKey,
+		DhAPIKey:                 p.DhAPIKey,
+		DuckdnsToken:             p.DuckdnsToken,
+		DdAPIUser:                p.DdAPIUser,
+		DdAPIKey:                 p.DdAPIKey,
+		DynCustomer:              p.DynCustomer,
+		DynUsername:              p.DynUsername,
+		DynPassword:              p.DynPassword,
+		DfUser:                   p.DfUser,
+		DfPassword:               p.DfPassword,
+		DynuClientid:             p.DynuClientid,
+		DynuSecret:               p.DynuSecret,
+		EasydnsKey:               p.EasydnsKey,
+		EasydnsToken:             p.EasydnsToken,
+		EuservUsername:           p.EuservUsername,
+		EuservPassword:           p.EuservPassword,
+		ExoscaleAPIKey:           p.ExoscaleAPIKey,
+		ExoscaleSecretKey:        p.ExoscaleSecretKey,
+		FornexAPIKey:             p.FornexAPIKey,
+		FreednsUser:              p.FreednsUser,
+		FreednsPassword:          p.FreednsPassword,
+		GandiLivednsKey:          p.GandiLivednsKey,
+		GcoreKey:                 p.GcoreKey,
+		GeoscalingUsername:       p.GeoscalingUsername,
+		GeoscalingPassword:       p.GeoscalingPassword,
+		GdKey:                    p.GdKey,
+		GdSecret:                 p.GdSecret,
+		GoogledomainsAccessToken: p.GoogledomainsAccessToken,
+		GoogledomainsZone:        p.GoogledomainsZone,
+		HetznerToken:             p.HetznerToken,
+		HexonetLogin:             p.HexonetLogin,
+		HexonetPassword:          p.HexonetPassword,
+		HuaweicloudUsername:      p.HuaweicloudUsername,
+		HuaweicloudPassword:      p.HuaweicloudPassword,
+		HuaweicloudDomainname:    p.HuaweicloudDomainname,
+		HeUsername:               p.HeUsername,
+		HePassword:               p.HePassword,
+		HostingdeApikey:          p.HostingdeApikey,
+		HostingdeEndpoint:        p.HostingdeEndpoint,
+		InfobloxCreds:            p.InfobloxCreds,
+		InfobloxServer:           p.InfobloxServer,
+		InfobloxView:             p.InfobloxView,
+		InfomaniakAPIToken:       p.InfomaniakAPIToken,
+		DefaultInfomaniakApiUrl:  p.DefaultInfomaniakApiUrl,
+		InfomaniakTTL:            p.InfomaniakTTL,
+		IonosPrefix:              p.IonosPrefix,
+		IonosSecret:              p.IonosSecret,
+		Ipv64Token:               p.Ipv64Token,
+		InternetbsAPIKey:         p.InternetbsAPIKey,
+		InternetbsAPIPassword:    p.InternetbsAPIPassword,
+		InwxUsername:             p.InwxUsername,
+		InwxPassword:             p.InwxPassword,
+		InwxSharedSecret:         p.InwxSharedSecret,
+		IspcUser:                 p.IspcUser,
+		IspcPassword:             p.IspcPassword,
+		IspcAPI:                  p.IspcAPI,
+		IspcAPIInsecure:          p.IspcAPIInsecure,
+		JdAccessKeyID:            p.JdAccessKeyID,
+		JdAccessKeySecret:        p.JdAccessKeySecret,
+		JdRegion:                 p.JdRegion,
+		JokerUsername:            p.JokerUsername,
+		JokerPassword:            p.JokerPassword,
+		KappernetdnsKey:          p.KappernetdnsKey,
+		KappernetdnsSecret:       p.KappernetdnsSecret,
+		KinghostUsername:         p.KinghostUsername,
+		KinghostPassword:         p.KinghostPassword,
+		KnotServer:               p.KnotServer,
+		KnotKey:                  p.KnotKey,
+		LaID:                     p.LaID,
+		LaKey:                    p.LaKey,
+		LswKey:                   p.LswKey,
+		LimacityApikey:           p.LimacityApikey,
+		LinodeAPIKey:             p.LinodeAPIKey,
+		LinodeV4APIKey:           p.LinodeV4APIKey,
+		LoopiaUser:               p.LoopiaUser,
+		LoopiaPassword:           p.LoopiaPassword,
+		LuaKey:                   p.LuaKey,
+		LuaEmail:                 p.LuaEmail,
+		MiabUsername:             p.MiabUsername,
+		MiabPassword:             p.MiabPassword,
+		MiabServer:               p.MiabServer,
+		MisakaKey:                p.MisakaKey,
+		MydnsjpMasterid:          p.MydnsjpMasterid,
+		MydnsjpPassword:          p.MydnsjpPassword,
+		MbAk:                     p.MbAk,
+		MbAs:                     p.MbAs,
+		NamecomUsername:          p.NamecomUsername,
+		NamecomToken:             p.NamecomToken,
+		NamecheapAPIKey:          p.NamecheapAPIKey,
+		NamecheapUsername:        p.NamecheapUsername,
+		NmUser:                   p.NmUser,
+		NmSha256:                 p.NmSha256,
+		NaneloToken:              p.NaneloToken,
+		NederhostKey:             p.NederhostKey,
+		NamesiloKey:              p.NamesiloKey,
+		NeodigitAPIToken:         p.NeodigitAPIToken,
+		NcApikey:                 p.NcApikey,
+		NcApipw:                  p.NcApipw,
+		NcCid:                    p.NcCid,
+		NetlifyAccessToken:       p.NetlifyAccessToken,
+		NicClientid:              p.NicClientid,
+		NicClientsecret:          p.NicClientsecret,
+		NicUsername:              p.NicUsername,
+		NicPassword:              p.NicPassword,
+		Ns1Key:                   p.Ns1Key,
+		NwAPIToken:               p.NwAPIToken,
+		NwAPIEndpoint:            p.NwAPIEndpoint,
+		OnecomUser:               p.OnecomUser,
+		OnecomPassword:           p.OnecomPassword,
+		OnlineAPIKey:             p.OnlineAPIKey,
+		OciCliTenancy:            p.OciCliTenancy,
+		OciCliUser:               p.OciCliUser,
+		OciCliRegion:             p.OciCliRegion,
+		OciCliKey:                p.OciCliKey,
+		OpenproviderUser:         p.OpenproviderUser,
+		OpenproviderPasswordhash: p.OpenproviderPasswordhash,
+		OvhAk:                    p.OvhAk,
+		OvhAs:                    p.OvhAs,
+		OvhCk:                    p.OvhCk,
+		OvhEndPoint:              p.OvhEndPoint,
+		PleskxmlUser:             p.PleskxmlUser,
+		PleskxmlPass:             p.PleskxmlPass,
+		PleskxmlURI:              p.PleskxmlURI,
+		PointhqKey:               p.PointhqKey,
+		PointhqEmail:             p.PointhqEmail,
+		PorkbunAPIKey:            p.PorkbunAPIKey,
+		PorkbunSecretAPIKey:      p.PorkbunSecretAPIKey,
+		PdnsURL:                  p.PdnsURL,
+		PdnsServerid:             p.PdnsServerid,
+		PdnsToken:                p.PdnsToken,
+		PdnsTTL:                  p.PdnsTTL,
+		RackcorpApiuuid:          p.RackcorpApiuuid,
+		RackcorpApisecret:        p.RackcorpApisecret,
+		RackspaceUsername:        p.RackspaceUsername,
+		RackspaceApikey:          p.RackspaceApikey,
+		Rage4Username:            p.Rage4Username,
+		Rage4Token:               p.Rage4Token,
+		Rcode0APIToken:           p.Rcode0APIToken,
+		Rcode0URL:                p.Rcode0URL,
+		Rcode0TTL:                p.Rcode0TTL,
+		RegruAPIUsername:         p.RegruAPIUsername,
+		RegruAPIPassword:         p.RegruAPIPassword,
+		ScalewayAPIToken:         p.ScalewayAPIToken,
+		SchlundtechUser:          p.SchlundtechUser,
+		SchlundtechPassword:      p.SchlundtechPassword,
+		SlKey:                    p.SlKey,
+		SelfhostdnsUsername:      p.SelfhostdnsUsername,
+		SelfhostdnsPassword:      p.SelfhostdnsPassword,
+		SelfhostdnsMap:           p.SelfhostdnsMap,
+		ServercowAPIUsername:     p.ServercowAPIUsername,
+		ServercowAPIPassword:     p.ServercowAPIPassword,
+		SimplyAccountname:        p.SimplyAccountname,
+		SimplyApikey:             p.SimplyApikey,
+		SimplyAPI:                p.SimplyAPI,
+		Tele3Key:                 p.Tele3Key,
+		Tele3Secret:              p.Tele3Secret,
+		TencentSecretid:          p.TencentSecretid,
+		TencentSecretkey:         p.TencentSecretkey,
+		UdrUser:                  p.UdrUser,
+		UdrPass:                  p.UdrPass,
+		UltraUsr:                 p.UltraUsr,
+		UltraPwd:                 p.UltraPwd,
+		UnoUser:                  p.UnoUser,
+		UnoKey:                   p.UnoKey,
+		VariomediaAPIToken:       p.VariomediaAPIToken,
+		VeespUser:                p.VeespUser,
+		VeespPassword:            p.VeespPassword,
+		VercelToken:              p.VercelToken,
+		VscaleAPIKey:             p.VscaleAPIKey,
+		VultrAPIKey:              p.VultrAPIKey,
+		WsApikey:                 p.WsApikey,
+		WsApisecret:              p.WsApisecret,
+		WestUsername:             p.WestUsername,
+		WestKey:                  p.WestKey,
+		World4YouUsername:        p.World4YouUsername,
+		World4YouPassword:        p.World4YouPassword,
+		PddToken:                 p.PddToken,
+		YcZoneID:                 p.YcZoneID,
+		YcFolderID:               p.YcFolderID,
+		YcSaID:                   p.YcSaID,
+		YcSaKeyID:                p.YcSaKeyID,
+		YcSaKeyFilePemB64:        p.YcSaKeyFilePemB64,
+		ZmKey:                    p.ZmKey,
+		ZoneUsername:             p.ZoneUsername,
+		ZoneKey:                  p.ZoneKey,
+		ZiloreKey:                p.ZiloreKey,
+		Anydnschallengealias:     p.Anydnschallengealias,
+		Anydnschallengedomain:    p.Anydnschallengedomain,
+	}
+}
+
+// ToPost returns a request that creates a copy of a. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with a.
+func (a *AcmeCertificate) ToPost() *PostServicesAcmeCertificateEndpointRequest {
+	if a == nil {
+		return &PostServicesAcmeCertificateEndpointRequest{}
+	}
+	return &PostServicesAcmeCertificateEndpointRequest{
+		Name:           a.Name,
+		Descr:          a.Descr,
+		Status:         a.Status,
+		Acmeaccount:    a.Acmeaccount,
+		Keylength:      a.Keylength,
+		Keypaste:       a.Keypaste,
+		Preferredchain: a.Preferredchain,
+		Oscpstaple:     a.Oscpstaple,
+		Dnssleep:       a.Dnssleep,
+		Renewafter:     a.Renewafter,
+		ADomainlist:    a.ADomainlist,
+		AActionlist:    a.AActionlist,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with a. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with a.
+func (a *AcmeCertificate) ToPatch(id int) *PatchServicesAcmeCertificateEndpointRequest {
+	if a == nil {
+		return &PatchServicesAcmeCertificateEndpointRequest{ID: id}
+	}
+	return &PatchServicesAcmeCertificateEndpointRequest{
+		ID:             id,
+		Name:           a.Name,
+		Descr:          a.Descr,
+		Status:         a.Status,
+		Acmeaccount:    a.Acmeaccount,
+		Keylength:      a.Keylength,
+		Keypaste:       a.Keypaste,
+		Preferredchain: a.Preferredchain,
+		Oscpstaple:     a.Oscpstaple,
+		Dnssleep:       a.Dnssleep,
+		Renewafter:     a.Renewafter,
+		ADomainlist:    a.ADomainlist,
+		AActionlist:    a.AActionlist,
+	}
+}
+
+// ToPutItem returns a as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with a.
+func (a *AcmeCertificate) ToPutItem() *PutServicesAcmeCertificatesEndpointRequestItem {
+	if a == nil {
+		return nil
+	}
+	return &PutServicesAcmeCertificatesEndpointRequestItem{
+		Name:           a.Name,
+		Descr:          a.Descr,
+		Status:         a.Status,
+		Acmeaccount:    a.Acmeaccount,
+		Keylength:      a.Keylength,
+		Keypaste:       a.Keypaste,
+		Preferredchain: a.Preferredchain,
+		Oscpstaple:     a.Oscpstaple,
+		Dnssleep:       a.Dnssleep,
+		Renewafter:     a.Renewafter,
+		ADomainlist:    a.ADomainlist,
+		AActionlist:    a.AActionlist,
+	}
+}
+
+// ToModel copies g into a new AcmeCertificate, sharing its slices and pointers.
+func (g *GetServicesAcmeCertificateEndpointResponseData) ToModel() *AcmeCertificate {
+	if g == nil {
+		return nil
+	}
+	return &AcmeCertificate{
+		Name:           g.Name,
+		Descr:          g.Descr,
+		Status:         g.Status,
+		Acmeaccount:    g.Acmeaccount,
+		Keylength:      g.Keylength,
+		Keypaste:       g.Keypaste,
+		Preferredchain: g.Preferredchain,
+		Oscpstaple:     g.Oscpstaple,
+		Dnssleep:       g.Dnssleep,
+		Renewafter:     g.Renewafter,
+		ADomainlist:    g.ADomainlist,
+		AActionlist:    g.AActionlist,
+	}
+}
+
+// ToModel copies p into a new AcmeCertificate, sharing its slices and pointers.
+func (p *PostServicesAcmeCertificateEndpointResponseData) ToModel() *AcmeCertificate {
+	if p == nil {
+		return nil
+	}
+	return &AcmeCertificate{
+		Name:           p.Name,
+		Descr:          p.Descr,
+		Status:         p.Status,
+		Acmeaccount:    p.Acmeaccount,
+		Keylength:      p.Keylength,
+		Keypaste:       p.Keypaste,
+		Preferredchain: p.Preferredchain,
+		Oscpstaple:     p.Oscpstaple,
+		Dnssleep:       p.Dnssleep,
+		Renewafter:     p.Renewafter,
+		ADomainlist:    p.ADomainlist,
+		AActionlist:    p.AActionlist,
+	}
+}
+
+// ToModel copies p into a new AcmeCertificate, sharing its slices and pointers.
+func (p *PatchServicesAcmeCertificateEndpointResponseData) ToModel() *AcmeCertificate {
+	if p == nil {
+		return nil
+	}
+	return &AcmeCertificate{
+		Name:           p.Name,
+		Descr:          p.Descr,
+		Status:         p.Status,
+		Acmeaccount:    p.Acmeaccount,
+		Keylength:      p.Keylength,
+		Keypaste:       p.Keypaste,
+		Preferredchain: p.Preferredchain,
+		Oscpstaple:     p.Oscpstaple,
+		Dnssleep:       p.Dnssleep,
+		Renewafter:     p.Renewafter,
+		ADomainlist:    p.ADomainlist,
+		AActionlist:    p.AActionlist,
+	}
+}
+
+// ToModel copies g into a new AcmeCertificate, sharing its slices and pointers.
+func (g *GetServicesAcmeCertificatesEndpointResponseDataItem) ToModel() *AcmeCertificate {
+	if g == nil {
+		return nil
+	}
+	return &AcmeCertificate{
+		Name:           g.Name,
+		Descr:          g.Descr,
+		Status:         g.Status,
+		Acmeaccount:    g.Acmeaccount,
+		Keylength:      g.Keylength,
+		Keypaste:       g.Keypaste,
+		Preferredchain: g.Preferredchain,
+		Oscpstaple:     g.Oscpstaple,
+		Dnssleep:       g.Dnssleep,
+		Renewafter:     g.Renewafter,
+		ADomainlist:    g.ADomainlist,
+		AActionlist:    g.AActionlist,
+	}
+}
+
+// ToPost returns a request that creates a copy of a. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with a.
+func (a *AuthServer) ToPost() *PostUserAuthServerEndpointRequest {
+	if a == nil {
+		return &PostUserAuthServerEndpointRequest{}
+	}
+	return &PostUserAuthServerEndpointRequest{
+		Refid:                    a.Refid,
+		Type:                     a.Type,
+		Name:                     a.Name,
+		Host:                     a.Host,
+		LdapPort:                 a.LdapPort,
+		LdapUrltype:              a.LdapUrltype,
+		LdapProtver:              a.LdapProtver,
+		LdapTimeout:              a.LdapTimeout,
+		LdapCaref:                a.LdapCaref,
+		LdapScope:                a.LdapScope,
+		LdapBasedn:               a.LdapBasedn,
+		LdapAuthcn:               a.LdapAuthcn,
+		LdapExtendedEnabled:      a.LdapExtendedEnabled,
+		LdapExtendedQuery:        a.LdapExtendedQuery,
+		LdapBinddn:               a.LdapBinddn,
+		LdapBindpw:               a.LdapBindpw,
+		LdapAttrUser:             a.LdapAttrUser,
+		LdapAttrGroup:            a.LdapAttrGroup,
+		LdapAttrMember:           a.LdapAttrMember,
+		LdapRfc2307:              a.LdapRfc2307,
+		LdapRfc2307Userdn:        a.LdapRfc2307Userdn,
+		LdapAttrGroupobj:         a.LdapAttrGroupobj,
+		LdapPamGroupdn:           a.LdapPamGroupdn,
+		LdapUtf8:                 a.LdapUtf8,
+		LdapNostripAt:            a.LdapNostripAt,
+		LdapAllowUnauthenticated: a.LdapAllowUnauthenticated,
+		RadiusSecret:             a.RadiusSecret,
+		RadiusAuthPort:           a.RadiusAuthPort,
+		RadiusAcctPort:           a.RadiusAcctPort,
+		RadiusProtocol:           a.RadiusProtocol,
+		RadiusTimeout:            a.RadiusTimeout,
+		RadiusNasipAttribute:     a.RadiusNasipAttribute,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with a. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with a.
+func (a *AuthServer) ToPatch(id int) *PatchUserAuthServerEndpointRequest {
+	if a == nil {
+		return &PatchUserAuthServerEndpointRequest{ID: id}
+	}
+	return &PatchUserAuthServerEndpointRequest{
+		ID:                       id,
+		Refid:                    a.Refid,
+		Type:                     a.Type,
+		Name:                     a.Name,
+		Host:                     a.Host,
+		LdapPort:                 a.LdapPort,
+		LdapUrltype:              a.LdapUrltype,
+		LdapProtver:              a.LdapProtver,
+		LdapTimeout:              a.LdapTimeout,
+		LdapCaref:                a.LdapCaref,
+		LdapScope:                a.LdapScope,
+		LdapBasedn:               a.LdapBasedn,
+		LdapAuthcn:               a.LdapAuthcn,
+		LdapExtendedEnabled:      a.LdapExtendedEnabled,
+		LdapExtendedQuery:        a.LdapExtendedQuery,
+		LdapBinddn:               a.LdapBinddn,
+		LdapBindpw:               a.LdapBindpw,
+		LdapAttrUser:             a.LdapAttrUser,
+		LdapAttrGroup:            a.LdapAttrGroup,
+		LdapAttrMember:           a.LdapAttrMember,
+		LdapRfc2307:              a.LdapRfc2307,
+		LdapRfc2307Userdn:        a.LdapRfc2307Userdn,
+		LdapAttrGroupobj:         a.LdapAttrGroupobj,
+		LdapPamGroupdn:           a.LdapPamGroupdn,
+		LdapUtf8:                 a.LdapUtf8,
+		LdapNostripAt:            a.LdapNostripAt,
+		LdapAllowUnauthenticated: a.LdapAllowUnauthenticated,
+		RadiusSecret:             a.RadiusSecret,
+		RadiusAuthPort:           a.RadiusAuthPort,
+		RadiusAcctPort:           a.RadiusAcctPort,
+		RadiusProtocol:           a.RadiusProtocol,
+		RadiusTimeout:            a.RadiusTimeout,
+		RadiusNasipAttribute:     a.RadiusNasipAttribute,
+	}
+}
+
+// ToPutItem returns a as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with a.
+func (a *AuthServer) ToPutItem() *PutUserAuthServersEndpointRequestItem {
+	if a == nil {
+		return nil
+	}
+	return &PutUserAuthServersEndpointRequestItem{
+		Refid:                    a.Refid,
+		Type:                     a.Type,
+		Name:                     a.Name,
+		Host:                     a.Host,
+		LdapPort:                 a.LdapPort,
+		LdapUrltype:              a.LdapUrltype,
+		LdapProtver:              a.LdapProtver,
+		LdapTimeout:              a.LdapTimeout,
+		LdapCaref:                a.LdapCaref,
+		LdapScope:                a.LdapScope,
+		LdapBasedn:               a.LdapBasedn,
+		LdapAuthcn:               a.LdapAuthcn,
+		LdapExtendedEnabled:      a.LdapExtendedEnabled,
+		LdapExtendedQuery:        a.LdapExtendedQuery,
+		LdapBinddn:               a.LdapBinddn,
+		LdapBindpw:               a.LdapBindpw,
+		LdapAttrUser:             a.LdapAttrUser,
+		LdapAttrGroup:            a.LdapAttrGroup,
+		LdapAttrMember:           a.LdapAttrMember,
+		LdapRfc2307:              a.LdapRfc2307,
+		LdapRfc2307Userdn:        a.LdapRfc2307Userdn,
+		LdapAttrGroupobj:         a.LdapAttrGroupobj,
+		LdapPamGroupdn:           a.LdapPamGroupdn,
+		LdapUtf8:                 a.LdapUtf8,
+		LdapNostripAt:            a.LdapNostripAt,
+		LdapAllowUnauthenticated: a.LdapAllowUnauthenticated,
+		RadiusSecret:             a.RadiusSecret,
+		RadiusAuthPort:           a.RadiusAuthPort,
+		RadiusAcctPort:           a.RadiusAcctPort,
+		RadiusProtocol:           a.RadiusProtocol,
+		RadiusTimeout:            a.RadiusTimeout,
+		RadiusNasipAttribute:     a.RadiusNasipAttribute,
+	}
+}
+
+// ToModel copies g into a new AuthServer, sharing its slices and pointers.
+func (g *GetUserAuthServerEndpointResponseData) ToModel() *AuthServer {
+	if g == nil {
+		return nil
+	}
+	return &AuthServer{
+		Refid:                    g.Refid,
+		Type:                     g.Type,
+		Name:                     g.Name,
+		Host:                     g.Host,
+		LdapPort:                 g.LdapPort,
+		LdapUrltype:              g.LdapUrltype,
+		LdapProtver:              g.LdapProtver,
+		LdapTimeout:              g.LdapTimeout,
+		LdapCaref:                g.LdapCaref,
+		LdapScope:                g.LdapScope,
+		LdapBasedn:               g.LdapBasedn,
+		LdapAuthcn:               g.LdapAuthcn,
+		LdapExtendedEnabled:      g.LdapExtendedEnabled,
+		LdapExtendedQuery:        g.LdapExtendedQuery,
+		LdapBinddn:               g.LdapBinddn,
+		LdapBindpw:               g.LdapBindpw,
+		LdapAttrUser:             g.LdapAttrUser,
+		LdapAttrGroup:            g.LdapAttrGroup,
+		LdapAttrMember:           g.LdapAttrMember,
+		LdapRfc2307:              g.LdapRfc2307,
+		LdapRfc2307Userdn:        g.LdapRfc2307Userdn,
+		LdapAttrGroupobj:         g.LdapAttrGroupobj,
+		LdapPamGroupdn:           g.LdapPamGroupdn,
+		LdapUtf8:                 g.LdapUtf8,
+		LdapNostripAt:            g.LdapNostripAt,
+		LdapAllowUnauthenticated: g.LdapAllowUnauthenticated,
+		RadiusSecret:             g.RadiusSecret,
+		RadiusAuthPort:           g.RadiusAuthPort,
+		RadiusAcctPort:           g.RadiusAcctPort,
+		RadiusProtocol:           g.RadiusProtocol,
+		RadiusTimeout:            g.RadiusTimeout,
+		RadiusNasipAttribute:     g.RadiusNasipAttribute,
+	}
+}
+
+// ToModel copies p into a new AuthServer, sharing its slices and pointers.
+func (p *PostUserAuthServerEndpointResponseData) ToModel() *AuthServer {
+	if p == nil {
+		return nil
+	}
+	return &AuthServer{
+		Refid:                    p.Refid,
+		Type:                     p.Type,
+		Name:                     p.Name,
+		Host:                     p.Host,
+		LdapPort:                 p.LdapPort,
+		LdapUrltype:              p.LdapUrltype,
+		LdapProtver:              p.LdapProtver,
+		LdapTimeout:              p.LdapTimeout,
+		LdapCaref:                p.LdapCaref,
+		LdapScope:                p.LdapScope,
+		LdapBasedn:               p.LdapBasedn,
+		LdapAuthcn:               p.LdapAuthcn,
+		LdapExtendedEnabled:      p.LdapExtendedEnabled,
+		LdapExtendedQuery:        p.LdapExtendedQuery,
+		LdapBinddn:               p.LdapBinddn,
+		LdapBindpw:               p.LdapBindpw,
+		LdapAttrUser:             p.LdapAttrUser,
+		LdapAttrGroup:            p.LdapAttrGroup,
+		LdapAttrMember:           p.LdapAttrMember,
+		LdapRfc2307:              p.LdapRfc2307,
+		LdapRfc2307Userdn:        p.LdapRfc2307Userdn,
+		LdapAttrGroupobj:         p.LdapAttrGroupobj,
+		LdapPamGroupdn:           p.LdapPamGroupdn,
+		LdapUtf8:                 p.LdapUtf8,
+		LdapNostripAt:            p.LdapNostripAt,
+		LdapAllowUnauthenticated: p.LdapAllowUnauthenticated,
+		RadiusSecret:             p.RadiusSecret,
+		RadiusAuthPort:           p.RadiusAuthPort,
+		RadiusAcctPort:           p.RadiusAcctPort,
+		RadiusProtocol:           p.RadiusProtocol,
+		RadiusTimeout:            p.RadiusTimeout,
+		RadiusNasipAttribute:     p.RadiusNasipAttribute,
+	}
+}
+
+// ToModel copies p into a new AuthServer, sharing its slices and pointers.
+func (p *PatchUserAuthServerEndpointResponseData) ToModel() *AuthServer {
+	if p == nil {
+		return nil
+	}
+	return &AuthServer{
+		Refid:                    p.Refid,
+		Type:                     p.Type,
+		Name:                     p.Name,
+		Host:                     p.Host,
+		LdapPort:                 p.LdapPort,
+		LdapUrltype:              p.LdapUrltype,
+		LdapProtver:              p.LdapProtver,
+		LdapTimeout:              p.LdapTimeout,
+		LdapCaref:                p.LdapCaref,
+		LdapScope:                p.LdapScope,
+		LdapBasedn:               p.LdapBasedn,
+		LdapAuthcn:               p.LdapAuthcn,
+		LdapExtendedEnabled:      p.LdapExtendedEnabled,
+		LdapExtendedQuery:        p.LdapExtendedQuery,
+		LdapBinddn:               p.LdapBinddn,
+		LdapBindpw:               p.LdapBindpw,
+		LdapAttrUser:             p.LdapAttrUser,
+		LdapAttrGroup:            p.LdapAttrGroup,
+		LdapAttrMember:           p.LdapAttrMember,
+		LdapRfc2307:              p.LdapRfc2307,
+		LdapRfc2307Userdn:        p.LdapRfc2307Userdn,
+		LdapAttrGroupobj:         p.LdapAttrGroupobj,
+		LdapPamGroupdn:           p.LdapPamGroupdn,
+		LdapUtf8:                 p.LdapUtf8,
+		LdapNostripAt:            p.LdapNostripAt,
+		LdapAllowUnauthenticated: p.LdapAllowUnauthenticated,
+		RadiusSecret:             p.RadiusSecret,
+		RadiusAuthPort:           p.RadiusAuthPort,
+		RadiusAcctPort:           p.RadiusAcctPort,
+		RadiusProtocol:           p.RadiusProtocol,
+		RadiusTimeout:            p.RadiusTimeout,
+		RadiusNasipAttribute:     p.RadiusNasipAttribute,
+	}
+}
+
+// ToModel copies g into a new AuthServer, sharing its slices and pointers.
+func (g *GetUserAuthServersEndpointResponseDataItem) ToModel() *AuthServer {
+	if g == nil {
+		return nil
+	}
+	return &AuthServer{
+		Refid:                    g.Refid,
+		Type:                     g.Type,
+		Name:                     g.Name,
+		Host:                     g.Host,
+		LdapPort:                 g.LdapPort,
+		LdapUrltype:              g.LdapUrltype,
+		LdapProtver:              g.LdapProtver,
+		LdapTimeout:              g.LdapTimeout,
+		LdapCaref:                g.LdapCaref,
+		LdapScope:                g.LdapScope,
+		LdapBasedn:               g.LdapBasedn,
+		LdapAuthcn:               g.LdapAuthcn,
+		LdapExtendedEnabled:      g.LdapExtendedEnabled,
+		LdapExtendedQuery:        g.LdapExtendedQuery,
+		LdapBinddn:               g.LdapBinddn,
+		LdapBindpw:               g.LdapBindpw,
+		LdapAttrUser:             g.LdapAttrUser,
+		LdapAttrGroup:            g.LdapAttrGroup,
+		LdapAttrMember:           g.LdapAttrMember,
+		LdapRfc2307:              g.LdapRfc2307,
+		LdapRfc2307Userdn:        g.LdapRfc2307Userdn,
+		LdapAttrGroupobj:         g.LdapAttrGroupobj,
+		LdapPamGroupdn:           g.LdapPamGroupdn,
+		LdapUtf8:                 g.LdapUtf8,
+		LdapNostripAt:            g.LdapNostripAt,
+		LdapAllowUnauthenticated: g.LdapAllowUnauthenticated,
+		RadiusSecret:             g.RadiusSecret,
+		RadiusAuthPort:           g.RadiusAuthPort,
+		RadiusAcctPort:           g.RadiusAcctPort,
+		RadiusProtocol:           g.RadiusProtocol,
+		RadiusTimeout:            g.RadiusTimeout,
+		RadiusNasipAttribute:     g.RadiusNasipAttribute,
+	}
+}
+
+// ToPost returns a request that creates a copy of b. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with b.
+func (b *BindAccessListEntry) ToPost(parentID int) *PostServicesBindAccessListEntryEndpointRequest {
+	if b == nil {
+		return &PostServicesBindAccessListEntryEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesBindAccessListEntryEndpointRequest{
+		ParentID:    parentID,
+		Value:       b.Value,
+		Description: b.Description,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with b. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with b.
+func (b *BindAccessListEntry) ToPatch(parentID, id int) *PatchServicesBindAccessListEntryEndpointRequest {
+	if b == nil {
+		return &PatchServicesBindAccessListEntryEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesBindAccessListEntryEndpointRequest{
+		ParentID:    parentID,
+		ID:          id,
+		Value:       b.Value,
+		Description: b.Description,
+	}
+}
+
+// ToModel copies g into a new BindAccessListEntry, sharing its slices and pointers.
+func (g *GetServicesBindAccessListEntryEndpointResponseData) ToModel() *BindAccessListEntry {
+	if g == nil {
+		return nil
+	}
+	return &BindAccessListEntry{
+		Value:       g.Value,
+		Description: g.Description,
+	}
+}
+
+// ToModel copies p into a new BindAccessListEntry, sharing its slices and pointers.
+func (p *PostServicesBindAccessListEntryEndpointResponseData) ToModel() *BindAccessListEntry {
+	if p == nil {
+		return nil
+	}
+	return &BindAccessListEntry{
+		Value:       p.Value,
+		Description: p.Description,
+	}
+}
+
+// ToModel copies p into a new BindAccessListEntry, sharing its slices and pointers.
+func (p *PatchServicesBindAccessListEntryEndpointResponseData) ToModel() *BindAccessListEntry {
+	if p == nil {
+		return nil
+	}
+	return &BindAccessListEntry{
+		Value:       p.Value,
+		Description: p.Description,
+	}
+}
+
+// ToModel copies g into a new BindAccessListEntry, sharing its slices and pointers.
+func (g *GetServicesBindAccessListEntriesEndpointResponseDataItem) ToModel() *BindAccessListEntry {
+	if g == nil {
+		return nil
+	}
+	return &BindAccessListEntry{
+		Value:       g.Value,
+		Description: g.Description,
+	}
+}
+
+// ToPost returns a request that creates a copy of b. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with b.
+func (b *BindAccessList) ToPost() *PostServicesBindAccessListEndpointRequest {
+	if b == nil {
+		return &PostServicesBindAccessListEndpointRequest{}
+	}
+	return &PostServicesBindAccessListEndpointRequest{
+		Name:        b.Name,
+		Description: b.Description,
+		Entries:     b.Entries,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with b. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with b.
+func (b *BindAccessList) ToPatch(id int) *PatchServicesBindAccessListEndpointRequest {
+	if b == nil {
+		return &PatchServicesBindAccessListEndpointRequest{ID: id}
+	}
+	return &PatchServicesBindAccessListEndpointRequest{
+		ID:          id,
+		Name:        b.Name,
+		Description: b.Description,
+		Entries:     b.Entries,
+	}
+}
+
+// ToPutItem returns b as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with b.
+func (b *BindAccessList) ToPutItem() *PutServicesBindAccessListsEndpointRequestItem {
+	if b == nil {
+		return nil
+	}
+	return &PutServicesBindAccessListsEndpointRequestItem{
+		Name:        b.Name,
+		Description: b.Description,
+		Entries:     b.Entries,
+	}
+}
+
+// ToModel copies g into a new BindAccessList, sharing its slices and pointers.
+func (g *GetServicesBindAccessListEndpointResponseData) ToModel() *BindAccessList {
+	if g == nil {
+		return nil
+	}
+	return &BindAccessList{
+		Name:        g.Name,
+		Description: g.Description,
+		Entries:     g.Entries,
+	}
+}
+
+// ToModel copies p into a new BindAccessList, sharing its slices and pointers.
+func (p *PostServicesBindAccessListEndpointResponseData) ToModel() *BindAccessList {
+	if p == nil {
+		return nil
+	}
+	return &BindAccessList{
+		Name:        p.Name,
+		Description: p.Description,
+		Entries:     p.Entries,
+	}
+}
+
+// ToModel copies p into a new BindAccessList, sharing its slices and pointers.
+func (p *PatchServicesBindAccessListEndpointResponseData) ToModel() *BindAccessList {
+	if p == nil {
+		return nil
+	}
+	return &BindAccessList{
+		Name:        p.Name,
+		Description: p.Description,
+		Entries:     p.Entries,
+	}
+}
+
+// ToModel copies g into a new BindAccessList, sharing its slices and pointers.
+func (g *GetServicesBindAccessListsEndpointResponseDataItem) ToModel() *BindAccessList {
+	if g == nil {
+		return nil
+	}
+	return &BindAccessList{
+		Name:        g.Name,
+		Description: g.Description,
+		Entries:     g.Entries,
+	}
+}
+
+// ToPost returns a request that creates a copy of b. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with b.
+func (b *BindSyncRemoteHost) ToPost() *PostServicesBindSyncRemoteHostEndpointRequest {
+	if b == nil {
+		return &PostServicesBindSyncRemoteHostEndpointRequest{}
+	}
+	return &PostServicesBindSyncRemoteHostEndpointRequest{
+		Syncdestinenable: b.Syncdestinenable,
+		Syncprotocol:     b.Syncprotocol,
+		Ipaddress:        b.Ipaddress,
+		Syncport:         b.Syncport,
+		Username:         b.Username,
+		Password:         b.Password,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with b. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with b.
+func (b *BindSyncRemoteHost) ToPatch(id int) *PatchServicesBindSyncRemoteHostEndpointRequest {
+	if b == nil {
+		return &PatchServicesBindSyncRemoteHostEndpointRequest{ID: id}
+	}
+	return &PatchServicesBindSyncRemoteHostEndpointRequest{
+		ID:               id,
+		Syncdestinenable: b.Syncdestinenable,
+		Syncprotocol:     b.Syncprotocol,
+		Ipaddress:        b.Ipaddress,
+		Syncport:         b.Syncport,
+		Username:         b.Username,
+		Password:         b.Password,
+	}
+}
+
+// ToPutItem returns b as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with b.
+func (b *BindSyncRemoteHost) ToPutItem() *PutServicesBindSyncRemoteHostsEndpointRequestItem {
+	if b == nil {
+		return nil
+	}
+	return &PutServicesBindSyncRemoteHostsEndpointRequestItem{
+		Syncdestinenable: b.Syncdestinenable,
+		Syncprotocol:     b.Syncprotocol,
+		Ipaddress:        b.Ipaddress,
+		Syncport:         b.Syncport,
+		Username:         b.Username,
+		Password:         b.Password,
+	}
+}
+
+// ToModel copies g into a new BindSyncRemoteHost, sharing its slices and pointers.
+func (g *GetServicesBindSyncRemoteHostEndpointResponseData) ToModel() *BindSyncRemoteHost {
+	if g == nil {
+		return nil
+	}
+	return &BindSyncRemoteHost{
+		Syncdestinenable: g.Syncdestinenable,
+		Syncprotocol:     g.Syncprotocol,
+		Ipaddress:        g.Ipaddress,
+		Syncport:         g.Syncport,
+		Username:         g.Username,
+		Password:         g.Password,
+	}
+}
+
+// ToModel copies p into a new BindSyncRemoteHost, sharing its slices and pointers.
+func (p *PostServicesBindSyncRemoteHostEndpointResponseData) ToModel() *BindSyncRemoteHost {
+	if p == nil {
+		return nil
+	}
+	return &BindSyncRemoteHost{
+		Syncdestinenable: p.Syncdestinenable,
+		Syncprotocol:     p.Syncprotocol,
+		Ipaddress:        p.Ipaddress,
+		Syncport:         p.Syncport,
+		Username:         p.Username,
+		Password:         p.Password,
+	}
+}
+
+// ToModel copies p into a new BindSyncRemoteHost, sharing its slices and pointers.
+func (p *PatchServicesBindSyncRemoteHostEndpointResponseData) ToModel() *BindSyncRemoteHost {
+	if p == nil {
+		return nil
+	}
+	return &BindSyncRemoteHost{
+		Syncdestinenable: p.Syncdestinenable,
+		Syncprotocol:     p.Syncprotocol,
+		Ipaddress:        p.Ipaddress,
+		Syncport:         p.Syncport,
+		Username:         p.Username,
+		Password:         p.Password,
+	}
+}
+
+// ToModel copies g into a new BindSyncRemoteHost, sharing its slices and pointers.
+func (g *GetServicesBindSyncRemoteHostsEndpointResponseDataItem) ToModel() *BindSyncRemoteHost {
+	if g == nil {
+		return nil
+	}
+	return &BindSyncRemoteHost{
+		Syncdestinenable: g.Syncdestinenable,
+		Syncprotocol:     g.Syncprotocol,
+		Ipaddress:        g.Ipaddress,
+		Syncport:         g.Syncport,
+		Username:         g.Username,
+		Password:         g.Password,
+	}
+}
+
+// ToPost returns a request that creates a copy of b. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with b.
+func (b *BindView) ToPost() *PostServicesBindViewEndpointRequest {
+	if b == nil {
+		return &PostServicesBindViewEndpointRequest{}
+	}
+	return &PostServicesBindViewEndpointRequest{
+		Name:              b.Name,
+		Descr:             b.Descr,
+		Recursion:         b.Recursion,
+		MatchClients:      b.MatchClients,
+		AllowRecursion:    b.AllowRecursion,
+		BindCustomOptions: b.BindCustomOptions,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with b. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with b.
+func (b *BindView) ToPatch(id int) *PatchServicesBindViewEndpointRequest {
+	if b == nil {
+		return &PatchServicesBindViewEndpointRequest{ID: id}
+	}
+	return &PatchServicesBindViewEndpointRequest{
+		ID:                id,
+		Name:              b.Name,
+		Descr:             b.Descr,
+		Recursion:         b.Recursion,
+		MatchClients:      b.MatchClients,
+		AllowRecursion:    b.AllowRecursion,
+		BindCustomOptions: b.BindCustomOptions,
+	}
+}
+
+// ToPutItem returns b as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with b.
+func (b *BindView) ToPutItem() *PutServicesBindViewsEndpointRequestItem {
+	if b == nil {
+		return nil
+	}
+	return &PutServicesBindViewsEndpointRequestItem{
+		Name:              b.Name,
+		Descr:             b.Descr,
+		Recursion:         b.Recursion,
+		MatchClients:      b.MatchClients,
+		AllowRecursion:    b.AllowRecursion,
+		BindCustomOptions: b.BindCustomOptions,
+	}
+}
+
+// ToModel copies g into a new BindView, sharing its slices and pointers.
+func (g *GetServicesBindViewEndpointResponseData) ToModel() *BindView {
+	if g == nil {
+		return nil
+	}
+	return &BindView{
+		Name:              g.Name,
+		Descr:             g.Descr,
+		Recursion:         g.Recursion,
+		MatchClients:      g.MatchClients,
+		AllowRecursion:    g.AllowRecursion,
+		BindCustomOptions: g.BindCustomOptions,
+	}
+}
+
+// ToModel copies p into a new BindView, sharing its slices and pointers.
+func (p *PostServicesBindViewEndpointResponseData) ToModel() *BindView {
+	if p == nil {
+		return nil
+	}
+	return &BindView{
+		Name:              p.Name,
+		Descr:             p.Descr,
+		Recursion:         p.Recursion,
+		MatchClients:      p.MatchClients,
+		AllowRecursion:    p.AllowRecursion,
+		BindCustomOptions: p.BindCustomOptions,
+	}
+}
+
+// ToModel copies p into a new BindView, sharing its slices and pointers.
+func (p *PatchServicesBindViewEndpointResponseData) ToModel() *BindView {
+	if p == nil {
+		return nil
+	}
+	return &BindView{
+		Name:              p.Name,
+		Descr:             p.Descr,
+		Recursion:         p.Recursion,
+		MatchClients:      p.MatchClients,
+		AllowRecursion:    p.AllowRecursion,
+		BindCustomOptions: p.BindCustomOptions,
+	}
+}
+
+// ToModel copies g into a new BindView, sharing its slices and pointers.
+func (g *GetServicesBindViewsEndpointResponseDataItem) ToModel() *BindView {
+	if g == nil {
+		return nil
+	}
+	return &BindView{
+		Name:              g.Name,
+		Descr:             g.Descr,
+		Recursion:         g.Recursion,
+		MatchClients:      g.MatchClients,
+		AllowRecursion:    g.AllowRecursion,
+		BindCustomOptions: g.BindCustomOptions,
+	}
+}
+
+// ToPost returns a request that creates a copy of b. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with b.
+func (b *BindZoneRecord) ToPost(parentID int) *PostServicesBindZoneRecordEndpointRequest {
+	if b == nil {
+		return &PostServicesBindZoneRecordEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesBindZoneRecordEndpointRequest{
+		ParentID: parentID,
+		Name:     b.Name,
+		Type:     b.Type,
+		Rdata:    b.Rdata,
+		Priority: b.Priority,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with b. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with b.
+func (b *BindZoneRecord) ToPatch(parentID, id int) *PatchServicesBindZoneRecordEndpointRequest {
+	if b == nil {
+		return &PatchServicesBindZoneRecordEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesBindZoneRecordEndpointRequest{
+		ParentID: parentID,
+		ID:       id,
+		Name:     b.Name,
+		Type:     b.Type,
+		Rdata:    b.Rdata,
+		Priority: b.Priority,
+	}
+}
+
+// ToModel copies g into a new BindZoneRecord, sharing its slices and pointers.
+func (g *GetServicesBindZoneRecordEndpointResponseData) ToModel() *BindZoneRecord {
+	if g == nil {
+		return nil
+	}
+	return &BindZoneRecord{
+		Name:     g.Name,
+		Type:     g.Type,
+		Rdata:    g.Rdata,
+		Priority: g.Priority,
+	}
+}
+
+// ToModel copies p into a new BindZoneRecord, sharing its slices and pointers.
+func (p *PostServicesBindZoneRecordEndpointResponseData) ToModel() *BindZoneRecord {
+	if p == nil {
+		return nil
+	}
+	return &BindZoneRecord{
+		Name:     p.Name,
+		Type:     p.Type,
+		Rdata:    p.Rdata,
+		Priority: p.Priority,
+	}
+}
+
+// ToModel copies p into a new BindZoneRecord, sharing its slices and pointers.
+func (p *PatchServicesBindZoneRecordEndpointResponseData) ToModel() *BindZoneRecord {
+	if p == nil {
+		return nil
+	}
+	return &BindZoneRecord{
+		Name:     p.Name,
+		Type:     p.Type,
+		Rdata:    p.Rdata,
+		Priority: p.Priority,
+	}
+}
+
+// ToPost returns a request that creates a copy of b. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with b.
+func (b *BindZone) ToPost() *PostServicesBindZoneEndpointRequest {
+	if b == nil {
+		return &PostServicesBindZoneEndpointRequest{}
+	}
+	return &PostServicesBindZoneEndpointRequest{
+		Disabled:           b.Disabled,
+		Name:               b.Name,
+		Description:        b.Description,
+		Type:               b.Type,
+		View:               b.View,
+		Reversev4:          b.Reversev4,
+		Reversev6:          b.Reversev6,
+		Rpz:                b.Rpz,
+		Custom:             b.Custom,
+		Dnssec:             b.Dnssec,
+		Backupkeys:         b.Backupkeys,
+		Slaveip:            b.Slaveip,
+		Forwarders:         b.Forwarders,
+		TTL:                b.TTL,
+		Baseip:             b.Baseip,
+		Nameserver:         b.Nameserver,
+		Mail:               b.Mail,
+		Serial:             b.Serial,
+		Refresh:            b.Refresh,
+		Retry:              b.Retry,
+		Expire:             b.Expire,
+		Minimum:            b.Minimum,
+		EnableUpdatepolicy: b.EnableUpdatepolicy,
+		Updatepolicy:       b.Updatepolicy,
+		Allowupdate:        b.Allowupdate,
+		Allowtransfer:      b.Allowtransfer,
+		Allowquery:         b.Allowquery,
+		Regdhcpstatic:      b.Regdhcpstatic,
+		Customzonerecords:  b.Customzonerecords,
+		Records:            b.Records,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with b. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with b.
+func (b *BindZone) ToPatch(id int) *PatchServicesBindZoneEndpointRequest {
+	if b == nil {
+		return &PatchServicesBindZoneEndpointRequest{ID: id}
+	}
+	return &PatchServicesBindZoneEndpointRequest{
+		ID:                 id,
+		Disabled:           b.Disabled,
+		Name:               b.Name,
+		Description:        b.Description,
+		Type:               b.Type,
+		View:               b.View,
+		Reversev4:          b.Reversev4,
+		Reversev6:          b.Reversev6,
+		Rpz:                b.Rpz,
+		Custom:             b.Custom,
+		Dnssec:             b.Dnssec,
+		Backupkeys:         b.Backupkeys,
+		Slaveip:            b.Slaveip,
+		Forwarders:         b.Forwarders,
+		TTL:                b.TTL,
+		Baseip:             b.Baseip,
+		Nameserver:         b.Nameserver,
+		Mail:               b.Mail,
+		Serial:             b.Serial,
+		Refresh:            b.Refresh,
+		Retry:              b.Retry,
+		Expire:             b.Expire,
+		Minimum:            b.Minimum,
+		EnableUpdatepolicy: b.EnableUpdatepolicy,
+		Updatepolicy:       b.Updatepolicy,
+		Allowupdate:        b.Allowupdate,
+		Allowtransfer:      b.Allowtransfer,
+		Allowquery:         b.Allowquery,
+		Regdhcpstatic:      b.Regdhcpstatic,
+		Customzonerecords:  b.Customzonerecords,
+		Records:            b.Records,
+	}
+}
+
+// ToPutItem returns b as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with b.
+func (b *BindZone) ToPutItem() *PutServicesBindZonesEndpointRequestItem {
+	if b == nil {
+		return nil
+	}
+	return &PutServicesBindZonesEndpointRequestItem{
+		Disabled:           b.Disabled,
+		Name:               b.Name,
+		Description:        b.Description,
+		Type:               b.Type,
+		View:               b.View,
+		Reversev4:          b.Reversev4,
+		Reversev6:          b.Reversev6,
+		Rpz:                b.Rpz,
+		Custom:             b.Custom,
+		Dnssec:             b.Dnssec,
+		Backupkeys:         b.Backupkeys,
+		Slaveip:            b.Slaveip,
+		Forwarders:         b.Forwarders,
+		TTL:                b.TTL,
+		Baseip:             b.Baseip,
+		Nameserver:         b.Nameserver,
+		Mail:               b.Mail,
+		Serial:             b.Serial,
+		Refresh:            b.Refresh,
+		Retry:              b.Retry,
+		Expire:             b.Expire,
+		Minimum:            b.Minimum,
+		EnableUpdatepolicy: b.EnableUpdatepolicy,
+		Updatepolicy:       b.Updatepolicy,
+		Allowupdate:        b.Allowupdate,
+		Allowtransfer:      b.Allowtransfer,
+		Allowquery:         b.Allowquery,
+		Regdhcpstatic:      b.Regdhcpstatic,
+		Customzonerecords:  b.Customzonerecords,
+		Records:            b.Records,
+	}
+}
+
+// ToModel copies g into a new BindZone, sharing its slices and pointers.
+func (g *GetServicesBindZoneEndpointResponseData) ToModel() *BindZone {
+	if g == nil {
+		return nil
+	}
+	return &BindZone{
+		Disabled:           g.Disabled,
+		Name:               g.Name,
+		Description:        g.Description,
+		Type:               g.Type,
+		View:               g.View,
+		Reversev4:          g.Reversev4,
+		Reversev6:          g.Reversev6,
+		Rpz:                g.Rpz,
+		Custom:             g.Custom,
+		Dnssec:             g.Dnssec,
+		Backupkeys:         g.Backupkeys,
+		Slaveip:            g.Slaveip,
+		Forwarders:         g.Forwarders,
+		TTL:                g.TTL,
+		Baseip:             g.Baseip,
+		Nameserver:         g.Nameserver,
+		Mail:               g.Mail,
+		Serial:             g.Serial,
+		Refresh:            g.Refresh,
+		Retry:              g.Retry,
+		Expire:             g.Expire,
+		Minimum:            g.Minimum,
+		EnableUpdatepolicy: g.EnableUpdatepolicy,
+		Updatepolicy:       g.Updatepolicy,
+		Allowupdate:        g.Allowupdate,
+		Allowtransfer:      g.Allowtransfer,
+		Allowquery:         g.Allowquery,
+		Regdhcpstatic:      g.Regdhcpstatic,
+		Customzonerecords:  g.Customzonerecords,
+		Records:            g.Records,
+	}
+}
+
+// ToModel copies p into a new BindZone, sharing its slices and pointers.
+func (p *PostServicesBindZoneEndpointResponseData) ToModel() *BindZone {
+	if p == nil {
+		return nil
+	}
+	return &BindZone{
+		Disabled:           p.Disabled,
+		Name:               p.Name,
+		Description:        p.Description,
+		Type:               p.Type,
+		View:               p.View,
+		Reversev4:          p.Reversev4,
+		Reversev6:          p.Reversev6,
+		Rpz:                p.Rpz,
+		Custom:             p.Custom,
+		Dnssec:             p.Dnssec,
+		Backupkeys:         p.Backupkeys,
+		Slaveip:            p.Slaveip,
+		Forwarders:         p.Forwarders,
+		TTL:                p.TTL,
+		Baseip:             p.Baseip,
+		Nameserver:         p.Nameserver,
+		Mail:               p.Mail,
+		Serial:             p.Serial,
+		Refresh:            p.Refresh,
+		Retry:              p.Retry,
+		Expire:             p.Expire,
+		Minimum:            p.Minimum,
+		EnableUpdatepolicy: p.EnableUpdatepolicy,
+		Updatepolicy:       p.Updatepolicy,
+		Allowupdate:        p.Allowupdate,
+		Allowtransfer:      p.Allowtransfer,
+		Allowquery:         p.Allowquery,
+		Regdhcpstatic:      p.Regdhcpstatic,
+		Customzonerecords:  p.Customzonerecords,
+		Records:            p.Records,
+	}
+}
+
+// ToModel copies p into a new BindZone, sharing its slices and pointers.
+func (p *PatchServicesBindZoneEndpointResponseData) ToModel() *BindZone {
+	if p == nil {
+		return nil
+	}
+	return &BindZone{
+		Disabled:           p.Disabled,
+		Name:               p.Name,
+		Description:        p.Description,
+		Type:               p.Type,
+		View:               p.View,
+		Reversev4:          p.Reversev4,
+		Reversev6:          p.Reversev6,
+		Rpz:                p.Rpz,
+		Custom:             p.Custom,
+		Dnssec:             p.Dnssec,
+		Backupkeys:         p.Backupkeys,
+		Slaveip:            p.Slaveip,
+		Forwarders:         p.Forwarders,
+		TTL:                p.TTL,
+		Baseip:             p.Baseip,
+		Nameserver:         p.Nameserver,
+		Mail:               p.Mail,
+		Serial:             p.Serial,
+		Refresh:            p.Refresh,
+		Retry:              p.Retry,
+		Expire:             p.Expire,
+		Minimum:            p.Minimum,
+		EnableUpdatepolicy: p.EnableUpdatepolicy,
+		Updatepolicy:       p.Updatepolicy,
+		Allowupdate:        p.Allowupdate,
+		Allowtransfer:      p.Allowtransfer,
+		Allowquery:         p.Allowquery,
+		Regdhcpstatic:      p.Regdhcpstatic,
+		Customzonerecords:  p.Customzonerecords,
+		Records:            p.Records,
+	}
+}
+
+// ToModel copies g into a new BindZone, sharing its slices and pointers.
+func (g *GetServicesBindZonesEndpointResponseDataItem) ToModel() *BindZone {
+	if g == nil {
+		return nil
+	}
+	return &BindZone{
+		Disabled:           g.Disabled,
+		Name:               g.Name,
+		Description:        g.Description,
+		Type:               g.Type,
+		View:               g.View,
+		Reversev4:          g.Reversev4,
+		Reversev6:          g.Reversev6,
+		Rpz:                g.Rpz,
+		Custom:             g.Custom,
+		Dnssec:             g.Dnssec,
+		Backupkeys:         g.Backupkeys,
+		Slaveip:            g.Slaveip,
+		Forwarders:         g.Forwarders,
+		TTL:                g.TTL,
+		Baseip:             g.Baseip,
+		Nameserver:         g.Nameserver,
+		Mail:               g.Mail,
+		Serial:             g.Serial,
+		Refresh:            g.Refresh,
+		Retry:              g.Retry,
+		Expire:             g.Expire,
+		Minimum:            g.Minimum,
+		EnableUpdatepolicy: g.EnableUpdatepolicy,
+		Updatepolicy:       g.Updatepolicy,
+		Allowupdate:        g.Allowupdate,
+		Allowtransfer:      g.Allowtransfer,
+		Allowquery:         g.Allowquery,
+		Regdhcpstatic:      g.Regdhcpstatic,
+		Customzonerecords:  g.Customzonerecords,
+		Records:            g.Records,
+	}
+}
+
+// ToPost returns a request that creates a copy of c. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with c.
+func (c *CertificateAuthority) ToPost() *PostSystemCertificateAuthorityEndpointRequest {
+	if c == nil {
+		return &PostSystemCertificateAuthorityEndpointRequest{}
+	}
+	return &PostSystemCertificateAuthorityEndpointRequest{
+		Descr:        c.Descr,
+		Refid:        c.Refid,
+		Trust:        c.Trust,
+		Randomserial: c.Randomserial,
+		Serial:       c.Serial,
+		Crt:          c.Crt,
+		Prv:          c.Prv,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with c. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with c.
+func (c *CertificateAuthority) ToPatch(id int) *PatchSystemCertificateAuthorityEndpointRequest {
+	if c == nil {
+		return &PatchSystemCertificateAuthorityEndpointRequest{ID: id}
+	}
+	return &PatchSystemCertificateAuthorityEndpointRequest{
+		ID:           id,
+		Descr:        c.Descr,
+		Refid:        c.Refid,
+		Trust:        c.Trust,
+		Randomserial: c.Randomserial,
+		Serial:       c.Serial,
+		Crt:          c.Crt,
+		Prv:          c.Prv,
+	}
+}
+
+// ToModel copies g into a new CertificateAuthority, sharing its slices and pointers.
+func (g *GetSystemCertificateAuthorityEndpointResponseData) ToModel() *CertificateAuthority {
+	if g == nil {
+		return nil
+	}
+	return &CertificateAuthority{
+		Descr:        g.Descr,
+		Refid:        g.Refid,
+		Trust:        g.Trust,
+		Randomserial: g.Randomserial,
+		Serial:       g.Serial,
+		Crt:          g.Crt,
+		Prv:          g.Prv,
+	}
+}
+
+// ToModel copies p into a new CertificateAuthority, sharing its slices and pointers.
+func (p *PostSystemCertificateAuthorityEndpointResponseData) ToModel() *CertificateAuthority {
+	if p == nil {
+		return nil
+	}
+	return &CertificateAuthority{
+		Descr:        p.Descr,
+		Refid:        p.Refid,
+		Trust:        p.Trust,
+		Randomserial: p.Randomserial,
+		Serial:       p.Serial,
+		Crt:          p.Crt,
+		Prv:          p.Prv,
+	}
+}
+
+// ToModel copies p into a new CertificateAuthority, sharing its slices and pointers.
+func (p *PatchSystemCertificateAuthorityEndpointResponseData) ToModel() *CertificateAuthority {
+	if p == nil {
+		return nil
+	}
+	return &CertificateAuthority{
+		Descr:        p.Descr,
+		Refid:        p.Refid,
+		Trust:        p.Trust,
+		Randomserial: p.Randomserial,
+		Serial:       p.Serial,
+		Crt:          p.Crt,
+		Prv:          p.Prv,
+	}
+}
+
+// ToModel copies g into a new CertificateAuthority, sharing its slices and pointers.
+func (g *GetSystemCertificateAuthoritiesEndpointResponseDataItem) ToModel() *CertificateAuthority {
+	if g == nil {
+		return nil
+	}
+	return &CertificateAuthority{
+		Descr:        g.Descr,
+		Refid:        g.Refid,
+		Trust:        g.Trust,
+		Randomserial: g.Randomserial,
+		Serial:       g.Serial,
+		Crt:          g.Crt,
+		Prv:          g.Prv,
+	}
+}
+
+// ToPost returns a request that creates a copy of c. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with c.
+func (c *CertificateRevocationListRevokedCertificate) ToPost(parentID int) *PostSystemCrlRevokedCertificateEndpointRequest {
+	if c == nil {
+		return &PostSystemCrlRevokedCertificateEndpointRequest{ParentID: parentID}
+	}
+	return &PostSystemCrlRevokedCertificateEndpointRequest{
+		ParentID:   parentID,
+		Certref:    c.Certref,
+		Serial:     c.Serial,
+		Reason:     c.Reason,
+		RevokeTime: c.RevokeTime,
+		Caref:      c.Caref,
+		Descr:      c.Descr,
+		Type:       c.Type,
+		Crt:        c.Crt,
+		Prv:        c.Prv,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with c. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with c.
+func (c *CertificateRevocationListRevokedCertificate) ToPatch(parentID, id int) *PatchSystemCrlRevokedCertificateEndpointRequest {
+	if c == nil {
+		return &PatchSystemCrlRevokedCertificateEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchSystemCrlRevokedCertificateEndpointRequest{
+		ParentID:   parentID,
+		ID:         id,
+		Certref:    c.Certref,
+		Serial:     c.Serial,
+		Reason:     c.Reason,
+		RevokeTime: c.RevokeTime,
+		Caref:      c.Caref,
+		Descr:      c.Descr,
+		Type:       c.Type,
+		Crt:        c.Crt,
+		Prv:        c.Prv,
+	}
+}
+
+// ToModel copies g into a new CertificateRevocationListRevokedCertificate, sharing its slices and pointers.
+func (g *GetSystemCrlRevokedCertificateEndpointResponseData) ToModel() *CertificateRevocationListRevokedCertificate {
+	if g == nil {
+		return nil
+	}
+	return &CertificateRevocationListRevokedCertificate{
+		Certref:    g.Certref,
+		Serial:     g.Serial,
+		Reason:     g.Reason,
+		RevokeTime: g.RevokeTime,
+		Caref:      g.Caref,
+		Descr:      g.Descr,
+		Type:       g.Type,
+		Crt:        g.Crt,
+		Prv:        g.Prv,
+	}
+}
+
+// ToModel copies p into a new CertificateRevocationListRevokedCertificate, sharing its slices and pointers.
+func (p *PostSystemCrlRevokedCertificateEndpointResponseData) ToModel() *CertificateRevocationListRevokedCertificate {
+	if p == nil {
+		return nil
+	}
+	return &CertificateRevocationListRevokedCertificate{
+		Certref:    p.Certref,
+		Serial:     p.Serial,
+		Reason:     p.Reason,
+		RevokeTime: p.RevokeTime,
+		Caref:      p.Caref,
+		Descr:      p.Descr,
+		Type:       p.Type,
+		Crt:        p.Crt,
+		Prv:        p.Prv,
+	}
+}
+
+// ToModel copies p into a new CertificateRevocationListRevokedCertificate, sharing its slices and pointers.
+func (p *PatchSystemCrlRevokedCertificateEndpointResponseData) ToModel() *CertificateRevocationListRevokedCertificate {
+	if p == nil {
+		return nil
+	}
+	return &CertificateRevocationListRevokedCertificate{
+		Certref:    p.Certref,
+		Serial:     p.Serial,
+		Reason:     p.Reason,
+		RevokeTime: p.RevokeTime,
+		Caref:      p.Caref,
+		Descr:      p.Descr,
+		Type:       p.Type,
+		Crt:        p.Crt,
+		Prv:        p.Prv,
+	}
+}
+
+// ToPost returns a request that creates a copy of c. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with c.
+func (c *CertificateRevocationList) ToPost() *PostSystemCrlEndpointRequest {
+	if c == nil {
+		return &PostSystemCrlEndpointRequest{}
+	}
+	return &PostSystemCrlEndpointRequest{
+		Refid:    c.Refid,
+		Caref:    c.Caref,
+		Descr:    c.Descr,
+		Method:   c.Method,
+		Lifetime: c.Lifetime,
+		Serial:   c.Serial,
+		Text:     c.Text,
+		Cert:     c.Cert,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with c. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with c.
+func (c *CertificateRevocationList) ToPatch(id int) *PatchSystemCrlEndpointRequest {
+	if c == nil {
+		return &PatchSystemCrlEndpointRequest{ID: id}
+	}
+	return &PatchSystemCrlEndpointRequest{
+		ID:       id,
+		Refid:    c.Refid,
+		Caref:    c.Caref,
+		Descr:    c.Descr,
+		Method:   c.Method,
+		Lifetime: c.Lifetime,
+		Serial:   c.Serial,
+		Text:     c.Text,
+		Cert:     c.Cert,
+	}
+}
+
+// ToModel copies g into a new CertificateRevocationList, sharing its slices and pointers.
+func (g *GetSystemCrlEndpointResponseData) ToModel() *CertificateRevocationList {
+	if g == nil {
+		return nil
+	}
+	return &CertificateRevocationList{
+		Refid:    g.Refid,
+		Caref:    g.Caref,
+		Descr:    g.Descr,
+		Method:   g.Method,
+		Lifetime: g.Lifetime,
+		Serial:   g.Serial,
+		Text:     g.Text,
+		Cert:     g.Cert,
+	}
+}
+
+// ToModel copies p into a new CertificateRevocationList, sharing its slices and pointers.
+func (p *PostSystemCrlEndpointResponseData) ToModel() *CertificateRevocationList {
+	if p == nil {
+		return nil
+	}
+	return &CertificateRevocationList{
+		Refid:    p.Refid,
+		Caref:    p.Caref,
+		Descr:    p.Descr,
+		Method:   p.Method,
+		Lifetime: p.Lifetime,
+		Serial:   p.Serial,
+		Text:     p.Text,
+		Cert:     p.Cert,
+	}
+}
+
+// ToModel copies p into a new CertificateRevocationList, sharing its slices and pointers.
+func (p *PatchSystemCrlEndpointResponseData) ToModel() *CertificateRevocationList {
+	if p == nil {
+		return nil
+	}
+	return &CertificateRevocationList{
+		Refid:    p.Refid,
+		Caref:    p.Caref,
+		Descr:    p.Descr,
+		Method:   p.Method,
+		Lifetime: p.Lifetime,
+		Serial:   p.Serial,
+		Text:     p.Text,
+		Cert:     p.Cert,
+	}
+}
+
+// ToModel copies g into a new CertificateRevocationList, sharing its slices and pointers.
+func (g *GetSystemCrLsEndpointResponseDataItem) ToModel() *CertificateRevocationList {
+	if g == nil {
+		return nil
+	}
+	return &CertificateRevocationList{
+		Refid:    g.Refid,
+		Caref:    g.Caref,
+		Descr:    g.Descr,
+		Method:   g.Method,
+		Lifetime: g.Lifetime,
+		Serial:   g.Serial,
+		Text:     g.Text,
+		Cert:     g.Cert,
+	}
+}
+
+// ToPost returns a request that creates a copy of c. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with c.
+func (c *Certificate) ToPost() *PostSystemCertificateEndpointRequest {
+	if c == nil {
+		return &PostSystemCertificateEndpointRequest{}
+	}
+	return &PostSystemCertificateEndpointRequest{
+		Descr: c.Descr,
+		Refid: c.Refid,
+		Caref: c.Caref,
+		Type:  c.Type,
+		Csr:   c.Csr,
+		Crt:   c.Crt,
+		Prv:   c.Prv,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with c. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with c.
+func (c *Certificate) ToPatch(id int) *PatchSystemCertificateEndpointRequest {
+	if c == nil {
+		return &PatchSystemCertificateEndpointRequest{ID: id}
+	}
+	return &PatchSystemCertificateEndpointRequest{
+		ID:    id,
+		Descr: c.Descr,
+		Refid: c.Refid,
+		Caref: c.Caref,
+		Type:  c.Type,
+		Csr:   c.Csr,
+		Crt:   c.Crt,
+		Prv:   c.Prv,
+	}
+}
+
+// ToModel copies g into a new Certificate, sharing its slices and pointers.
+func (g *GetSystemCertificateEndpointResponseData) ToModel() *Certificate {
+	if g == nil {
+		return nil
+	}
+	return &Certificate{
+		Descr: g.Descr,
+		Refid: g.Refid,
+		Caref: g.Caref,
+		Type:  g.Type,
+		Csr:   g.Csr,
+		Crt:   g.Crt,
+		Prv:   g.Prv,
+	}
+}
+
+// ToModel copies p into a new Certificate, sharing its slices and pointers.
+func (p *PostSystemCertificateEndpointResponseData) ToModel() *Certificate {
+	if p == nil {
+		return nil
+	}
+	return &Certificate{
+		Descr: p.Descr,
+		Refid: p.Refid,
+		Caref: p.Caref,
+		Type:  p.Type,
+		Csr:   p.Csr,
+		Crt:   p.Crt,
+		Prv:   p.Prv,
+	}
+}
+
+// ToModel copies p into a new Certificate, sharing its slices and pointers.
+func (p *PatchSystemCertificateEndpointResponseData) ToModel() *Certificate {
+	if p == nil {
+		return nil
+	}
+	return &Certificate{
+		Descr: p.Descr,
+		Refid: p.Refid,
+		Caref: p.Caref,
+		Type:  p.Type,
+		Csr:   p.Csr,
+		Crt:   p.Crt,
+		Prv:   p.Prv,
+	}
+}
+
+// ToModel copies g into a new Certificate, sharing its slices and pointers.
+func (g *GetSystemCertificatesEndpointResponseDataItem) ToModel() *Certificate {
+	if g == nil {
+		return nil
+	}
+	return &Certificate{
+		Descr: g.Descr,
+		Refid: g.Refid,
+		Caref: g.Caref,
+		Type:  g.Type,
+		Csr:   g.Csr,
+		Crt:   g.Crt,
+		Prv:   g.Prv,
+	}
+}
+
+// ToPost returns a request that creates a copy of c. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with c.
+func (c *CronJob) ToPost() *PostServicesCronJobEndpointRequest {
+	if c == nil {
+		return &PostServicesCronJobEndpointRequest{}
+	}
+	return &PostServicesCronJobEndpointRequest{
+		Minute:  c.Minute,
+		Hour:    c.Hour,
+		Mday:    c.Mday,
+		Month:   c.Month,
+		Wday:    c.Wday,
+		Who:     c.Who,
+		Command: c.Command,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with c. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with c.
+func (c *CronJob) ToPatch(id int) *PatchServicesCronJobEndpointRequest {
+	if c == nil {
+		return &PatchServicesCronJobEndpointRequest{ID: id}
+	}
+	return &PatchServicesCronJobEndpointRequest{
+		ID:      id,
+		Minute:  c.Minute,
+		Hour:    c.Hour,
+		Mday:    c.Mday,
+		Month:   c.Month,
+		Wday:    c.Wday,
+		Who:     c.Who,
+		Command: c.Command,
+	}
+}
+
+// ToPutItem returns c as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with c.
+func (c *CronJob) ToPutItem() *PutServicesCronJobsEndpointRequestItem {
+	if c == nil {
+		return nil
+	}
+	return &PutServicesCronJobsEndpointRequestItem{
+		Minute:  c.Minute,
+		Hour:    c.Hour,
+		Mday:    c.Mday,
+		Month:   c.Month,
+		Wday:    c.Wday,
+		Who:     c.Who,
+		Command: c.Command,
+	}
+}
+
+// ToModel copies g into a new CronJob, sharing its slices and pointers.
+func (g *GetServicesCronJobEndpointResponseData) ToModel() *CronJob {
+	if g == nil {
+		return nil
+	}
+	return &CronJob{
+		Minute:  g.Minute,
+		Hour:    g.Hour,
+		Mday:    g.Mday,
+		Month:   g.Month,
+		Wday:    g.Wday,
+		Who:     g.Who,
+		Command: g.Command,
+	}
+}
+
+// ToModel copies p into a new CronJob, sharing its slices and pointers.
+func (p *PostServicesCronJobEndpointResponseData) ToModel() *CronJob {
+	if p == nil {
+		return nil
+	}
+	return &CronJob{
+		Minute:  p.Minute,
+		Hour:    p.Hour,
+		Mday:    p.Mday,
+		Month:   p.Month,
+		Wday:    p.Wday,
+		Who:     p.Who,
+		Command: p.Command,
+	}
+}
+
+// ToModel copies p into a new CronJob, sharing its slices and pointers.
+func (p *PatchServicesCronJobEndpointResponseData) ToModel() *CronJob {
+	if p == nil {
+		return nil
+	}
+	return &CronJob{
+		Minute:  p.Minute,
+		Hour:    p.Hour,
+		Mday:    p.Mday,
+		Month:   p.Month,
+		Wday:    p.Wday,
+		Who:     p.Who,
+		Command: p.Command,
+	}
+}
+
+// ToModel copies g into a new CronJob, sharing its slices and pointers.
+func (g *GetServicesCronJobsEndpointResponseDataItem) ToModel() *CronJob {
+	if g == nil {
+		return nil
+	}
+	return &CronJob{
+		Minute:  g.Minute,
+		Hour:    g.Hour,
+		Mday:    g.Mday,
+		Month:   g.Month,
+		Wday:    g.Wday,
+		Who:     g.Who,
+		Command: g.Command,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DNSForwarderHostOverrideAlias) ToPost(parentID int) *PostServicesDNSForwarderHostOverrideAliasEndpointRequest {
+	if d == nil {
+		return &PostServicesDNSForwarderHostOverrideAliasEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesDNSForwarderHostOverrideAliasEndpointRequest{
+		ParentID:    parentID,
+		Host:        d.Host,
+		Domain:      d.Domain,
+		Description: d.Description,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DNSForwarderHostOverrideAlias) ToPatch(parentID, id int) *PatchServicesDNSForwarderHostOverrideAliasEndpointRequest {
+	if d == nil {
+		return &PatchServicesDNSForwarderHostOverrideAliasEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesDNSForwarderHostOverrideAliasEndpointRequest{
+		ParentID:    parentID,
+		ID:          id,
+		Host:        d.Host,
+		Domain:      d.Domain,
+		Description: d.Description,
+	}
+}
+
+// ToModel copies g into a new DNSForwarderHostOverrideAlias, sharing its slices and pointers.
+func (g *GetServicesDNSForwarderHostOverrideAliasEndpointResponseData) ToModel() *DNSForwarderHostOverrideAlias {
+	if g == nil {
+		return nil
+	}
+	return &DNSForwarderHostOverrideAlias{
+		Host:        g.Host,
+		Domain:      g.Domain,
+		Description: g.Description,
+	}
+}
+
+// ToModel copies p into a new DNSForwarderHostOverrideAlias, sharing its slices and pointers.
+func (p *PostServicesDNSForwarderHostOverrideAliasEndpointResponseData) ToModel() *DNSForwarderHostOverrideAlias {
+	if p == nil {
+		return nil
+	}
+	return &DNSForwarderHostOverrideAlias{
+		Host:        p.Host,
+		Domain:      p.Domain,
+		Description: p.Description,
+	}
+}
+
+// ToModel copies p into a new DNSForwarderHostOverrideAlias, sharing its slices and pointers.
+func (p *PatchServicesDNSForwarderHostOverrideAliasEndpointResponseData) ToModel() *DNSForwarderHostOverrideAlias {
+	if p == nil {
+		return nil
+	}
+	return &DNSForwarderHostOverrideAlias{
+		Host:        p.Host,
+		Domain:      p.Domain,
+		Description: p.Description,
+	}
+}
+
+// ToModel copies g into a new DNSForwarderHostOverrideAlias, sharing its slices and pointers.
+func (g *GetServicesDNSForwarderHostOverrideAliasesEndpointResponseDataItem) ToModel() *DNSForwarderHostOverrideAlias {
+	if g == nil {
+		return nil
+	}
+	return &DNSForwarderHostOverrideAlias{
+		Host:        g.Host,
+		Domain:      g.Domain,
+		Description: g.Description,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DNSForwarderHostOverride) ToPost() *PostServicesDNSForwarderHostOverrideEndpointRequest {
+	if d == nil {
+		return &PostServicesDNSForwarderHostOverrideEndpointRequest{}
+	}
+	return &PostServicesDNSForwarderHostOverrideEndpointRequest{
+		Host:    d.Host,
+		Domain:  d.Domain,
+		IP:      d.IP,
+		Descr:   d.Descr,
+		Aliases: d.Aliases,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DNSForwarderHostOverride) ToPatch(id int) *PatchServicesDNSForwarderHostOverrideEndpointRequest {
+	if d == nil {
+		return &PatchServicesDNSForwarderHostOverrideEndpointRequest{ID: id}
+	}
+	return &PatchServicesDNSForwarderHostOverrideEndpointRequest{
+		ID:      id,
+		Host:    d.Host,
+		Domain:  d.Domain,
+		IP:      d.IP,
+		Descr:   d.Descr,
+		Aliases: d.Aliases,
+	}
+}
+
+// ToPutItem returns d as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with d.
+func (d *DNSForwarderHostOverride) ToPutItem() *PutServicesDNSForwarderHostOverridesEndpointRequestItem {
+	if d == nil {
+		return nil
+	}
+	return &PutServicesDNSForwarderHostOverridesEndpointRequestItem{
+		Host:    d.Host,
+		Domain:  d.Domain,
+		IP:      d.IP,
+		Descr:   d.Descr,
+		Aliases: d.Aliases,
+	}
+}
+
+// ToModel copies g into a new DNSForwarderHostOverride, sharing its slices and pointers.
+func (g *GetServicesDNSForwarderHostOverrideEndpointResponseData) ToModel() *DNSForwarderHostOverride {
+	if g == nil {
+		return nil
+	}
+	return &DNSForwarderHostOverride{
+		Host:    g.Host,
+		Domain:  g.Domain,
+		IP:      g.IP,
+		Descr:   g.Descr,
+		Aliases: g.Aliases,
+	}
+}
+
+// ToModel copies p into a new DNSForwarderHostOverride, sharing its slices and pointers.
+func (p *PostServicesDNSForwarderHostOverrideEndpointResponseData) ToModel() *DNSForwarderHostOverride {
+	if p == nil {
+		return nil
+	}
+	return &DNSForwarderHostOverride{
+		Host:    p.Host,
+		Domain:  p.Domain,
+		IP:      p.IP,
+		Descr:   p.Descr,
+		Aliases: p.Aliases,
+	}
+}
+
+// ToModel copies p into a new DNSForwarderHostOverride, sharing its slices and pointers.
+func (p *PatchServicesDNSForwarderHostOverrideEndpointResponseData) ToModel() *DNSForwarderHostOverride {
+	if p == nil {
+		return nil
+	}
+	return &DNSForwarderHostOverride{
+		Host:    p.Host,
+		Domain:  p.Domain,
+		IP:      p.IP,
+		Descr:   p.Descr,
+		Aliases: p.Aliases,
+	}
+}
+
+// ToModel copies g into a new DNSForwarderHostOverride, sharing its slices and pointers.
+func (g *GetServicesDNSForwarderHostOverridesEndpointResponseDataItem) ToModel() *DNSForwarderHostOverride {
+	if g == nil {
+		return nil
+	}
+	return &DNSForwarderHostOverride{
+		Host:    g.Host,
+		Domain:  g.Domain,
+		IP:      g.IP,
+		Descr:   g.Descr,
+		Aliases: g.Aliases,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DNSResolverAccessListNetwork) ToPost(parentID int) *PostServicesDNSResolverAccessListNetworkEndpointRequest {
+	if d == nil {
+		return &PostServicesDNSResolverAccessListNetworkEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesDNSResolverAccessListNetworkEndpointRequest{
+		ParentID:    parentID,
+		Network:     d.Network,
+		Mask:        d.Mask,
+		Description: d.Description,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DNSResolverAccessListNetwork) ToPatch(parentID, id int) *PatchServicesDNSResolverAccessListNetworkEndpointRequest {
+	if d == nil {
+		return &PatchServicesDNSResolverAccessListNetworkEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesDNSResolverAccessListNetworkEndpointRequest{
+		ParentID:    parentID,
+		ID:          id,
+		Network:     d.Network,
+		Mask:        d.Mask,
+		Description: d.Description,
+	}
+}
+
+// ToModel copies g into a new DNSResolverAccessListNetwork, sharing its slices and pointers.
+func (g *GetServicesDNSResolverAccessListNetworkEndpointResponseData) ToModel() *DNSResolverAccessListNetwork {
+	if g == nil {
+		return nil
+	}
+	return &DNSResolverAccessListNetwork{
+		Network:     g.Network,
+		Mask:        g.Mask,
+		Description: g.Description,
+	}
+}
+
+// ToModel copies p into a new DNSResolverAccessListNetwork, sharing its slices and pointers.
+func (p *PostServicesDNSResolverAccessListNetworkEndpointResponseData) ToModel() *DNSResolverAccessListNetwork {
+	if p == nil {
+		return nil
+	}
+	return &DNSResolverAccessListNetwork{
+		Network:     p.Network,
+		Mask:        p.Mask,
+		Description: p.Description,
+	}
+}
+
+// ToModel copies p into a new DNSResolverAccessListNetwork, sharing its slices and pointers.
+func (p *PatchServicesDNSResolverAccessListNetworkEndpointResponseData) ToModel() *DNSResolverAccessListNetwork {
+	if p == nil {
+		return nil
+	}
+	return &DNSResolverAccessListNetwork{
+		Network:     p.Network,
+		Mask:        p.Mask,
+		Description: p.Description,
+	}
+}
+
+// ToModel copies g into a new DNSResolverAccessListNetwork, sharing its slices and pointers.
+func (g *GetServicesDNSResolverAccessListNetworksEndpointResponseDataItem) ToModel() *DNSResolverAccessListNetwork {
+	if g == nil {
+		return nil
+	}
+	return &DNSResolverAccessListNetwork{
+		Network:     g.Network,
+		Mask:        g.Mask,
+		Description: g.Description,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DNSResolverAccessList) ToPost() *PostServicesDNSResolverAccessListEndpointRequest {
+	if d == nil {
+		return &PostServicesDNSResolverAccessListEndpointRequest{}
+	}
+	return &PostServicesDNSResolverAccessListEndpointRequest{
+		Name:        d.Name,
+		Action:      d.Action,
+		Description: d.Description,
+		Networks:    d.Networks,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DNSResolverAccessList) ToPatch(id int) *PatchServicesDNSResolverAccessListEndpointRequest {
+	if d == nil {
+		return &PatchServicesDNSResolverAccessListEndpointRequest{ID: id}
+	}
+	return &PatchServicesDNSResolverAccessListEndpointRequest{
+		ID:          id,
+		Name:        d.Name,
+		Action:      d.Action,
+		Description: d.Description,
+		Networks:    d.Networks,
+	}
+}
+
+// ToPutItem returns d as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with d.
+func (d *DNSResolverAccessList) ToPutItem() *PutServicesDNSResolverAccessListsEndpointRequestItem {
+	if d == nil {
+		return nil
+	}
+	return &PutServicesDNSResolverAccessListsEndpointRequestItem{
+		Name:        d.Name,
+		Action:      d.Action,
+		Description: d.Description,
+		Networks:    d.Networks,
+	}
+}
+
+// ToModel copies g into a new DNSResolverAccessList, sharing its slices and pointers.
+func (g *GetServicesDNSResolverAccessListEndpointResponseData) ToModel() *DNSResolverAccessList {
+	if g == nil {
+		return nil
+	}
+	return &DNSResolverAccessList{
+		Name:        g.Name,
+		Action:      g.Action,
+		Description: g.Description,
+		Networks:    g.Networks,
+	}
+}
+
+// ToModel copies p into a new DNSResolverAccessList, sharing its slices and pointers.
+func (p *PostServicesDNSResolverAccessListEndpointResponseData) ToModel() *DNSResolverAccessList {
+	if p == nil {
+		return nil
+	}
+	return &DNSResolverAccessList{
+		Name:        p.Name,
+		Action:      p.Action,
+		Description: p.Description,
+		Networks:    p.Networks,
+	}
+}
+
+// ToModel copies p into a new DNSResolverAccessList, sharing its slices and pointers.
+func (p *PatchServicesDNSResolverAccessListEndpointResponseData) ToModel() *DNSResolverAccessList {
+	if p == nil {
+		return nil
+	}
+	return &DNSResolverAccessList{
+		Name:        p.Name,
+		Action:      p.Action,
+		Description: p.Description,
+		Networks:    p.Networks,
+	}
+}
+
+// ToModel copies g into a new DNSResolverAccessList, sharing its slices and pointers.
+func (g *GetServicesDNSResolverAccessListsEndpointResponseDataItem) ToModel() *DNSResolverAccessList {
+	if g == nil {
+		return nil
+	}
+	return &DNSResolverAccessList{
+		Name:        g.Name,
+		Action:      g.Action,
+		Description: g.Description,
+		Networks:    g.Networks,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DNSResolverDomainOverride) ToPost() *PostServicesDNSResolverDomainOverrideEndpointRequest {
+	if d == nil {
+		return &PostServicesDNSResolverDomainOverrideEndpointRequest{}
+	}
+	return &PostServicesDNSResolverDomainOverrideEndpointRequest{
+		Domain:             d.Domain,
+		IP:                 d.IP,
+		Descr:              d.Descr,
+		ForwardTLSUpstream: d.ForwardTLSUpstream,
+		TLSHostname:        d.TLSHostname,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DNSResolverDomainOverride) ToPatch(id int) *PatchServicesDNSResolverDomainOverrideEndpointRequest {
+	if d == nil {
+		return &PatchServicesDNSResolverDomainOverrideEndpointRequest{ID: id}
+	}
+	return &PatchServicesDNSResolverDomainOverrideEndpointRequest{
+		ID:                 id,
+		Domain:             d.Domain,
+		IP:                 d.IP,
+		Descr:              d.Descr,
+		ForwardTLSUpstream: d.ForwardTLSUpstream,
+		TLSHostname:        d.TLSHostname,
+	}
+}
+
+// ToPutItem returns d as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with d.
+func (d *DNSResolverDomainOverride) ToPutItem() *PutServicesDNSResolverDomainOverridesEndpointRequestItem {
+	if d == nil {
+		return nil
+	}
+	return &PutServicesDNSResolverDomainOverridesEndpointRequestItem{
+		Domain:             d.Domain,
+		IP:                 d.IP,
+		Descr:              d.Descr,
+		ForwardTLSUpstream: d.ForwardTLSUpstream,
+		TLSHostname:        d.TLSHostname,
+	}
+}
+
+// ToModel copies g into a new DNSResolverDomainOverride, sharing its slices and pointers.
+func (g *GetServicesDNSResolverDomainOverrideEndpointResponseData) ToModel() *DNSResolverDomainOverride {
+	if g == nil {
+		return nil
+	}
+	return &DNSResolverDomainOverride{
+		Domain:             g.Domain,
+		IP:                 g.IP,
+		Descr:              g.Descr,
+		ForwardTLSUpstream: g.ForwardTLSUpstream,
+		TLSHostname:        g.TLSHostname,
+	}
+}
+
+// ToModel copies p into a new DNSResolverDomainOverride, sharing its slices and pointers.
+func (p *PostServicesDNSResolverDomainOverrideEndpointResponseData) ToModel() *DNSResolverDomainOverride {
+	if p == nil {
+		return nil
+	}
+	return &DNSResolverDomainOverride{
+		Domain:             p.Domain,
+		IP:                 p.IP,
+		Descr:              p.Descr,
+		ForwardTLSUpstream: p.ForwardTLSUpstream,
+		TLSHostname:        p.TLSHostname,
+	}
+}
+
+// ToModel copies p into a new DNSResolverDomainOverride, sharing its slices and pointers.
+func (p *PatchServicesDNSResolverDomainOverrideEndpointResponseData) ToModel() *DNSResolverDomainOverride {
+	if p == nil {
+		return nil
+	}
+	return &DNSResolverDomainOverride{
+		Domain:             p.Domain,
+		IP:                 p.IP,
+		Descr:              p.Descr,
+		ForwardTLSUpstream: p.ForwardTLSUpstream,
+		TLSHostname:        p.TLSHostname,
+	}
+}
+
+// ToModel copies g into a new DNSResolverDomainOverride, sharing its slices and pointers.
+func (g *GetServicesDNSResolverDomainOverridesEndpointResponseDataItem) ToModel() *DNSResolverDomainOverride {
+	if g == nil {
+		return nil
+	}
+	return &DNSResolverDomainOverride{
+		Domain:             g.Domain,
+		IP:                 g.IP,
+		Descr:              g.Descr,
+		ForwardTLSUpstream: g.ForwardTLSUpstream,
+		TLSHostname:        g.TLSHostname,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DNSResolverHostOverrideAlias) ToPost(parentID int) *PostServicesDNSResolverHostOverrideAliasEndpointRequest {
+	if d == nil {
+		return &PostServicesDNSResolverHostOverrideAliasEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesDNSResolverHostOverrideAliasEndpointRequest{
+		ParentID: parentID,
+		Host:     d.Host,
+		Domain:   d.Domain,
+		Descr:    d.Descr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DNSResolverHostOverrideAlias) ToPatch(parentID, id int) *PatchServicesDNSResolverHostOverrideAliasEndpointRequest {
+	if d == nil {
+		return &PatchServicesDNSResolverHostOverrideAliasEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesDNSResolverHostOverrideAliasEndpointRequest{
+		ParentID: parentID,
+		ID:       id,
+		Host:     d.Host,
+		Domain:   d.Domain,
+		Descr:    d.Descr,
+	}
+}
+
+// ToModel copies g into a new DNSResolverHostOverrideAlias, sharing its slices and pointers.
+func (g *GetServicesDNSResolverHostOverrideAliasEndpointResponseData) ToModel() *DNSResolverHostOverrideAlias {
+	if g == nil {
+		return nil
+	}
+	return &DNSResolverHostOverrideAlias{
+		Host:   g.Host,
+		Domain: g.Domain,
+		Descr:  g.Descr,
+	}
+}
+
+// ToModel copies p into a new DNSResolverHostOverrideAlias, sharing its slices and pointers.
+func (p *PostServicesDNSResolverHostOverrideAliasEndpointResponseData) ToModel() *DNSResolverHostOverrideAlias {
+	if p == nil {
+		return nil
+	}
+	return &DNSResolverHostOverrideAlias{
+		Host:   p.Host,
+		Domain: p.Domain,
+		Descr:  p.Descr,
+	}
+}
+
+// ToModel copies p into a new DNSResolverHostOverrideAlias, sharing its slices and pointers.
+func (p *PatchServicesDNSResolverHostOverrideAliasEndpointResponseData) ToModel() *DNSResolverHostOverrideAlias {
+	if p == nil {
+		return nil
+	}
+	return &DNSResolverHostOverrideAlias{
+		Host:   p.Host,
+		Domain: p.Domain,
+		Descr:  p.Descr,
+	}
+}
+
+// ToModel copies g into a new DNSResolverHostOverrideAlias, sharing its slices and pointers.
+func (g *GetServicesDNSResolverHostOverrideAliasesEndpointResponseDataItem) ToModel() *DNSResolverHostOverrideAlias {
+	if g == nil {
+		return nil
+	}
+	return &DNSResolverHostOverrideAlias{
+		Host:   g.Host,
+		Domain: g.Domain,
+		Descr:  g.Descr,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DNSResolverHostOverride) ToPost() *PostServicesDNSResolverHostOverrideEndpointRequest {
+	if d == nil {
+		return &PostServicesDNSResolverHostOverrideEndpointRequest{}
+	}
+	return &PostServicesDNSResolverHostOverrideEndpointRequest{
+		Host:    d.Host,
+		Domain:  d.Domain,
+		IP:      d.IP,
+		Descr:   d.Descr,
+		Aliases: d.Aliases,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DNSResolverHostOverride) ToPatch(id int) *PatchServicesDNSResolverHostOverrideEndpointRequest {
+	if d == nil {
+		return &PatchServicesDNSResolverHostOverrideEndpointRequest{ID: id}
+	}
+	return &PatchServicesDNSResolverHostOverrideEndpointRequest{
+		ID:      id,
+		Host:    d.Host,
+		Domain:  d.Domain,
+		IP:      d.IP,
+		Descr:   d.Descr,
+		Aliases: d.Aliases,
+	}
+}
+
+// ToPutItem returns d as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with d.
+func (d *DNSResolverHostOverride) ToPutItem() *PutServicesDNSResolverHostOverridesEndpointRequestItem {
+	if d == nil {
+		return nil
+	}
+	return &PutServicesDNSResolverHostOverridesEndpointRequestItem{
+		Host:    d.Host,
+		Domain:  d.Domain,
+		IP:      d.IP,
+		Descr:   d.Descr,
+		Aliases: d.Aliases,
+	}
+}
+
+// ToModel copies g into a new DNSResolverHostOverride, sharing its slices and pointers.
+func (g *GetServicesDNSResolverHostOverrideEndpointResponseData) ToModel() *DNSResolverHostOverride {
+	if g == nil {
+		return nil
+	}
+	return &DNSResolverHostOverride{
+		Host:    g.Host,
+		Domain:  g.Domain,
+		IP:      g.IP,
+		Descr:   g.Descr,
+		Aliases: g.Aliases,
+	}
+}
+
+// ToModel copies p into a new DNSResolverHostOverride, sharing its slices and pointers.
+func (p *PostServicesDNSResolverHostOverrideEndpointResponseData) ToModel() *DNSResolverHostOverride {
+	if p == nil {
+		return nil
+	}
+	return &DNSResolverHostOverride{
+		Host:    p.Host,
+		Domain:  p.Domain,
+		IP:      p.IP,
+		Descr:   p.Descr,
+		Aliases: p.Aliases,
+	}
+}
+
+// ToModel copies p into a new DNSResolverHostOverride, sharing its slices and pointers.
+func (p *PatchServicesDNSResolverHostOverrideEndpointResponseData) ToModel() *DNSResolverHostOverride {
+	if p == nil {
+		return nil
+	}
+	return &DNSResolverHostOverride{
+		Host:    p.Host,
+		Domain:  p.Domain,
+		IP:      p.IP,
+		Descr:   p.Descr,
+		Aliases: p.Aliases,
+	}
+}
+
+// ToModel copies g into a new DNSResolverHostOverride, sharing its slices and pointers.
+func (g *GetServicesDNSResolverHostOverridesEndpointResponseDataItem) ToModel() *DNSResolverHostOverride {
+	if g == nil {
+		return nil
+	}
+	return &DNSResolverHostOverride{
+		Host:    g.Host,
+		Domain:  g.Domain,
+		IP:      g.IP,
+		Descr:   g.Descr,
+		Aliases: g.Aliases,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DhcpServerAddressPool) ToPost(parentID int) *PostServicesDhcpServerAddressPoolEndpointRequest {
+	if d == nil {
+		return &PostServicesDhcpServerAddressPoolEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesDhcpServerAddressPoolEndpointRequest{
+		ParentID:         parentID,
+		RangeFrom:        d.RangeFrom,
+		RangeTo:          d.RangeTo,
+		Domain:           d.Domain,
+		MacAllow:         d.MacAllow,
+		MacDeny:          d.MacDeny,
+		Domainsearchlist: d.Domainsearchlist,
+		Defaultleasetime: d.Defaultleasetime,
+		Maxleasetime:     d.Maxleasetime,
+		Gateway:          d.Gateway,
+		Dnsserver:        d.Dnsserver,
+		Winsserver:       d.Winsserver,
+		Ntpserver:        d.Ntpserver,
+		Ignorebootp:      d.Ignorebootp,
+		Ignoreclientuids: d.Ignoreclientuids,
+		Denyunknown:      d.Denyunknown,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DhcpServerAddressPool) ToPatch(parentID, id int) *PatchServicesDhcpServerAddressPoolEndpointRequest {
+	if d == nil {
+		return &PatchServicesDhcpServerAddressPoolEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesDhcpServerAddressPoolEndpointRequest{
+		ParentID:         parentID,
+		ID:               id,
+		RangeFrom:        d.RangeFrom,
+		RangeTo:          d.RangeTo,
+		Domain:           d.Domain,
+		MacAllow:         d.MacAllow,
+		MacDeny:          d.MacDeny,
+		Domainsearchlist: d.Domainsearchlist,
+		Defaultleasetime: d.Defaultleasetime,
+		Maxleasetime:     d.Maxleasetime,
+		Gateway:          d.Gateway,
+		Dnsserver:        d.Dnsserver,
+		Winsserver:       d.Winsserver,
+		Ntpserver:        d.Ntpserver,
+		Ignorebootp:      d.Ignorebootp,
+		Ignoreclientuids: d.Ignoreclientuids,
+		Denyunknown:      d.Denyunknown,
+	}
+}
+
+// ToModel copies g into a new DhcpServerAddressPool, sharing its slices and pointers.
+func (g *GetServicesDhcpServerAddressPoolEndpointResponseData) ToModel() *DhcpServerAddressPool {
+	if g == nil {
+		return nil
+	}
+	return &DhcpServerAddressPool{
+		RangeFrom:        g.RangeFrom,
+		RangeTo:          g.RangeTo,
+		Domain:           g.Domain,
+		MacAllow:         g.MacAllow,
+		MacDeny:          g.MacDeny,
+		Domainsearchlist: g.Domainsearchlist,
+		Defaultleasetime: g.Defaultleasetime,
+		Maxleasetime:     g.Maxleasetime,
+		Gateway:          g.Gateway,
+		Dnsserver:        g.Dnsserver,
+		Winsserver:       g.Winsserver,
+		Ntpserver:        g.Ntpserver,
+		Ignorebootp:      g.Ignorebootp,
+		Ignoreclientuids: g.Ignoreclientuids,
+		Denyunknown:      g.Denyunknown,
+	}
+}
+
+// ToModel copies p into a new DhcpServerAddressPool, sharing its slices and pointers.
+func (p *PostServicesDhcpServerAddressPoolEndpointResponseData) ToModel() *DhcpServerAddressPool {
+	if p == nil {
+		return nil
+	}
+	return &DhcpServerAddressPool{
+		RangeFrom:        p.RangeFrom,
+		RangeTo:          p.RangeTo,
+		Domain:           p.Domain,
+		MacAllow:         p.MacAllow,
+		MacDeny:          p.MacDeny,
+		Domainsearchlist: p.Domainsearchlist,
+		Defaultleasetime: p.Defaultleasetime,
+		Maxleasetime:     p.Maxleasetime,
+		Gateway:          p.Gateway,
+		Dnsserver:        p.Dnsserver,
+		Winsserver:       p.Winsserver,
+		Ntpserver:        p.Ntpserver,
+		Ignorebootp:      p.Ignorebootp,
+		Ignoreclientuids: p.Ignoreclientuids,
+		Denyunknown:      p.Denyunknown,
+	}
+}
+
+// ToModel copies p into a new DhcpServerAddressPool, sharing its slices and pointers.
+func (p *PatchServicesDhcpServerAddressPoolEndpointResponseData) ToModel() *DhcpServerAddressPool {
+	if p == nil {
+		return nil
+	}
+	return &DhcpServerAddressPool{
+		RangeFrom:        p.RangeFrom,
+		RangeTo:          p.RangeTo,
+		Domain:           p.Domain,
+		MacAllow:         p.MacAllow,
+		MacDeny:          p.MacDeny,
+		Domainsearchlist: p.Domainsearchlist,
+		Defaultleasetime: p.Defaultleasetime,
+		Maxleasetime:     p.Maxleasetime,
+		Gateway:          p.Gateway,
+		Dnsserver:        p.Dnsserver,
+		Winsserver:       p.Winsserver,
+		Ntpserver:        p.Ntpserver,
+		Ignorebootp:      p.Ignorebootp,
+		Ignoreclientuids: p.Ignoreclientuids,
+		Denyunknown:      p.Denyunknown,
+	}
+}
+
+// ToModel copies g into a new DhcpServerAddressPool, sharing its slices and pointers.
+func (g *GetServicesDhcpServerAddressPoolsEndpointResponseDataItem) ToModel() *DhcpServerAddressPool {
+	if g == nil {
+		return nil
+	}
+	return &DhcpServerAddressPool{
+		RangeFrom:        g.RangeFrom,
+		RangeTo:          g.RangeTo,
+		Domain:           g.Domain,
+		MacAllow:         g.MacAllow,
+		MacDeny:          g.MacDeny,
+		Domainsearchlist: g.Domainsearchlist,
+		Defaultleasetime: g.Defaultleasetime,
+		Maxleasetime:     g.Maxleasetime,
+		Gateway:          g.Gateway,
+		Dnsserver:        g.Dnsserver,
+		Winsserver:       g.Winsserver,
+		Ntpserver:        g.Ntpserver,
+		Ignorebootp:      g.Ignorebootp,
+		Ignoreclientuids: g.Ignoreclientuids,
+		Denyunknown:      g.Denyunknown,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DhcpServerCustomOption) ToPost(parentID int) *PostServicesDhcpServerCustomOptionEndpointRequest {
+	if d == nil {
+		return &PostServicesDhcpServerCustomOptionEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesDhcpServerCustomOptionEndpointRequest{
+		ParentID: parentID,
+		Number:   d.Number,
+		Type:     d.Type,
+		Value:    d.Value,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DhcpServerCustomOption) ToPatch(parentID, id int) *PatchServicesDhcpServerCustomOptionEndpointRequest {
+	if d == nil {
+		return &PatchServicesDhcpServerCustomOptionEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesDhcpServerCustomOptionEndpointRequest{
+		ParentID: parentID,
+		ID:       id,
+		Number:   d.Number,
+		Type:     d.Type,
+		Value:    d.Value,
+	}
+}
+
+// ToModel copies g into a new DhcpServerCustomOption, sharing its slices and pointers.
+func (g *GetServicesDhcpServerCustomOptionEndpointResponseData) ToModel() *DhcpServerCustomOption {
+	if g == nil {
+		return nil
+	}
+	return &DhcpServerCustomOption{
+		Number: g.Number,
+		Type:   g.Type,
+		Value:  g.Value,
+	}
+}
+
+// ToModel copies p into a new DhcpServerCustomOption, sharing its slices and pointers.
+func (p *PostServicesDhcpServerCustomOptionEndpointResponseData) ToModel() *DhcpServerCustomOption {
+	if p == nil {
+		return nil
+	}
+	return &DhcpServerCustomOption{
+		Number: p.Number,
+		Type:   p.Type,
+		Value:  p.Value,
+	}
+}
+
+// ToModel copies p into a new DhcpServerCustomOption, sharing its slices and pointers.
+func (p *PatchServicesDhcpServerCustomOptionEndpointResponseData) ToModel() *DhcpServerCustomOption {
+	if p == nil {
+		return nil
+	}
+	return &DhcpServerCustomOption{
+		Number: p.Number,
+		Type:   p.Type,
+		Value:  p.Value,
+	}
+}
+
+// ToModel copies g into a new DhcpServerCustomOption, sharing its slices and pointers.
+func (g *GetServicesDhcpServerCustomOptionsEndpointResponseDataItem) ToModel() *DhcpServerCustomOption {
+	if g == nil {
+		return nil
+	}
+	return &DhcpServerCustomOption{
+		Number: g.Number,
+		Type:   g.Type,
+		Value:  g.Value,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DhcpServerStaticMapping) ToPost(parentID int) *PostServicesDhcpServerStaticMappingEndpointRequest {
+	if d == nil {
+		return &PostServicesDhcpServerStaticMappingEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesDhcpServerStaticMappingEndpointRequest{
+		ParentID:            parentID,
+		Mac:                 d.Mac,
+		Ipaddr:              d.Ipaddr,
+		Cid:                 d.Cid,
+		Hostname:            d.Hostname,
+		Domain:              d.Domain,
+		Domainsearchlist:    d.Domainsearchlist,
+		Defaultleasetime:    d.Defaultleasetime,
+		Maxleasetime:        d.Maxleasetime,
+		Gateway:             d.Gateway,
+		Dnsserver:           d.Dnsserver,
+		Winsserver:          d.Winsserver,
+		Ntpserver:           d.Ntpserver,
+		ArpTableStaticEntry: d.ArpTableStaticEntry,
+		Descr:               d.Descr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DhcpServerStaticMapping) ToPatch(parentID, id int) *PatchServicesDhcpServerStaticMappingEndpointRequest {
+	if d == nil {
+		return &PatchServicesDhcpServerStaticMappingEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesDhcpServerStaticMappingEndpointRequest{
+		ParentID:            parentID,
+		ID:                  id,
+		Mac:                 d.Mac,
+		Ipaddr:              d.Ipaddr,
+		Cid:                 d.Cid,
+		Hostname:            d.Hostname,
+		Domain:              d.Domain,
+		Domainsearchlist:    d.Domainsearchlist,
+		Defaultleasetime:    d.Defaultleasetime,
+		Maxleasetime:        d.Maxleasetime,
+		Gateway:             d.Gateway,
+		Dnsserver:           d.Dnsserver,
+		Winsserver:          d.Winsserver,
+		Ntpserver:           d.Ntpserver,
+		ArpTableStaticEntry: d.ArpTableStaticEntry,
+		Descr:               d.Descr,
+	}
+}
+
+// ToModel copies g into a new DhcpServerStaticMapping, sharing its slices and pointers.
+func (g *GetServicesDhcpServerStaticMappingEndpointResponseData) ToModel() *DhcpServerStaticMapping {
+	if g == nil {
+		return nil
+	}
+	return &DhcpServerStaticMapping{
+		Mac:                 g.Mac,
+		Ipaddr:              g.Ipaddr,
+		Cid:                 g.Cid,
+		Hostname:            g.Hostname,
+		Domain:              g.Domain,
+		Domainsearchlist:    g.Domainsearchlist,
+		Defaultleasetime:    g.Defaultleasetime,
+		Maxleasetime:        g.Maxleasetime,
+		Gateway:             g.Gateway,
+		Dnsserver:           g.Dnsserver,
+		Winsserver:          g.Winsserver,
+		Ntpserver:           g.Ntpserver,
+		ArpTableStaticEntry: g.ArpTableStaticEntry,
+		Descr:               g.Descr,
+	}
+}
+
+// ToModel copies p into a new DhcpServerStaticMapping, sharing its slices and pointers.
+func (p *PostServicesDhcpServerStaticMappingEndpointResponseData) ToModel() *DhcpServerStaticMapping {
+	if p == nil {
+		return nil
+	}
+	return &DhcpServerStaticMapping{
+		Mac:                 p.Mac,
+		Ipaddr:              p.Ipaddr,
+		Cid:                 p.Cid,
+		Hostname:            p.Hostname,
+		Domain:              p.Domain,
+		Domainsearchlist:    p.Domainsearchlist,
+		Defaultleasetime:    p.Defaultleasetime,
+		Maxleasetime:        p.Maxleasetime,
+		Gateway:             p.Gateway,
+		Dnsserver:           p.Dnsserver,
+		Winsserver:          p.Winsserver,
+		Ntpserver:           p.Ntpserver,
+		ArpTableStaticEntry: p.ArpTableStaticEntry,
+		Descr:               p.Descr,
+	}
+}
+
+// ToModel copies p into a new DhcpServerStaticMapping, sharing its slices and pointers.
+func (p *PatchServicesDhcpServerStaticMappingEndpointResponseData) ToModel() *DhcpServerStaticMapping {
+	if p == nil {
+		return nil
+	}
+	return &DhcpServerStaticMapping{
+		Mac:                 p.Mac,
+		Ipaddr:              p.Ipaddr,
+		Cid:                 p.Cid,
+		Hostname:            p.Hostname,
+		Domain:              p.Domain,
+		Domainsearchlist:    p.Domainsearchlist,
+		Defaultleasetime:    p.Defaultleasetime,
+		Maxleasetime:        p.Maxleasetime,
+		Gateway:             p.Gateway,
+		Dnsserver:           p.Dnsserver,
+		Winsserver:          p.Winsserver,
+		Ntpserver:           p.Ntpserver,
+		ArpTableStaticEntry: p.ArpTableStaticEntry,
+		Descr:               p.Descr,
+	}
+}
+
+// ToModel copies g into a new DhcpServerStaticMapping, sharing its slices and pointers.
+func (g *GetServicesDhcpServerStaticMappingsEndpointResponseDataItem) ToModel() *DhcpServerStaticMapping {
+	if g == nil {
+		return nil
+	}
+	return &DhcpServerStaticMapping{
+		Mac:                 g.Mac,
+		Ipaddr:              g.Ipaddr,
+		Cid:                 g.Cid,
+		Hostname:            g.Hostname,
+		Domain:              g.Domain,
+		Domainsearchlist:    g.Domainsearchlist,
+		Defaultleasetime:    g.Defaultleasetime,
+		Maxleasetime:        g.Maxleasetime,
+		Gateway:             g.Gateway,
+		Dnsserver:           g.Dnsserver,
+		Winsserver:          g.Winsserver,
+		Ntpserver:           g.Ntpserver,
+		ArpTableStaticEntry: g.ArpTableStaticEntry,
+		Descr:               g.Descr,
+	}
+}
+
+// ToPost returns a request that creates a copy of d. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with d.
+func (d *DhcpServer) ToPost() *PostServicesDhcpServerEndpointRequest {
+	if d == nil {
+		return &PostServicesDhcpServerEndpointRequest{}
+	}
+	return &PostServicesDhcpServerEndpointRequest{
+		Interface:            d.Interface,
+		Enable:               d.Enable,
+		RangeFrom:            d.RangeFrom,
+		RangeTo:              d.RangeTo,
+		Domain:               d.Domain,
+		FailoverPeerip:       d.FailoverPeerip,
+		MacAllow:             d.MacAllow,
+		MacDeny:              d.MacDeny,
+		Domainsearchlist:     d.Domainsearchlist,
+		Defaultleasetime:     d.Defaultleasetime,
+		Maxleasetime:         d.Maxleasetime,
+		Gateway:              d.Gateway,
+		Dnsserver:            d.Dnsserver,
+		Winsserver:           d.Winsserver,
+		Ntpserver:            d.Ntpserver,
+		Staticarp:            d.Staticarp,
+		Ignorebootp:          d.Ignorebootp,
+		Ignoreclientuids:     d.Ignoreclientuids,
+		Nonak:                d.Nonak,
+		Disablepingcheck:     d.Disablepingcheck,
+		Dhcpleaseinlocaltime: d.Dhcpleaseinlocaltime,
+		Statsgraph:           d.Statsgraph,
+		Denyunknown:          d.Denyunknown,
+		Pool:                 d.Pool,
+		Numberoptions:        d.Numberoptions,
+		Staticmap:            d.Staticmap,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with d. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with d.
+func (d *DhcpServer) ToPatch(id int) *PatchServicesDhcpServerEndpointRequest {
+	if d == nil {
+		return &PatchServicesDhcpServerEndpointRequest{ID: id}
+	}
+	return &PatchServicesDhcpServerEndpointRequest{
+		ID:                   id,
+		Interface:            d.Interface,
+		Enable:               d.Enable,
+		RangeFrom:            d.RangeFrom,
+		RangeTo:              d.RangeTo,
+		Domain:               d.Domain,
+		FailoverPeerip:       d.FailoverPeerip,
+		MacAllow:             d.MacAllow,
+		MacDeny:              d.MacDeny,
+		Domainsearchlist:     d.Domainsearchlist,
+		Defaultleasetime:     d.Defaultleasetime,
+		Maxleasetime:         d.Maxleasetime,
+		Gateway:              d.Gateway,
+		Dnsserver:            d.Dnsserver,
+		Winsserver:           d.Winsserver,
+		Ntpserver:            d.Ntpserver,
+		Staticarp:            d.Staticarp,
+		Ignorebootp:          d.Ignorebootp,
+		Ignoreclientuids:     d.Ignoreclientuids,
+		Nonak:                d.Nonak,
+		Disablepingcheck:     d.Disablepingcheck,
+		Dhcpleaseinlocaltime: d.Dhcpleaseinlocaltime,
+		Statsgraph:           d.Statsgraph,
+		Denyunknown:          d.Denyunknown,
+		Pool:                 d.Pool,
+		Numberoptions:        d.Numberoptions,
+		Staticmap:            d.Staticmap,
+	}
+}
+
+// ToPutItem returns d as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with d.
+func (d *DhcpServer) ToPutItem() *PutServicesDhcpServersEndpointRequestItem {
+	if d == nil {
+		return nil
+	}
+	return &PutServicesDhcpServersEndpointRequestItem{
+		Interface:            d.Interface,
+		Enable:               d.Enable,
+		RangeFrom:            d.RangeFrom,
+		RangeTo:              d.RangeTo,
+		Domain:               d.Domain,
+		FailoverPeerip:       d.FailoverPeerip,
+		MacAllow:             d.MacAllow,
+		MacDeny:              d.MacDeny,
+		Domainsearchlist:     d.Domainsearchlist,
+		Defaultleasetime:     d.Defaultleasetime,
+		Maxleasetime:         d.Maxleasetime,
+		Gateway:              d.Gateway,
+		Dnsserver:            d.Dnsserver,
+		Winsserver:           d.Winsserver,
+		Ntpserver:            d.Ntpserver,
+		Staticarp:            d.Staticarp,
+		Ignorebootp:          d.Ignorebootp,
+		Ignoreclientuids:     d.Ignoreclientuids,
+		Nonak:                d.Nonak,
+		Disablepingcheck:     d.Disablepingcheck,
+		Dhcpleaseinlocaltime: d.Dhcpleaseinlocaltime,
+		Statsgraph:           d.Statsgraph,
+		Denyunknown:          d.Denyunknown,
+		Pool:                 d.Pool,
+		Numberoptions:        d.Numberoptions,
+		Staticmap:            d.Staticmap,
+	}
+}
+
+// ToModel copies g into a new DhcpServer, sharing its slices and pointers.
+func (g *GetServicesDhcpServerEndpointResponseData) ToModel() *DhcpServer {
+	if g == nil {
+		return nil
+	}
+	return &DhcpServer{
+		Interface:            g.Interface,
+		Enable:               g.Enable,
+		RangeFrom:            g.RangeFrom,
+		RangeTo:              g.RangeTo,
+		Domain:               g.Domain,
+		FailoverPeerip:       g.FailoverPeerip,
+		MacAllow:             g.MacAllow,
+		MacDeny:              g.MacDeny,
+		Domainsearchlist:     g.Domainsearchlist,
+		Defaultleasetime:     g.Defaultleasetime,
+		Maxleasetime:         g.Maxleasetime,
+		Gateway:              g.Gateway,
+		Dnsserver:            g.Dnsserver,
+		Winsserver:           g.Winsserver,
+		Ntpserver:            g.Ntpserver,
+		Staticarp:            g.Staticarp,
+		Ignorebootp:          g.Ignorebootp,
+		Ignoreclientuids:     g.Ignoreclientuids,
+		Nonak:                g.Nonak,
+		Disablepingcheck:     g.Disablepingcheck,
+		Dhcpleaseinlocaltime: g.Dhcpleaseinlocaltime,
+		Statsgraph:           g.Statsgraph,
+		Denyunknown:          g.Denyunknown,
+		Pool:                 g.Pool,
+		Numberoptions:        g.Numberoptions,
+		Staticmap:            g.Staticmap,
+	}
+}
+
+// ToModel copies p into a new DhcpServer, sharing its slices and pointers.
+func (p *PostServicesDhcpServerEndpointResponseData) ToModel() *DhcpServer {
+	if p == nil {
+		return nil
+	}
+	return &DhcpServer{
+		Interface:            p.Interface,
+		Enable:               p.Enable,
+		RangeFrom:            p.RangeFrom,
+		RangeTo:              p.RangeTo,
+		Domain:               p.Domain,
+		FailoverPeerip:       p.FailoverPeerip,
+		MacAllow:             p.MacAllow,
+		MacDeny:              p.MacDeny,
+		Domainsearchlist:     p.Domainsearchlist,
+		Defaultleasetime:     p.Defaultleasetime,
+		Maxleasetime:         p.Maxleasetime,
+		Gateway:              p.Gateway,
+		Dnsserver:            p.Dnsserver,
+		Winsserver:           p.Winsserver,
+		Ntpserver:            p.Ntpserver,
+		Staticarp:            p.Staticarp,
+		Ignorebootp:          p.Ignorebootp,
+		Ignoreclientuids:     p.Ignoreclientuids,
+		Nonak:                p.Nonak,
+		Disablepingcheck:     p.Disablepingcheck,
+		Dhcpleaseinlocaltime: p.Dhcpleaseinlocaltime,
+		Statsgraph:           p.Statsgraph,
+		Denyunknown:          p.Denyunknown,
+		Pool:                 p.Pool,
+		Numberoptions:        p.Numberoptions,
+		Staticmap:            p.Staticmap,
+	}
+}
+
+// ToModel copies p into a new DhcpServer, sharing its slices and pointers.
+func (p *PatchServicesDhcpServerEndpointResponseData) ToModel() *DhcpServer {
+	if p == nil {
+		return nil
+	}
+	return &DhcpServer{
+		Interface:            p.Interface,
+		Enable:               p.Enable,
+		RangeFrom:            p.RangeFrom,
+		RangeTo:              p.RangeTo,
+		Domain:               p.Domain,
+		FailoverPeerip:       p.FailoverPeerip,
+		MacAllow:             p.MacAllow,
+		MacDeny:              p.MacDeny,
+		Domainsearchlist:     p.Domainsearchlist,
+		Defaultleasetime:     p.Defaultleasetime,
+		Maxleasetime:         p.Maxleasetime,
+		Gateway:              p.Gateway,
+		Dnsserver:            p.Dnsserver,
+		Winsserver:           p.Winsserver,
+		Ntpserver:            p.Ntpserver,
+		Staticarp:            p.Staticarp,
+		Ignorebootp:          p.Ignorebootp,
+		Ignoreclientuids:     p.Ignoreclientuids,
+		Nonak:                p.Nonak,
+		Disablepingcheck:     p.Disablepingcheck,
+		Dhcpleaseinlocaltime: p.Dhcpleaseinlocaltime,
+		Statsgraph:           p.Statsgraph,
+		Denyunknown:          p.Denyunknown,
+		Pool:                 p.Pool,
+		Numberoptions:        p.Numberoptions,
+		Staticmap:            p.Staticmap,
+	}
+}
+
+// ToModel copies g into a new DhcpServer, sharing its slices and pointers.
+func (g *GetServicesDhcpServersEndpointResponseDataItem) ToModel() *DhcpServer {
+	if g == nil {
+		return nil
+	}
+	return &DhcpServer{
+		Interface:            g.Interface,
+		Enable:               g.Enable,
+		RangeFrom:            g.RangeFrom,
+		RangeTo:              g.RangeTo,
+		Domain:               g.Domain,
+		FailoverPeerip:       g.FailoverPeerip,
+		MacAllow:             g.MacAllow,
+		MacDeny:              g.MacDeny,
+		Domainsearchlist:     g.Domainsearchlist,
+		Defaultleasetime:     g.Defaultleasetime,
+		Maxleasetime:         g.Maxleasetime,
+		Gateway:              g.Gateway,
+		Dnsserver:            g.Dnsserver,
+		Winsserver:           g.Winsserver,
+		Ntpserver:            g.Ntpserver,
+		Staticarp:            g.Staticarp,
+		Ignorebootp:          g.Ignorebootp,
+		Ignoreclientuids:     g.Ignoreclientuids,
+		Nonak:                g.Nonak,
+		Disablepingcheck:     g.Disablepingcheck,
+		Dhcpleaseinlocaltime: g.Dhcpleaseinlocaltime,
+		Statsgraph:           g.Statsgraph,
+		Denyunknown:          g.Denyunknown,
+		Pool:                 g.Pool,
+		Numberoptions:        g.Numberoptions,
+		Staticmap:            g.Staticmap,
+	}
+}
+
+// ToPost returns a request that creates a copy of f. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with f.
+func (f *FirewallAlias) ToPost() *PostFirewallAliasEndpointRequest {
+	if f == nil {
+		return &PostFirewallAliasEndpointRequest{}
+	}
+	return &PostFirewallAliasEndpointRequest{
+		Name:    f.Name,
+		Type:    f.Type,
+		Descr:   f.Descr,
+		Address: f.Address,
+		Detail:  f.Detail,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with f. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with f.
+func (f *FirewallAlias) ToPatch(id int) *PatchFirewallAliasEndpointRequest {
+	if f == nil {
+		return &PatchFirewallAliasEndpointRequest{ID: id}
+	}
+	return &PatchFirewallAliasEndpointRequest{
+		ID:      id,
+		Name:    f.Name,
+		Type:    f.Type,
+		Descr:   f.Descr,
+		Address: f.Address,
+		Detail:  f.Detail,
+	}
+}
+
+// ToPutItem returns f as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with f.
+func (f *FirewallAlias) ToPutItem() *PutFirewallAliasesEndpointRequestItem {
+	if f == nil {
+		return nil
+	}
+	return &PutFirewallAliasesEndpointRequestItem{
+		Name:    f.Name,
+		Type:    f.Type,
+		Descr:   f.Descr,
+		Address: f.Address,
+		Detail:  f.Detail,
+	}
+}
+
+// ToModel copies g into a new FirewallAlias, sharing its slices and pointers.
+func (g *GetFirewallAliasEndpointResponseData) ToModel() *FirewallAlias {
+	if g == nil {
+		return nil
+	}
+	return &FirewallAlias{
+		Name:    g.Name,
+		Type:    g.Type,
+		Descr:   g.Descr,
+		Address: g.Address,
+		Detail:  g.Detail,
+	}
+}
+
+// ToModel copies p into a new FirewallAlias, sharing its slices and pointers.
+func (p *PostFirewallAliasEndpointResponseData) ToModel() *FirewallAlias {
+	if p == nil {
+		return nil
+	}
+	return &FirewallAlias{
+		Name:    p.Name,
+		Type:    p.Type,
+		Descr:   p.Descr,
+		Address: p.Address,
+		Detail:  p.Detail,
+	}
+}
+
+// ToModel copies p into a new FirewallAlias, sharing its slices and pointers.
+func (p *PatchFirewallAliasEndpointResponseData) ToModel() *FirewallAlias {
+	if p == nil {
+		return nil
+	}
+	return &FirewallAlias{
+		Name:    p.Name,
+		Type:    p.Type,
+		Descr:   p.Descr,
+		Address: p.Address,
+		Detail:  p.Detail,
+	}
+}
+
+// ToModel copies g into a new FirewallAlias, sharing its slices and pointers.
+func (g *GetFirewallAliasesEndpointResponseDataItem) ToModel() *FirewallAlias {
+	if g == nil {
+		return nil
+	}
+	return &FirewallAlias{
+		Name:    g.Name,
+		Type:    g.Type,
+		Descr:   g.Descr,
+		Address: g.Address,
+		Detail:  g.Detail,
+	}
+}
+
+// ToPost returns a request that creates a copy of f. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with f.
+func (f *FirewallRule) ToPost() *PostFirewallRuleEndpointRequest {
+	if f == nil {
+		return &PostFirewallRuleEndpointRequest{}
+	}
+	return &PostFirewallRuleEndpointRequest{
+		Type:             f.Type,
+		Interface:        f.Interface,
+		Ipprotocol:       f.Ipprotocol,
+		Protocol:         f.Protocol,
+		Icmptype:         f.Icmptype,
+		Source:           f.Source,
+		SourcePort:       f.SourcePort,
+		Destination:      f.Destination,
+		DestinationPort:  f.DestinationPort,
+		Descr:            f.Descr,
+		Disabled:         f.Disabled,
+		Log:              f.Log,
+		Tag:              f.Tag,
+		Statetype:        f.Statetype,
+		TCPFlagsAny:      f.TCPFlagsAny,
+		TCPFlagsOutOf:    f.TCPFlagsOutOf,
+		TCPFlagsSet:      f.TCPFlagsSet,
+		Gateway:          f.Gateway,
+		Sched:            f.Sched,
+		Dnpipe:           f.Dnpipe,
+		Pdnpipe:          f.Pdnpipe,
+		Defaultqueue:     f.Defaultqueue,
+		Ackqueue:         f.Ackqueue,
+		Floating:         f.Floating,
+		Quick:            f.Quick,
+		Direction:        f.Direction,
+		AssociatedRuleID: f.AssociatedRuleID,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with f. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with f.
+func (f *FirewallRule) ToPatch(id int) *PatchFirewallRuleEndpointRequest {
+	if f == nil {
+		return &PatchFirewallRuleEndpointRequest{ID: id}
+	}
+	return &PatchFirewallRuleEndpointRequest{
+		ID:               id,
+		Type:             f.Type,
+		Interface:        f.Interface,
+		Ipprotocol:       f.Ipprotocol,
+		Protocol:         f.Protocol,
+		Icmptype:         f.Icmptype,
+		Source:           f.Source,
+		SourcePort:       f.SourcePort,
+		Destination:      f.Destination,
+		DestinationPort:  f.DestinationPort,
+		Descr:            f.Descr,
+		Disabled:         f.Disabled,
+		Log:              f.Log,
+		Tag:              f.Tag,
+		Statetype:        f.Statetype,
+		TCPFlagsAny:      f.TCPFlagsAny,
+		TCPFlagsOutOf:    f.TCPFlagsOutOf,
+		TCPFlagsSet:      f.TCPFlagsSet,
+		Gateway:          f.Gateway,
+		Sched:            f.Sched,
+		Dnpipe:           f.Dnpipe,
+		Pdnpipe:          f.Pdnpipe,
+		Defaultqueue:     f.Defaultqueue,
+		Ackqueue:         f.Ackqueue,
+		Floating:         f.Floating,
+		Quick:            f.Quick,
+		Direction:        f.Direction,
+		AssociatedRuleID: f.AssociatedRuleID,
+	}
+}
+
+// ToPutItem returns f as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with f.
+func (f *FirewallRule) ToPutItem() *PutFirewallRulesEndpointRequestItem {
+	if f == nil {
+		return nil
+	}
+	return &PutFirewallRulesEndpointRequestItem{
+		Type:             f.Type,
+		Interface:        f.Interface,
+		Ipprotocol:       f.Ipprotocol,
+		Protocol:         f.Protocol,
+		Icmptype:         f.Icmptype,
+		Source:           f.Source,
+		SourcePort:       f.SourcePort,
+		Destination:      f.Destination,
+		DestinationPort:  f.DestinationPort,
+		Descr:            f.Descr,
+		Disabled:         f.Disabled,
+		Log:              f.Log,
+		Tag:              f.Tag,
+		Statetype:        f.Statetype,
+		TCPFlagsAny:      f.TCPFlagsAny,
+		TCPFlagsOutOf:    f.TCPFlagsOutOf,
+		TCPFlagsSet:      f.TCPFlagsSet,
+		Gateway:          f.Gateway,
+		Sched:            f.Sched,
+		Dnpipe:           f.Dnpipe,
+		Pdnpipe:          f.Pdnpipe,
+		Defaultqueue:     f.Defaultqueue,
+		Ackqueue:         f.Ackqueue,
+		Floating:         f.Floating,
+		Quick:            f.Quick,
+		Direction:        f.Direction,
+		AssociatedRuleID: f.AssociatedRuleID,
+	}
+}
+
+// ToModel copies g into a new FirewallRule, sharing its slices and pointers.
+func (g *GetFirewallRuleEndpointResponseData) ToModel() *FirewallRule {
+	if g == nil {
+		return nil
+	}
+	return &FirewallRule{
+		Type:             g.Type,
+		Interface:        g.Interface,
+		Ipprotocol:       g.Ipprotocol,
+		Protocol:         g.Protocol,
+		Icmptype:         g.Icmptype,
+		Source:           g.Source,
+		SourcePort:       g.SourcePort,
+		Destination:      g.Destination,
+		DestinationPort:  g.DestinationPort,
+		Descr:            g.Descr,
+		Disabled:         g.Disabled,
+		Log:              g.Log,
+		Tag:              g.Tag,
+		Statetype:        g.Statetype,
+		TCPFlagsAny:      g.TCPFlagsAny,
+		TCPFlagsOutOf:    g.TCPFlagsOutOf,
+		TCPFlagsSet:      g.TCPFlagsSet,
+		Gateway:          g.Gateway,
+		Sched:            g.Sched,
+		Dnpipe:           g.Dnpipe,
+		Pdnpipe:          g.Pdnpipe,
+		Defaultqueue:     g.Defaultqueue,
+		Ackqueue:         g.Ackqueue,
+		Floating:         g.Floating,
+		Quick:            g.Quick,
+		Direction:        g.Direction,
+		Tracker:          g.Tracker,
+		AssociatedRuleID: g.AssociatedRuleID,
+		CreatedTime:      g.CreatedTime,
+		CreatedBy:        g.CreatedBy,
+		UpdatedTime:      g.UpdatedTime,
+		UpdatedBy:        g.UpdatedBy,
+	}
+}
+
+// ToModel copies p into a new FirewallRule, sharing its slices and pointers.
+func (p *PostFirewallRuleEndpointResponseData) ToModel() *FirewallRule {
+	if p == nil {
+		return nil
+	}
+	return &FirewallRule{
+		Type:             p.Type,
+		Interface:        p.Interface,
+		Ipprotocol:       p.Ipprotocol,
+		Protocol:         p.Protocol,
+		Icmptype:         p.Icmptype,
+		Source:           p.Source,
+		SourcePort:       p.SourcePort,
+		Destination:      p.Destination,
+		DestinationPort:  p.DestinationPort,
+		Descr:            p.Descr,
+		Disabled:         p.Disabled,
+		Log:              p.Log,
+		Tag:              p.Tag,
+		Statetype:        p.Statetype,
+		TCPFlagsAny:      p.TCPFlagsAny,
+		TCPFlagsOutOf:    p.TCPFlagsOutOf,
+		TCPFlagsSet:      p.TCPFlagsSet,
+		Gateway:          p.Gateway,
+		Sched:            p.Sched,
+		Dnpipe:           p.Dnpipe,
+		Pdnpipe:          p.Pdnpipe,
+		Defaultqueue:     p.Defaultqueue,
+		Ackqueue:         p.Ackqueue,
+		Floating:         p.Floating,
+		Quick:            p.Quick,
+		Direction:        p.Direction,
+		Tracker:          p.Tracker,
+		AssociatedRuleID: p.AssociatedRuleID,
+		CreatedTime:      p.CreatedTime,
+		CreatedBy:        p.CreatedBy,
+		UpdatedTime:      p.UpdatedTime,
+		UpdatedBy:        p.UpdatedBy,
+	}
+}
+
+// ToModel copies p into a new FirewallRule, sharing its slices and pointers.
+func (p *PatchFirewallRuleEndpointResponseData) ToModel() *FirewallRule {
+	if p == nil {
+		return nil
+	}
+	return &FirewallRule{
+		Type:             p.Type,
+		Interface:        p.Interface,
+		Ipprotocol:       p.Ipprotocol,
+		Protocol:         p.Protocol,
+		Icmptype:         p.Icmptype,
+		Source:           p.Source,
+		SourcePort:       p.SourcePort,
+		Destination:      p.Destination,
+		DestinationPort:  p.DestinationPort,
+		Descr:            p.Descr,
+		Disabled:         p.Disabled,
+		Log:              p.Log,
+		Tag:              p.Tag,
+		Statetype:        p.Statetype,
+		TCPFlagsAny:      p.TCPFlagsAny,
+		TCPFlagsOutOf:    p.TCPFlagsOutOf,
+		TCPFlagsSet:      p.TCPFlagsSet,
+		Gateway:          p.Gateway,
+		Sched:            p.Sched,
+		Dnpipe:           p.Dnpipe,
+		Pdnpipe:          p.Pdnpipe,
+		Defaultqueue:     p.Defaultqueue,
+		Ackqueue:         p.Ackqueue,
+		Floating:         p.Floating,
+		Quick:            p.Quick,
+		Direction:        p.Direction,
+		Tracker:          p.Tracker,
+		AssociatedRuleID: p.AssociatedRuleID,
+		CreatedTime:      p.CreatedTime,
+		CreatedBy:        p.CreatedBy,
+		UpdatedTime:      p.UpdatedTime,
+		UpdatedBy:        p.UpdatedBy,
+	}
+}
+
+// ToModel copies g into a new FirewallRule, sharing its slices and pointers.
+func (g *GetFirewallRulesEndpointResponseDataItem) ToModel() *FirewallRule {
+	if g == nil {
+		return nil
+	}
+	return &FirewallRule{
+		Type:             g.Type,
+		Interface:        g.Interface,
+		Ipprotocol:       g.Ipprotocol,
+		Protocol:         g.Protocol,
+		Icmptype:         g.Icmptype,
+		Source:           g.Source,
+		SourcePort:       g.SourcePort,
+		Destination:      g.Destination,
+		DestinationPort:  g.DestinationPort,
+		Descr:            g.Descr,
+		Disabled:         g.Disabled,
+		Log:              g.Log,
+		Tag:              g.Tag,
+		Statetype:        g.Statetype,
+		TCPFlagsAny:      g.TCPFlagsAny,
+		TCPFlagsOutOf:    g.TCPFlagsOutOf,
+		TCPFlagsSet:      g.TCPFlagsSet,
+		Gateway:          g.Gateway,
+		Sched:            g.Sched,
+		Dnpipe:           g.Dnpipe,
+		Pdnpipe:          g.Pdnpipe,
+		Defaultqueue:     g.Defaultqueue,
+		Ackqueue:         g.Ackqueue,
+		Floating:         g.Floating,
+		Quick:            g.Quick,
+		Direction:        g.Direction,
+		Tracker:          g.Tracker,
+		AssociatedRuleID: g.AssociatedRuleID,
+		CreatedTime:      g.CreatedTime,
+		CreatedBy:        g.CreatedBy,
+		UpdatedTime:      g.UpdatedTime,
+		UpdatedBy:        g.UpdatedBy,
+	}
+}
+
+// ToPost returns a request that creates a copy of f. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with f.
+func (f *FirewallScheduleTimeRange) ToPost(parentID int) *PostFirewallScheduleTimeRangeEndpointRequest {
+	if f == nil {
+		return &PostFirewallScheduleTimeRangeEndpointRequest{ParentID: parentID}
+	}
+	return &PostFirewallScheduleTimeRangeEndpointRequest{
+		ParentID:   parentID,
+		Position:   f.Position,
+		Month:      f.Month,
+		Day:        f.Day,
+		Hour:       f.Hour,
+		Rangedescr: f.Rangedescr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with f. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with f.
+func (f *FirewallScheduleTimeRange) ToPatch(parentID, id int) *PatchFirewallScheduleTimeRangeEndpointRequest {
+	if f == nil {
+		return &PatchFirewallScheduleTimeRangeEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchFirewallScheduleTimeRangeEndpointRequest{
+		ParentID:   parentID,
+		ID:         id,
+		Position:   f.Position,
+		Month:      f.Month,
+		Day:        f.Day,
+		Hour:       f.Hour,
+		Rangedescr: f.Rangedescr,
+	}
+}
+
+// ToModel copies g into a new FirewallScheduleTimeRange, sharing its slices and pointers.
+func (g *GetFirewallScheduleTimeRangeEndpointResponseData) ToModel() *FirewallScheduleTimeRange {
+	if g == nil {
+		return nil
+	}
+	return &FirewallScheduleTimeRange{
+		Position:   g.Position,
+		Month:      g.Month,
+		Day:        g.Day,
+		Hour:       g.Hour,
+		Rangedescr: g.Rangedescr,
+	}
+}
+
+// ToModel copies p into a new FirewallScheduleTimeRange, sharing its slices and pointers.
+func (p *PostFirewallScheduleTimeRangeEndpointResponseData) ToModel() *FirewallScheduleTimeRange {
+	if p == nil {
+		return nil
+	}
+	return &FirewallScheduleTimeRange{
+		Position:   p.Position,
+		Month:      p.Month,
+		Day:        p.Day,
+		Hour:       p.Hour,
+		Rangedescr: p.Rangedescr,
+	}
+}
+
+// ToModel copies p into a new FirewallScheduleTimeRange, sharing its slices and pointers.
+func (p *PatchFirewallScheduleTimeRangeEndpointResponseData) ToModel() *FirewallScheduleTimeRange {
+	if p == nil {
+		return nil
+	}
+	return &FirewallScheduleTimeRange{
+		Position:   p.Position,
+		Month:      p.Month,
+		Day:        p.Day,
+		Hour:       p.Hour,
+		Rangedescr: p.Rangedescr,
+	}
+}
+
+// ToModel copies g into a new FirewallScheduleTimeRange, sharing its slices and pointers.
+func (g *GetFirewallScheduleTimeRangesEndpointResponseDataItem) ToModel() *FirewallScheduleTimeRange {
+	if g == nil {
+		return nil
+	}
+	return &FirewallScheduleTimeRange{
+		Position:   g.Position,
+		Month:      g.Month,
+		Day:        g.Day,
+		Hour:       g.Hour,
+		Rangedescr: g.Rangedescr,
+	}
+}
+
+// ToPost returns a request that creates a copy of f. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with f.
+func (f *FirewallSchedule) ToPost() *PostFirewallScheduleEndpointRequest {
+	if f == nil {
+		return &PostFirewallScheduleEndpointRequest{}
+	}
+	return &PostFirewallScheduleEndpointRequest{
+		Schedlabel: f.Schedlabel,
+		Name:       f.Name,
+		Descr:      f.Descr,
+		Active:     f.Active,
+		Timerange:  f.Timerange,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with f. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with f.
+func (f *FirewallSchedule) ToPatch(id int) *PatchFirewallScheduleEndpointRequest {
+	if f == nil {
+		return &PatchFirewallScheduleEndpointRequest{ID: id}
+	}
+	return &PatchFirewallScheduleEndpointRequest{
+		ID:         id,
+		Schedlabel: f.Schedlabel,
+		Name:       f.Name,
+		Descr:      f.Descr,
+		Active:     f.Active,
+		Timerange:  f.Timerange,
+	}
+}
+
+// ToPutItem returns f as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with f.
+func (f *FirewallSchedule) ToPutItem() *PutFirewallSchedulesEndpointRequestItem {
+	if f == nil {
+		return nil
+	}
+	return &PutFirewallSchedulesEndpointRequestItem{
+		Schedlabel: f.Schedlabel,
+		Name:       f.Name,
+		Descr:      f.Descr,
+		Active:     f.Active,
+		Timerange:  f.Timerange,
+	}
+}
+
+// ToModel copies g into a new FirewallSchedule, sharing its slices and pointers.
+func (g *GetFirewallScheduleEndpointResponseData) ToModel() *FirewallSchedule {
+	if g == nil {
+		return nil
+	}
+	return &FirewallSchedule{
+		Schedlabel: g.Schedlabel,
+		Name:       g.Name,
+		Descr:      g.Descr,
+		Active:     g.Active,
+		Timerange:  g.Timerange,
+	}
+}
+
+// ToModel copies p into a new FirewallSchedule, sharing its slices and pointers.
+func (p *PostFirewallScheduleEndpointResponseData) ToModel() *FirewallSchedule {
+	if p == nil {
+		return nil
+	}
+	return &FirewallSchedule{
+		Schedlabel: p.Schedlabel,
+		Name:       p.Name,
+		Descr:      p.Descr,
+		Active:     p.Active,
+		Timerange:  p.Timerange,
+	}
+}
+
+// ToModel copies p into a new FirewallSchedule, sharing its slices and pointers.
+func (p *PatchFirewallScheduleEndpointResponseData) ToModel() *FirewallSchedule {
+	if p == nil {
+		return nil
+	}
+	return &FirewallSchedule{
+		Schedlabel: p.Schedlabel,
+		Name:       p.Name,
+		Descr:      p.Descr,
+		Active:     p.Active,
+		Timerange:  p.Timerange,
+	}
+}
+
+// ToModel copies g into a new FirewallSchedule, sharing its slices and pointers.
+func (g *GetFirewallSchedulesEndpointResponseDataItem) ToModel() *FirewallSchedule {
+	if g == nil {
+		return nil
+	}
+	return &FirewallSchedule{
+		Schedlabel: g.Schedlabel,
+		Name:       g.Name,
+		Descr:      g.Descr,
+		Active:     g.Active,
+		Timerange:  g.Timerange,
+	}
+}
+
+// ToPost returns a request that creates a copy of f. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with f.
+func (f *FreeRadiusClient) ToPost() *PostServicesFreeRadiusClientEndpointRequest {
+	if f == nil {
+		return &PostServicesFreeRadiusClientEndpointRequest{}
+	}
+	return &PostServicesFreeRadiusClientEndpointRequest{
+		Addr:        f.Addr,
+		IPVersion:   f.IPVersion,
+		Description: f.Description,
+		Shortname:   f.Shortname,
+		Secret:      f.Secret,
+		Proto:       f.Proto,
+		Nastype:     f.Nastype,
+		Msgauth:     f.Msgauth,
+		Maxconn:     f.Maxconn,
+		Naslogin:    f.Naslogin,
+		Naspassword: f.Naspassword,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with f. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with f.
+func (f *FreeRadiusClient) ToPatch(id int) *PatchServicesFreeRadiusClientEndpointRequest {
+	if f == nil {
+		return &PatchServicesFreeRadiusClientEndpointRequest{ID: id}
+	}
+	return &PatchServicesFreeRadiusClientEndpointRequest{
+		ID:          id,
+		Addr:        f.Addr,
+		IPVersion:   f.IPVersion,
+		Description: f.Description,
+		Shortname:   f.Shortname,
+		Secret:      f.Secret,
+		Proto:       f.Proto,
+		Nastype:     f.Nastype,
+		Msgauth:     f.Msgauth,
+		Maxconn:     f.Maxconn,
+		Naslogin:    f.Naslogin,
+		Naspassword: f.Naspassword,
+	}
+}
+
+// ToPutItem returns f as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with f.
+func (f *FreeRadiusClient) ToPutItem() *PutServicesFreeRadiusClientsEndpointRequestItem {
+	if f == nil {
+		return nil
+	}
+	return &PutServicesFreeRadiusClientsEndpointRequestItem{
+		Addr:        f.Addr,
+		IPVersion:   f.IPVersion,
+		Description: f.Description,
+		Shortname:   f.Shortname,
+		Secret:      f.Secret,
+		Proto:       f.Proto,
+		Nastype:     f.Nastype,
+		Msgauth:     f.Msgauth,
+		Maxconn:     f.Maxconn,
+		Naslogin:    f.Naslogin,
+		Naspassword: f.Naspassword,
+	}
+}
+
+// ToModel copies g into a new FreeRadiusClient, sharing its slices and pointers.
+func (g *GetServicesFreeRadiusClientEndpointResponseData) ToModel() *FreeRadiusClient {
+	if g == nil {
+		return nil
+	}
+	return &FreeRadiusClient{
+		Addr:        g.Addr,
+		IPVersion:   g.IPVersion,
+		Description: g.Description,
+		Shortname:   g.Shortname,
+		Secret:      g.Secret,
+		Proto:       g.Proto,
+		Nastype:     g.Nastype,
+		Msgauth:     g.Msgauth,
+		Maxconn:     g.Maxconn,
+		Naslogin:    g.Naslogin,
+		Naspassword: g.Naspassword,
+	}
+}
+
+// ToModel copies p into a new FreeRadiusClient, sharing its slices and pointers.
+func (p *PostServicesFreeRadiusClientEndpointResponseData) ToModel() *FreeRadiusClient {
+	if p == nil {
+		return nil
+	}
+	return &FreeRadiusClient{
+		Addr:        p.Addr,
+		IPVersion:   p.IPVersion,
+		Description: p.Description,
+		Shortname:   p.Shortname,
+		Secret:      p.Secret,
+		Proto:       p.Proto,
+		Nastype:     p.Nastype,
+		Msgauth:     p.Msgauth,
+		Maxconn:     p.Maxconn,
+		Naslogin:    p.Naslogin,
+		Naspassword: p.Naspassword,
+	}
+}
+
+// ToModel copies p into a new FreeRadiusClient, sharing its slices and pointers.
+func (p *PatchServicesFreeRadiusClientEndpointResponseData) ToModel() *FreeRadiusClient {
+	if p == nil {
+		return nil
+	}
+	return &FreeRadiusClient{
+		Addr:        p.Addr,
+		IPVersion:   p.IPVersion,
+		Description: p.Description,
+		Shortname:   p.Shortname,
+		Secret:      p.Secret,
+		Proto:       p.Proto,
+		Nastype:     p.Nastype,
+		Msgauth:     p.Msgauth,
+		Maxconn:     p.Maxconn,
+		Naslogin:    p.Naslogin,
+		Naspassword: p.Naspassword,
+	}
+}
+
+// ToModel copies g into a new FreeRadiusClient, sharing its slices and pointers.
+func (g *GetServicesFreeRadiusClientsEndpointResponseDataItem) ToModel() *FreeRadiusClient {
+	if g == nil {
+		return nil
+	}
+	return &FreeRadiusClient{
+		Addr:        g.Addr,
+		IPVersion:   g.IPVersion,
+		Description: g.Description,
+		Shortname:   g.Shortname,
+		Secret:      g.Secret,
+		Proto:       g.Proto,
+		Nastype:     g.Nastype,
+		Msgauth:     g.Msgauth,
+		Maxconn:     g.Maxconn,
+		Naslogin:    g.Naslogin,
+		Naspassword: g.Naspassword,
+	}
+}
+
+// ToPost returns a request that creates a copy of f. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with f.
+func (f *FreeRadiusInterface) ToPost() *PostServicesFreeRadiusInterfaceEndpointRequest {
+	if f == nil {
+		return &PostServicesFreeRadiusInterfaceEndpointRequest{}
+	}
+	return &PostServicesFreeRadiusInterfaceEndpointRequest{
+		Addr:        f.Addr,
+		Port:        f.Port,
+		Type:        f.Type,
+		IPVersion:   f.IPVersion,
+		Description: f.Description,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with f. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with f.
+func (f *FreeRadiusInterface) ToPatch(id int) *PatchServicesFreeRadiusInterfaceEndpointRequest {
+	if f == nil {
+		return &PatchServicesFreeRadiusInterfaceEndpointRequest{ID: id}
+	}
+	return &PatchServicesFreeRadiusInterfaceEndpointRequest{
+		ID:          id,
+		Addr:        f.Addr,
+		Port:        f.Port,
+		Type:        f.Type,
+		IPVersion:   f.IPVersion,
+		Description: f.Description,
+	}
+}
+
+// ToPutItem returns f as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with f.
+func (f *FreeRadiusInterface) ToPutItem() *PutServicesFreeRadiusInterfacesEndpointRequestItem {
+	if f == nil {
+		return nil
+	}
+	return &PutServicesFreeRadiusInterfacesEndpointRequestItem{
+		Addr:        f.Addr,
+		Port:        f.Port,
+		Type:        f.Type,
+		IPVersion:   f.IPVersion,
+		Description: f.Description,
+	}
+}
+
+// ToModel copies g into a new FreeRadiusInterface, sharing its slices and pointers.
+func (g *GetServicesFreeRadiusInterfaceEndpointResponseData) ToModel() *FreeRadiusInterface {
+	if g == nil {
+		return nil
+	}
+	return &FreeRadiusInterface{
+		Addr:        g.Addr,
+		Port:        g.Port,
+		Type:        g.Type,
+		IPVersion:   g.IPVersion,
+		Description: g.Description,
+	}
+}
+
+// ToModel copies p into a new FreeRadiusInterface, sharing its slices and pointers.
+func (p *PostServicesFreeRadiusInterfaceEndpointResponseData) ToModel() *FreeRadiusInterface {
+	if p == nil {
+		return nil
+	}
+	return &FreeRadiusInterface{
+		Addr:        p.Addr,
+		Port:        p.Port,
+		Type:        p.Type,
+		IPVersion:   p.IPVersion,
+		Description: p.Description,
+	}
+}
+
+// ToModel copies p into a new FreeRadiusInterface, sharing its slices and pointers.
+func (p *PatchServicesFreeRadiusInterfaceEndpointResponseData) ToModel() *FreeRadiusInterface {
+	if p == nil {
+		return nil
+	}
+	return &FreeRadiusInterface{
+		Addr:        p.Addr,
+		Port:        p.Port,
+		Type:        p.Type,
+		IPVersion:   p.IPVersion,
+		Description: p.Description,
+	}
+}
+
+// ToModel copies g into a new FreeRadiusInterface, sharing its slices and pointers.
+func (g *GetServicesFreeRadiusInterfacesEndpointResponseDataItem) ToModel() *FreeRadiusInterface {
+	if g == nil {
+		return nil
+	}
+	return &FreeRadiusInterface{
+		Addr:        g.Addr,
+		Port:        g.Port,
+		Type:        g.Type,
+		IPVersion:   g.IPVersion,
+		Description: g.Description,
+	}
+}
+
+// ToPost returns a request that creates a copy of f. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with f.
+func (f *FreeRadiusUser) ToPost() *PostServicesFreeRadiusUserEndpointRequest {
+	if f == nil {
+		return &PostServicesFreeRadiusUserEndpointRequest{}
+	}
+	return &PostServicesFreeRadiusUserEndpointRequest{
+		Username:           f.Username,
+		Password:           f.Password,
+		PasswordEncryption: f.PasswordEncryption,
+		MotpEnable:         f.MotpEnable,
+		MotpAuthmethod:     f.MotpAuthmethod,
+		MotpSecret:         f.MotpSecret,
+		MotpPin:            f.MotpPin,
+		MotpOffset:         f.MotpOffset,
+		Description:        f.Description,
+		FramedIPAddress:    f.FramedIPAddress,
+		FramedIPNetmask:    f.FramedIPNetmask,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with f. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with f.
+func (f *FreeRadiusUser) ToPatch(id int) *PatchServicesFreeRadiusUserEndpointRequest {
+	if f == nil {
+		return &PatchServicesFreeRadiusUserEndpointRequest{ID: id}
+	}
+	return &PatchServicesFreeRadiusUserEndpointRequest{
+		ID:                 id,
+		Username:           f.Username,
+		Password:           f.Password,
+		PasswordEncryption: f.PasswordEncryption,
+		MotpEnable:         f.MotpEnable,
+		MotpAuthmethod:     f.MotpAuthmethod,
+		MotpSecret:         f.MotpSecret,
+		MotpPin:            f.MotpPin,
+		MotpOffset:         f.MotpOffset,
+		Description:        f.Description,
+		FramedIPAddress:    f.FramedIPAddress,
+		FramedIPNetmask:    f.FramedIPNetmask,
+	}
+}
+
+// ToPutItem returns f as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with f.
+func (f *FreeRadiusUser) ToPutItem() *PutServicesFreeRadiusUsersEndpointRequestItem {
+	if f == nil {
+		return nil
+	}
+	return &PutServicesFreeRadiusUsersEndpointRequestItem{
+		Username:           f.Username,
+		Password:           f.Password,
+		PasswordEncryption: f.PasswordEncryption,
+		MotpEnable:         f.MotpEnable,
+		MotpAuthmethod:     f.MotpAuthmethod,
+		MotpSecret:         f.MotpSecret,
+		MotpPin:            f.MotpPin,
+		MotpOffset:         f.MotpOffset,
+		Description:        f.Description,
+		FramedIPAddress:    f.FramedIPAddress,
+		FramedIPNetmask:    f.FramedIPNetmask,
+	}
+}
+
+// ToModel copies g into a new FreeRadiusUser, sharing its slices and pointers.
+func (g *GetServicesFreeRadiusUserEndpointResponseData) ToModel() *FreeRadiusUser {
+	if g == nil {
+		return nil
+	}
+	return &FreeRadiusUser{
+		Username:           g.Username,
+		Password:           g.Password,
+		PasswordEncryption: g.PasswordEncryption,
+		MotpEnable:         g.MotpEnable,
+		MotpAuthmethod:     g.MotpAuthmethod,
+		MotpSecret:         g.MotpSecret,
+		MotpPin:            g.MotpPin,
+		MotpOffset:         g.MotpOffset,
+		Description:        g.Description,
+		FramedIPAddress:    g.FramedIPAddress,
+		FramedIPNetmask:    g.FramedIPNetmask,
+	}
+}
+
+// ToModel copies p into a new FreeRadiusUser, sharing its slices and pointers.
+func (p *PostServicesFreeRadiusUserEndpointResponseData) ToModel() *FreeRadiusUser {
+	if p == nil {
+		return nil
+	}
+	return &FreeRadiusUser{
+		Username:           p.Username,
+		Password:           p.Password,
+		PasswordEncryption: p.PasswordEncryption,
+		MotpEnable:         p.MotpEnable,
+		MotpAuthmethod:     p.MotpAuthmethod,
+		MotpSecret:         p.MotpSecret,
+		MotpPin:            p.MotpPin,
+		MotpOffset:         p.MotpOffset,
+		Description:        p.Description,
+		FramedIPAddress:    p.FramedIPAddress,
+		FramedIPNetmask:    p.FramedIPNetmask,
+	}
+}
+
+// ToModel copies p into a new FreeRadiusUser, sharing its slices and pointers.
+func (p *PatchServicesFreeRadiusUserEndpointResponseData) ToModel() *FreeRadiusUser {
+	if p == nil {
+		return nil
+	}
+	return &FreeRadiusUser{
+		Username:           p.Username,
+		Password:           p.Password,
+		PasswordEncryption: p.PasswordEncryption,
+		MotpEnable:         p.MotpEnable,
+		MotpAuthmethod:     p.MotpAuthmethod,
+		MotpSecret:         p.MotpSecret,
+		MotpPin:            p.MotpPin,
+		MotpOffset:         p.MotpOffset,
+		Description:        p.Description,
+		FramedIPAddress:    p.FramedIPAddress,
+		FramedIPNetmask:    p.FramedIPNetmask,
+	}
+}
+
+// ToModel copies g into a new FreeRadiusUser, sharing its slices and pointers.
+func (g *GetServicesFreeRadiusUsersEndpointResponseDataItem) ToModel() *FreeRadiusUser {
+	if g == nil {
+		return nil
+	}
+	return &FreeRadiusUser{
+		Username:           g.Username,
+		Password:           g.Password,
+		PasswordEncryption: g.PasswordEncryption,
+		MotpEnable:         g.MotpEnable,
+		MotpAuthmethod:     g.MotpAuthmethod,
+		MotpSecret:         g.MotpSecret,
+		MotpPin:            g.MotpPin,
+		MotpOffset:         g.MotpOffset,
+		Description:        g.Description,
+		FramedIPAddress:    g.FramedIPAddress,
+		FramedIPNetmask:    g.FramedIPNetmask,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyBackendACL) ToPost(parentID int) *PostServicesHaProxyBackendACLEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyBackendACLEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesHaProxyBackendACLEndpointRequest{
+		ParentID:      parentID,
+		Name:          h.Name,
+		Expression:    h.Expression,
+		Value:         h.Value,
+		Casesensitive: h.Casesensitive,
+		Not:           h.Not,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyBackendACL) ToPatch(parentID, id int) *PatchServicesHaProxyBackendACLEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyBackendACLEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesHaProxyBackendACLEndpointRequest{
+		ParentID:      parentID,
+		ID:            id,
+		Name:          h.Name,
+		Expression:    h.Expression,
+		Value:         h.Value,
+		Casesensitive: h.Casesensitive,
+		Not:           h.Not,
+	}
+}
+
+// ToModel copies g into a new HaProxyBackendACL, sharing its slices and pointers.
+func (g *GetServicesHaProxyBackendACLEndpointResponseData) ToModel() *HaProxyBackendACL {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyBackendACL{
+		Name:          g.Name,
+		Expression:    g.Expression,
+		Value:         g.Value,
+		Casesensitive: g.Casesensitive,
+		Not:           g.Not,
+	}
+}
+
+// ToModel copies p into a new HaProxyBackendACL, sharing its slices and pointers.
+func (p *PostServicesHaProxyBackendACLEndpointResponseData) ToModel() *HaProxyBackendACL {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyBackendACL{
+		Name:          p.Name,
+		Expression:    p.Expression,
+		Value:         p.Value,
+		Casesensitive: p.Casesensitive,
+		Not:           p.Not,
+	}
+}
+
+// ToModel copies p into a new HaProxyBackendACL, sharing its slices and pointers.
+func (p *PatchServicesHaProxyBackendACLEndpointResponseData) ToModel() *HaProxyBackendACL {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyBackendACL{
+		Name:          p.Name,
+		Expression:    p.Expression,
+		Value:         p.Value,
+		Casesensitive: p.Casesensitive,
+		Not:           p.Not,
+	}
+}
+
+// ToModel copies g into a new HaProxyBackendACL, sharing its slices and pointers.
+func (g *GetServicesHaProxyBackendAcLsEndpointResponseDataItem) ToModel() *HaProxyBackendACL {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyBackendACL{
+		Name:          g.Name,
+		Expression:    g.Expression,
+		Value:         g.Value,
+		Casesensitive: g.Casesensitive,
+		Not:           g.Not,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyBackendAction) ToPost(parentID int) *PostServicesHaProxyBackendActionEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyBackendActionEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesHaProxyBackendActionEndpointRequest{
+		ParentID:     parentID,
+		Action:       h.Action,
+		ACL:          h.ACL,
+		Server:       h.Server,
+		Customaction: h.Customaction,
+		DenyStatus:   h.DenyStatus,
+		Realm:        h.Realm,
+		Rule:         h.Rule,
+		LuaFunction:  h.LuaFunction,
+		Name:         h.Name,
+		Fmt:          h.Fmt,
+		Find:         h.Find,
+		Replace:      h.Replace,
+		Path:         h.Path,
+		Status:       h.Status,
+		Reason:       h.Reason,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyBackendAction) ToPatch(parentID, id int) *PatchServicesHaProxyBackendActionEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyBackendActionEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesHaProxyBackendActionEndpointRequest{
+		ParentID:     parentID,
+		ID:           id,
+		Action:       h.Action,
+		ACL:          h.ACL,
+		Server:       h.Server,
+		Customaction: h.Customaction,
+		DenyStatus:   h.DenyStatus,
+		Realm:        h.Realm,
+		Rule:         h.Rule,
+		LuaFunction:  h.LuaFunction,
+		Name:         h.Name,
+		Fmt:          h.Fmt,
+		Find:         h.Find,
+		Replace:      h.Replace,
+		Path:         h.Path,
+		Status:       h.Status,
+		Reason:       h.Reason,
+	}
+}
+
+// ToModel copies g into a new HaProxyBackendAction, sharing its slices and pointers.
+func (g *GetServicesHaProxyBackendActionEndpointResponseData) ToModel() *HaProxyBackendAction {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyBackendAction{
+		Action:       g.Action,
+		ACL:          g.ACL,
+		Server:       g.Server,
+		Customaction: g.Customaction,
+		DenyStatus:   g.DenyStatus,
+		Realm:        g.Realm,
+		Rule:         g.Rule,
+		LuaFunction:  g.LuaFunction,
+		Name:         g.Name,
+		Fmt:          g.Fmt,
+		Find:         g.Find,
+		Replace:      g.Replace,
+		Path:         g.Path,
+		Status:       g.Status,
+		Reason:       g.Reason,
+	}
+}
+
+// ToModel copies p into a new HaProxyBackendAction, sharing its slices and pointers.
+func (p *PostServicesHaProxyBackendActionEndpointResponseData) ToModel() *HaProxyBackendAction {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyBackendAction{
+		Action:       p.Action,
+		ACL:          p.ACL,
+		Server:       p.Server,
+		Customaction: p.Customaction,
+		DenyStatus:   p.DenyStatus,
+		Realm:        p.Realm,
+		Rule:         p.Rule,
+		LuaFunction:  p.LuaFunction,
+		Name:         p.Name,
+		Fmt:          p.Fmt,
+		Find:         p.Find,
+		Replace:      p.Replace,
+		Path:         p.Path,
+		Status:       p.Status,
+		Reason:       p.Reason,
+	}
+}
+
+// ToModel copies p into a new HaProxyBackendAction, sharing its slices and pointers.
+func (p *PatchServicesHaProxyBackendActionEndpointResponseData) ToModel() *HaProxyBackendAction {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyBackendAction{
+		Action:       p.Action,
+		ACL:          p.ACL,
+		Server:       p.Server,
+		Customaction: p.Customaction,
+		DenyStatus:   p.DenyStatus,
+		Realm:        p.Realm,
+		Rule:         p.Rule,
+		LuaFunction:  p.LuaFunction,
+		Name:         p.Name,
+		Fmt:          p.Fmt,
+		Find:         p.Find,
+		Replace:      p.Replace,
+		Path:         p.Path,
+		Status:       p.Status,
+		Reason:       p.Reason,
+	}
+}
+
+// ToModel copies g into a new HaProxyBackendAction, sharing its slices and pointers.
+func (g *GetServicesHaProxyBackendActionsEndpointResponseDataItem) ToModel() *HaProxyBackendAction {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyBackendAction{
+		Action:       g.Action,
+		ACL:          g.ACL,
+		Server:       g.Server,
+		Customaction: g.Customaction,
+		DenyStatus:   g.DenyStatus,
+		Realm:        g.Realm,
+		Rule:         g.Rule,
+		LuaFunction:  g.LuaFunction,
+		Name:         g.Name,
+		Fmt:          g.Fmt,
+		Find:         g.Find,
+		Replace:      g.Replace,
+		Path:         g.Path,
+		Status:       g.Status,
+		Reason:       g.Reason,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyBackendErrorFile) ToPost(parentID int) *PostServicesHaProxyBackendErrorFileEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyBackendErrorFileEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesHaProxyBackendErrorFileEndpointRequest{
+		ParentID:  parentID,
+		Errorcode: h.Errorcode,
+		Errorfile: h.Errorfile,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyBackendErrorFile) ToPatch(parentID, id int) *PatchServicesHaProxyBackendErrorFileEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyBackendErrorFileEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesHaProxyBackendErrorFileEndpointRequest{
+		ParentID:  parentID,
+		ID:        id,
+		Errorcode: h.Errorcode,
+		Errorfile: h.Errorfile,
+	}
+}
+
+// ToModel copies g into a new HaProxyBackendErrorFile, sharing its slices and pointers.
+func (g *GetServicesHaProxyBackendErrorFileEndpointResponseData) ToModel() *HaProxyBackendErrorFile {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyBackendErrorFile{
+		Errorcode: g.Errorcode,
+		Errorfile: g.Errorfile,
+	}
+}
+
+// ToModel copies p into a new HaProxyBackendErrorFile, sharing its slices and pointers.
+func (p *PostServicesHaProxyBackendErrorFileEndpointResponseData) ToModel() *HaProxyBackendErrorFile {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyBackendErrorFile{
+		Errorcode: p.Errorcode,
+		Errorfile: p.Errorfile,
+	}
+}
+
+// ToModel copies p into a new HaProxyBackendErrorFile, sharing its slices and pointers.
+func (p *PatchServicesHaProxyBackendErrorFileEndpointResponseData) ToModel() *HaProxyBackendErrorFile {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyBackendErrorFile{
+		Errorcode: p.Errorcode,
+		Errorfile: p.Errorfile,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyBackendServer) ToPost(parentID int) *PostServicesHaProxyBackendServerEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyBackendServerEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesHaProxyBackendServerEndpointRequest{
+		ParentID:        parentID,
+		Name:            h.Name,
+		Status:          h.Status,
+		Address:         h.Address,
+		Port:            h.Port,
+		Weight:          h.Weight,
+		Ssl:             h.Ssl,
+		Sslserververify: h.Sslserververify,
+		Serverid:        h.Serverid,
+		Advanced:        h.Advanced,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyBackendServer) ToPatch(parentID, id int) *PatchServicesHaProxyBackendServerEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyBackendServerEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesHaProxyBackendServerEndpointRequest{
+		ParentID:        parentID,
+		ID:              id,
+		Name:            h.Name,
+		Status:          h.Status,
+		Address:         h.Address,
+		Port:            h.Port,
+		Weight:          h.Weight,
+		Ssl:             h.Ssl,
+		Sslserververify: h.Sslserververify,
+		Serverid:        h.Serverid,
+		Advanced:        h.Advanced,
+	}
+}
+
+// ToModel copies g into a new HaProxyBackendServer, sharing its slices and pointers.
+func (g *GetServicesHaProxyBackendServerEndpointResponseData) ToModel() *HaProxyBackendServer {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyBackendServer{
+		Name:            g.Name,
+		Status:          g.Status,
+		Address:         g.Address,
+		Port:            g.Port,
+		Weight:          g.Weight,
+		Ssl:             g.Ssl,
+		Sslserververify: g.Sslserververify,
+		Serverid:        g.Serverid,
+		Advanced:        g.Advanced,
+	}
+}
+
+// ToModel copies p into a new HaProxyBackendServer, sharing its slices and pointers.
+func (p *PostServicesHaProxyBackendServerEndpointResponseData) ToModel() *HaProxyBackendServer {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyBackendServer{
+		Name:            p.Name,
+		Status:          p.Status,
+		Address:         p.Address,
+		Port:            p.Port,
+		Weight:          p.Weight,
+		Ssl:             p.Ssl,
+		Sslserververify: p.Sslserververify,
+		Serverid:        p.Serverid,
+		Advanced:        p.Advanced,
+	}
+}
+
+// ToModel copies p into a new HaProxyBackendServer, sharing its slices and pointers.
+func (p *PatchServicesHaProxyBackendServerEndpointResponseData) ToModel() *HaProxyBackendServer {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyBackendServer{
+		Name:            p.Name,
+		Status:          p.Status,
+		Address:         p.Address,
+		Port:            p.Port,
+		Weight:          p.Weight,
+		Ssl:             p.Ssl,
+		Sslserververify: p.Sslserververify,
+		Serverid:        p.Serverid,
+		Advanced:        p.Advanced,
+	}
+}
+
+// ToModel copies g into a new HaProxyBackendServer, sharing its slices and pointers.
+func (g *GetServicesHaProxyBackendServersEndpointResponseDataItem) ToModel() *HaProxyBackendServer {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyBackendServer{
+		Name:            g.Name,
+		Status:          g.Status,
+		Address:         g.Address,
+		Port:            g.Port,
+		Weight:          g.Weight,
+		Ssl:             g.Ssl,
+		Sslserververify: g.Sslserververify,
+		Serverid:        g.Serverid,
+		Advanced:        g.Advanced,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyBackend) ToPost() *PostServicesHaProxyBackendEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyBackendEndpointRequest{}
+	}
+	return &PostServicesHaProxyBackendEndpointRequest{
+		Name:                          h.Name,
+		Servers:                       h.Servers,
+		Balance:                       h.Balance,
+		BalanceUrilen:                 h.BalanceUrilen,
+		BalanceUridepth:               h.BalanceUridepth,
+		BalanceUriwhole:               h.BalanceUriwhole,
+		ACLs:                          h.ACLs,
+		Actions:                       h.Actions,
+		ConnectionTimeout:             h.ConnectionTimeout,
+		ServerTimeout:                 h.ServerTimeout,
+		Retries:                       h.Retries,
+		CheckType:                     h.CheckType,
+		Checkinter:                    h.Checkinter,
+		LogHealthChecks:               h.LogHealthChecks,
+		HttpcheckMethod:               h.HttpcheckMethod,
+		MonitorURI:                    h.MonitorURI,
+		MonitorHttpversion:            h.MonitorHttpversion,
+		MonitorUsername:               h.MonitorUsername,
+		MonitorDomain:                 h.MonitorDomain,
+		AgentChecks:                   h.AgentChecks,
+		AgentPort:                     h.AgentPort,
+		AgentInter:                    h.AgentInter,
+		PersistCookieEnabled:          h.PersistCookieEnabled,
+		PersistCookieName:             h.PersistCookieName,
+		PersistCookieMode:             h.PersistCookieMode,
+		PersistCookieCachable:         h.PersistCookieCachable,
+		PersistCookiePostonly:         h.PersistCookiePostonly,
+		PersistCookieHttponly:         h.PersistCookieHttponly,
+		PersistCookieSecure:           h.PersistCookieSecure,
+		HaproxyCookieMaxidle:          h.HaproxyCookieMaxidle,
+		HaproxyCookieMaxlife:          h.HaproxyCookieMaxlife,
+		HaproxyCookieDomains:          h.HaproxyCookieDomains,
+		HaproxyCookieDynamicCookieKey: h.HaproxyCookieDynamicCookieKey,
+		PersistStickyType:             h.PersistStickyType,
+		PersistStickExpire:            h.PersistStickExpire,
+		PersistStickTablesize:         h.PersistStickTablesize,
+		PersistStickCookiename:        h.PersistStickCookiename,
+		PersistStickLength:            h.PersistStickLength,
+		EmailLevel:                    h.EmailLevel,
+		EmailTo:                       h.EmailTo,
+		StatsEnabled:                  h.StatsEnabled,
+		StatsURI:                      h.StatsURI,
+		StatsScope:                    h.StatsScope,
+		StatsRealm:                    h.StatsRealm,
+		StatsUsername:                 h.StatsUsername,
+		StatsPassword:                 h.StatsPassword,
+		StatsAdmin:                    h.StatsAdmin,
+		StatsNode:                     h.StatsNode,
+		StatsDesc:                     h.StatsDesc,
+		StatsRefresh:                  h.StatsRefresh,
+		StrictTransportSecurity:       h.StrictTransportSecurity,
+		Errorfiles:                    h.Errorfiles,
+		CookieAttributeSecure:         h.CookieAttributeSecure,
+		Advanced:                      h.Advanced,
+		AdvancedBackend:               h.AdvancedBackend,
+		TransparentClientip:           h.TransparentClientip,
+		TransparentInterface:          h.TransparentInterface,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyBackend) ToPatch(id int) *PatchServicesHaProxyBackendEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyBackendEndpointRequest{ID: id}
+	}
+	return &PatchServicesHaProxyBackendEndpointRequest{
+		ID:                            id,
+		Name:                          h.Name,
+		Servers:                       h.Servers,
+		Balance:                       h.Balance,
+		BalanceUrilen:                 h.BalanceUrilen,
+		BalanceUridepth:               h.BalanceUridepth,
+		BalanceUriwhole:               h.BalanceUriwhole,
+		ACLs:                          h.ACLs,
+		Actions:                       h.Actions,
+		ConnectionTimeout:             h.ConnectionTimeout,
+		ServerTimeout:                 h.ServerTimeout,
+		Retries:                       h.Retries,
+		CheckType:                     h.CheckType,
+		Checkinter:                    h.Checkinter,
+		LogHealthChecks:               h.LogHealthChecks,
+		HttpcheckMethod:               h.HttpcheckMethod,
+		MonitorURI:                    h.MonitorURI,
+		MonitorHttpversion:            h.MonitorHttpversion,
+		MonitorUsername:               h.MonitorUsername,
+		MonitorDomain:                 h.MonitorDomain,
+		AgentChecks:                   h.AgentChecks,
+		AgentPort:                     h.AgentPort,
+		AgentInter:                    h.AgentInter,
+		PersistCookieEnabled:          h.PersistCookieEnabled,
+		PersistCookieName:             h.PersistCookieName,
+		PersistCookieMode:             h.PersistCookieMode,
+		PersistCookieCachable:         h.PersistCookieCachable,
+		PersistCookiePostonly:         h.PersistCookiePostonly,
+		PersistCookieHttponly:         h.PersistCookieHttponly,
+		PersistCookieSecure:           h.PersistCookieSecure,
+		HaproxyCookieMaxidle:          h.HaproxyCookieMaxidle,
+		HaproxyCookieMaxlife:          h.HaproxyCookieMaxlife,
+		HaproxyCookieDomains:          h.HaproxyCookieDomains,
+		HaproxyCookieDynamicCookieKey: h.HaproxyCookieDynamicCookieKey,
+		PersistStickyType:             h.PersistStickyType,
+		PersistStickExpire:            h.PersistStickExpire,
+		PersistStickTablesize:         h.PersistStickTablesize,
+		PersistStickCookiename:        h.PersistStickCookiename,
+		PersistStickLength:            h.PersistStickLength,
+		EmailLevel:                    h.EmailLevel,
+		EmailTo:                       h.EmailTo,
+		StatsEnabled:                  h.StatsEnabled,
+		StatsURI:                      h.StatsURI,
+		StatsScope:                    h.StatsScope,
+		StatsRealm:                    h.StatsRealm,
+		StatsUsername:                 h.StatsUsername,
+		StatsPassword:                 h.StatsPassword,
+		StatsAdmin:                    h.StatsAdmin,
+		StatsNode:                     h.StatsNode,
+		StatsDesc:                     h.StatsDesc,
+		StatsRefresh:                  h.StatsRefresh,
+		StrictTransportSecurity:       h.StrictTransportSecurity,
+		Errorfiles:                    h.Errorfiles,
+		CookieAttributeSecure:         h.CookieAttributeSecure,
+		Advanced:                      h.Advanced,
+		AdvancedBackend:               h.AdvancedBackend,
+		TransparentClientip:           h.TransparentClientip,
+		TransparentInterface:          h.TransparentInterface,
+	}
+}
+
+// ToPutItem returns h as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with h.
+func (h *HaProxyBackend) ToPutItem() *PutServicesHaProxyBackendsEndpointRequestItem {
+	if h == nil {
+		return nil
+	}
+	return &PutServicesHaProxyBackendsEndpointRequestItem{
+		Name:                          h.Name,
+		Servers:                       h.Servers,
+		Balance:                       h.Balance,
+		BalanceUrilen:                 h.BalanceUrilen,
+		BalanceUridepth:               h.BalanceUridepth,
+		BalanceUriwhole:               h.BalanceUriwhole,
+		ACLs:                          h.ACLs,
+		Actions:                       h.Actions,
+		ConnectionTimeout:             h.ConnectionTimeout,
+		ServerTimeout:                 h.ServerTimeout,
+		Retries:                       h.Retries,
+		CheckType:                     h.CheckType,
+		Checkinter:                    h.Checkinter,
+		LogHealthChecks:               h.LogHealthChecks,
+		HttpcheckMethod:               h.HttpcheckMethod,
+		MonitorURI:                    h.MonitorURI,
+		MonitorHttpversion:            h.MonitorHttpversion,
+		MonitorUsername:               h.MonitorUsername,
+		MonitorDomain:                 h.MonitorDomain,
+		AgentChecks:                   h.AgentChecks,
+		AgentPort:                     h.AgentPort,
+		AgentInter:                    h.AgentInter,
+		PersistCookieEnabled:          h.PersistCookieEnabled,
+		PersistCookieName:             h.PersistCookieName,
+		PersistCookieMode:             h.PersistCookieMode,
+		PersistCookieCachable:         h.PersistCookieCachable,
+		PersistCookiePostonly:         h.PersistCookiePostonly,
+		PersistCookieHttponly:         h.PersistCookieHttponly,
+		PersistCookieSecure:           h.PersistCookieSecure,
+		HaproxyCookieMaxidle:          h.HaproxyCookieMaxidle,
+		HaproxyCookieMaxlife:          h.HaproxyCookieMaxlife,
+		HaproxyCookieDomains:          h.HaproxyCookieDomains,
+		HaproxyCookieDynamicCookieKey: h.HaproxyCookieDynamicCookieKey,
+		PersistStickyType:             h.PersistStickyType,
+		PersistStickExpire:            h.PersistStickExpire,
+		PersistStickTablesize:         h.PersistStickTablesize,
+		PersistStickCookiename:        h.PersistStickCookiename,
+		PersistStickLength:            h.PersistStickLength,
+		EmailLevel:                    h.EmailLevel,
+		EmailTo:                       h.EmailTo,
+		StatsEnabled:                  h.StatsEnabled,
+		StatsURI:                      h.StatsURI,
+		StatsScope:                    h.StatsScope,
+		StatsRealm:                    h.StatsRealm,
+		StatsUsername:                 h.StatsUsername,
+		StatsPassword:                 h.StatsPassword,
+		StatsAdmin:                    h.StatsAdmin,
+		StatsNode:                     h.StatsNode,
+		StatsDesc:                     h.StatsDesc,
+		StatsRefresh:                  h.StatsRefresh,
+		StrictTransportSecurity:       h.StrictTransportSecurity,
+		Errorfiles:                    h.Errorfiles,
+		CookieAttributeSecure:         h.CookieAttributeSecure,
+		Advanced:                      h.Advanced,
+		AdvancedBackend:               h.AdvancedBackend,
+		TransparentClientip:           h.TransparentClientip,
+		TransparentInterface:          h.TransparentInterface,
+	}
+}
+
+// ToModel copies g into a new HaProxyBackend, sharing its slices and pointers.
+func (g *GetServicesHaProxyBackendEndpointResponseData) ToModel() *HaProxyBackend {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyBackend{
+		Name:                          g.Name,
+		Servers:                       g.Servers,
+		Balance:                       g.Balance,
+		BalanceUrilen:                 g.BalanceUrilen,
+		BalanceUridepth:               g.BalanceUridepth,
+		BalanceUriwhole:               g.BalanceUriwhole,
+		ACLs:                          g.ACLs,
+		Actions:                       g.Actions,
+		ConnectionTimeout:             g.ConnectionTimeout,
+		ServerTimeout:                 g.ServerTimeout,
+		Retries:                       g.Retries,
+		CheckType:                     g.CheckType,
+		Checkinter:                    g.Checkinter,
+		LogHealthChecks:               g.LogHealthChecks,
+		HttpcheckMethod:               g.HttpcheckMethod,
+		MonitorURI:                    g.MonitorURI,
+		MonitorHttpversion:            g.MonitorHttpversion,
+		MonitorUsername:               g.MonitorUsername,
+		MonitorDomain:                 g.MonitorDomain,
+		AgentChecks:                   g.AgentChecks,
+		AgentPort:                     g.AgentPort,
+		AgentInter:                    g.AgentInter,
+		PersistCookieEnabled:          g.PersistCookieEnabled,
+		PersistCookieName:             g.PersistCookieName,
+		PersistCookieMode:             g.PersistCookieMode,
+		PersistCookieCachable:         g.PersistCookieCachable,
+		PersistCookiePostonly:         g.PersistCookiePostonly,
+		PersistCookieHttponly:         g.PersistCookieHttponly,
+		PersistCookieSecure:           g.PersistCookieSecure,
+		HaproxyCookieMaxidle:          g.HaproxyCookieMaxidle,
+		HaproxyCookieMaxlife:          g.HaproxyCookieMaxlife,
+		HaproxyCookieDomains:          g.HaproxyCookieDomains,
+		HaproxyCookieDynamicCookieKey: g.HaproxyCookieDynamicCookieKey,
+		PersistStickyType:             g.PersistStickyType,
+		PersistStickExpire:            g.PersistStickExpire,
+		PersistStickTablesize:         g.PersistStickTablesize,
+		PersistStickCookiename:        g.PersistStickCookiename,
+		PersistStickLength:            g.PersistStickLength,
+		EmailLevel:                    g.EmailLevel,
+		EmailTo:                       g.EmailTo,
+		StatsEnabled:                  g.StatsEnabled,
+		StatsURI:                      g.StatsURI,
+		StatsScope:                    g.StatsScope,
+		StatsRealm:                    g.StatsRealm,
+		StatsUsername:                 g.StatsUsername,
+		StatsPassword:                 g.StatsPassword,
+		StatsAdmin:                    g.StatsAdmin,
+		StatsNode:                     g.StatsNode,
+		StatsDesc:                     g.StatsDesc,
+		StatsRefresh:                  g.StatsRefresh,
+		StrictTransportSecurity:       g.StrictTransportSecurity,
+		Errorfiles:                    g.Errorfiles,
+		CookieAttributeSecure:         g.CookieAttributeSecure,
+		Advanced:                      g.Advanced,
+		AdvancedBackend:               g.AdvancedBackend,
+		TransparentClientip:           g.TransparentClientip,
+		TransparentInterface:          g.TransparentInterface,
+	}
+}
+
+// ToModel copies p into a new HaProxyBackend, sharing its slices and pointers.
+func (p *PostServicesHaProxyBackendEndpointResponseData) ToModel() *HaProxyBackend {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyBackend{
+		Name:                          p.Name,
+		Servers:                       p.Servers,
+		Balance:                       p.Balance,
+		BalanceUrilen:                 p.BalanceUrilen,
+		BalanceUridepth:               p.BalanceUridepth,
+		BalanceUriwhole:               p.BalanceUriwhole,
+		ACLs:                          p.ACLs,
+		Actions:                       p.Actions,
+		ConnectionTimeout:             p.ConnectionTimeout,
+		ServerTimeout:                 p.ServerTimeout,
+		Retries:                       p.Retries,
+		CheckType:                     p.CheckType,
+		Checkinter:                    p.Checkinter,
+		LogHealthChecks:               p.LogHealthChecks,
+		HttpcheckMethod:               p.HttpcheckMethod,
+		MonitorURI:                    p.MonitorURI,
+		MonitorHttpversion:            p.MonitorHttpversion,
+		MonitorUsername:               p.MonitorUsername,
+		MonitorDomain:                 p.MonitorDomain,
+		AgentChecks:                   p.AgentChecks,
+		AgentPort:                     p.AgentPort,
+		AgentInter:                    p.AgentInter,
+		PersistCookieEnabled:          p.PersistCookieEnabled,
+		PersistCookieName:             p.PersistCookieName,
+		PersistCookieMode:             p.PersistCookieMode,
+		PersistCookieCachable:         p.PersistCookieCachable,
+		PersistCookiePostonly:         p.PersistCookiePostonly,
+		PersistCookieHttponly:         p.PersistCookieHttponly,
+		PersistCookieSecure:           p.PersistCookieSecure,
+		HaproxyCookieMaxidle:          p.HaproxyCookieMaxidle,
+		HaproxyCookieMaxlife:          p.HaproxyCookieMaxlife,
+		HaproxyCookieDomains:          p.HaproxyCookieDomains,
+		HaproxyCookieDynamicCookieKey: p.HaproxyCookieDynamicCookieKey,
+		PersistStickyType:             p.PersistStickyType,
+		PersistStickExpire:            p.PersistStickExpire,
+		PersistStickTablesize:         p.PersistStickTablesize,
+		PersistStickCookiename:        p.PersistStickCookiename,
+		PersistStickLength:            p.PersistStickLength,
+		EmailLevel:                    p.EmailLevel,
+		EmailTo:                       p.EmailTo,
+		StatsEnabled:                  p.StatsEnabled,
+		StatsURI:                      p.StatsURI,
+		StatsScope:                    p.StatsScope,
+		StatsRealm:                    p.StatsRealm,
+		StatsUsername:                 p.StatsUsername,
+		StatsPassword:                 p.StatsPassword,
+		StatsAdmin:                    p.StatsAdmin,
+		StatsNode:                     p.StatsNode,
+		StatsDesc:                     p.StatsDesc,
+		StatsRefresh:                  p.StatsRefresh,
+		StrictTransportSecurity:       p.StrictTransportSecurity,
+		Errorfiles:                    p.Errorfiles,
+		CookieAttributeSecure:         p.CookieAttributeSecure,
+		Advanced:                      p.Advanced,
+		AdvancedBackend:               p.AdvancedBackend,
+		TransparentClientip:           p.TransparentClientip,
+		TransparentInterface:          p.TransparentInterface,
+	}
+}
+
+// ToModel copies p into a new HaProxyBackend, sharing its slices and pointers.
+func (p *PatchServicesHaProxyBackendEndpointResponseData) ToModel() *HaProxyBackend {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyBackend{
+		Name:                          p.Name,
+		Servers:                       p.Servers,
+		Balance:                       p.Balance,
+		BalanceUrilen:                 p.BalanceUrilen,
+		BalanceUridepth:               p.BalanceUridepth,
+		BalanceUriwhole:               p.BalanceUriwhole,
+		ACLs:                          p.ACLs,
+		Actions:                       p.Actions,
+		ConnectionTimeout:             p.ConnectionTimeout,
+		ServerTimeout:                 p.ServerTimeout,
+		Retries:                       p.Retries,
+		CheckType:                     p.CheckType,
+		Checkinter:                    p.Checkinter,
+		LogHealthChecks:               p.LogHealthChecks,
+		HttpcheckMethod:               p.HttpcheckMethod,
+		MonitorURI:                    p.MonitorURI,
+		MonitorHttpversion:            p.MonitorHttpversion,
+		MonitorUsername:               p.MonitorUsername,
+		MonitorDomain:                 p.MonitorDomain,
+		AgentChecks:                   p.AgentChecks,
+		AgentPort:                     p.AgentPort,
+		AgentInter:                    p.AgentInter,
+		PersistCookieEnabled:          p.PersistCookieEnabled,
+		PersistCookieName:             p.PersistCookieName,
+		PersistCookieMode:             p.PersistCookieMode,
+		PersistCookieCachable:         p.PersistCookieCachable,
+		PersistCookiePostonly:         p.PersistCookiePostonly,
+		PersistCookieHttponly:         p.PersistCookieHttponly,
+		PersistCookieSecure:           p.PersistCookieSecure,
+		HaproxyCookieMaxidle:          p.HaproxyCookieMaxidle,
+		HaproxyCookieMaxlife:          p.HaproxyCookieMaxlife,
+		HaproxyCookieDomains:          p.HaproxyCookieDomains,
+		HaproxyCookieDynamicCookieKey: p.HaproxyCookieDynamicCookieKey,
+		PersistStickyType:             p.PersistStickyType,
+		PersistStickExpire:            p.PersistStickExpire,
+		PersistStickTablesize:         p.PersistStickTablesize,
+		PersistStickCookiename:        p.PersistStickCookiename,
+		PersistStickLength:            p.PersistStickLength,
+		EmailLevel:                    p.EmailLevel,
+		EmailTo:                       p.EmailTo,
+		StatsEnabled:                  p.StatsEnabled,
+		StatsURI:                      p.StatsURI,
+		StatsScope:                    p.StatsScope,
+		StatsRealm:                    p.StatsRealm,
+		StatsUsername:                 p.StatsUsername,
+		StatsPassword:                 p.StatsPassword,
+		StatsAdmin:                    p.StatsAdmin,
+		StatsNode:                     p.StatsNode,
+		StatsDesc:                     p.StatsDesc,
+		StatsRefresh:                  p.StatsRefresh,
+		StrictTransportSecurity:       p.StrictTransportSecurity,
+		Errorfiles:                    p.Errorfiles,
+		CookieAttributeSecure:         p.CookieAttributeSecure,
+		Advanced:                      p.Advanced,
+		AdvancedBackend:               p.AdvancedBackend,
+		TransparentClientip:           p.TransparentClientip,
+		TransparentInterface:          p.TransparentInterface,
+	}
+}
+
+// ToModel copies g into a new HaProxyBackend, sharing its slices and pointers.
+func (g *GetServicesHaProxyBackendsEndpointResponseDataItem) ToModel() *HaProxyBackend {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyBackend{
+		Name:                          g.Name,
+		Servers:                       g.Servers,
+		Balance:                       g.Balance,
+		BalanceUrilen:                 g.BalanceUrilen,
+		BalanceUridepth:               g.BalanceUridepth,
+		BalanceUriwhole:               g.BalanceUriwhole,
+		ACLs:                          g.ACLs,
+		Actions:                       g.Actions,
+		ConnectionTimeout:             g.ConnectionTimeout,
+		ServerTimeout:                 g.ServerTimeout,
+		Retries:                       g.Retries,
+		CheckType:                     g.CheckType,
+		Checkinter:                    g.Checkinter,
+		LogHealthChecks:               g.LogHealthChecks,
+		HttpcheckMethod:               g.HttpcheckMethod,
+		MonitorURI:                    g.MonitorURI,
+		MonitorHttpversion:            g.MonitorHttpversion,
+		MonitorUsername:               g.MonitorUsername,
+		MonitorDomain:                 g.MonitorDomain,
+		AgentChecks:                   g.AgentChecks,
+		AgentPort:                     g.AgentPort,
+		AgentInter:                    g.AgentInter,
+		PersistCookieEnabled:          g.PersistCookieEnabled,
+		PersistCookieName:             g.PersistCookieName,
+		PersistCookieMode:             g.PersistCookieMode,
+		PersistCookieCachable:         g.PersistCookieCachable,
+		PersistCookiePostonly:         g.PersistCookiePostonly,
+		PersistCookieHttponly:         g.PersistCookieHttponly,
+		PersistCookieSecure:           g.PersistCookieSecure,
+		HaproxyCookieMaxidle:          g.HaproxyCookieMaxidle,
+		HaproxyCookieMaxlife:          g.HaproxyCookieMaxlife,
+		HaproxyCookieDomains:          g.HaproxyCookieDomains,
+		HaproxyCookieDynamicCookieKey: g.HaproxyCookieDynamicCookieKey,
+		PersistStickyType:             g.PersistStickyType,
+		PersistStickExpire:            g.PersistStickExpire,
+		PersistStickTablesize:         g.PersistStickTablesize,
+		PersistStickCookiename:        g.PersistStickCookiename,
+		PersistStickLength:            g.PersistStickLength,
+		EmailLevel:                    g.EmailLevel,
+		EmailTo:                       g.EmailTo,
+		StatsEnabled:                  g.StatsEnabled,
+		StatsURI:                      g.StatsURI,
+		StatsScope:                    g.StatsScope,
+		StatsRealm:                    g.StatsRealm,
+		StatsUsername:                 g.StatsUsername,
+		StatsPassword:                 g.StatsPassword,
+		StatsAdmin:                    g.StatsAdmin,
+		StatsNode:                     g.StatsNode,
+		StatsDesc:                     g.StatsDesc,
+		StatsRefresh:                  g.StatsRefresh,
+		StrictTransportSecurity:       g.StrictTransportSecurity,
+		Errorfiles:                    g.Errorfiles,
+		CookieAttributeSecure:         g.CookieAttributeSecure,
+		Advanced:                      g.Advanced,
+		AdvancedBackend:               g.AdvancedBackend,
+		TransparentClientip:           g.TransparentClientip,
+		TransparentInterface:          g.TransparentInterface,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyDNSResolver) ToPost() *PostServicesHaProxySettingsDNSResolverEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxySettingsDNSResolverEndpointRequest{}
+	}
+	return &PostServicesHaProxySettingsDNSResolverEndpointRequest{
+		Name:   h.Name,
+		Server: h.Server,
+		Port:   h.Port,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyDNSResolver) ToPatch(id int) *PatchServicesHaProxySettingsDNSResolverEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxySettingsDNSResolverEndpointRequest{ID: id}
+	}
+	return &PatchServicesHaProxySettingsDNSResolverEndpointRequest{
+		ID:     id,
+		Name:   h.Name,
+		Server: h.Server,
+		Port:   h.Port,
+	}
+}
+
+// ToModel copies g into a new HaProxyDNSResolver, sharing its slices and pointers.
+func (g *GetServicesHaProxySettingsDNSResolverEndpointResponseData) ToModel() *HaProxyDNSResolver {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyDNSResolver{
+		Name:   g.Name,
+		Server: g.Server,
+		Port:   g.Port,
+	}
+}
+
+// ToModel copies p into a new HaProxyDNSResolver, sharing its slices and pointers.
+func (p *PostServicesHaProxySettingsDNSResolverEndpointResponseData) ToModel() *HaProxyDNSResolver {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyDNSResolver{
+		Name:   p.Name,
+		Server: p.Server,
+		Port:   p.Port,
+	}
+}
+
+// ToModel copies p into a new HaProxyDNSResolver, sharing its slices and pointers.
+func (p *PatchServicesHaProxySettingsDNSResolverEndpointResponseData) ToModel() *HaProxyDNSResolver {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyDNSResolver{
+		Name:   p.Name,
+		Server: p.Server,
+		Port:   p.Port,
+	}
+}
+
+// ToModel copies g into a new HaProxyDNSResolver, sharing its slices and pointers.
+func (g *GetServicesHaProxySettingsDNSResolversEndpointResponseDataItem) ToModel() *HaProxyDNSResolver {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyDNSResolver{
+		Name:   g.Name,
+		Server: g.Server,
+		Port:   g.Port,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyEmailMailer) ToPost() *PostServicesHaProxySettingsEmailMailerEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxySettingsEmailMailerEndpointRequest{}
+	}
+	return &PostServicesHaProxySettingsEmailMailerEndpointRequest{
+		Name:           h.Name,
+		Mailserver:     h.Mailserver,
+		Mailserverport: h.Mailserverport,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyEmailMailer) ToPatch(id int) *PatchServicesHaProxySettingsEmailMailerEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxySettingsEmailMailerEndpointRequest{ID: id}
+	}
+	return &PatchServicesHaProxySettingsEmailMailerEndpointRequest{
+		ID:             id,
+		Name:           h.Name,
+		Mailserver:     h.Mailserver,
+		Mailserverport: h.Mailserverport,
+	}
+}
+
+// ToModel copies g into a new HaProxyEmailMailer, sharing its slices and pointers.
+func (g *GetServicesHaProxySettingsEmailMailerEndpointResponseData) ToModel() *HaProxyEmailMailer {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyEmailMailer{
+		Name:           g.Name,
+		Mailserver:     g.Mailserver,
+		Mailserverport: g.Mailserverport,
+	}
+}
+
+// ToModel copies p into a new HaProxyEmailMailer, sharing its slices and pointers.
+func (p *PostServicesHaProxySettingsEmailMailerEndpointResponseData) ToModel() *HaProxyEmailMailer {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyEmailMailer{
+		Name:           p.Name,
+		Mailserver:     p.Mailserver,
+		Mailserverport: p.Mailserverport,
+	}
+}
+
+// ToModel copies p into a new HaProxyEmailMailer, sharing its slices and pointers.
+func (p *PatchServicesHaProxySettingsEmailMailerEndpointResponseData) ToModel() *HaProxyEmailMailer {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyEmailMailer{
+		Name:           p.Name,
+		Mailserver:     p.Mailserver,
+		Mailserverport: p.Mailserverport,
+	}
+}
+
+// ToModel copies g into a new HaProxyEmailMailer, sharing its slices and pointers.
+func (g *GetServicesHaProxySettingsEmailMailersEndpointResponseDataItem) ToModel() *HaProxyEmailMailer {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyEmailMailer{
+		Name:           g.Name,
+		Mailserver:     g.Mailserver,
+		Mailserverport: g.Mailserverport,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyFile) ToPost() *PostServicesHaProxyFileEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyFileEndpointRequest{}
+	}
+	return &PostServicesHaProxyFileEndpointRequest{
+		Name:    h.Name,
+		Type:    h.Type,
+		Content: h.Content,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyFile) ToPatch(id int) *PatchServicesHaProxyFileEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyFileEndpointRequest{ID: id}
+	}
+	return &PatchServicesHaProxyFileEndpointRequest{
+		ID:      id,
+		Name:    h.Name,
+		Type:    h.Type,
+		Content: h.Content,
+	}
+}
+
+// ToPutItem returns h as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with h.
+func (h *HaProxyFile) ToPutItem() *PutServicesHaProxyFilesRequestItem {
+	if h == nil {
+		return nil
+	}
+	return &PutServicesHaProxyFilesRequestItem{
+		Name:    h.Name,
+		Type:    h.Type,
+		Content: h.Content,
+	}
+}
+
+// ToModel copies g into a new HaProxyFile, sharing its slices and pointers.
+func (g *GetServicesHaProxyFileEndpointResponseData) ToModel() *HaProxyFile {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFile{
+		Name:    g.Name,
+		Type:    g.Type,
+		Content: g.Content,
+	}
+}
+
+// ToModel copies p into a new HaProxyFile, sharing its slices and pointers.
+func (p *PostServicesHaProxyFileEndpointResponseData) ToModel() *HaProxyFile {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFile{
+		Name:    p.Name,
+		Type:    p.Type,
+		Content: p.Content,
+	}
+}
+
+// ToModel copies p into a new HaProxyFile, sharing its slices and pointers.
+func (p *PatchServicesHaProxyFileEndpointResponseData) ToModel() *HaProxyFile {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFile{
+		Name:    p.Name,
+		Type:    p.Type,
+		Content: p.Content,
+	}
+}
+
+// ToModel copies g into a new HaProxyFile, sharing its slices and pointers.
+func (g *GetServicesHaProxyFilesResponseDataItem) ToModel() *HaProxyFile {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFile{
+		Name:    g.Name,
+		Type:    g.Type,
+		Content: g.Content,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyFrontendACL) ToPost(parentID int) *PostServicesHaProxyFrontendACLEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyFrontendACLEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesHaProxyFrontendACLEndpointRequest{
+		ParentID:      parentID,
+		Name:          h.Name,
+		Expression:    h.Expression,
+		Value:         h.Value,
+		Casesensitive: h.Casesensitive,
+		Not:           h.Not,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyFrontendACL) ToPatch(parentID, id int) *PatchServicesHaProxyFrontendACLEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyFrontendACLEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesHaProxyFrontendACLEndpointRequest{
+		ParentID:      parentID,
+		ID:            id,
+		Name:          h.Name,
+		Expression:    h.Expression,
+		Value:         h.Value,
+		Casesensitive: h.Casesensitive,
+		Not:           h.Not,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontendACL, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendACLEndpointResponseData) ToModel() *HaProxyFrontendACL {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontendACL{
+		Name:          g.Name,
+		Expression:    g.Expression,
+		Value:         g.Value,
+		Casesensitive: g.Casesensitive,
+		Not:           g.Not,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontendACL, sharing its slices and pointers.
+func (p *PostServicesHaProxyFrontendACLEndpointResponseData) ToModel() *HaProxyFrontendACL {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontendACL{
+		Name:          p.Name,
+		Expression:    p.Expression,
+		Value:         p.Value,
+		Casesensitive: p.Casesensitive,
+		Not:           p.Not,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontendACL, sharing its slices and pointers.
+func (p *PatchServicesHaProxyFrontendACLEndpointResponseData) ToModel() *HaProxyFrontendACL {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontendACL{
+		Name:          p.Name,
+		Expression:    p.Expression,
+		Value:         p.Value,
+		Casesensitive: p.Casesensitive,
+		Not:           p.Not,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontendACL, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendAcLsEndpointResponseDataItem) ToModel() *HaProxyFrontendACL {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontendACL{
+		Name:          g.Name,
+		Expression:    g.Expression,
+		Value:         g.Value,
+		Casesensitive: g.Casesensitive,
+		Not:           g.Not,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyFrontendAction) ToPost(parentID int) *PostServicesHaProxyFrontendActionEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyFrontendActionEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesHaProxyFrontendActionEndpointRequest{
+		ParentID:     parentID,
+		Action:       h.Action,
+		ACL:          h.ACL,
+		Backend:      h.Backend,
+		Customaction: h.Customaction,
+		DenyStatus:   h.DenyStatus,
+		Realm:        h.Realm,
+		Rule:         h.Rule,
+		LuaFunction:  h.LuaFunction,
+		Name:         h.Name,
+		Fmt:          h.Fmt,
+		Find:         h.Find,
+		Replace:      h.Replace,
+		Path:         h.Path,
+		Status:       h.Status,
+		Reason:       h.Reason,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyFrontendAction) ToPatch(parentID, id int) *PatchServicesHaProxyFrontendActionEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyFrontendActionEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesHaProxyFrontendActionEndpointRequest{
+		ParentID:     parentID,
+		ID:           id,
+		Action:       h.Action,
+		ACL:          h.ACL,
+		Backend:      h.Backend,
+		Customaction: h.Customaction,
+		DenyStatus:   h.DenyStatus,
+		Realm:        h.Realm,
+		Rule:         h.Rule,
+		LuaFunction:  h.LuaFunction,
+		Name:         h.Name,
+		Fmt:          h.Fmt,
+		Find:         h.Find,
+		Replace:      h.Replace,
+		Path:         h.Path,
+		Status:       h.Status,
+		Reason:       h.Reason,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontendAction, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendActionEndpointResponseData) ToModel() *HaProxyFrontendAction {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontendAction{
+		Action:       g.Action,
+		ACL:          g.ACL,
+		Backend:      g.Backend,
+		Customaction: g.Customaction,
+		DenyStatus:   g.DenyStatus,
+		Realm:        g.Realm,
+		Rule:         g.Rule,
+		LuaFunction:  g.LuaFunction,
+		Name:         g.Name,
+		Fmt:          g.Fmt,
+		Find:         g.Find,
+		Replace:      g.Replace,
+		Path:         g.Path,
+		Status:       g.Status,
+		Reason:       g.Reason,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontendAction, sharing its slices and pointers.
+func (p *PostServicesHaProxyFrontendActionEndpointResponseData) ToModel() *HaProxyFrontendAction {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontendAction{
+		Action:       p.Action,
+		ACL:          p.ACL,
+		Backend:      p.Backend,
+		Customaction: p.Customaction,
+		DenyStatus:   p.DenyStatus,
+		Realm:        p.Realm,
+		Rule:         p.Rule,
+		LuaFunction:  p.LuaFunction,
+		Name:         p.Name,
+		Fmt:          p.Fmt,
+		Find:         p.Find,
+		Replace:      p.Replace,
+		Path:         p.Path,
+		Status:       p.Status,
+		Reason:       p.Reason,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontendAction, sharing its slices and pointers.
+func (p *PatchServicesHaProxyFrontendActionEndpointResponseData) ToModel() *HaProxyFrontendAction {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontendAction{
+		Action:       p.Action,
+		ACL:          p.ACL,
+		Backend:      p.Backend,
+		Customaction: p.Customaction,
+		DenyStatus:   p.DenyStatus,
+		Realm:        p.Realm,
+		Rule:         p.Rule,
+		LuaFunction:  p.LuaFunction,
+		Name:         p.Name,
+		Fmt:          p.Fmt,
+		Find:         p.Find,
+		Replace:      p.Replace,
+		Path:         p.Path,
+		Status:       p.Status,
+		Reason:       p.Reason,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontendAction, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendActionsEndpointResponseDataItem) ToModel() *HaProxyFrontendAction {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontendAction{
+		Action:       g.Action,
+		ACL:          g.ACL,
+		Backend:      g.Backend,
+		Customaction: g.Customaction,
+		DenyStatus:   g.DenyStatus,
+		Realm:        g.Realm,
+		Rule:         g.Rule,
+		LuaFunction:  g.LuaFunction,
+		Name:         g.Name,
+		Fmt:          g.Fmt,
+		Find:         g.Find,
+		Replace:      g.Replace,
+		Path:         g.Path,
+		Status:       g.Status,
+		Reason:       g.Reason,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyFrontendAddress) ToPost(parentID int) *PostServicesHaProxyFrontendAddressEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyFrontendAddressEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesHaProxyFrontendAddressEndpointRequest{
+		ParentID:       parentID,
+		Extaddr:        h.Extaddr,
+		ExtaddrCustom:  h.ExtaddrCustom,
+		ExtaddrPort:    h.ExtaddrPort,
+		ExtaddrSsl:     h.ExtaddrSsl,
+		ExaddrAdvanced: h.ExaddrAdvanced,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyFrontendAddress) ToPatch(parentID, id int) *PatchServicesHaProxyFrontendAddressEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyFrontendAddressEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesHaProxyFrontendAddressEndpointRequest{
+		ParentID:       parentID,
+		ID:             id,
+		Extaddr:        h.Extaddr,
+		ExtaddrCustom:  h.ExtaddrCustom,
+		ExtaddrPort:    h.ExtaddrPort,
+		ExtaddrSsl:     h.ExtaddrSsl,
+		ExaddrAdvanced: h.ExaddrAdvanced,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontendAddress, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendAddressEndpointResponseData) ToModel() *HaProxyFrontendAddress {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontendAddress{
+		Extaddr:        g.Extaddr,
+		ExtaddrCustom:  g.ExtaddrCustom,
+		ExtaddrPort:    g.ExtaddrPort,
+		ExtaddrSsl:     g.ExtaddrSsl,
+		ExaddrAdvanced: g.ExaddrAdvanced,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontendAddress, sharing its slices and pointers.
+func (p *PostServicesHaProxyFrontendAddressEndpointResponseData) ToModel() *HaProxyFrontendAddress {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontendAddress{
+		Extaddr:        p.Extaddr,
+		ExtaddrCustom:  p.ExtaddrCustom,
+		ExtaddrPort:    p.ExtaddrPort,
+		ExtaddrSsl:     p.ExtaddrSsl,
+		ExaddrAdvanced: p.ExaddrAdvanced,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontendAddress, sharing its slices and pointers.
+func (p *PatchServicesHaProxyFrontendAddressEndpointResponseData) ToModel() *HaProxyFrontendAddress {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontendAddress{
+		Extaddr:        p.Extaddr,
+		ExtaddrCustom:  p.ExtaddrCustom,
+		ExtaddrPort:    p.ExtaddrPort,
+		ExtaddrSsl:     p.ExtaddrSsl,
+		ExaddrAdvanced: p.ExaddrAdvanced,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontendAddress, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendAddressesEndpointResponseDataItem) ToModel() *HaProxyFrontendAddress {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontendAddress{
+		Extaddr:        g.Extaddr,
+		ExtaddrCustom:  g.ExtaddrCustom,
+		ExtaddrPort:    g.ExtaddrPort,
+		ExtaddrSsl:     g.ExtaddrSsl,
+		ExaddrAdvanced: g.ExaddrAdvanced,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyFrontendCertificate) ToPost(parentID int) *PostServicesHaProxyFrontendCertificateEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyFrontendCertificateEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesHaProxyFrontendCertificateEndpointRequest{
+		ParentID:       parentID,
+		SslCertificate: h.SslCertificate,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyFrontendCertificate) ToPatch(parentID, id int) *PatchServicesHaProxyFrontendCertificateEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyFrontendCertificateEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesHaProxyFrontendCertificateEndpointRequest{
+		ParentID:       parentID,
+		ID:             id,
+		SslCertificate: h.SslCertificate,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontendCertificate, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendCertificateEndpointResponseData) ToModel() *HaProxyFrontendCertificate {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontendCertificate{
+		SslCertificate: g.SslCertificate,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontendCertificate, sharing its slices and pointers.
+func (p *PostServicesHaProxyFrontendCertificateEndpointResponseData) ToModel() *HaProxyFrontendCertificate {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontendCertificate{
+		SslCertificate: p.SslCertificate,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontendCertificate, sharing its slices and pointers.
+func (p *PatchServicesHaProxyFrontendCertificateEndpointResponseData) ToModel() *HaProxyFrontendCertificate {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontendCertificate{
+		SslCertificate: p.SslCertificate,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontendCertificate, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendCertificatesEndpointResponseDataItem) ToModel() *HaProxyFrontendCertificate {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontendCertificate{
+		SslCertificate: g.SslCertificate,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyFrontendErrorFile) ToPost(parentID int) *PostServicesHaProxyFrontendErrorFileEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyFrontendErrorFileEndpointRequest{ParentID: parentID}
+	}
+	return &PostServicesHaProxyFrontendErrorFileEndpointRequest{
+		ParentID:  parentID,
+		Errorcode: h.Errorcode,
+		Errorfile: h.Errorfile,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyFrontendErrorFile) ToPatch(parentID, id int) *PatchServicesHaProxyFrontendErrorFileEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyFrontendErrorFileEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchServicesHaProxyFrontendErrorFileEndpointRequest{
+		ParentID:  parentID,
+		ID:        id,
+		Errorcode: h.Errorcode,
+		Errorfile: h.Errorfile,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontendErrorFile, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendErrorFileEndpointResponseData) ToModel() *HaProxyFrontendErrorFile {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontendErrorFile{
+		Errorcode: g.Errorcode,
+		Errorfile: g.Errorfile,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontendErrorFile, sharing its slices and pointers.
+func (p *PostServicesHaProxyFrontendErrorFileEndpointResponseData) ToModel() *HaProxyFrontendErrorFile {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontendErrorFile{
+		Errorcode: p.Errorcode,
+		Errorfile: p.Errorfile,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontendErrorFile, sharing its slices and pointers.
+func (p *PatchServicesHaProxyFrontendErrorFileEndpointResponseData) ToModel() *HaProxyFrontendErrorFile {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontendErrorFile{
+		Errorcode: p.Errorcode,
+		Errorfile: p.Errorfile,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontendErrorFile, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendErrorFilesEndpointResponseDataItem) ToModel() *HaProxyFrontendErrorFile {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontendErrorFile{
+		Errorcode: g.Errorcode,
+		Errorfile: g.Errorfile,
+	}
+}
+
+// ToPost returns a request that creates a copy of h. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with h.
+func (h *HaProxyFrontend) ToPost() *PostServicesHaProxyFrontendEndpointRequest {
+	if h == nil {
+		return &PostServicesHaProxyFrontendEndpointRequest{}
+	}
+	return &PostServicesHaProxyFrontendEndpointRequest{
+		Name:              h.Name,
+		Descr:             h.Descr,
+		Status:            h.Status,
+		AExtaddr:          h.AExtaddr,
+		MaxConnections:    h.MaxConnections,
+		Type:              h.Type,
+		HaACLs:            h.HaACLs,
+		AActionitems:      h.AActionitems,
+		BackendServerpool: h.BackendServerpool,
+		SocketStats:       h.SocketStats,
+		Dontlognull:       h.Dontlognull,
+		DontlogNormal:     h.DontlogNormal,
+		LogSeparateErrors: h.LogSeparateErrors,
+		LogDetailed:       h.LogDetailed,
+		AErrorfiles:       h.AErrorfiles,
+		ClientTimeout:     h.ClientTimeout,
+		Forwardfor:        h.Forwardfor,
+		Httpclose:         h.Httpclose,
+		AdvancedBind:      h.AdvancedBind,
+		Advanced:          h.Advanced,
+		Ssloffloadcert:    h.Ssloffloadcert,
+		HaCertificates:    h.HaCertificates,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with h. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with h.
+func (h *HaProxyFrontend) ToPatch(id int) *PatchServicesHaProxyFrontendEndpointRequest {
+	if h == nil {
+		return &PatchServicesHaProxyFrontendEndpointRequest{ID: id}
+	}
+	return &PatchServicesHaProxyFrontendEndpointRequest{
+		ID:                id,
+		Name:              h.Name,
+		Descr:             h.Descr,
+		Status:            h.Status,
+		AExtaddr:          h.AExtaddr,
+		MaxConnections:    h.MaxConnections,
+		Type:              h.Type,
+		HaACLs:            h.HaACLs,
+		AActionitems:      h.AActionitems,
+		BackendServerpool: h.BackendServerpool,
+		SocketStats:       h.SocketStats,
+		Dontlognull:       h.Dontlognull,
+		DontlogNormal:     h.DontlogNormal,
+		LogSeparateErrors: h.LogSeparateErrors,
+		LogDetailed:       h.LogDetailed,
+		AErrorfiles:       h.AErrorfiles,
+		ClientTimeout:     h.ClientTimeout,
+		Forwardfor:        h.Forwardfor,
+		Httpclose:         h.Httpclose,
+		AdvancedBind:      h.AdvancedBind,
+		Advanced:          h.Advanced,
+		Ssloffloadcert:    h.Ssloffloadcert,
+		HaCertificates:    h.HaCertificates,
+	}
+}
+
+// ToPutItem returns h as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with h.
+func (h *HaProxyFrontend) ToPutItem() *PutServicesHaProxyFrontendsEndpointRequestItem {
+	if h == nil {
+		return nil
+	}
+	return &PutServicesHaProxyFrontendsEndpointRequestItem{
+		Name:              h.Name,
+		Descr:             h.Descr,
+		Status:            h.Status,
+		AExtaddr:          h.AExtaddr,
+		MaxConnections:    h.MaxConnections,
+		Type:              h.Type,
+		HaACLs:            h.HaACLs,
+		AActionitems:      h.AActionitems,
+		BackendServerpool: h.BackendServerpool,
+		SocketStats:       h.SocketStats,
+		Dontlognull:       h.Dontlognull,
+		DontlogNormal:     h.DontlogNormal,
+		LogSeparateErrors: h.LogSeparateErrors,
+		LogDetailed:       h.LogDetailed,
+		AErrorfiles:       h.AErrorfiles,
+		ClientTimeout:     h.ClientTimeout,
+		Forwardfor:        h.Forwardfor,
+		Httpclose:         h.Httpclose,
+		AdvancedBind:      h.AdvancedBind,
+		Advanced:          h.Advanced,
+		Ssloffloadcert:    h.Ssloffloadcert,
+		HaCertificates:    h.HaCertificates,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontend, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendEndpointResponseData) ToModel() *HaProxyFrontend {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontend{
+		Name:              g.Name,
+		Descr:             g.Descr,
+		Status:            g.Status,
+		AExtaddr:          g.AExtaddr,
+		MaxConnections:    g.MaxConnections,
+		Type:              g.Type,
+		HaACLs:            g.HaACLs,
+		AActionitems:      g.AActionitems,
+		BackendServerpool: g.BackendServerpool,
+		SocketStats:       g.SocketStats,
+		Dontlognull:       g.Dontlognull,
+		DontlogNormal:     g.DontlogNormal,
+		LogSeparateErrors: g.LogSeparateErrors,
+		LogDetailed:       g.LogDetailed,
+		AErrorfiles:       g.AErrorfiles,
+		ClientTimeout:     g.ClientTimeout,
+		Forwardfor:        g.Forwardfor,
+		Httpclose:         g.Httpclose,
+		AdvancedBind:      g.AdvancedBind,
+		Advanced:          g.Advanced,
+		Ssloffloadcert:    g.Ssloffloadcert,
+		HaCertificates:    g.HaCertificates,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontend, sharing its slices and pointers.
+func (p *PostServicesHaProxyFrontendEndpointResponseData) ToModel() *HaProxyFrontend {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontend{
+		Name:              p.Name,
+		Descr:             p.Descr,
+		Status:            p.Status,
+		AExtaddr:          p.AExtaddr,
+		MaxConnections:    p.MaxConnections,
+		Type:              p.Type,
+		HaACLs:            p.HaACLs,
+		AActionitems:      p.AActionitems,
+		BackendServerpool: p.BackendServerpool,
+		SocketStats:       p.SocketStats,
+		Dontlognull:       p.Dontlognull,
+		DontlogNormal:     p.DontlogNormal,
+		LogSeparateErrors: p.LogSeparateErrors,
+		LogDetailed:       p.LogDetailed,
+		AErrorfiles:       p.AErrorfiles,
+		ClientTimeout:     p.ClientTimeout,
+		Forwardfor:        p.Forwardfor,
+		Httpclose:         p.Httpclose,
+		AdvancedBind:      p.AdvancedBind,
+		Advanced:          p.Advanced,
+		Ssloffloadcert:    p.Ssloffloadcert,
+		HaCertificates:    p.HaCertificates,
+	}
+}
+
+// ToModel copies p into a new HaProxyFrontend, sharing its slices and pointers.
+func (p *PatchServicesHaProxyFrontendEndpointResponseData) ToModel() *HaProxyFrontend {
+	if p == nil {
+		return nil
+	}
+	return &HaProxyFrontend{
+		Name:              p.Name,
+		Descr:             p.Descr,
+		Status:            p.Status,
+		AExtaddr:          p.AExtaddr,
+		MaxConnections:    p.MaxConnections,
+		Type:              p.Type,
+		HaACLs:            p.HaACLs,
+		AActionitems:      p.AActionitems,
+		BackendServerpool: p.BackendServerpool,
+		SocketStats:       p.SocketStats,
+		Dontlognull:       p.Dontlognull,
+		DontlogNormal:     p.DontlogNormal,
+		LogSeparateErrors: p.LogSeparateErrors,
+		LogDetailed:       p.LogDetailed,
+		AErrorfiles:       p.AErrorfiles,
+		ClientTimeout:     p.ClientTimeout,
+		Forwardfor:        p.Forwardfor,
+		Httpclose:         p.Httpclose,
+		AdvancedBind:      p.AdvancedBind,
+		Advanced:          p.Advanced,
+		Ssloffloadcert:    p.Ssloffloadcert,
+		HaCertificates:    p.HaCertificates,
+	}
+}
+
+// ToModel copies g into a new HaProxyFrontend, sharing its slices and pointers.
+func (g *GetServicesHaProxyFrontendsEndpointResponseDataItem) ToModel() *HaProxyFrontend {
+	if g == nil {
+		return nil
+	}
+	return &HaProxyFrontend{
+		Name:              g.Name,
+		Descr:             g.Descr,
+		Status:            g.Status,
+		AExtaddr:          g.AExtaddr,
+		MaxConnections:    g.MaxConnections,
+		Type:              g.Type,
+		HaACLs:            g.HaACLs,
+		AActionitems:      g.AActionitems,
+		BackendServerpool: g.BackendServerpool,
+		SocketStats:       g.SocketStats,
+		Dontlognull:       g.Dontlognull,
+		DontlogNormal:     g.DontlogNormal,
+		LogSeparateErrors: g.LogSeparateErrors,
+		LogDetailed:       g.LogDetailed,
+		AErrorfiles:       g.AErrorfiles,
+		ClientTimeout:     g.ClientTimeout,
+		Forwardfor:        g.Forwardfor,
+		Httpclose:         g.Httpclose,
+		AdvancedBind:      g.AdvancedBind,
+		Advanced:          g.Advanced,
+		Ssloffloadcert:    g.Ssloffloadcert,
+		HaCertificates:    g.HaCertificates,
+	}
+}
+
+// ToPost returns a request that creates a copy of i. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with i.
+func (i *IPsecPhase1Encryption) ToPost(parentID int) *PostVpniPsecPhase1EncryptionEndpointRequest {
+	if i == nil {
+		return &PostVpniPsecPhase1EncryptionEndpointRequest{ParentID: parentID}
+	}
+	return &PostVpniPsecPhase1EncryptionEndpointRequest{
+		ParentID:                  parentID,
+		EncryptionAlgorithmName:   i.EncryptionAlgorithmName,
+		EncryptionAlgorithmKeylen: i.EncryptionAlgorithmKeylen,
+		HashAlgorithm:             i.HashAlgorithm,
+		Dhgroup:                   i.Dhgroup,
+		PrfAlgorithm:              i.PrfAlgorithm,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with i. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with i.
+func (i *IPsecPhase1Encryption) ToPatch(parentID, id int) *PatchVpniPsecPhase1EncryptionEndpointRequest {
+	if i == nil {
+		return &PatchVpniPsecPhase1EncryptionEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchVpniPsecPhase1EncryptionEndpointRequest{
+		ParentID:                  parentID,
+		ID:                        id,
+		EncryptionAlgorithmName:   i.EncryptionAlgorithmName,
+		EncryptionAlgorithmKeylen: i.EncryptionAlgorithmKeylen,
+		HashAlgorithm:             i.HashAlgorithm,
+		Dhgroup:                   i.Dhgroup,
+		PrfAlgorithm:              i.PrfAlgorithm,
+	}
+}
+
+// ToModel copies g into a new IPsecPhase1Encryption, sharing its slices and pointers.
+func (g *GetVpniPsecPhase1EncryptionEndpointResponseData) ToModel() *IPsecPhase1Encryption {
+	if g == nil {
+		return nil
+	}
+	return &IPsecPhase1Encryption{
+		EncryptionAlgorithmName:   g.EncryptionAlgorithmName,
+		EncryptionAlgorithmKeylen: g.EncryptionAlgorithmKeylen,
+		HashAlgorithm:             g.HashAlgorithm,
+		Dhgroup:                   g.Dhgroup,
+		PrfAlgorithm:              g.PrfAlgorithm,
+	}
+}
+
+// ToModel copies p into a new IPsecPhase1Encryption, sharing its slices and pointers.
+func (p *PostVpniPsecPhase1EncryptionEndpointResponseData) ToModel() *IPsecPhase1Encryption {
+	if p == nil {
+		return nil
+	}
+	return &IPsecPhase1Encryption{
+		EncryptionAlgorithmName:   p.EncryptionAlgorithmName,
+		EncryptionAlgorithmKeylen: p.EncryptionAlgorithmKeylen,
+		HashAlgorithm:             p.HashAlgorithm,
+		Dhgroup:                   p.Dhgroup,
+		PrfAlgorithm:              p.PrfAlgorithm,
+	}
+}
+
+// ToModel copies p into a new IPsecPhase1Encryption, sharing its slices and pointers.
+func (p *PatchVpniPsecPhase1EncryptionEndpointResponseData) ToModel() *IPsecPhase1Encryption {
+	if p == nil {
+		return nil
+	}
+	return &IPsecPhase1Encryption{
+		EncryptionAlgorithmName:   p.EncryptionAlgorithmName,
+		EncryptionAlgorithmKeylen: p.EncryptionAlgorithmKeylen,
+		HashAlgorithm:             p.HashAlgorithm,
+		Dhgroup:                   p.Dhgroup,
+		PrfAlgorithm:              p.PrfAlgorithm,
+	}
+}
+
+// ToModel copies g into a new IPsecPhase1Encryption, sharing its slices and pointers.
+func (g *GetVpniPsecPhase1EncryptionsEndpointResponseDataItem) ToModel() *IPsecPhase1Encryption {
+	if g == nil {
+		return nil
+	}
+	return &IPsecPhase1Encryption{
+		EncryptionAlgorithmName:   g.EncryptionAlgorithmName,
+		EncryptionAlgorithmKeylen: g.EncryptionAlgorithmKeylen,
+		HashAlgorithm:             g.HashAlgorithm,
+		Dhgroup:                   g.Dhgroup,
+		PrfAlgorithm:              g.PrfAlgorithm,
+	}
+}
+
+// ToPost returns a request that creates a copy of i. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with i.
+func (i *IPsecPhase1) ToPost() *PostVpniPsecPhase1EndpointRequest {
+	if i == nil {
+		return &PostVpniPsecPhase1EndpointRequest{}
+	}
+	return &PostVpniPsecPhase1EndpointRequest{
+		Ikeid:                i.Ikeid,
+		Descr:                i.Descr,
+		Disabled:             i.Disabled,
+		Iketype:              i.Iketype,
+		Mode:                 i.Mode,
+		Protocol:             i.Protocol,
+		Interface:            i.Interface,
+		RemoteGateway:        i.RemoteGateway,
+		AuthenticationMethod: i.AuthenticationMethod,
+		MyidType:             i.MyidType,
+		MyidData:             i.MyidData,
+		PeeridType:           i.PeeridType,
+		PeeridData:           i.PeeridData,
+		PreSharedKey:         i.PreSharedKey,
+		Certref:              i.Certref,
+		Caref:                i.Caref,
+		RekeyTime:            i.RekeyTime,
+		ReauthTime:           i.ReauthTime,
+		RandTime:             i.RandTime,
+		Lifetime:             i.Lifetime,
+		Startaction:          i.Startaction,
+		Closeaction:          i.Closeaction,
+		NatTraversal:         i.NatTraversal,
+		GwDuplicates:         i.GwDuplicates,
+		Mobike:               i.Mobike,
+		Splitconn:            i.Splitconn,
+		PrfselectEnable:      i.PrfselectEnable,
+		Ikeport:              i.Ikeport,
+		Nattport:             i.Nattport,
+		DpdDelay:             i.DpdDelay,
+		DpdMaxfail:           i.DpdMaxfail,
+		Encryption:           i.Encryption,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with i. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with i.
+func (i *IPsecPhase1) ToPatch(id int) *PatchVpniPsecPhase1EndpointRequest {
+	if i == nil {
+		return &PatchVpniPsecPhase1EndpointRequest{ID: id}
+	}
+	return &PatchVpniPsecPhase1EndpointRequest{
+		ID:                   id,
+		Ikeid:                i.Ikeid,
+		Descr:                i.Descr,
+		Disabled:             i.Disabled,
+		Iketype:              i.Iketype,
+		Mode:                 i.Mode,
+		Protocol:             i.Protocol,
+		Interface:            i.Interface,
+		RemoteGateway:        i.RemoteGateway,
+		AuthenticationMethod: i.AuthenticationMethod,
+		MyidType:             i.MyidType,
+		MyidData:             i.MyidData,
+		PeeridType:           i.PeeridType,
+		PeeridData:           i.PeeridData,
+		PreSharedKey:         i.PreSharedKey,
+		Certref:              i.Certref,
+		Caref:                i.Caref,
+		RekeyTime:            i.RekeyTime,
+		ReauthTime:           i.ReauthTime,
+		RandTime:             i.RandTime,
+		Lifetime:             i.Lifetime,
+		Startaction:          i.Startaction,
+		Closeaction:          i.Closeaction,
+		NatTraversal:         i.NatTraversal,
+		GwDuplicates:         i.GwDuplicates,
+		Mobike:               i.Mobike,
+		Splitconn:            i.Splitconn,
+		PrfselectEnable:      i.PrfselectEnable,
+		Ikeport:              i.Ikeport,
+		Nattport:             i.Nattport,
+		DpdDelay:             i.DpdDelay,
+		DpdMaxfail:           i.DpdMaxfail,
+		Encryption:           i.Encryption,
+	}
+}
+
+// ToPutItem returns i as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with i.
+func (i *IPsecPhase1) ToPutItem() *PutVpniPsecPhase1SEndpointRequestItem {
+	if i == nil {
+		return nil
+	}
+	return &PutVpniPsecPhase1SEndpointRequestItem{
+		Ikeid:                i.Ikeid,
+		Descr:                i.Descr,
+		Disabled:             i.Disabled,
+		Iketype:              i.Iketype,
+		Mode:                 i.Mode,
+		Protocol:             i.Protocol,
+		Interface:            i.Interface,
+		RemoteGateway:        i.RemoteGateway,
+		AuthenticationMethod: i.AuthenticationMethod,
+		MyidType:             i.MyidType,
+		MyidData:             i.MyidData,
+		PeeridType:           i.PeeridType,
+		PeeridData:           i.PeeridData,
+		PreSharedKey:         i.PreSharedKey,
+		Certref:              i.Certref,
+		Caref:                i.Caref,
+		RekeyTime:            i.RekeyTime,
+		ReauthTime:           i.ReauthTime,
+		RandTime:             i.RandTime,
+		Lifetime:             i.Lifetime,
+		Startaction:          i.Startaction,
+		Closeaction:          i.Closeaction,
+		NatTraversal:         i.NatTraversal,
+		GwDuplicates:         i.GwDuplicates,
+		Mobike:               i.Mobike,
+		Splitconn:            i.Splitconn,
+		PrfselectEnable:      i.PrfselectEnable,
+		Ikeport:              i.Ikeport,
+		Nattport:             i.Nattport,
+		DpdDelay:             i.DpdDelay,
+		DpdMaxfail:           i.DpdMaxfail,
+		Encryption:           i.Encryption,
+	}
+}
+
+// ToModel copies g into a new IPsecPhase1, sharing its slices and pointers.
+func (g *GetVpniPsecPhase1EndpointResponseData) ToModel() *IPsecPhase1 {
+	if g == nil {
+		return nil
+	}
+	return &IPsecPhase1{
+		Ikeid:                g.Ikeid,
+		Descr:                g.Descr,
+		Disabled:             g.Disabled,
+		Iketype:              g.Iketype,
+		Mode:                 g.Mode,
+		Protocol:             g.Protocol,
+		Interface:            g.Interface,
+		RemoteGateway:        g.RemoteGateway,
+		AuthenticationMethod: g.AuthenticationMethod,
+		MyidType:             g.MyidType,
+		MyidData:             g.MyidData,
+		PeeridType:           g.PeeridType,
+		PeeridData:           g.PeeridData,
+		PreSharedKey:         g.PreSharedKey,
+		Certref:              g.Certref,
+		Caref:                g.Caref,
+		RekeyTime:            g.RekeyTime,
+		ReauthTime:           g.ReauthTime,
+		RandTime:             g.RandTime,
+		Lifetime:             g.Lifetime,
+		Startaction:          g.Startaction,
+		Closeaction:          g.Closeaction,
+		NatTraversal:         g.NatTraversal,
+		GwDuplicates:         g.GwDuplicates,
+		Mobike:               g.Mobike,
+		Splitconn:            g.Splitconn,
+		PrfselectEnable:      g.PrfselectEnable,
+		Ikeport:              g.Ikeport,
+		Nattport:             g.Nattport,
+		DpdDelay:             g.DpdDelay,
+		DpdMaxfail:           g.DpdMaxfail,
+		Encryption:           g.Encryption,
+	}
+}
+
+// ToModel copies p into a new IPsecPhase1, sharing its slices and pointers.
+func (p *PostVpniPsecPhase1EndpointResponseData) ToModel() *IPsecPhase1 {
+	if p == nil {
+		return nil
+	}
+	return &IPsecPhase1{
+		Ikeid:                p.Ikeid,
+		Descr:                p.Descr,
+		Disabled:             p.Disabled,
+		Iketype:              p.Iketype,
+		Mode:                 p.Mode,
+		Protocol:             p.Protocol,
+		Interface:            p.Interface,
+		RemoteGateway:        p.RemoteGateway,
+		AuthenticationMethod: p.AuthenticationMethod,
+		MyidType:             p.MyidType,
+		MyidData:             p.MyidData,
+		PeeridType:           p.PeeridType,
+		PeeridData:           p.PeeridData,
+		PreSharedKey:         p.PreSharedKey,
+		Certref:              p.Certref,
+		Caref:                p.Caref,
+		RekeyTime:            p.RekeyTime,
+		ReauthTime:           p.ReauthTime,
+		RandTime:             p.RandTime,
+		Lifetime:             p.Lifetime,
+		Startaction:          p.Startaction,
+		Closeaction:          p.Closeaction,
+		NatTraversal:         p.NatTraversal,
+		GwDuplicates:         p.GwDuplicates,
+		Mobike:               p.Mobike,
+		Splitconn:            p.Splitconn,
+		PrfselectEnable:      p.PrfselectEnable,
+		Ikeport:              p.Ikeport,
+		Nattport:             p.Nattport,
+		DpdDelay:             p.DpdDelay,
+		DpdMaxfail:           p.DpdMaxfail,
+		Encryption:           p.Encryption,
+	}
+}
+
+// ToModel copies p into a new IPsecPhase1, sharing its slices and pointers.
+func (p *PatchVpniPsecPhase1EndpointResponseData) ToModel() *IPsecPhase1 {
+	if p == nil {
+		return nil
+	}
+	return &IPsecPhase1{
+		Ikeid:                p.Ikeid,
+		Descr:                p.Descr,
+		Disabled:             p.Disabled,
+		Iketype:              p.Iketype,
+		Mode:                 p.Mode,
+		Protocol:             p.Protocol,
+		Interface:            p.Interface,
+		RemoteGateway:        p.RemoteGateway,
+		AuthenticationMethod: p.AuthenticationMethod,
+		MyidType:             p.MyidType,
+		MyidData:             p.MyidData,
+		PeeridType:           p.PeeridType,
+		PeeridData:           p.PeeridData,
+		PreSharedKey:         p.PreSharedKey,
+		Certref:              p.Certref,
+		Caref:                p.Caref,
+		RekeyTime:            p.RekeyTime,
+		ReauthTime:           p.ReauthTime,
+		RandTime:             p.RandTime,
+		Lifetime:             p.Lifetime,
+		Startaction:          p.Startaction,
+		Closeaction:          p.Closeaction,
+		NatTraversal:         p.NatTraversal,
+		GwDuplicates:         p.GwDuplicates,
+		Mobike:               p.Mobike,
+		Splitconn:            p.Splitconn,
+		PrfselectEnable:      p.PrfselectEnable,
+		Ikeport:              p.Ikeport,
+		Nattport:             p.Nattport,
+		DpdDelay:             p.DpdDelay,
+		DpdMaxfail:           p.DpdMaxfail,
+		Encryption:           p.Encryption,
+	}
+}
+
+// ToModel copies g into a new IPsecPhase1, sharing its slices and pointers.
+func (g *GetVpniPsecPhase1SEndpointResponseDataItem) ToModel() *IPsecPhase1 {
+	if g == nil {
+		return nil
+	}
+	return &IPsecPhase1{
+		Ikeid:                g.Ikeid,
+		Descr:                g.Descr,
+		Disabled:             g.Disabled,
+		Iketype:              g.Iketype,
+		Mode:                 g.Mode,
+		Protocol:             g.Protocol,
+		Interface:            g.Interface,
+		RemoteGateway:        g.RemoteGateway,
+		AuthenticationMethod: g.AuthenticationMethod,
+		MyidType:             g.MyidType,
+		MyidData:             g.MyidData,
+		PeeridType:           g.PeeridType,
+		PeeridData:           g.PeeridData,
+		PreSharedKey:         g.PreSharedKey,
+		Certref:              g.Certref,
+		Caref:                g.Caref,
+		RekeyTime:            g.RekeyTime,
+		ReauthTime:           g.ReauthTime,
+		RandTime:             g.RandTime,
+		Lifetime:             g.Lifetime,
+		Startaction:          g.Startaction,
+		Closeaction:          g.Closeaction,
+		NatTraversal:         g.NatTraversal,
+		GwDuplicates:         g.GwDuplicates,
+		Mobike:               g.Mobike,
+		Splitconn:            g.Splitconn,
+		PrfselectEnable:      g.PrfselectEnable,
+		Ikeport:              g.Ikeport,
+		Nattport:             g.Nattport,
+		DpdDelay:             g.DpdDelay,
+		DpdMaxfail:           g.DpdMaxfail,
+		Encryption:           g.Encryption,
+	}
+}
+
+// ToPost returns a request that creates a copy of i. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with i.
+func (i *IPsecPhase2Encryption) ToPost(parentID int) *PostVpniPsecPhase2EncryptionEndpointRequest {
+	if i == nil {
+		return &PostVpniPsecPhase2EncryptionEndpointRequest{ParentID: parentID}
+	}
+	return &PostVpniPsecPhase2EncryptionEndpointRequest{
+		ParentID: parentID,
+		Name:     i.Name,
+		Keylen:   i.Keylen,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with i. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with i.
+func (i *IPsecPhase2Encryption) ToPatch(parentID, id int) *PatchVpniPsecPhase2EncryptionEndpointRequest {
+	if i == nil {
+		return &PatchVpniPsecPhase2EncryptionEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchVpniPsecPhase2EncryptionEndpointRequest{
+		ParentID: parentID,
+		ID:       id,
+		Name:     i.Name,
+		Keylen:   i.Keylen,
+	}
+}
+
+// ToModel copies g into a new IPsecPhase2Encryption, sharing its slices and pointers.
+func (g *GetVpniPsecPhase2EncryptionEndpointResponseData) ToModel() *IPsecPhase2Encryption {
+	if g == nil {
+		return nil
+	}
+	return &IPsecPhase2Encryption{
+		Name:   g.Name,
+		Keylen: g.Keylen,
+	}
+}
+
+// ToModel copies p into a new IPsecPhase2Encryption, sharing its slices and pointers.
+func (p *PostVpniPsecPhase2EncryptionEndpointResponseData) ToModel() *IPsecPhase2Encryption {
+	if p == nil {
+		return nil
+	}
+	return &IPsecPhase2Encryption{
+		Name:   p.Name,
+		Keylen: p.Keylen,
+	}
+}
+
+// ToModel copies p into a new IPsecPhase2Encryption, sharing its slices and pointers.
+func (p *PatchVpniPsecPhase2EncryptionEndpointResponseData) ToModel() *IPsecPhase2Encryption {
+	if p == nil {
+		return nil
+	}
+	return &IPsecPhase2Encryption{
+		Name:   p.Name,
+		Keylen: p.Keylen,
+	}
+}
+
+// ToModel copies g into a new IPsecPhase2Encryption, sharing its slices and pointers.
+func (g *GetVpniPsecPhase2EncryptionsEndpointResponseDataItem) ToModel() *IPsecPhase2Encryption {
+	if g == nil {
+		return nil
+	}
+	return &IPsecPhase2Encryption{
+		Name:   g.Name,
+		Keylen: g.Keylen,
+	}
+}
+
+// ToPost returns a request that creates a copy of i. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with i.
+func (i *IPsecPhase2) ToPost() *PostVpniPsecPhase2EndpointRequest {
+	if i == nil {
+		return &PostVpniPsecPhase2EndpointRequest{}
+	}
+	return &PostVpniPsecPhase2EndpointRequest{
+		Uniqid:                    i.Uniqid,
+		Reqid:                     i.Reqid,
+		Ikeid:                     i.Ikeid,
+		Descr:                     i.Descr,
+		Disabled:                  i.Disabled,
+		Mode:                      i.Mode,
+		LocalidType:               i.LocalidType,
+		LocalidAddress:            i.LocalidAddress,
+		LocalidNetbits:            i.LocalidNetbits,
+		NatlocalidType:            i.NatlocalidType,
+		NatlocalidAddress:         i.NatlocalidAddress,
+		NatlocalidNetbits:         i.NatlocalidNetbits,
+		RemoteidType:              i.RemoteidType,
+		RemoteidAddress:           i.RemoteidAddress,
+		RemoteidNetbits:           i.RemoteidNetbits,
+		Protocol:                  i.Protocol,
+		EncryptionAlgorithmOption: i.EncryptionAlgorithmOption,
+		HashAlgorithmOption:       i.HashAlgorithmOption,
+		Pfsgroup:                  i.Pfsgroup,
+		RekeyTime:                 i.RekeyTime,
+		RandTime:                  i.RandTime,
+		Lifetime:                  i.Lifetime,
+		Pinghost:                  i.Pinghost,
+		Keepalive:                 i.Keepalive,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with i. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with i.
+func (i *IPsecPhase2) ToPatch(id int) *PatchVpniPsecPhase2EndpointRequest {
+	if i == nil {
+		return &PatchVpniPsecPhase2EndpointRequest{ID: id}
+	}
+	return &PatchVpniPsecPhase2EndpointRequest{
+		ID:                        id,
+		Uniqid:                    i.Uniqid,
+		Reqid:                     i.Reqid,
+		Ikeid:                     i.Ikeid,
+		Descr:                     i.Descr,
+		Disabled:                  i.Disabled,
+		Mode:                      i.Mode,
+		LocalidType:               i.LocalidType,
+		LocalidAddress:            i.LocalidAddress,
+		LocalidNetbits:            i.LocalidNetbits,
+		NatlocalidType:            i.NatlocalidType,
+		NatlocalidAddress:         i.NatlocalidAddress,
+		NatlocalidNetbits:         i.NatlocalidNetbits,
+		RemoteidType:              i.RemoteidType,
+		RemoteidAddress:           i.RemoteidAddress,
+		RemoteidNetbits:           i.RemoteidNetbits,
+		Protocol:                  i.Protocol,
+		EncryptionAlgorithmOption: i.EncryptionAlgorithmOption,
+		HashAlgorithmOption:       i.HashAlgorithmOption,
+		Pfsgroup:                  i.Pfsgroup,
+		RekeyTime:                 i.RekeyTime,
+		RandTime:                  i.RandTime,
+		Lifetime:                  i.Lifetime,
+		Pinghost:                  i.Pinghost,
+		Keepalive:                 i.Keepalive,
+	}
+}
+
+// ToPutItem returns i as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with i.
+func (i *IPsecPhase2) ToPutItem() *PutVpniPsecPhase2SEndpointRequestItem {
+	if i == nil {
+		return nil
+	}
+	return &PutVpniPsecPhase2SEndpointRequestItem{
+		Uniqid:                    i.Uniqid,
+		Reqid:                     i.Reqid,
+		Ikeid:                     i.Ikeid,
+		Descr:                     i.Descr,
+		Disabled:                  i.Disabled,
+		Mode:                      i.Mode,
+		LocalidType:               i.LocalidType,
+		LocalidAddress:            i.LocalidAddress,
+		LocalidNetbits:            i.LocalidNetbits,
+		NatlocalidType:            i.NatlocalidType,
+		NatlocalidAddress:         i.NatlocalidAddress,
+		NatlocalidNetbits:         i.NatlocalidNetbits,
+		RemoteidType:              i.RemoteidType,
+		RemoteidAddress:           i.RemoteidAddress,
+		RemoteidNetbits:           i.RemoteidNetbits,
+		Protocol:                  i.Protocol,
+		EncryptionAlgorithmOption: i.EncryptionAlgorithmOption,
+		HashAlgorithmOption:       i.HashAlgorithmOption,
+		Pfsgroup:                  i.Pfsgroup,
+		RekeyTime:                 i.RekeyTime,
+		RandTime:                  i.RandTime,
+		Lifetime:                  i.Lifetime,
+		Pinghost:                  i.Pinghost,
+		Keepalive:                 i.Keepalive,
+	}
+}
+
+// ToModel copies g into a new IPsecPhase2, sharing its slices and pointers.
+func (g *GetVpniPsecPhase2EndpointResponseData) ToModel() *IPsecPhase2 {
+	if g == nil {
+		return nil
+	}
+	return &IPsecPhase2{
+		Uniqid:                    g.Uniqid,
+		Reqid:                     g.Reqid,
+		Ikeid:                     g.Ikeid,
+		Descr:                     g.Descr,
+		Disabled:                  g.Disabled,
+		Mode:                      g.Mode,
+		LocalidType:               g.LocalidType,
+		LocalidAddress:            g.LocalidAddress,
+		LocalidNetbits:            g.LocalidNetbits,
+		NatlocalidType:            g.NatlocalidType,
+		NatlocalidAddress:         g.NatlocalidAddress,
+		NatlocalidNetbits:         g.NatlocalidNetbits,
+		RemoteidType:              g.RemoteidType,
+		RemoteidAddress:           g.RemoteidAddress,
+		RemoteidNetbits:           g.RemoteidNetbits,
+		Protocol:                  g.Protocol,
+		EncryptionAlgorithmOption: g.EncryptionAlgorithmOption,
+		HashAlgorithmOption:       g.HashAlgorithmOption,
+		Pfsgroup:                  g.Pfsgroup,
+		RekeyTime:                 g.RekeyTime,
+		RandTime:                  g.RandTime,
+		Lifetime:                  g.Lifetime,
+		Pinghost:                  g.Pinghost,
+		Keepalive:                 g.Keepalive,
+	}
+}
+
+// ToModel copies p into a new IPsecPhase2, sharing its slices and pointers.
+func (p *PostVpniPsecPhase2EndpointResponseData) ToModel() *IPsecPhase2 {
+	if p == nil {
+		return nil
+	}
+	return &IPsecPhase2{
+		Uniqid:                    p.Uniqid,
+		Reqid:                     p.Reqid,
+		Ikeid:                     p.Ikeid,
+		Descr:                     p.Descr,
+		Disabled:                  p.Disabled,
+		Mode:                      p.Mode,
+		LocalidType:               p.LocalidType,
+		LocalidAddress:            p.LocalidAddress,
+		LocalidNetbits:            p.LocalidNetbits,
+		NatlocalidType:            p.NatlocalidType,
+		NatlocalidAddress:         p.NatlocalidAddress,
+		NatlocalidNetbits:         p.NatlocalidNetbits,
+		RemoteidType:              p.RemoteidType,
+		RemoteidAddress:           p.RemoteidAddress,
+		RemoteidNetbits:           p.RemoteidNetbits,
+		Protocol:                  p.Protocol,
+		EncryptionAlgorithmOption: p.EncryptionAlgorithmOption,
+		HashAlgorithmOption:       p.HashAlgorithmOption,
+		Pfsgroup:                  p.Pfsgroup,
+		RekeyTime:                 p.RekeyTime,
+		RandTime:                  p.RandTime,
+		Lifetime:                  p.Lifetime,
+		Pinghost:                  p.Pinghost,
+		Keepalive:                 p.Keepalive,
+	}
+}
+
+// ToModel copies p into a new IPsecPhase2, sharing its slices and pointers.
+func (p *PatchVpniPsecPhase2EndpointResponseData) ToModel() *IPsecPhase2 {
+	if p == nil {
+		return nil
+	}
+	return &IPsecPhase2{
+		Uniqid:                    p.Uniqid,
+		Reqid:                     p.Reqid,
+		Ikeid:                     p.Ikeid,
+		Descr:                     p.Descr,
+		Disabled:                  p.Disabled,
+		Mode:                      p.Mode,
+		LocalidType:               p.LocalidType,
+		LocalidAddress:            p.LocalidAddress,
+		LocalidNetbits:            p.LocalidNetbits,
+		NatlocalidType:            p.NatlocalidType,
+		NatlocalidAddress:         p.NatlocalidAddress,
+		NatlocalidNetbits:         p.NatlocalidNetbits,
+		RemoteidType:              p.RemoteidType,
+		RemoteidAddress:           p.RemoteidAddress,
+		RemoteidNetbits:           p.RemoteidNetbits,
+		Protocol:                  p.Protocol,
+		EncryptionAlgorithmOption: p.EncryptionAlgorithmOption,
+		HashAlgorithmOption:       p.HashAlgorithmOption,
+		Pfsgroup:                  p.Pfsgroup,
+		RekeyTime:                 p.RekeyTime,
+		RandTime:                  p.RandTime,
+		Lifetime:                  p.Lifetime,
+		Pinghost:                  p.Pinghost,
+		Keepalive:                 p.Keepalive,
+	}
+}
+
+// ToModel copies g into a new IPsecPhase2, sharing its slices and pointers.
+func (g *GetVpniPsecPhase2SEndpointResponseDataItem) ToModel() *IPsecPhase2 {
+	if g == nil {
+		return nil
+	}
+	return &IPsecPhase2{
+		Uniqid:                    g.Uniqid,
+		Reqid:                     g.Reqid,
+		Ikeid:                     g.Ikeid,
+		Descr:                     g.Descr,
+		Disabled:                  g.Disabled,
+		Mode:                      g.Mode,
+		LocalidType:               g.LocalidType,
+		LocalidAddress:            g.LocalidAddress,
+		LocalidNetbits:            g.LocalidNetbits,
+		NatlocalidType:            g.NatlocalidType,
+		NatlocalidAddress:         g.NatlocalidAddress,
+		NatlocalidNetbits:         g.NatlocalidNetbits,
+		RemoteidType:              g.RemoteidType,
+		RemoteidAddress:           g.RemoteidAddress,
+		RemoteidNetbits:           g.RemoteidNetbits,
+		Protocol:                  g.Protocol,
+		EncryptionAlgorithmOption: g.EncryptionAlgorithmOption,
+		HashAlgorithmOption:       g.HashAlgorithmOption,
+		Pfsgroup:                  g.Pfsgroup,
+		RekeyTime:                 g.RekeyTime,
+		RandTime:                  g.RandTime,
+		Lifetime:                  g.Lifetime,
+		Pinghost:                  g.Pinghost,
+		Keepalive:                 g.Keepalive,
+	}
+}
+
+// ToPost returns a request that creates a copy of i. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with i.
+func (i *InterfaceBridge) ToPost() *PostInterfaceBridgeEndpointRequest {
+	if i == nil {
+		return &PostInterfaceBridgeEndpointRequest{}
+	}
+	return &PostInterfaceBridgeEndpointRequest{
+		Members:  i.Members,
+		Descr:    i.Descr,
+		Bridgeif: i.Bridgeif,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with i. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with i.
+func (i *InterfaceBridge) ToPatch(id int) *PatchInterfaceBridgeEndpointRequest {
+	if i == nil {
+		return &PatchInterfaceBridgeEndpointRequest{ID: id}
+	}
+	return &PatchInterfaceBridgeEndpointRequest{
+		ID:       id,
+		Members:  i.Members,
+		Descr:    i.Descr,
+		Bridgeif: i.Bridgeif,
+	}
+}
+
+// ToModel copies g into a new InterfaceBridge, sharing its slices and pointers.
+func (g *GetInterfaceBridgeEndpointResponseData) ToModel() *InterfaceBridge {
+	if g == nil {
+		return nil
+	}
+	return &InterfaceBridge{
+		Members:  g.Members,
+		Descr:    g.Descr,
+		Bridgeif: g.Bridgeif,
+	}
+}
+
+// ToModel copies p into a new InterfaceBridge, sharing its slices and pointers.
+func (p *PostInterfaceBridgeEndpointResponseData) ToModel() *InterfaceBridge {
+	if p == nil {
+		return nil
+	}
+	return &InterfaceBridge{
+		Members:  p.Members,
+		Descr:    p.Descr,
+		Bridgeif: p.Bridgeif,
+	}
+}
+
+// ToModel copies p into a new InterfaceBridge, sharing its slices and pointers.
+func (p *PatchInterfaceBridgeEndpointResponseData) ToModel() *InterfaceBridge {
+	if p == nil {
+		return nil
+	}
+	return &InterfaceBridge{
+		Members:  p.Members,
+		Descr:    p.Descr,
+		Bridgeif: p.Bridgeif,
+	}
+}
+
+// ToModel copies g into a new InterfaceBridge, sharing its slices and pointers.
+func (g *GetInterfaceBridgesEndpointResponseDataItem) ToModel() *InterfaceBridge {
+	if g == nil {
+		return nil
+	}
+	return &InterfaceBridge{
+		Members:  g.Members,
+		Descr:    g.Descr,
+		Bridgeif: g.Bridgeif,
+	}
+}
+
+// ToPost returns a request that creates a copy of i. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with i.
+func (i *InterfaceGre) ToPost() *PostInterfaceGreEndpointRequest {
+	if i == nil {
+		return &PostInterfaceGreEndpointRequest{}
+	}
+	return &PostInterfaceGreEndpointRequest{
+		If:                i.If,
+		Greif:             i.Greif,
+		Descr:             i.Descr,
+		AddStaticRoute:    i.AddStaticRoute,
+		RemoteAddr:        i.RemoteAddr,
+		TunnelLocalAddr:   i.TunnelLocalAddr,
+		TunnelRemoteAddr:  i.TunnelRemoteAddr,
+		TunnelRemoteNet:   i.TunnelRemoteNet,
+		TunnelLocalAddr6:  i.TunnelLocalAddr6,
+		TunnelRemoteAddr6: i.TunnelRemoteAddr6,
+		TunnelRemoteNet6:  i.TunnelRemoteNet6,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with i. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with i.
+func (i *InterfaceGre) ToPatch(id int) *PatchInterfaceGreEndpointRequest {
+	if i == nil {
+		return &PatchInterfaceGreEndpointRequest{ID: id}
+	}
+	return &PatchInterfaceGreEndpointRequest{
+		ID:                id,
+		If:                i.If,
+		Greif:             i.Greif,
+		Descr:             i.Descr,
+		AddStaticRoute:    i.AddStaticRoute,
+		RemoteAddr:        i.RemoteAddr,
+		TunnelLocalAddr:   i.TunnelLocalAddr,
+		TunnelRemoteAddr:  i.TunnelRemoteAddr,
+		TunnelRemoteNet:   i.TunnelRemoteNet,
+		TunnelLocalAddr6:  i.TunnelLocalAddr6,
+		TunnelRemoteAddr6: i.TunnelRemoteAddr6,
+		TunnelRemoteNet6:  i.TunnelRemoteNet6,
+	}
+}
+
+// ToModel copies g into a new InterfaceGre, sharing its slices and pointers.
+func (g *GetInterfaceGreEndpointResponseData) ToModel() *InterfaceGre {
+	if g == nil {
+		return nil
+	}
+	return &InterfaceGre{
+		If:                g.If,
+		Greif:             g.Greif,
+		Descr:             g.Descr,
+		AddStaticRoute:    g.AddStaticRoute,
+		RemoteAddr:        g.RemoteAddr,
+		TunnelLocalAddr:   g.TunnelLocalAddr,
+		TunnelRemoteAddr:  g.TunnelRemoteAddr,
+		TunnelRemoteNet:   g.TunnelRemoteNet,
+		TunnelLocalAddr6:  g.TunnelLocalAddr6,
+		TunnelRemoteAddr6: g.TunnelRemoteAddr6,
+		TunnelRemoteNet6:  g.TunnelRemoteNet6,
+	}
+}
+
+// ToModel copies p into a new InterfaceGre, sharing its slices and pointers.
+func (p *PostInterfaceGreEndpointResponseData) ToModel() *InterfaceGre {
+	if p == nil {
+		return nil
+	}
+	return &InterfaceGre{
+		If:                p.If,
+		Greif:             p.Greif,
+		Descr:             p.Descr,
+		AddStaticRoute:    p.AddStaticRoute,
+		RemoteAddr:        p.RemoteAddr,
+		TunnelLocalAddr:   p.TunnelLocalAddr,
+		TunnelRemoteAddr:  p.TunnelRemoteAddr,
+		TunnelRemoteNet:   p.TunnelRemoteNet,
+		TunnelLocalAddr6:  p.TunnelLocalAddr6,
+		TunnelRemoteAddr6: p.TunnelRemoteAddr6,
+		TunnelRemoteNet6:  p.TunnelRemoteNet6,
+	}
+}
+
+// ToModel copies p into a new InterfaceGre, sharing its slices and pointers.
+func (p *PatchInterfaceGreEndpointResponseData) ToModel() *InterfaceGre {
+	if p == nil {
+		return nil
+	}
+	return &InterfaceGre{
+		If:                p.If,
+		Greif:             p.Greif,
+		Descr:             p.Descr,
+		AddStaticRoute:    p.AddStaticRoute,
+		RemoteAddr:        p.RemoteAddr,
+		TunnelLocalAddr:   p.TunnelLocalAddr,
+		TunnelRemoteAddr:  p.TunnelRemoteAddr,
+		TunnelRemoteNet:   p.TunnelRemoteNet,
+		TunnelLocalAddr6:  p.TunnelLocalAddr6,
+		TunnelRemoteAddr6: p.TunnelRemoteAddr6,
+		TunnelRemoteNet6:  p.TunnelRemoteNet6,
+	}
+}
+
+// ToModel copies g into a new InterfaceGre, sharing its slices and pointers.
+func (g *GetInterfaceGrEsEndpointResponseDataItem) ToModel() *InterfaceGre {
+	if g == nil {
+		return nil
+	}
+	return &InterfaceGre{
+		If:                g.If,
+		Greif:             g.Greif,
+		Descr:             g.Descr,
+		AddStaticRoute:    g.AddStaticRoute,
+		RemoteAddr:        g.RemoteAddr,
+		TunnelLocalAddr:   g.TunnelLocalAddr,
+		TunnelRemoteAddr:  g.TunnelRemoteAddr,
+		TunnelRemoteNet:   g.TunnelRemoteNet,
+		TunnelLocalAddr6:  g.TunnelLocalAddr6,
+		TunnelRemoteAddr6: g.TunnelRemoteAddr6,
+		TunnelRemoteNet6:  g.TunnelRemoteNet6,
+	}
+}
+
+// ToPost returns a request that creates a copy of i. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with i.
+func (i *InterfaceGroup) ToPost() *PostInterfaceGroupEndpointRequest {
+	if i == nil {
+		return &PostInterfaceGroupEndpointRequest{}
+	}
+	return &PostInterfaceGroupEndpointRequest{
+		Ifname:  i.Ifname,
+		Members: i.Members,
+		Descr:   i.Descr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with i. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with i.
+func (i *InterfaceGroup) ToPatch(id int) *PatchInterfaceGroupEndpointRequest {
+	if i == nil {
+		return &PatchInterfaceGroupEndpointRequest{ID: id}
+	}
+	return &PatchInterfaceGroupEndpointRequest{
+		ID:      id,
+		Ifname:  i.Ifname,
+		Members: i.Members,
+		Descr:   i.Descr,
+	}
+}
+
+// ToPutItem returns i as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with i.
+func (i *InterfaceGroup) ToPutItem() *PutInterfaceGroupsEndpointRequestItem {
+	if i == nil {
+		return nil
+	}
+	return &PutInterfaceGroupsEndpointRequestItem{
+		Ifname:  i.Ifname,
+		Members: i.Members,
+		Descr:   i.Descr,
+	}
+}
+
+// ToModel copies g into a new InterfaceGroup, sharing its slices and pointers.
+func (g *GetInterfaceGroupEndpointResponseData) ToModel() *InterfaceGroup {
+	if g == nil {
+		return nil
+	}
+	return &InterfaceGroup{
+		Ifname:  g.Ifname,
+		Members: g.Members,
+		Descr:   g.Descr,
+	}
+}
+
+// ToModel copies p into a new InterfaceGroup, sharing its slices and pointers.
+func (p *PostInterfaceGroupEndpointResponseData) ToModel() *InterfaceGroup {
+	if p == nil {
+		return nil
+	}
+	return &InterfaceGroup{
+		Ifname:  p.Ifname,
+		Members: p.Members,
+		Descr:   p.Descr,
+	}
+}
+
+// ToModel copies p into a new InterfaceGroup, sharing its slices and pointers.
+func (p *PatchInterfaceGroupEndpointResponseData) ToModel() *InterfaceGroup {
+	if p == nil {
+		return nil
+	}
+	return &InterfaceGroup{
+		Ifname:  p.Ifname,
+		Members: p.Members,
+		Descr:   p.Descr,
+	}
+}
+
+// ToModel copies g into a new InterfaceGroup, sharing its slices and pointers.
+func (g *GetInterfaceGroupsEndpointResponseDataItem) ToModel() *InterfaceGroup {
+	if g == nil {
+		return nil
+	}
+	return &InterfaceGroup{
+		Ifname:  g.Ifname,
+		Members: g.Members,
+		Descr:   g.Descr,
+	}
+}
+
+// ToPost returns a request that creates a copy of i. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with i.
+func (i *InterfaceLagg) ToPost() *PostInterfaceLaggEndpointRequest {
+	if i == nil {
+		return &PostInterfaceLaggEndpointRequest{}
+	}
+	return &PostInterfaceLaggEndpointRequest{
+		Laggif:         i.Laggif,
+		Descr:          i.Descr,
+		Members:        i.Members,
+		Proto:          i.Proto,
+		Lacptimeout:    i.Lacptimeout,
+		Lagghash:       i.Lagghash,
+		Failovermaster: i.Failovermaster,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with i. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with i.
+func (i *InterfaceLagg) ToPatch(id int) *PatchInterfaceLaggEndpointRequest {
+	if i == nil {
+		return &PatchInterfaceLaggEndpointRequest{ID: id}
+	}
+	return &PatchInterfaceLaggEndpointRequest{
+		ID:             id,
+		Laggif:         i.Laggif,
+		Descr:          i.Descr,
+		Members:        i.Members,
+		Proto:          i.Proto,
+		Lacptimeout:    i.Lacptimeout,
+		Lagghash:       i.Lagghash,
+		Failovermaster: i.Failovermaster,
+	}
+}
+
+// ToModel copies g into a new InterfaceLagg, sharing its slices and pointers.
+func (g *GetInterfaceLaggEndpointResponseData) ToModel() *InterfaceLagg {
+	if g == nil {
+		return nil
+	}
+	return &InterfaceLagg{
+		Laggif:         g.Laggif,
+		Descr:          g.Descr,
+		Members:        g.Members,
+		Proto:          g.Proto,
+		Lacptimeout:    g.Lacptimeout,
+		Lagghash:       g.Lagghash,
+		Failovermaster: g.Failovermaster,
+	}
+}
+
+// ToModel copies p into a new InterfaceLagg, sharing its slices and pointers.
+func (p *PostInterfaceLaggEndpointResponseData) ToModel() *InterfaceLagg {
+	if p == nil {
+		return nil
+	}
+	return &InterfaceLagg{
+		Laggif:         p.Laggif,
+		Descr:          p.Descr,
+		Members:        p.Members,
+		Proto:          p.Proto,
+		Lacptimeout:    p.Lacptimeout,
+		Lagghash:       p.Lagghash,
+		Failovermaster: p.Failovermaster,
+	}
+}
+
+// ToModel copies p into a new InterfaceLagg, sharing its slices and pointers.
+func (p *PatchInterfaceLaggEndpointResponseData) ToModel() *InterfaceLagg {
+	if p == nil {
+		return nil
+	}
+	return &InterfaceLagg{
+		Laggif:         p.Laggif,
+		Descr:          p.Descr,
+		Members:        p.Members,
+		Proto:          p.Proto,
+		Lacptimeout:    p.Lacptimeout,
+		Lagghash:       p.Lagghash,
+		Failovermaster: p.Failovermaster,
+	}
+}
+
+// ToModel copies g into a new InterfaceLagg, sharing its slices and pointers.
+func (g *GetInterfaceLagGsEndpointResponseDataItem) ToModel() *InterfaceLagg {
+	if g == nil {
+		return nil
+	}
+	return &InterfaceLagg{
+		Laggif:         g.Laggif,
+		Descr:          g.Descr,
+		Members:        g.Members,
+		Proto:          g.Proto,
+		Lacptimeout:    g.Lacptimeout,
+		Lagghash:       g.Lagghash,
+		Failovermaster: g.Failovermaster,
+	}
+}
+
+// ToPost returns a request that creates a copy of i. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with i.
+func (i *InterfaceVlan) ToPost() *PostInterfaceVlanEndpointRequest {
+	if i == nil {
+		return &PostInterfaceVlanEndpointRequest{}
+	}
+	return &PostInterfaceVlanEndpointRequest{
+		If:     i.If,
+		Tag:    i.Tag,
+		Vlanif: i.Vlanif,
+		Pcp:    i.Pcp,
+		Descr:  i.Descr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with i. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with i.
+func (i *InterfaceVlan) ToPatch(id int) *PatchInterfaceVlanEndpointRequest {
+	if i == nil {
+		return &PatchInterfaceVlanEndpointRequest{ID: id}
+	}
+	return &PatchInterfaceVlanEndpointRequest{
+		ID:     id,
+		If:     i.If,
+		Tag:    i.Tag,
+		Vlanif: i.Vlanif,
+		Pcp:    i.Pcp,
+		Descr:  i.Descr,
+	}
+}
+
+// ToModel copies g into a new InterfaceVlan, sharing its slices and pointers.
+func (g *GetInterfaceVlanEndpointResponseData) ToModel() *InterfaceVlan {
+	if g == nil {
+		return nil
+	}
+	return &InterfaceVlan{
+		If:     g.If,
+		Tag:    g.Tag,
+		Vlanif: g.Vlanif,
+		Pcp:    g.Pcp,
+		Descr:  g.Descr,
+	}
+}
+
+// ToModel copies p into a new InterfaceVlan, sharing its slices and pointers.
+func (p *PostInterfaceVlanEndpointResponseData) ToModel() *InterfaceVlan {
+	if p == nil {
+		return nil
+	}
+	return &InterfaceVlan{
+		If:     p.If,
+		Tag:    p.Tag,
+		Vlanif: p.Vlanif,
+		Pcp:    p.Pcp,
+		Descr:  p.Descr,
+	}
+}
+
+// ToModel copies p into a new InterfaceVlan, sharing its slices and pointers.
+func (p *PatchInterfaceVlanEndpointResponseData) ToModel() *InterfaceVlan {
+	if p == nil {
+		return nil
+	}
+	return &InterfaceVlan{
+		If:     p.If,
+		Tag:    p.Tag,
+		Vlanif: p.Vlanif,
+		Pcp:    p.Pcp,
+		Descr:  p.Descr,
+	}
+}
+
+// ToModel copies g into a new InterfaceVlan, sharing its slices and pointers.
+func (g *GetInterfaceVlaNsEndpointResponseDataItem) ToModel() *InterfaceVlan {
+	if g == nil {
+		return nil
+	}
+	return &InterfaceVlan{
+		If:     g.If,
+		Tag:    g.Tag,
+		Vlanif: g.Vlanif,
+		Pcp:    g.Pcp,
+		Descr:  g.Descr,
+	}
+}
+
+// ToPost returns a request that creates a copy of n. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with n.
+func (n *NetworkInterface) ToPost() *PostNetworkInterfaceEndpointRequest {
+	if n == nil {
+		return &PostNetworkInterfaceEndpointRequest{}
+	}
+	return &PostNetworkInterfaceEndpointRequest{
+		If:                            n.If,
+		Enable:                        n.Enable,
+		Descr:                         n.Descr,
+		Spoofmac:                      n.Spoofmac,
+		Mtu:                           n.Mtu,
+		Mss:                           n.Mss,
+		Media:                         n.Media,
+		Mediaopt:                      n.Mediaopt,
+		Blockpriv:                     n.Blockpriv,
+		Blockbogons:                   n.Blockbogons,
+		Typev4:                        n.Typev4,
+		Ipaddr:                        n.Ipaddr,
+		Subnet:                        n.Subnet,
+		Gateway:                       n.Gateway,
+		Dhcphostname:                  n.Dhcphostname,
+		AliasAddress:                  n.AliasAddress,
+		AliasSubnet:                   n.AliasSubnet,
+		Dhcprejectfrom:                n.Dhcprejectfrom,
+		AdvDhcpConfigAdvanced:         n.AdvDhcpConfigAdvanced,
+		AdvDhcpPtValues:               n.AdvDhcpPtValues,
+		AdvDhcpPtTimeout:              n.AdvDhcpPtTimeout,
+		AdvDhcpPtRetry:                n.AdvDhcpPtRetry,
+		AdvDhcpPtSelectTimeout:        n.AdvDhcpPtSelectTimeout,
+		AdvDhcpPtReboot:               n.AdvDhcpPtReboot,
+		AdvDhcpPtBackoffCutoff:        n.AdvDhcpPtBackoffCutoff,
+		AdvDhcpPtInitialInterval:      n.AdvDhcpPtInitialInterval,
+		AdvDhcpSendOptions:            n.AdvDhcpSendOptions,
+		AdvDhcpRequestOptions:         n.AdvDhcpRequestOptions,
+		AdvDhcpRequiredOptions:        n.AdvDhcpRequiredOptions,
+		AdvDhcpOptionModifiers:        n.AdvDhcpOptionModifiers,
+		AdvDhcpConfigFileOverride:     n.AdvDhcpConfigFileOverride,
+		AdvDhcpConfigFileOverridePath: n.AdvDhcpConfigFileOverridePath,
+		Typev6:                        n.Typev6,
+		Ipaddrv6:                      n.Ipaddrv6,
+		Subnetv6:                      n.Subnetv6,
+		Gatewayv6:                     n.Gatewayv6,
+		Ipv6Usev4Iface:                n.Ipv6Usev4Iface,
+		Slaacusev4Iface:               n.Slaacusev4Iface,
+		Prefix6Rd:                     n.Prefix6Rd,
+		Gateway6Rd:                    n.Gateway6Rd,
+		Prefix6RdV4Plen:               n.Prefix6RdV4Plen,
+		Track6Interface:               n.Track6Interface,
+		Track6PrefixIDHex:             n.Track6PrefixIDHex,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with n. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with n.
+func (n *NetworkInterface) ToPatch(id int) *PatchNetworkInterfaceEndpointRequest {
+	if n == nil {
+		return &PatchNetworkInterfaceEndpointRequest{ID: id}
+	}
+	return &PatchNetworkInterfaceEndpointRequest{
+		ID:                            id,
+		If:                            n.If,
+		Enable:                        n.Enable,
+		Descr:                         n.Descr,
+		Spoofmac:                      n.Spoofmac,
+		Mtu:                           n.Mtu,
+		Mss:                           n.Mss,
+		Media:                         n.Media,
+		Mediaopt:                      n.Mediaopt,
+		Blockpriv:                     n.Blockpriv,
+		Blockbogons:                   n.Blockbogons,
+		Typev4:                        n.Typev4,
+		Ipaddr:                        n.Ipaddr,
+		Subnet:                        n.Subnet,
+		Gateway:                       n.Gateway,
+		Dhcphostname:                  n.Dhcphostname,
+		AliasAddress:                  n.AliasAddress,
+		AliasSubnet:                   n.AliasSubnet,
+		Dhcprejectfrom:                n.Dhcprejectfrom,
+		AdvDhcpConfigAdvanced:         n.AdvDhcpConfigAdvanced,
+		AdvDhcpPtValues:               n.AdvDhcpPtValues,
+		AdvDhcpPtTimeout:              n.AdvDhcpPtTimeout,
+		AdvDhcpPtRetry:                n.AdvDhcpPtRetry,
+		AdvDhcpPtSelectTimeout:        n.AdvDhcpPtSelectTimeout,
+		AdvDhcpPtReboot:               n.AdvDhcpPtReboot,
+		AdvDhcpPtBackoffCutoff:        n.AdvDhcpPtBackoffCutoff,
+		AdvDhcpPtInitialInterval:      n.AdvDhcpPtInitialInterval,
+		AdvDhcpSendOptions:            n.AdvDhcpSendOptions,
+		AdvDhcpRequestOptions:         n.AdvDhcpRequestOptions,
+		AdvDhcpRequiredOptions:        n.AdvDhcpRequiredOptions,
+		AdvDhcpOptionModifiers:        n.AdvDhcpOptionModifiers,
+		AdvDhcpConfigFileOverride:     n.AdvDhcpConfigFileOverride,
+		AdvDhcpConfigFileOverridePath: n.AdvDhcpConfigFileOverridePath,
+		Typev6:                        n.Typev6,
+		Ipaddrv6:                      n.Ipaddrv6,
+		Subnetv6:                      n.Subnetv6,
+		Gatewayv6:                     n.Gatewayv6,
+		Ipv6Usev4Iface:                n.Ipv6Usev4Iface,
+		Slaacusev4Iface:               n.Slaacusev4Iface,
+		Prefix6Rd:                     n.Prefix6Rd,
+		Gateway6Rd:                    n.Gateway6Rd,
+		Prefix6RdV4Plen:               n.Prefix6RdV4Plen,
+		Track6Interface:               n.Track6Interface,
+		Track6PrefixIDHex:             n.Track6PrefixIDHex,
+	}
+}
+
+// ToModel copies g into a new NetworkInterface, sharing its slices and pointers.
+func (g *GetNetworkInterfaceEndpointResponseData) ToModel() *NetworkInterface {
+	if g == nil {
+		return nil
+	}
+	return &NetworkInterface{
+		If:                            g.If,
+		Enable:                        g.Enable,
+		Descr:                         g.Descr,
+		Spoofmac:                      g.Spoofmac,
+		Mtu:                           g.Mtu,
+		Mss:                           g.Mss,
+		Media:                         g.Media,
+		Mediaopt:                      g.Mediaopt,
+		Blockpriv:                     g.Blockpriv,
+		Blockbogons:                   g.Blockbogons,
+		Typev4:                        g.Typev4,
+		Ipaddr:                        g.Ipaddr,
+		Subnet:                        g.Subnet,
+		Gateway:                       g.Gateway,
+		Dhcphostname:                  g.Dhcphostname,
+		AliasAddress:                  g.AliasAddress,
+		AliasSubnet:                   g.AliasSubnet,
+		Dhcprejectfrom:                g.Dhcprejectfrom,
+		AdvDhcpConfigAdvanced:         g.AdvDhcpConfigAdvanced,
+		AdvDhcpPtValues:               g.AdvDhcpPtValues,
+		AdvDhcpPtTimeout:              g.AdvDhcpPtTimeout,
+		AdvDhcpPtRetry:                g.AdvDhcpPtRetry,
+		AdvDhcpPtSelectTimeout:        g.AdvDhcpPtSelectTimeout,
+		AdvDhcpPtReboot:               g.AdvDhcpPtReboot,
+		AdvDhcpPtBackoffCutoff:        g.AdvDhcpPtBackoffCutoff,
+		AdvDhcpPtInitialInterval:      g.AdvDhcpPtInitialInterval,
+		AdvDhcpSendOptions:            g.AdvDhcpSendOptions,
+		AdvDhcpRequestOptions:         g.AdvDhcpRequestOptions,
+		AdvDhcpRequiredOptions:        g.AdvDhcpRequiredOptions,
+		AdvDhcpOptionModifiers:        g.AdvDhcpOptionModifiers,
+		AdvDhcpConfigFileOverride:     g.AdvDhcpConfigFileOverride,
+		AdvDhcpConfigFileOverridePath: g.AdvDhcpConfigFileOverridePath,
+		Typev6:                        g.Typev6,
+		Ipaddrv6:                      g.Ipaddrv6,
+		Subnetv6:                      g.Subnetv6,
+		Gatewayv6:                     g.Gatewayv6,
+		Ipv6Usev4Iface:                g.Ipv6Usev4Iface,
+		Slaacusev4Iface:               g.Slaacusev4Iface,
+		Prefix6Rd:                     g.Prefix6Rd,
+		Gateway6Rd:                    g.Gateway6Rd,
+		Prefix6RdV4Plen:               g.Prefix6RdV4Plen,
+		Track6Interface:               g.Track6Interface,
+		Track6PrefixIDHex:             g.Track6PrefixIDHex,
+	}
+}
+
+// ToModel copies p into a new NetworkInterface, sharing its slices and pointers.
+func (p *PostNetworkInterfaceEndpointResponseData) ToModel() *NetworkInterface {
+	if p == nil {
+		return nil
+	}
+	return &NetworkInterface{
+		If:                            p.If,
+		Enable:                        p.Enable,
+		Descr:                         p.Descr,
+		Spoofmac:                      p.Spoofmac,
+		Mtu:                           p.Mtu,
+		Mss:                           p.Mss,
+		Media:                         p.Media,
+		Mediaopt:                      p.Mediaopt,
+		Blockpriv:                     p.Blockpriv,
+		Blockbogons:                   p.Blockbogons,
+		Typev4:                        p.Typev4,
+		Ipaddr:                        p.Ipaddr,
+		Subnet:                        p.Subnet,
+		Gateway:                       p.Gateway,
+		Dhcphostname:                  p.Dhcphostname,
+		AliasAddress:                  p.AliasAddress,
+		AliasSubnet:                   p.AliasSubnet,
+		Dhcprejectfrom:                p.Dhcprejectfrom,
+		AdvDhcpConfigAdvanced:         p.AdvDhcpConfigAdvanced,
+		AdvDhcpPtValues:               p.AdvDhcpPtValues,
+		AdvDhcpPtTimeout:              p.AdvDhcpPtTimeout,
+		AdvDhcpPtRetry:                p.AdvDhcpPtRetry,
+		AdvDhcpPtSelectTimeout:        p.AdvDhcpPtSelectTimeout,
+		AdvDhcpPtReboot:               p.AdvDhcpPtReboot,
+		AdvDhcpPtBackoffCutoff:        p.AdvDhcpPtBackoffCutoff,
+		AdvDhcpPtInitialInterval:      p.AdvDhcpPtInitialInterval,
+		AdvDhcpSendOptions:            p.AdvDhcpSendOptions,
+		AdvDhcpRequestOptions:         p.AdvDhcpRequestOptions,
+		AdvDhcpRequiredOptions:        p.AdvDhcpRequiredOptions,
+		AdvDhcpOptionModifiers:        p.AdvDhcpOptionModifiers,
+		AdvDhcpConfigFileOverride:     p.AdvDhcpConfigFileOverride,
+		AdvDhcpConfigFileOverridePath: p.AdvDhcpConfigFileOverridePath,
+		Typev6:                        p.Typev6,
+		Ipaddrv6:                      p.Ipaddrv6,
+		Subnetv6:                      p.Subnetv6,
+		Gatewayv6:                     p.Gatewayv6,
+		Ipv6Usev4Iface:                p.Ipv6Usev4Iface,
+		Slaacusev4Iface:               p.Slaacusev4Iface,
+		Prefix6Rd:                     p.Prefix6Rd,
+		Gateway6Rd:                    p.Gateway6Rd,
+		Prefix6RdV4Plen:               p.Prefix6RdV4Plen,
+		Track6Interface:               p.Track6Interface,
+		Track6PrefixIDHex:             p.Track6PrefixIDHex,
+	}
+}
+
+// ToModel copies p into a new NetworkInterface, sharing its slices and pointers.
+func (p *PatchNetworkInterfaceEndpointResponseData) ToModel() *NetworkInterface {
+	if p == nil {
+		return nil
+	}
+	return &NetworkInterface{
+		If:                            p.If,
+		Enable:                        p.Enable,
+		Descr:                         p.Descr,
+		Spoofmac:                      p.Spoofmac,
+		Mtu:                           p.Mtu,
+		Mss:                           p.Mss,
+		Media:                         p.Media,
+		Mediaopt:                      p.Mediaopt,
+		Blockpriv:                     p.Blockpriv,
+		Blockbogons:                   p.Blockbogons,
+		Typev4:                        p.Typev4,
+		Ipaddr:                        p.Ipaddr,
+		Subnet:                        p.Subnet,
+		Gateway:                       p.Gateway,
+		Dhcphostname:                  p.Dhcphostname,
+		AliasAddress:                  p.AliasAddress,
+		AliasSubnet:                   p.AliasSubnet,
+		Dhcprejectfrom:                p.Dhcprejectfrom,
+		AdvDhcpConfigAdvanced:         p.AdvDhcpConfigAdvanced,
+		AdvDhcpPtValues:               p.AdvDhcpPtValues,
+		AdvDhcpPtTimeout:              p.AdvDhcpPtTimeout,
+		AdvDhcpPtRetry:                p.AdvDhcpPtRetry,
+		AdvDhcpPtSelectTimeout:        p.AdvDhcpPtSelectTimeout,
+		AdvDhcpPtReboot:               p.AdvDhcpPtReboot,
+		AdvDhcpPtBackoffCutoff:        p.AdvDhcpPtBackoffCutoff,
+		AdvDhcpPtInitialInterval:      p.AdvDhcpPtInitialInterval,
+		AdvDhcpSendOptions:            p.AdvDhcpSendOptions,
+		AdvDhcpRequestOptions:         p.AdvDhcpRequestOptions,
+		AdvDhcpRequiredOptions:        p.AdvDhcpRequiredOptions,
+		AdvDhcpOptionModifiers:        p.AdvDhcpOptionModifiers,
+		AdvDhcpConfigFileOverride:     p.AdvDhcpConfigFileOverride,
+		AdvDhcpConfigFileOverridePath: p.AdvDhcpConfigFileOverridePath,
+		Typev6:                        p.Typev6,
+		Ipaddrv6:                      p.Ipaddrv6,
+		Subnetv6:                      p.Subnetv6,
+		Gatewayv6:                     p.Gatewayv6,
+		Ipv6Usev4Iface:                p.Ipv6Usev4Iface,
+		Slaacusev4Iface:               p.Slaacusev4Iface,
+		Prefix6Rd:                     p.Prefix6Rd,
+		Gateway6Rd:                    p.Gateway6Rd,
+		Prefix6RdV4Plen:               p.Prefix6RdV4Plen,
+		Track6Interface:               p.Track6Interface,
+		Track6PrefixIDHex:             p.Track6PrefixIDHex,
+	}
+}
+
+// ToModel copies g into a new NetworkInterface, sharing its slices and pointers.
+func (g *GetNetworkInterfacesEndpointResponseDataItem) ToModel() *NetworkInterface {
+	if g == nil {
+		return nil
+	}
+	return &NetworkInterface{
+		If:                            g.If,
+		Enable:                        g.Enable,
+		Descr:                         g.Descr,
+		Spoofmac:                      g.Spoofmac,
+		Mtu:                           g.Mtu,
+		Mss:                           g.Mss,
+		Media:                         g.Media,
+		Mediaopt:                      g.Mediaopt,
+		Blockpriv:                     g.Blockpriv,
+		Blockbogons:                   g.Blockbogons,
+		Typev4:                        g.Typev4,
+		Ipaddr:                        g.Ipaddr,
+		Subnet:                        g.Subnet,
+		Gateway:                       g.Gateway,
+		Dhcphostname:                  g.Dhcphostname,
+		AliasAddress:                  g.AliasAddress,
+		AliasSubnet:                   g.AliasSubnet,
+		Dhcprejectfrom:                g.Dhcprejectfrom,
+		AdvDhcpConfigAdvanced:         g.AdvDhcpConfigAdvanced,
+		AdvDhcpPtValues:               g.AdvDhcpPtValues,
+		AdvDhcpPtTimeout:              g.AdvDhcpPtTimeout,
+		AdvDhcpPtRetry:                g.AdvDhcpPtRetry,
+		AdvDhcpPtSelectTimeout:        g.AdvDhcpPtSelectTimeout,
+		AdvDhcpPtReboot:               g.AdvDhcpPtReboot,
+		AdvDhcpPtBackoffCutoff:        g.AdvDhcpPtBackoffCutoff,
+		AdvDhcpPtInitialInterval:      g.AdvDhcpPtInitialInterval,
+		AdvDhcpSendOptions:            g.AdvDhcpSendOptions,
+		AdvDhcpRequestOptions:         g.AdvDhcpRequestOptions,
+		AdvDhcpRequiredOptions:        g.AdvDhcpRequiredOptions,
+		AdvDhcpOptionModifiers:        g.AdvDhcpOptionModifiers,
+		AdvDhcpConfigFileOverride:     g.AdvDhcpConfigFileOverride,
+		AdvDhcpConfigFileOverridePath: g.AdvDhcpConfigFileOverridePath,
+		Typev6:                        g.Typev6,
+		Ipaddrv6:                      g.Ipaddrv6,
+		Subnetv6:                      g.Subnetv6,
+		Gatewayv6:                     g.Gatewayv6,
+		Ipv6Usev4Iface:                g.Ipv6Usev4Iface,
+		Slaacusev4Iface:               g.Slaacusev4Iface,
+		Prefix6Rd:                     g.Prefix6Rd,
+		Gateway6Rd:                    g.Gateway6Rd,
+		Prefix6RdV4Plen:               g.Prefix6RdV4Plen,
+		Track6Interface:               g.Track6Interface,
+		Track6PrefixIDHex:             g.Track6PrefixIDHex,
+	}
+}
+
+// ToPost returns a request that creates a copy of n. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with n.
+func (n *NtpTimeServer) ToPost() *PostServicesNtpTimeServerEndpointRequest {
+	if n == nil {
+		return &PostServicesNtpTimeServerEndpointRequest{}
+	}
+	return &PostServicesNtpTimeServerEndpointRequest{
+		Timeserver: n.Timeserver,
+		Type:       n.Type,
+		Prefer:     n.Prefer,
+		Noselect:   n.Noselect,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with n. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with n.
+func (n *NtpTimeServer) ToPatch(id int) *PatchServicesNtpTimeServerEndpointRequest {
+	if n == nil {
+		return &PatchServicesNtpTimeServerEndpointRequest{ID: id}
+	}
+	return &PatchServicesNtpTimeServerEndpointRequest{
+		ID:         id,
+		Timeserver: n.Timeserver,
+		Type:       n.Type,
+		Prefer:     n.Prefer,
+		Noselect:   n.Noselect,
+	}
+}
+
+// ToPutItem returns n as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with n.
+func (n *NtpTimeServer) ToPutItem() *PutServicesNtpTimeServersEndpointRequestItem {
+	if n == nil {
+		return nil
+	}
+	return &PutServicesNtpTimeServersEndpointRequestItem{
+		Timeserver: n.Timeserver,
+		Type:       n.Type,
+		Prefer:     n.Prefer,
+		Noselect:   n.Noselect,
+	}
+}
+
+// ToModel copies g into a new NtpTimeServer, sharing its slices and pointers.
+func (g *GetServicesNtpTimeServerEndpointResponseData) ToModel() *NtpTimeServer {
+	if g == nil {
+		return nil
+	}
+	return &NtpTimeServer{
+		Timeserver: g.Timeserver,
+		Type:       g.Type,
+		Prefer:     g.Prefer,
+		Noselect:   g.Noselect,
+	}
+}
+
+// ToModel copies p into a new NtpTimeServer, sharing its slices and pointers.
+func (p *PostServicesNtpTimeServerEndpointResponseData) ToModel() *NtpTimeServer {
+	if p == nil {
+		return nil
+	}
+	return &NtpTimeServer{
+		Timeserver: p.Timeserver,
+		Type:       p.Type,
+		Prefer:     p.Prefer,
+		Noselect:   p.Noselect,
+	}
+}
+
+// ToModel copies p into a new NtpTimeServer, sharing its slices and pointers.
+func (p *PatchServicesNtpTimeServerEndpointResponseData) ToModel() *NtpTimeServer {
+	if p == nil {
+		return nil
+	}
+	return &NtpTimeServer{
+		Timeserver: p.Timeserver,
+		Type:       p.Type,
+		Prefer:     p.Prefer,
+		Noselect:   p.Noselect,
+	}
+}
+
+// ToModel copies g into a new NtpTimeServer, sharing its slices and pointers.
+func (g *GetServicesNtpTimeServersEndpointResponseDataItem) ToModel() *NtpTimeServer {
+	if g == nil {
+		return nil
+	}
+	return &NtpTimeServer{
+		Timeserver: g.Timeserver,
+		Type:       g.Type,
+		Prefer:     g.Prefer,
+		Noselect:   g.Noselect,
+	}
+}
+
+// ToPost returns a request that creates a copy of o. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with o.
+func (o *OneToOneNatMapping) ToPost() *PostFirewallNatOneToOneMappingEndpointRequest {
+	if o == nil {
+		return &PostFirewallNatOneToOneMappingEndpointRequest{}
+	}
+	return &PostFirewallNatOneToOneMappingEndpointRequest{
+		Interface:     o.Interface,
+		Disabled:      o.Disabled,
+		Nobinat:       o.Nobinat,
+		Natreflection: o.Natreflection,
+		Ipprotocol:    o.Ipprotocol,
+		External:      o.External,
+		Source:        o.Source,
+		Destination:   o.Destination,
+		Descr:         o.Descr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with o. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with o.
+func (o *OneToOneNatMapping) ToPatch(id int) *PatchFirewallNatOneToOneMappingEndpointRequest {
+	if o == nil {
+		return &PatchFirewallNatOneToOneMappingEndpointRequest{ID: id}
+	}
+	return &PatchFirewallNatOneToOneMappingEndpointRequest{
+		ID:            id,
+		Interface:     o.Interface,
+		Disabled:      o.Disabled,
+		Nobinat:       o.Nobinat,
+		Natreflection: o.Natreflection,
+		Ipprotocol:    o.Ipprotocol,
+		External:      o.External,
+		Source:        o.Source,
+		Destination:   o.Destination,
+		Descr:         o.Descr,
+	}
+}
+
+// ToPutItem returns o as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with o.
+func (o *OneToOneNatMapping) ToPutItem() *PutFirewallNatOneToOneMappingsEndpointRequestItem {
+	if o == nil {
+		return nil
+	}
+	return &PutFirewallNatOneToOneMappingsEndpointRequestItem{
+		Interface:     o.Interface,
+		Disabled:      o.Disabled,
+		Nobinat:       o.Nobinat,
+		Natreflection: o.Natreflection,
+		Ipprotocol:    o.Ipprotocol,
+		External:      o.External,
+		Source:        o.Source,
+		Destination:   o.Destination,
+		Descr:         o.Descr,
+	}
+}
+
+// ToModel copies g into a new OneToOneNatMapping, sharing its slices and pointers.
+func (g *GetFirewallNatOneToOneMappingEndpointResponseData) ToModel() *OneToOneNatMapping {
+	if g == nil {
+		return nil
+	}
+	return &OneToOneNatMapping{
+		Interface:     g.Interface,
+		Disabled:      g.Disabled,
+		Nobinat:       g.Nobinat,
+		Natreflection: g.Natreflection,
+		Ipprotocol:    g.Ipprotocol,
+		External:      g.External,
+		Source:        g.Source,
+		Destination:   g.Destination,
+		Descr:         g.Descr,
+	}
+}
+
+// ToModel copies p into a new OneToOneNatMapping, sharing its slices and pointers.
+func (p *PostFirewallNatOneToOneMappingEndpointResponseData) ToModel() *OneToOneNatMapping {
+	if p == nil {
+		return nil
+	}
+	return &OneToOneNatMapping{
+		Interface:     p.Interface,
+		Disabled:      p.Disabled,
+		Nobinat:       p.Nobinat,
+		Natreflection: p.Natreflection,
+		Ipprotocol:    p.Ipprotocol,
+		External:      p.External,
+		Source:        p.Source,
+		Destination:   p.Destination,
+		Descr:         p.Descr,
+	}
+}
+
+// ToModel copies p into a new OneToOneNatMapping, sharing its slices and pointers.
+func (p *PatchFirewallNatOneToOneMappingEndpointResponseData) ToModel() *OneToOneNatMapping {
+	if p == nil {
+		return nil
+	}
+	return &OneToOneNatMapping{
+		Interface:     p.Interface,
+		Disabled:      p.Disabled,
+		Nobinat:       p.Nobinat,
+		Natreflection: p.Natreflection,
+		Ipprotocol:    p.Ipprotocol,
+		External:      p.External,
+		Source:        p.Source,
+		Destination:   p.Destination,
+		Descr:         p.Descr,
+	}
+}
+
+// ToModel copies g into a new OneToOneNatMapping, sharing its slices and pointers.
+func (g *GetFirewallNatOneToOneMappingsEndpointResponseDataItem) ToModel() *OneToOneNatMapping {
+	if g == nil {
+		return nil
+	}
+	return &OneToOneNatMapping{
+		Interface:     g.Interface,
+		Disabled:      g.Disabled,
+		Nobinat:       g.Nobinat,
+		Natreflection: g.Natreflection,
+		Ipprotocol:    g.Ipprotocol,
+		External:      g.External,
+		Source:        g.Source,
+		Destination:   g.Destination,
+		Descr:         g.Descr,
+	}
+}
+
+// ToPost returns a request that creates a copy of o. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with o.
+func (o *OpenVpnClientExportConfig) ToPost() *PostVpnOpenVpnClientExportConfigEndpointRequest {
+	if o == nil {
+		return &PostVpnOpenVpnClientExportConfigEndpointRequest{}
+	}
+	return &PostVpnOpenVpnClientExportConfigEndpointRequest{
+		Server:          o.Server,
+		Useaddr:         o.Useaddr,
+		UseaddrHostname: o.UseaddrHostname,
+		Verifyservercn:  o.Verifyservercn,
+		Blockoutsidedns: o.Blockoutsidedns,
+		Legacy:          o.Legacy,
+		Silent:          o.Silent,
+		Bindmode:        o.Bindmode,
+		Usepkcs11:       o.Usepkcs11,
+		Pkcs11Providers: o.Pkcs11Providers,
+		Pkcs11ID:        o.Pkcs11ID,
+		Usetoken:        o.Usetoken,
+		Usepass:         o.Usepass,
+		Pass:            o.Pass,
+		P12Encryption:   o.P12Encryption,
+		Useproxy:        o.Useproxy,
+		Useproxytype:    o.Useproxytype,
+		Proxyaddr:       o.Proxyaddr,
+		Proxyport:       o.Proxyport,
+		Useproxypass:    o.Useproxypass,
+		Proxyuser:       o.Proxyuser,
+		Proxypass:       o.Proxypass,
+		Advancedoptions: o.Advancedoptions,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with o. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with o.
+func (o *OpenVpnClientExportConfig) ToPatch(id int) *PatchVpnOpenVpnClientExportConfigEndpointRequest {
+	if o == nil {
+		return &PatchVpnOpenVpnClientExportConfigEndpointRequest{ID: id}
+	}
+	return &PatchVpnOpenVpnClientExportConfigEndpointRequest{
+		ID:              id,
+		Server:          o.Server,
+		Useaddr:         o.Useaddr,
+		UseaddrHostname: o.UseaddrHostname,
+		Verifyservercn:  o.Verifyservercn,
+		Blockoutsidedns: o.Blockoutsidedns,
+		Legacy:          o.Legacy,
+		Silent:          o.Silent,
+		Bindmode:        o.Bindmode,
+		Usepkcs11:       o.Usepkcs11,
+		Pkcs11Providers: o.Pkcs11Providers,
+		Pkcs11ID:        o.Pkcs11ID,
+		Usetoken:        o.Usetoken,
+		Usepass:         o.Usepass,
+		Pass:            o.Pass,
+		P12Encryption:   o.P12Encryption,
+		Useproxy:        o.Useproxy,
+		Useproxytype:    o.Useproxytype,
+		Proxyaddr:       o.Proxyaddr,
+		Proxyport:       o.Proxyport,
+		Useproxypass:    o.Useproxypass,
+		Proxyuser:       o.Proxyuser,
+		Proxypass:       o.Proxypass,
+		Advancedoptions: o.Advancedoptions,
+	}
+}
+
+// ToPutItem returns o as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with o.
+func (o *OpenVpnClientExportConfig) ToPutItem() *PutVpnOpenVpnClientExportConfigsEndpointRequestItem {
+	if o == nil {
+		return nil
+	}
+	return &PutVpnOpenVpnClientExportConfigsEndpointRequestItem{
+		Server:          o.Server,
+		Useaddr:         o.Useaddr,
+		UseaddrHostname: o.UseaddrHostname,
+		Verifyservercn:  o.Verifyservercn,
+		Blockoutsidedns: o.Blockoutsidedns,
+		Legacy:          o.Legacy,
+		Silent:          o.Silent,
+		Bindmode:        o.Bindmode,
+		Usepkcs11:       o.Usepkcs11,
+		Pkcs11Providers: o.Pkcs11Providers,
+		Pkcs11ID:        o.Pkcs11ID,
+		Usetoken:        o.Usetoken,
+		Usepass:         o.Usepass,
+		Pass:            o.Pass,
+		P12Encryption:   o.P12Encryption,
+		Useproxy:        o.Useproxy,
+		Useproxytype:    o.Useproxytype,
+		Proxyaddr:       o.Proxyaddr,
+		Proxyport:       o.Proxyport,
+		Useproxypass:    o.Useproxypass,
+		Proxyuser:       o.Proxyuser,
+		Proxypass:       o.Proxypass,
+		Advancedoptions: o.Advancedoptions,
+	}
+}
+
+// ToModel copies g into a new OpenVpnClientExportConfig, sharing its slices and pointers.
+func (g *GetVpnOpenVpnClientExportConfigEndpointResponseData) ToModel() *OpenVpnClientExportConfig {
+	if g == nil {
+		return nil
+	}
+	return &OpenVpnClientExportConfig{
+		Server:          g.Server,
+		Useaddr:         g.Useaddr,
+		UseaddrHostname: g.UseaddrHostname,
+		Verifyservercn:  g.Verifyservercn,
+		Blockoutsidedns: g.Blockoutsidedns,
+		Legacy:          g.Legacy,
+		Silent:          g.Silent,
+		Bindmode:        g.Bindmode,
+		Usepkcs11:       g.Usepkcs11,
+		Pkcs11Providers: g.Pkcs11Providers,
+		Pkcs11ID:        g.Pkcs11ID,
+		Usetoken:        g.Usetoken,
+		Usepass:         g.Usepass,
+		Pass:            g.Pass,
+		P12Encryption:   g.P12Encryption,
+		Useproxy:        g.Useproxy,
+		Useproxytype:    g.Useproxytype,
+		Proxyaddr:       g.Proxyaddr,
+		Proxyport:       g.Proxyport,
+		Useproxypass:    g.Useproxypass,
+		Proxyuser:       g.Proxyuser,
+		Proxypass:       g.Proxypass,
+		Advancedoptions: g.Advancedoptions,
+	}
+}
+
+// ToModel copies p into a new OpenVpnClientExportConfig, sharing its slices and pointers.
+func (p *PostVpnOpenVpnClientExportConfigEndpointResponseData) ToModel() *OpenVpnClientExportConfig {
+	if p == nil {
+		return nil
+	}
+	return &OpenVpnClientExportConfig{
+		Server:          p.Server,
+		Useaddr:         p.Useaddr,
+		UseaddrHostname: p.UseaddrHostname,
+		Verifyservercn:  p.Verifyservercn,
+		Blockoutsidedns: p.Blockoutsidedns,
+		Legacy:          p.Legacy,
+		Silent:          p.Silent,
+		Bindmode:        p.Bindmode,
+		Usepkcs11:       p.Usepkcs11,
+		Pkcs11Providers: p.Pkcs11Providers,
+		Pkcs11ID:        p.Pkcs11ID,
+		Usetoken:        p.Usetoken,
+		Usepass:         p.Usepass,
+		Pass:            p.Pass,
+		P12Encryption:   p.P12Encryption,
+		Useproxy:        p.Useproxy,
+		Useproxytype:    p.Useproxytype,
+		Proxyaddr:       p.Proxyaddr,
+		Proxyport:       p.Proxyport,
+		Useproxypass:    p.Useproxypass,
+		Proxyuser:       p.Proxyuser,
+		Proxypass:       p.Proxypass,
+		Advancedoptions: p.Advancedoptions,
+	}
+}
+
+// ToModel copies p into a new OpenVpnClientExportConfig, sharing its slices and pointers.
+func (p *PatchVpnOpenVpnClientExportConfigEndpointResponseData) ToModel() *OpenVpnClientExportConfig {
+	if p == nil {
+		return nil
+	}
+	return &OpenVpnClientExportConfig{
+		Server:          p.Server,
+		Useaddr:         p.Useaddr,
+		UseaddrHostname: p.UseaddrHostname,
+		Verifyservercn:  p.Verifyservercn,
+		Blockoutsidedns: p.Blockoutsidedns,
+		Legacy:          p.Legacy,
+		Silent:          p.Silent,
+		Bindmode:        p.Bindmode,
+		Usepkcs11:       p.Usepkcs11,
+		Pkcs11Providers: p.Pkcs11Providers,
+		Pkcs11ID:        p.Pkcs11ID,
+		Usetoken:        p.Usetoken,
+		Usepass:         p.Usepass,
+		Pass:            p.Pass,
+		P12Encryption:   p.P12Encryption,
+		Useproxy:        p.Useproxy,
+		Useproxytype:    p.Useproxytype,
+		Proxyaddr:       p.Proxyaddr,
+		Proxyport:       p.Proxyport,
+		Useproxypass:    p.Useproxypass,
+		Proxyuser:       p.Proxyuser,
+		Proxypass:       p.Proxypass,
+		Advancedoptions: p.Advancedoptions,
+	}
+}
+
+// ToModel copies g into a new OpenVpnClientExportConfig, sharing its slices and pointers.
+func (g *GetVpnOpenVpnClientExportConfigsEndpointResponseDataItem) ToModel() *OpenVpnClientExportConfig {
+	if g == nil {
+		return nil
+	}
+	return &OpenVpnClientExportConfig{
+		Server:          g.Server,
+		Useaddr:         g.Useaddr,
+		UseaddrHostname: g.UseaddrHostname,
+		Verifyservercn:  g.Verifyservercn,
+		Blockoutsidedns: g.Blockoutsidedns,
+		Legacy:          g.Legacy,
+		Silent:          g.Silent,
+		Bindmode:        g.Bindmode,
+		Usepkcs11:       g.Usepkcs11,
+		Pkcs11Providers: g.Pkcs11Providers,
+		Pkcs11ID:        g.Pkcs11ID,
+		Usetoken:        g.Usetoken,
+		Usepass:         g.Usepass,
+		Pass:            g.Pass,
+		P12Encryption:   g.P12Encryption,
+		Useproxy:        g.Useproxy,
+		Useproxytype:    g.Useproxytype,
+		Proxyaddr:       g.Proxyaddr,
+		Proxyport:       g.Proxyport,
+		Useproxypass:    g.Useproxypass,
+		Proxyuser:       g.Proxyuser,
+		Proxypass:       g.Proxypass,
+		Advancedoptions: g.Advancedoptions,
+	}
+}
+
+// ToPost returns a request that creates a copy of o. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with o.
+func (o *OpenVpnClientSpecificOverride) ToPost() *PostVpnOpenVpncsoEndpointRequest {
+	if o == nil {
+		return &PostVpnOpenVpncsoEndpointRequest{}
+	}
+	return &PostVpnOpenVpncsoEndpointRequest{
+		CommonName:      o.CommonName,
+		Disable:         o.Disable,
+		Block:           o.Block,
+		Description:     o.Description,
+		ServerList:      o.ServerList,
+		TunnelNetwork:   o.TunnelNetwork,
+		TunnelNetworkv6: o.TunnelNetworkv6,
+		LocalNetwork:    o.LocalNetwork,
+		LocalNetworkv6:  o.LocalNetworkv6,
+		RemoteNetwork:   o.RemoteNetwork,
+		RemoteNetworkv6: o.RemoteNetworkv6,
+		Gwredir:         o.Gwredir,
+		PushReset:       o.PushReset,
+		RemoveOptions:   o.RemoveOptions,
+		DNSDomain:       o.DNSDomain,
+		DNSServer1:      o.DNSServer1,
+		DNSServer2:      o.DNSServer2,
+		DNSServer3:      o.DNSServer3,
+		DNSServer4:      o.DNSServer4,
+		NtpServer1:      o.NtpServer1,
+		NtpServer2:      o.NtpServer2,
+		NetbiosEnable:   o.NetbiosEnable,
+		NetbiosNtype:    o.NetbiosNtype,
+		NetbiosScope:    o.NetbiosScope,
+		WinsServer1:     o.WinsServer1,
+		WinsServer2:     o.WinsServer2,
+		CustomOptions:   o.CustomOptions,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with o. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with o.
+func (o *OpenVpnClientSpecificOverride) ToPatch(id int) *PatchVpnOpenVpncsoEndpointRequest {
+	if o == nil {
+		return &PatchVpnOpenVpncsoEndpointRequest{ID: id}
+	}
+	return &PatchVpnOpenVpncsoEndpointRequest{
+		ID:              id,
+		CommonName:      o.CommonName,
+		Disable:         o.Disable,
+		Block:           o.Block,
+		Description:     o.Description,
+		ServerList:      o.ServerList,
+		TunnelNetwork:   o.TunnelNetwork,
+		TunnelNetworkv6: o.TunnelNetworkv6,
+		LocalNetwork:    o.LocalNetwork,
+		LocalNetworkv6:  o.LocalNetworkv6,
+		RemoteNetwork:   o.RemoteNetwork,
+		RemoteNetworkv6: o.RemoteNetworkv6,
+		Gwredir:         o.Gwredir,
+		PushReset:       o.PushReset,
+		RemoveOptions:   o.RemoveOptions,
+		DNSDomain:       o.DNSDomain,
+		DNSServer1:      o.DNSServer1,
+		DNSServer2:      o.DNSServer2,
+		DNSServer3:      o.DNSServer3,
+		DNSServer4:      o.DNSServer4,
+		NtpServer1:      o.NtpServer1,
+		NtpServer2:      o.NtpServer2,
+		NetbiosEnable:   o.NetbiosEnable,
+		NetbiosNtype:    o.NetbiosNtype,
+		NetbiosScope:    o.NetbiosScope,
+		WinsServer1:     o.WinsServer1,
+		WinsServer2:     o.WinsServer2,
+		CustomOptions:   o.CustomOptions,
+	}
+}
+
+// ToModel copies g into a new OpenVpnClientSpecificOverride, sharing its slices and pointers.
+func (g *GetVpnOpenVpncsoEndpointResponseData) ToModel() *OpenVpnClientSpecificOverride {
+	if g == nil {
+		return nil
+	}
+	return &OpenVpnClientSpecificOverride{
+		CommonName:      g.CommonName,
+		Disable:         g.Disable,
+		Block:           g.Block,
+		Description:     g.Description,
+		ServerList:      g.ServerList,
+		TunnelNetwork:   g.TunnelNetwork,
+		TunnelNetworkv6: g.TunnelNetworkv6,
+		LocalNetwork:    g.LocalNetwork,
+		LocalNetworkv6:  g.LocalNetworkv6,
+		RemoteNetwork:   g.RemoteNetwork,
+		RemoteNetworkv6: g.RemoteNetworkv6,
+		Gwredir:         g.Gwredir,
+		PushReset:       g.PushReset,
+		RemoveOptions:   g.RemoveOptions,
+		DNSDomain:       g.DNSDomain,
+		DNSServer1:      g.DNSServer1,
+		DNSServer2:      g.DNSServer2,
+		DNSServer3:      g.DNSServer3,
+		DNSServer4:      g.DNSServer4,
+		NtpServer1:      g.NtpServer1,
+		NtpServer2:      g.NtpServer2,
+		NetbiosEnable:   g.NetbiosEnable,
+		NetbiosNtype:    g.NetbiosNtype,
+		NetbiosScope:    g.NetbiosScope,
+		WinsServer1:     g.WinsServer1,
+		WinsServer2:     g.WinsServer2,
+		CustomOptions:   g.CustomOptions,
+	}
+}
+
+// ToModel copies p into a new OpenVpnClientSpecificOverride, sharing its slices and pointers.
+func (p *PostVpnOpenVpncsoEndpointResponseData) ToModel() *OpenVpnClientSpecificOverride {
+	if p == nil {
+		return nil
+	}
+	return &OpenVpnClientSpecificOverride{
+		CommonName:      p.CommonName,
+		Disable:         p.Disable,
+		Block:           p.Block,
+		Description:     p.Description,
+		ServerList:      p.ServerList,
+		TunnelNetwork:   p.TunnelNetwork,
+		TunnelNetworkv6: p.TunnelNetworkv6,
+		LocalNetwork:    p.LocalNetwork,
+		LocalNetworkv6:  p.LocalNetworkv6,
+		RemoteNetwork:   p.RemoteNetwork,
+		RemoteNetworkv6: p.RemoteNetworkv6,
+		Gwredir:         p.Gwredir,
+		PushReset:       p.PushReset,
+		RemoveOptions:   p.RemoveOptions,
+		DNSDomain:       p.DNSDomain,
+		DNSServer1:      p.DNSServer1,
+		DNSServer2:      p.DNSServer2,
+		DNSServer3:      p.DNSServer3,
+		DNSServer4:      p.DNSServer4,
+		NtpServer1:      p.NtpServer1,
+		NtpServer2:      p.NtpServer2,
+		NetbiosEnable:   p.NetbiosEnable,
+		NetbiosNtype:    p.NetbiosNtype,
+		NetbiosScope:    p.NetbiosScope,
+		WinsServer1:     p.WinsServer1,
+		WinsServer2:     p.WinsServer2,
+		CustomOptions:   p.CustomOptions,
+	}
+}
+
+// ToModel copies p into a new OpenVpnClientSpecificOverride, sharing its slices and pointers.
+func (p *PatchVpnOpenVpncsoEndpointResponseData) ToModel() *OpenVpnClientSpecificOverride {
+	if p == nil {
+		return nil
+	}
+	return &OpenVpnClientSpecificOverride{
+		CommonName:      p.CommonName,
+		Disable:         p.Disable,
+		Block:           p.Block,
+		Description:     p.Description,
+		ServerList:      p.ServerList,
+		TunnelNetwork:   p.TunnelNetwork,
+		TunnelNetworkv6: p.TunnelNetworkv6,
+		LocalNetwork:    p.LocalNetwork,
+		LocalNetworkv6:  p.LocalNetworkv6,
+		RemoteNetwork:   p.RemoteNetwork,
+		RemoteNetworkv6: p.RemoteNetworkv6,
+		Gwredir:         p.Gwredir,
+		PushReset:       p.PushReset,
+		RemoveOptions:   p.RemoveOptions,
+		DNSDomain:       p.DNSDomain,
+		DNSServer1:      p.DNSServer1,
+		DNSServer2:      p.DNSServer2,
+		DNSServer3:      p.DNSServer3,
+		DNSServer4:      p.DNSServer4,
+		NtpServer1:      p.NtpServer1,
+		NtpServer2:      p.NtpServer2,
+		NetbiosEnable:   p.NetbiosEnable,
+		NetbiosNtype:    p.NetbiosNtype,
+		NetbiosScope:    p.NetbiosScope,
+		WinsServer1:     p.WinsServer1,
+		WinsServer2:     p.WinsServer2,
+		CustomOptions:   p.CustomOptions,
+	}
+}
+
+// ToModel copies g into a new OpenVpnClientSpecificOverride, sharing its slices and pointers.
+func (g *GetVpnOpenVpncsOsEndpointResponseDataItem) ToModel() *OpenVpnClientSpecificOverride {
+	if g == nil {
+		return nil
+	}
+	return &OpenVpnClientSpecificOverride{
+		CommonName:      g.CommonName,
+		Disable:         g.Disable,
+		Block:           g.Block,
+		Description:     g.Description,
+		ServerList:      g.ServerList,
+		TunnelNetwork:   g.TunnelNetwork,
+		TunnelNetworkv6: g.TunnelNetworkv6,
+		LocalNetwork:    g.LocalNetwork,
+		LocalNetworkv6:  g.LocalNetworkv6,
+		RemoteNetwork:   g.RemoteNetwork,
+		RemoteNetworkv6: g.RemoteNetworkv6,
+		Gwredir:         g.Gwredir,
+		PushReset:       g.PushReset,
+		RemoveOptions:   g.RemoveOptions,
+		DNSDomain:       g.DNSDomain,
+		DNSServer1:      g.DNSServer1,
+		DNSServer2:      g.DNSServer2,
+		DNSServer3:      g.DNSServer3,
+		DNSServer4:      g.DNSServer4,
+		NtpServer1:      g.NtpServer1,
+		NtpServer2:      g.NtpServer2,
+		NetbiosEnable:   g.NetbiosEnable,
+		NetbiosNtype:    g.NetbiosNtype,
+		NetbiosScope:    g.NetbiosScope,
+		WinsServer1:     g.WinsServer1,
+		WinsServer2:     g.WinsServer2,
+		CustomOptions:   g.CustomOptions,
+	}
+}
+
+// ToPost returns a request that creates a copy of o. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with o.
+func (o *OpenVpnClient) ToPost() *PostVpnOpenVpnClientEndpointRequest {
+	if o == nil {
+		return &PostVpnOpenVpnClientEndpointRequest{}
+	}
+	return &PostVpnOpenVpnClientEndpointRequest{
+		Vpnid:               o.Vpnid,
+		Vpnif:               o.Vpnif,
+		Description:         o.Description,
+		Disable:             o.Disable,
+		Mode:                o.Mode,
+		DevMode:             o.DevMode,
+		Protocol:            o.Protocol,
+		Interface:           o.Interface,
+		ServerAddr:          o.ServerAddr,
+		ServerPort:          o.ServerPort,
+		LocalPort:           o.LocalPort,
+		ProxyAddr:           o.ProxyAddr,
+		ProxyPort:           o.ProxyPort,
+		ProxyAuthtype:       o.ProxyAuthtype,
+		ProxyUser:           o.ProxyUser,
+		ProxyPasswd:         o.ProxyPasswd,
+		AuthUser:            o.AuthUser,
+		AuthPass:            o.AuthPass,
+		AuthRetryNone:       o.AuthRetryNone,
+		TLS:                 o.TLS,
+		TLSType:             o.TLSType,
+		TlsauthKeydir:       o.TlsauthKeydir,
+		Caref:               o.Caref,
+		Certref:             o.Certref,
+		DataCiphers:         o.DataCiphers,
+		DataCiphersFallback: o.DataCiphersFallback,
+		Digest:              o.Digest,
+		RemoteCertTLS:       o.RemoteCertTLS,
+		TunnelNetwork:       o.TunnelNetwork,
+		TunnelNetworkv6:     o.TunnelNetworkv6,
+		RemoteNetwork:       o.RemoteNetwork,
+		RemoteNetworkv6:     o.RemoteNetworkv6,
+		UseShaper:           o.UseShaper,
+		AllowCompression:    o.AllowCompression,
+		Passtos:             o.Passtos,
+		RouteNoPull:         o.RouteNoPull,
+		RouteNoExec:         o.RouteNoExec,
+		DNSAdd:              o.DNSAdd,
+		Topology:            o.Topology,
+		InactiveSeconds:     o.InactiveSeconds,
+		PingMethod:          o.PingMethod,
+		KeepaliveInterval:   o.KeepaliveInterval,
+		KeepaliveTimeout:    o.KeepaliveTimeout,
+		PingSeconds:         o.PingSeconds,
+		PingAction:          o.PingAction,
+		PingActionSeconds:   o.PingActionSeconds,
+		CustomOptions:       o.CustomOptions,
+		UDPFastIo:           o.UDPFastIo,
+		ExitNotify:          o.ExitNotify,
+		Sndrcvbuf:           o.Sndrcvbuf,
+		CreateGw:            o.CreateGw,
+		VerbosityLevel:      o.VerbosityLevel,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with o. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with o.
+func (o *OpenVpnClient) ToPatch(id int) *PatchVpnOpenVpnClientEndpointRequest {
+	if o == nil {
+		return &PatchVpnOpenVpnClientEndpointRequest{ID: id}
+	}
+	return &PatchVpnOpenVpnClientEndpointRequest{
+		ID:                  id,
+		Vpnid:               o.Vpnid,
+		Vpnif:               o.Vpnif,
+		Description:         o.Description,
+		Disable:             o.Disable,
+		Mode:                o.Mode,
+		DevMode:             o.DevMode,
+		Protocol:            o.Protocol,
+		Interface:           o.Interface,
+		ServerAddr:          o.ServerAddr,
+		ServerPort:          o.ServerPort,
+		LocalPort:           o.LocalPort,
+		ProxyAddr:           o.ProxyAddr,
+		ProxyPort:           o.ProxyPort,
+		ProxyAuthtype:       o.ProxyAuthtype,
+		ProxyUser:           o.ProxyUser,
+		ProxyPasswd:         o.ProxyPasswd,
+		AuthUser:            o.AuthUser,
+		AuthPass:            o.AuthPass,
+		AuthRetryNone:       o.AuthRetryNone,
+		TLS:                 o.TLS,
+		TLSType:             o.TLSType,
+		TlsauthKeydir:       o.TlsauthKeydir,
+		Caref:               o.Caref,
+		Certref:             o.Certref,
+		DataCiphers:         o.DataCiphers,
+		DataCiphersFallback: o.DataCiphersFallback,
+		Digest:              o.Digest,
+		RemoteCertTLS:       o.RemoteCertTLS,
+		TunnelNetwork:       o.TunnelNetwork,
+		TunnelNetworkv6:     o.TunnelNetworkv6,
+		RemoteNetwork:       o.RemoteNetwork,
+		RemoteNetworkv6:     o.RemoteNetworkv6,
+		UseShaper:           o.UseShaper,
+		AllowCompression:    o.AllowCompression,
+		Passtos:             o.Passtos,
+		RouteNoPull:         o.RouteNoPull,
+		RouteNoExec:         o.RouteNoExec,
+		DNSAdd:              o.DNSAdd,
+		Topology:            o.Topology,
+		InactiveSeconds:     o.InactiveSeconds,
+		PingMethod:          o.PingMethod,
+		KeepaliveInterval:   o.KeepaliveInterval,
+		KeepaliveTimeout:    o.KeepaliveTimeout,
+		PingSeconds:         o.PingSeconds,
+		PingAction:          o.PingAction,
+		PingActionSeconds:   o.PingActionSeconds,
+		CustomOptions:       o.CustomOptions,
+		UDPFastIo:           o.UDPFastIo,
+		ExitNotify:          o.ExitNotify,
+		Sndrcvbuf:           o.Sndrcvbuf,
+		CreateGw:            o.CreateGw,
+		VerbosityLevel:      o.VerbosityLevel,
+	}
+}
+
+// ToModel copies g into a new OpenVpnClient, sharing its slices and pointers.
+func (g *GetVpnOpenVpnClientEndpointResponseData) ToModel() *OpenVpnClient {
+	if g == nil {
+		return nil
+	}
+	return &OpenVpnClient{
+		Vpnid:               g.Vpnid,
+		Vpnif:               g.Vpnif,
+		Description:         g.Description,
+		Disable:             g.Disable,
+		Mode:                g.Mode,
+		DevMode:             g.DevMode,
+		Protocol:            g.Protocol,
+		Interface:           g.Interface,
+		ServerAddr:          g.ServerAddr,
+		ServerPort:          g.ServerPort,
+		LocalPort:           g.LocalPort,
+		ProxyAddr:           g.ProxyAddr,
+		ProxyPort:           g.ProxyPort,
+		ProxyAuthtype:       g.ProxyAuthtype,
+		ProxyUser:           g.ProxyUser,
+		ProxyPasswd:         g.ProxyPasswd,
+		AuthUser:            g.AuthUser,
+		AuthPass:            g.AuthPass,
+		AuthRetryNone:       g.AuthRetryNone,
+		TLS:                 g.TLS,
+		TLSType:             g.TLSType,
+		TlsauthKeydir:       g.TlsauthKeydir,
+		Caref:               g.Caref,
+		Certref:             g.Certref,
+		DataCiphers:         g.DataCiphers,
+		DataCiphersFallback: g.DataCiphersFallback,
+		Digest:              g.Digest,
+		RemoteCertTLS:       g.RemoteCertTLS,
+		TunnelNetwork:       g.TunnelNetwork,
+		TunnelNetworkv6:     g.TunnelNetworkv6,
+		RemoteNetwork:       g.RemoteNetwork,
+		RemoteNetworkv6:     g.RemoteNetworkv6,
+		UseShaper:           g.UseShaper,
+		AllowCompression:    g.AllowCompression,
+		Passtos:             g.Passtos,
+		RouteNoPull:         g.RouteNoPull,
+		RouteNoExec:         g.RouteNoExec,
+		DNSAdd:              g.DNSAdd,
+		Topology:            g.Topology,
+		InactiveSeconds:     g.InactiveSeconds,
+		PingMethod:          g.PingMethod,
+		KeepaliveInterval:   g.KeepaliveInterval,
+		KeepaliveTimeout:    g.KeepaliveTimeout,
+		PingSeconds:         g.PingSeconds,
+		PingAction:          g.PingAction,
+		PingActionSeconds:   g.PingActionSeconds,
+		CustomOptions:       g.CustomOptions,
+		UDPFastIo:           g.UDPFastIo,
+		ExitNotify:          g.ExitNotify,
+		Sndrcvbuf:           g.Sndrcvbuf,
+		CreateGw:            g.CreateGw,
+		VerbosityLevel:      g.VerbosityLevel,
+	}
+}
+
+// ToModel copies p into a new OpenVpnClient, sharing its slices and pointers.
+func (p *PostVpnOpenVpnClientEndpointResponseData) ToModel() *OpenVpnClient {
+	if p == nil {
+		return nil
+	}
+	return &OpenVpnClient{
+		Vpnid:               p.Vpnid,
+		Vpnif:               p.Vpnif,
+		Description:         p.Description,
+		Disable:             p.Disable,
+		Mode:                p.Mode,
+		DevMode:             p.DevMode,
+		Protocol:            p.Protocol,
+		Interface:           p.Interface,
+		ServerAddr:          p.ServerAddr,
+		ServerPort:          p.ServerPort,
+		LocalPort:           p.LocalPort,
+		ProxyAddr:           p.ProxyAddr,
+		ProxyPort:           p.ProxyPort,
+		ProxyAuthtype:       p.ProxyAuthtype,
+		ProxyUser:           p.ProxyUser,
+		ProxyPasswd:         p.ProxyPasswd,
+		AuthUser:            p.AuthUser,
+		AuthPass:            p.AuthPass,
+		AuthRetryNone:       p.AuthRetryNone,
+		TLS:                 p.TLS,
+		TLSType:             p.TLSType,
+		TlsauthKeydir:       p.TlsauthKeydir,
+		Caref:               p.Caref,
+		Certref:             p.Certref,
+		DataCiphers:         p.DataCiphers,
+		DataCiphersFallback: p.DataCiphersFallback,
+		Digest:              p.Digest,
+		RemoteCertTLS:       p.RemoteCertTLS,
+		TunnelNetwork:       p.TunnelNetwork,
+		TunnelNetworkv6:     p.TunnelNetworkv6,
+		RemoteNetwork:       p.RemoteNetwork,
+		RemoteNetworkv6:     p.RemoteNetworkv6,
+		UseShaper:           p.UseShaper,
+		AllowCompression:    p.AllowCompression,
+		Passtos:             p.Passtos,
+		RouteNoPull:         p.RouteNoPull,
+		RouteNoExec:         p.RouteNoExec,
+		DNSAdd:              p.DNSAdd,
+		Topology:            p.Topology,
+		InactiveSeconds:     p.InactiveSeconds,
+		PingMethod:          p.PingMethod,
+		KeepaliveInterval:   p.KeepaliveInterval,
+		KeepaliveTimeout:    p.KeepaliveTimeout,
+		PingSeconds:         p.PingSeconds,
+		PingAction:          p.PingAction,
+		PingActionSeconds:   p.PingActionSeconds,
+		CustomOptions:       p.CustomOptions,
+		UDPFastIo:           p.UDPFastIo,
+		ExitNotify:          p.ExitNotify,
+		Sndrcvbuf:           p.Sndrcvbuf,
+		CreateGw:            p.CreateGw,
+		VerbosityLevel:      p.VerbosityLevel,
+	}
+}
+
+// ToModel copies p into a new OpenVpnClient, sharing its slices and pointers.
+func (p *PatchVpnOpenVpnClientEndpointResponseData) ToModel() *OpenVpnClient {
+	if p == nil {
+		return nil
+	}
+	return &OpenVpnClient{
+		Vpnid:               p.Vpnid,
+		Vpnif:               p.Vpnif,
+		Description:         p.Description,
+		Disable:             p.Disable,
+		Mode:                p.Mode,
+		DevMode:             p.DevMode,
+		Protocol:            p.Protocol,
+		Interface:           p.Interface,
+		ServerAddr:          p.ServerAddr,
+		ServerPort:          p.ServerPort,
+		LocalPort:           p.LocalPort,
+		ProxyAddr:           p.ProxyAddr,
+		ProxyPort:           p.ProxyPort,
+		ProxyAuthtype:       p.ProxyAuthtype,
+		ProxyUser:           p.ProxyUser,
+		ProxyPasswd:         p.ProxyPasswd,
+		AuthUser:            p.AuthUser,
+		AuthPass:            p.AuthPass,
+		AuthRetryNone:       p.AuthRetryNone,
+		TLS:                 p.TLS,
+		TLSType:             p.TLSType,
+		TlsauthKeydir:       p.TlsauthKeydir,
+		Caref:               p.Caref,
+		Certref:             p.Certref,
+		DataCiphers:         p.DataCiphers,
+		DataCiphersFallback: p.DataCiphersFallback,
+		Digest:              p.Digest,
+		RemoteCertTLS:       p.RemoteCertTLS,
+		TunnelNetwork:       p.TunnelNetwork,
+		TunnelNetworkv6:     p.TunnelNetworkv6,
+		RemoteNetwork:       p.RemoteNetwork,
+		RemoteNetworkv6:     p.RemoteNetworkv6,
+		UseShaper:           p.UseShaper,
+		AllowCompression:    p.AllowCompression,
+		Passtos:             p.Passtos,
+		RouteNoPull:         p.RouteNoPull,
+		RouteNoExec:         p.RouteNoExec,
+		DNSAdd:              p.DNSAdd,
+		Topology:            p.Topology,
+		InactiveSeconds:     p.InactiveSeconds,
+		PingMethod:          p.PingMethod,
+		KeepaliveInterval:   p.KeepaliveInterval,
+		KeepaliveTimeout:    p.KeepaliveTimeout,
+		PingSeconds:         p.PingSeconds,
+		PingAction:          p.PingAction,
+		PingActionSeconds:   p.PingActionSeconds,
+		CustomOptions:       p.CustomOptions,
+		UDPFastIo:           p.UDPFastIo,
+		ExitNotify:          p.ExitNotify,
+		Sndrcvbuf:           p.Sndrcvbuf,
+		CreateGw:            p.CreateGw,
+		VerbosityLevel:      p.VerbosityLevel,
+	}
+}
+
+// ToModel copies g into a new OpenVpnClient, sharing its slices and pointers.
+func (g *GetVpnOpenVpnClientsEndpointResponseDataItem) ToModel() *OpenVpnClient {
+	if g == nil {
+		return nil
+	}
+	return &OpenVpnClient{
+		Vpnid:               g.Vpnid,
+		Vpnif:               g.Vpnif,
+		Description:         g.Description,
+		Disable:             g.Disable,
+		Mode:                g.Mode,
+		DevMode:             g.DevMode,
+		Protocol:            g.Protocol,
+		Interface:           g.Interface,
+		ServerAddr:          g.ServerAddr,
+		ServerPort:          g.ServerPort,
+		LocalPort:           g.LocalPort,
+		ProxyAddr:           g.ProxyAddr,
+		ProxyPort:           g.ProxyPort,
+		ProxyAuthtype:       g.ProxyAuthtype,
+		ProxyUser:           g.ProxyUser,
+		ProxyPasswd:         g.ProxyPasswd,
+		AuthUser:            g.AuthUser,
+		AuthPass:            g.AuthPass,
+		AuthRetryNone:       g.AuthRetryNone,
+		TLS:                 g.TLS,
+		TLSType:             g.TLSType,
+		TlsauthKeydir:       g.TlsauthKeydir,
+		Caref:               g.Caref,
+		Certref:             g.Certref,
+		DataCiphers:         g.DataCiphers,
+		DataCiphersFallback: g.DataCiphersFallback,
+		Digest:              g.Digest,
+		RemoteCertTLS:       g.RemoteCertTLS,
+		TunnelNetwork:       g.TunnelNetwork,
+		TunnelNetworkv6:     g.TunnelNetworkv6,
+		RemoteNetwork:       g.RemoteNetwork,
+		RemoteNetworkv6:     g.RemoteNetworkv6,
+		UseShaper:           g.UseShaper,
+		AllowCompression:    g.AllowCompression,
+		Passtos:             g.Passtos,
+		RouteNoPull:         g.RouteNoPull,
+		RouteNoExec:         g.RouteNoExec,
+		DNSAdd:              g.DNSAdd,
+		Topology:            g.Topology,
+		InactiveSeconds:     g.InactiveSeconds,
+		PingMethod:          g.PingMethod,
+		KeepaliveInterval:   g.KeepaliveInterval,
+		KeepaliveTimeout:    g.KeepaliveTimeout,
+		PingSeconds:         g.PingSeconds,
+		PingAction:          g.PingAction,
+		PingActionSeconds:   g.PingActionSeconds,
+		CustomOptions:       g.CustomOptions,
+		UDPFastIo:           g.UDPFastIo,
+		ExitNotify:          g.ExitNotify,
+		Sndrcvbuf:           g.Sndrcvbuf,
+		CreateGw:            g.CreateGw,
+		VerbosityLevel:      g.VerbosityLevel,
+	}
+}
+
+// ToPost returns a request that creates a copy of o. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with o.
+func (o *OpenVpnServer) ToPost() *PostVpnOpenVpnServerEndpointRequest {
+	if o == nil {
+		return &PostVpnOpenVpnServerEndpointRequest{}
+	}
+	return &PostVpnOpenVpnServerEndpointRequest{
+		Vpnid:                    o.Vpnid,
+		Vpnif:                    o.Vpnif,
+		Description:              o.Description,
+		Disable:                  o.Disable,
+		Mode:                     o.Mode,
+		Authmode:                 o.Authmode,
+		DevMode:                  o.DevMode,
+		Protocol:                 o.Protocol,
+		Interface:                o.Interface,
+		LocalPort:                o.LocalPort,
+		UseTLS:                   o.UseTLS,
+		TLS:                      o.TLS,
+		TLSType:                  o.TLSType,
+		TlsauthKeydir:            o.TlsauthKeydir,
+		Caref:                    o.Caref,
+		Certref:                  o.Certref,
+		CertDepth:                o.CertDepth,
+		DhLength:                 o.DhLength,
+		EcdhCurve:                o.EcdhCurve,
+		DataCiphers:              o.DataCiphers,
+		DataCiphersFallback:      o.DataCiphersFallback,
+		Digest:                   o.Digest,
+		Strictusercn:             o.Strictusercn,
+		RemoteCertTLS:            o.RemoteCertTLS,
+		TunnelNetwork:            o.TunnelNetwork,
+		TunnelNetworkv6:          o.TunnelNetworkv6,
+		ServerbridgeDhcp:         o.ServerbridgeDhcp,
+		ServerbridgeInterface:    o.ServerbridgeInterface,
+		ServerbridgeRoutegateway: o.ServerbridgeRoutegateway,
+		ServerbridgeDhcpStart:    o.ServerbridgeDhcpStart,
+		ServerbridgeDhcpEnd:      o.ServerbridgeDhcpEnd,
+		Gwredir:                  o.Gwredir,
+		Gwredir6:                 o.Gwredir6,
+		LocalNetwork:             o.LocalNetwork,
+		LocalNetworkv6:           o.LocalNetworkv6,
+		RemoteNetwork:            o.RemoteNetwork,
+		RemoteNetworkv6:          o.RemoteNetworkv6,
+		Maxclients:               o.Maxclients,
+		AllowCompression:         o.AllowCompression,
+		Passtos:                  o.Passtos,
+		Client2Client:            o.Client2Client,
+		DuplicateCn:              o.DuplicateCn,
+		Connlimit:                o.Connlimit,
+		DynamicIP:                o.DynamicIP,
+		Topology:                 o.Topology,
+		InactiveSeconds:          o.InactiveSeconds,
+		PingMethod:               o.PingMethod,
+		KeepaliveInterval:        o.KeepaliveInterval,
+		KeepaliveTimeout:         o.KeepaliveTimeout,
+		PingSeconds:              o.PingSeconds,
+		PingPush:                 o.PingPush,
+		PingAction:               o.PingAction,
+		PingActionSeconds:        o.PingActionSeconds,
+		PingActionPush:           o.PingActionPush,
+		DNSDomain:                o.DNSDomain,
+		DNSServer1:               o.DNSServer1,
+		DNSServer2:               o.DNSServer2,
+		DNSServer3:               o.DNSServer3,
+		DNSServer4:               o.DNSServer4,
+		PushBlockoutsidedns:      o.PushBlockoutsidedns,
+		PushRegisterDNS:          o.PushRegisterDNS,
+		NtpServer1:               o.NtpServer1,
+		NtpServer2:               o.NtpServer2,
+		NetbiosEnable:            o.NetbiosEnable,
+		NetbiosNtype:             o.NetbiosNtype,
+		NetbiosScope:             o.NetbiosScope,
+		WinsServer1:              o.WinsServer1,
+		WinsServer2:              o.WinsServer2,
+		CustomOptions:            o.CustomOptions,
+		UsernameAsCommonName:     o.UsernameAsCommonName,
+		Sndrcvbuf:                o.Sndrcvbuf,
+		CreateGw:                 o.CreateGw,
+		VerbosityLevel:           o.VerbosityLevel,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with o. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with o.
+func (o *OpenVpnServer) ToPatch(id int) *PatchVpnOpenVpnServerEndpointRequest {
+	if o == nil {
+		return &PatchVpnOpenVpnServerEndpointRequest{ID: id}
+	}
+	return &PatchVpnOpenVpnServerEndpointRequest{
+		ID:                       id,
+		Vpnid:                    o.Vpnid,
+		Vpnif:                    o.Vpnif,
+		Description:              o.Description,
+		Disable:                  o.Disable,
+		Mode:                     o.Mode,
+		Authmode:                 o.Authmode,
+		DevMode:                  o.DevMode,
+		Protocol:                 o.Protocol,
+		Interface:                o.Interface,
+		LocalPort:                o.LocalPort,
+		UseTLS:                   o.UseTLS,
+		TLS:                      o.TLS,
+		TLSType:                  o.TLSType,
+		TlsauthKeydir:            o.TlsauthKeydir,
+		Caref:                    o.Caref,
+		Certref:                  o.Certref,
+		CertDepth:                o.CertDepth,
+		DhLength:                 o.DhLength,
+		EcdhCurve:                o.EcdhCurve,
+		DataCiphers:              o.DataCiphers,
+		DataCiphersFallback:      o.DataCiphersFallback,
+		Digest:                   o.Digest,
+		Strictusercn:             o.Strictusercn,
+		RemoteCertTLS:            o.RemoteCertTLS,
+		TunnelNetwork:            o.TunnelNetwork,
+		TunnelNetworkv6:          o.TunnelNetworkv6,
+		ServerbridgeDhcp:         o.ServerbridgeDhcp,
+		ServerbridgeInterface:    o.ServerbridgeInterface,
+		ServerbridgeRoutegateway: o.ServerbridgeRoutegateway,
+		ServerbridgeDhcpStart:    o.ServerbridgeDhcpStart,
+		ServerbridgeDhcpEnd:      o.ServerbridgeDhcpEnd,
+		Gwredir:                  o.Gwredir,
+		Gwredir6:                 o.Gwredir6,
+		LocalNetwork:             o.LocalNetwork,
+		LocalNetworkv6:           o.LocalNetworkv6,
+		RemoteNetwork:            o.RemoteNetwork,
+		RemoteNetworkv6:          o.RemoteNetworkv6,
+		Maxclients:               o.Maxclients,
+		AllowCompression:         o.AllowCompression,
+		Passtos:                  o.Passtos,
+		Client2Client:            o.Client2Client,
+		DuplicateCn:              o.DuplicateCn,
+		Connlimit:                o.Connlimit,
+		DynamicIP:                o.DynamicIP,
+		Topology:                 o.Topology,
+		InactiveSeconds:          o.InactiveSeconds,
+		PingMethod:               o.PingMethod,
+		KeepaliveInterval:        o.KeepaliveInterval,
+		KeepaliveTimeout:         o.KeepaliveTimeout,
+		PingSeconds:              o.PingSeconds,
+		PingPush:                 o.PingPush,
+		PingAction:               o.PingAction,
+		PingActionSeconds:        o.PingActionSeconds,
+		PingActionPush:           o.PingActionPush,
+		DNSDomain:                o.DNSDomain,
+		DNSServer1:               o.DNSServer1,
+		DNSServer2:               o.DNSServer2,
+		DNSServer3:               o.DNSServer3,
+		DNSServer4:               o.DNSServer4,
+		PushBlockoutsidedns:      o.PushBlockoutsidedns,
+		PushRegisterDNS:          o.PushRegisterDNS,
+		NtpServer1:               o.NtpServer1,
+		NtpServer2:               o.NtpServer2,
+		NetbiosEnable:            o.NetbiosEnable,
+		NetbiosNtype:             o.NetbiosNtype,
+		NetbiosScope:             o.NetbiosScope,
+		WinsServer1:              o.WinsServer1,
+		WinsServer2:              o.WinsServer2,
+		CustomOptions:            o.CustomOptions,
+		UsernameAsCommonName:     o.UsernameAsCommonName,
+		Sndrcvbuf:                o.Sndrcvbuf,
+		CreateGw:                 o.CreateGw,
+		VerbosityLevel:           o.VerbosityLevel,
+	}
+}
+
+// ToModel copies g into a new OpenVpnServer, sharing its slices and pointers.
+func (g *GetVpnOpenVpnServerEndpointResponseData) ToModel() *OpenVpnServer {
+	if g == nil {
+		return nil
+	}
+	return &OpenVpnServer{
+		Vpnid:                    g.Vpnid,
+		Vpnif:                    g.Vpnif,
+		Description:              g.Description,
+		Disable:                  g.Disable,
+		Mode:                     g.Mode,
+		Authmode:                 g.Authmode,
+		DevMode:                  g.DevMode,
+		Protocol:                 g.Protocol,
+		Interface:                g.Interface,
+		LocalPort:                g.LocalPort,
+		UseTLS:                   g.UseTLS,
+		TLS:                      g.TLS,
+		TLSType:                  g.TLSType,
+		TlsauthKeydir:            g.TlsauthKeydir,
+		Caref:                    g.Caref,
+		Certref:                  g.Certref,
+		CertDepth:                g.CertDepth,
+		DhLength:                 g.DhLength,
+		EcdhCurve:                g.EcdhCurve,
+		DataCiphers:              g.DataCiphers,
+		DataCiphersFallback:      g.DataCiphersFallback,
+		Digest:                   g.Digest,
+		Strictusercn:             g.Strictusercn,
+		RemoteCertTLS:            g.RemoteCertTLS,
+		TunnelNetwork:            g.TunnelNetwork,
+		TunnelNetworkv6:          g.TunnelNetworkv6,
+		ServerbridgeDhcp:         g.ServerbridgeDhcp,
+		ServerbridgeInterface:    g.ServerbridgeInterface,
+		ServerbridgeRoutegateway: g.ServerbridgeRoutegateway,
+		ServerbridgeDhcpStart:    g.ServerbridgeDhcpStart,
+		ServerbridgeDhcpEnd:      g.ServerbridgeDhcpEnd,
+		Gwredir:                  g.Gwredir,
+		Gwredir6:                 g.Gwredir6,
+		LocalNetwork:             g.LocalNetwork,
+		LocalNetworkv6:           g.LocalNetworkv6,
+		RemoteNetwork:            g.RemoteNetwork,
+		RemoteNetworkv6:          g.RemoteNetworkv6,
+		Maxclients:               g.Maxclients,
+		AllowCompression:         g.AllowCompression,
+		Passtos:                  g.Passtos,
+		Client2Client:            g.Client2Client,
+		DuplicateCn:              g.DuplicateCn,
+		Connlimit:                g.Connlimit,
+		DynamicIP:                g.DynamicIP,
+		Topology:                 g.Topology,
+		InactiveSeconds:          g.InactiveSeconds,
+		PingMethod:               g.PingMethod,
+		KeepaliveInterval:        g.KeepaliveInterval,
+		KeepaliveTimeout:         g.KeepaliveTimeout,
+		PingSeconds:              g.PingSeconds,
+		PingPush:                 g.PingPush,
+		PingAction:               g.PingAction,
+		PingActionSeconds:        g.PingActionSeconds,
+		PingActionPush:           g.PingActionPush,
+		DNSDomain:                g.DNSDomain,
+		DNSServer1:               g.DNSServer1,
+		DNSServer2:               g.DNSServer2,
+		DNSServer3:               g.DNSServer3,
+		DNSServer4:               g.DNSServer4,
+		PushBlockoutsidedns:      g.PushBlockoutsidedns,
+		PushRegisterDNS:          g.PushRegisterDNS,
+		NtpServer1:               g.NtpServer1,
+		NtpServer2:               g.NtpServer2,
+		NetbiosEnable:            g.NetbiosEnable,
+		NetbiosNtype:             g.NetbiosNtype,
+		NetbiosScope:             g.NetbiosScope,
+		WinsServer1:              g.WinsServer1,
+		WinsServer2:              g.WinsServer2,
+		CustomOptions:            g.CustomOptions,
+		UsernameAsCommonName:     g.UsernameAsCommonName,
+		Sndrcvbuf:                g.Sndrcvbuf,
+		CreateGw:                 g.CreateGw,
+		VerbosityLevel:           g.VerbosityLevel,
+	}
+}
+
+// ToModel copies p into a new OpenVpnServer, sharing its slices and pointers.
+func (p *PostVpnOpenVpnServerEndpointResponseData) ToModel() *OpenVpnServer {
+	if p == nil {
+		return nil
+	}
+	return &OpenVpnServer{
+		Vpnid:                    p.Vpnid,
+		Vpnif:                    p.Vpnif,
+		Description:              p.Description,
+		Disable:                  p.Disable,
+		Mode:                     p.Mode,
+		Authmode:                 p.Authmode,
+		DevMode:                  p.DevMode,
+		Protocol:                 p.Protocol,
+		Interface:                p.Interface,
+		LocalPort:                p.LocalPort,
+		UseTLS:                   p.UseTLS,
+		TLS:                      p.TLS,
+		TLSType:                  p.TLSType,
+		TlsauthKeydir:            p.TlsauthKeydir,
+		Caref:                    p.Caref,
+		Certref:                  p.Certref,
+		CertDepth:                p.CertDepth,
+		DhLength:                 p.DhLength,
+		EcdhCurve:                p.EcdhCurve,
+		DataCiphers:              p.DataCiphers,
+		DataCiphersFallback:      p.DataCiphersFallback,
+		Digest:                   p.Digest,
+		Strictusercn:             p.Strictusercn,
+		RemoteCertTLS:            p.RemoteCertTLS,
+		TunnelNetwork:            p.TunnelNetwork,
+		TunnelNetworkv6:          p.TunnelNetworkv6,
+		ServerbridgeDhcp:         p.ServerbridgeDhcp,
+		ServerbridgeInterface:    p.ServerbridgeInterface,
+		ServerbridgeRoutegateway: p.ServerbridgeRoutegateway,
+		ServerbridgeDhcpStart:    p.ServerbridgeDhcpStart,
+		ServerbridgeDhcpEnd:      p.ServerbridgeDhcpEnd,
+		Gwredir:                  p.Gwredir,
+		Gwredir6:                 p.Gwredir6,
+		LocalNetwork:             p.LocalNetwork,
+		LocalNetworkv6:           p.LocalNetworkv6,
+		RemoteNetwork:            p.RemoteNetwork,
+		RemoteNetworkv6:          p.RemoteNetworkv6,
+		Maxclients:               p.Maxclients,
+		AllowCompression:         p.AllowCompression,
+		Passtos:                  p.Passtos,
+		Client2Client:            p.Client2Client,
+		DuplicateCn:              p.DuplicateCn,
+		Connlimit:                p.Connlimit,
+		DynamicIP:                p.DynamicIP,
+		Topology:                 p.Topology,
+		InactiveSeconds:          p.InactiveSeconds,
+		PingMethod:               p.PingMethod,
+		KeepaliveInterval:        p.KeepaliveInterval,
+		KeepaliveTimeout:         p.KeepaliveTimeout,
+		PingSeconds:              p.PingSeconds,
+		PingPush:                 p.PingPush,
+		PingAction:               p.PingAction,
+		PingActionSeconds:        p.PingActionSeconds,
+		PingActionPush:           p.PingActionPush,
+		DNSDomain:                p.DNSDomain,
+		DNSServer1:               p.DNSServer1,
+		DNSServer2:               p.DNSServer2,
+		DNSServer3:               p.DNSServer3,
+		DNSServer4:               p.DNSServer4,
+		PushBlockoutsidedns:      p.PushBlockoutsidedns,
+		PushRegisterDNS:          p.PushRegisterDNS,
+		NtpServer1:               p.NtpServer1,
+		NtpServer2:               p.NtpServer2,
+		NetbiosEnable:            p.NetbiosEnable,
+		NetbiosNtype:             p.NetbiosNtype,
+		NetbiosScope:             p.NetbiosScope,
+		WinsServer1:              p.WinsServer1,
+		WinsServer2:              p.WinsServer2,
+		CustomOptions:            p.CustomOptions,
+		UsernameAsCommonName:     p.UsernameAsCommonName,
+		Sndrcvbuf:                p.Sndrcvbuf,
+		CreateGw:                 p.CreateGw,
+		VerbosityLevel:           p.VerbosityLevel,
+	}
+}
+
+// ToModel copies p into a new OpenVpnServer, sharing its slices and pointers.
+func (p *PatchVpnOpenVpnServerEndpointResponseData) ToModel() *OpenVpnServer {
+	if p == nil {
+		return nil
+	}
+	return &OpenVpnServer{
+		Vpnid:                    p.Vpnid,
+		Vpnif:                    p.Vpnif,
+		Description:              p.Description,
+		Disable:                  p.Disable,
+		Mode:                     p.Mode,
+		Authmode:                 p.Authmode,
+		DevMode:                  p.DevMode,
+		Protocol:                 p.Protocol,
+		Interface:                p.Interface,
+		LocalPort:                p.LocalPort,
+		UseTLS:                   p.UseTLS,
+		TLS:                      p.TLS,
+		TLSType:                  p.TLSType,
+		TlsauthKeydir:            p.TlsauthKeydir,
+		Caref:                    p.Caref,
+		Certref:                  p.Certref,
+		CertDepth:                p.CertDepth,
+		DhLength:                 p.DhLength,
+		EcdhCurve:                p.EcdhCurve,
+		DataCiphers:              p.DataCiphers,
+		DataCiphersFallback:      p.DataCiphersFallback,
+		Digest:                   p.Digest,
+		Strictusercn:             p.Strictusercn,
+		RemoteCertTLS:            p.RemoteCertTLS,
+		TunnelNetwork:            p.TunnelNetwork,
+		TunnelNetworkv6:          p.TunnelNetworkv6,
+		ServerbridgeDhcp:         p.ServerbridgeDhcp,
+		ServerbridgeInterface:    p.ServerbridgeInterface,
+		ServerbridgeRoutegateway: p.ServerbridgeRoutegateway,
+		ServerbridgeDhcpStart:    p.ServerbridgeDhcpStart,
+		ServerbridgeDhcpEnd:      p.ServerbridgeDhcpEnd,
+		Gwredir:                  p.Gwredir,
+		Gwredir6:                 p.Gwredir6,
+		LocalNetwork:             p.LocalNetwork,
+		LocalNetworkv6:           p.LocalNetworkv6,
+		RemoteNetwork:            p.RemoteNetwork,
+		RemoteNetworkv6:          p.RemoteNetworkv6,
+		Maxclients:               p.Maxclients,
+		AllowCompression:         p.AllowCompression,
+		Passtos:                  p.Passtos,
+		Client2Client:            p.Client2Client,
+		DuplicateCn:              p.DuplicateCn,
+		Connlimit:                p.Connlimit,
+		DynamicIP:                p.DynamicIP,
+		Topology:                 p.Topology,
+		InactiveSeconds:          p.InactiveSeconds,
+		PingMethod:               p.PingMethod,
+		KeepaliveInterval:        p.KeepaliveInterval,
+		KeepaliveTimeout:         p.KeepaliveTimeout,
+		PingSeconds:              p.PingSeconds,
+		PingPush:                 p.PingPush,
+		PingAction:               p.PingAction,
+		PingActionSeconds:        p.PingActionSeconds,
+		PingActionPush:           p.PingActionPush,
+		DNSDomain:                p.DNSDomain,
+		DNSServer1:               p.DNSServer1,
+		DNSServer2:               p.DNSServer2,
+		DNSServer3:               p.DNSServer3,
+		DNSServer4:               p.DNSServer4,
+		PushBlockoutsidedns:      p.PushBlockoutsidedns,
+		PushRegisterDNS:          p.PushRegisterDNS,
+		NtpServer1:               p.NtpServer1,
+		NtpServer2:               p.NtpServer2,
+		NetbiosEnable:            p.NetbiosEnable,
+		NetbiosNtype:             p.NetbiosNtype,
+		NetbiosScope:             p.NetbiosScope,
+		WinsServer1:              p.WinsServer1,
+		WinsServer2:              p.WinsServer2,
+		CustomOptions:            p.CustomOptions,
+		UsernameAsCommonName:     p.UsernameAsCommonName,
+		Sndrcvbuf:                p.Sndrcvbuf,
+		CreateGw:                 p.CreateGw,
+		VerbosityLevel:           p.VerbosityLevel,
+	}
+}
+
+// ToModel copies g into a new OpenVpnServer, sharing its slices and pointers.
+func (g *GetVpnOpenVpnServersEndpointResponseDataItem) ToModel() *OpenVpnServer {
+	if g == nil {
+		return nil
+	}
+	return &OpenVpnServer{
+		Vpnid:                    g.Vpnid,
+		Vpnif:                    g.Vpnif,
+		Description:              g.Description,
+		Disable:                  g.Disable,
+		Mode:                     g.Mode,
+		Authmode:                 g.Authmode,
+		DevMode:                  g.DevMode,
+		Protocol:                 g.Protocol,
+		Interface:                g.Interface,
+		LocalPort:                g.LocalPort,
+		UseTLS:                   g.UseTLS,
+		TLS:                      g.TLS,
+		TLSType:                  g.TLSType,
+		TlsauthKeydir:            g.TlsauthKeydir,
+		Caref:                    g.Caref,
+		Certref:                  g.Certref,
+		CertDepth:                g.CertDepth,
+		DhLength:                 g.DhLength,
+		EcdhCurve:                g.EcdhCurve,
+		DataCiphers:              g.DataCiphers,
+		DataCiphersFallback:      g.DataCiphersFallback,
+		Digest:                   g.Digest,
+		Strictusercn:             g.Strictusercn,
+		RemoteCertTLS:            g.RemoteCertTLS,
+		TunnelNetwork:            g.TunnelNetwork,
+		TunnelNetworkv6:          g.TunnelNetworkv6,
+		ServerbridgeDhcp:         g.ServerbridgeDhcp,
+		ServerbridgeInterface:    g.ServerbridgeInterface,
+		ServerbridgeRoutegateway: g.ServerbridgeRoutegateway,
+		ServerbridgeDhcpStart:    g.ServerbridgeDhcpStart,
+		ServerbridgeDhcpEnd:      g.ServerbridgeDhcpEnd,
+		Gwredir:                  g.Gwredir,
+		Gwredir6:                 g.Gwredir6,
+		LocalNetwork:             g.LocalNetwork,
+		LocalNetworkv6:           g.LocalNetworkv6,
+		RemoteNetwork:            g.RemoteNetwork,
+		RemoteNetworkv6:          g.RemoteNetworkv6,
+		Maxclients:               g.Maxclients,
+		AllowCompression:         g.AllowCompression,
+		Passtos:                  g.Passtos,
+		Client2Client:            g.Client2Client,
+		DuplicateCn:              g.DuplicateCn,
+		Connlimit:                g.Connlimit,
+		DynamicIP:                g.DynamicIP,
+		Topology:                 g.Topology,
+		InactiveSeconds:          g.InactiveSeconds,
+		PingMethod:               g.PingMethod,
+		KeepaliveInterval:        g.KeepaliveInterval,
+		KeepaliveTimeout:         g.KeepaliveTimeout,
+		PingSeconds:              g.PingSeconds,
+		PingPush:                 g.PingPush,
+		PingAction:               g.PingAction,
+		PingActionSeconds:        g.PingActionSeconds,
+		PingActionPush:           g.PingActionPush,
+		DNSDomain:                g.DNSDomain,
+		DNSServer1:               g.DNSServer1,
+		DNSServer2:               g.DNSServer2,
+		DNSServer3:               g.DNSServer3,
+		DNSServer4:               g.DNSServer4,
+		PushBlockoutsidedns:      g.PushBlockoutsidedns,
+		PushRegisterDNS:          g.PushRegisterDNS,
+		NtpServer1:               g.NtpServer1,
+		NtpServer2:               g.NtpServer2,
+		NetbiosEnable:            g.NetbiosEnable,
+		NetbiosNtype:             g.NetbiosNtype,
+		NetbiosScope:             g.NetbiosScope,
+		WinsServer1:              g.WinsServer1,
+		WinsServer2:              g.WinsServer2,
+		CustomOptions:            g.CustomOptions,
+		UsernameAsCommonName:     g.UsernameAsCommonName,
+		Sndrcvbuf:                g.Sndrcvbuf,
+		CreateGw:                 g.CreateGw,
+		VerbosityLevel:           g.VerbosityLevel,
+	}
+}
+
+// ToPost returns a request that creates a copy of o. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with o.
+func (o *OutboundNatMapping) ToPost() *PostFirewallNatOutboundMappingEndpointRequest {
+	if o == nil {
+		return &PostFirewallNatOutboundMappingEndpointRequest{}
+	}
+	return &PostFirewallNatOutboundMappingEndpointRequest{
+		Interface:       o.Interface,
+		Protocol:        o.Protocol,
+		Disabled:        o.Disabled,
+		Nonat:           o.Nonat,
+		Nosync:          o.Nosync,
+		Source:          o.Source,
+		SourcePort:      o.SourcePort,
+		Destination:     o.Destination,
+		DestinationPort: o.DestinationPort,
+		Target:          o.Target,
+		TargetSubnet:    o.TargetSubnet,
+		NatPort:         o.NatPort,
+		StaticNatPort:   o.StaticNatPort,
+		Poolopts:        o.Poolopts,
+		SourceHashKey:   o.SourceHashKey,
+		Descr:           o.Descr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with o. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with o.
+func (o *OutboundNatMapping) ToPatch(id int) *PatchFirewallNatOutboundMappingEndpointRequest {
+	if o == nil {
+		return &PatchFirewallNatOutboundMappingEndpointRequest{ID: id}
+	}
+	return &PatchFirewallNatOutboundMappingEndpointRequest{
+		ID:              id,
+		Interface:       o.Interface,
+		Protocol:        o.Protocol,
+		Disabled:        o.Disabled,
+		Nonat:           o.Nonat,
+		Nosync:          o.Nosync,
+		Source:          o.Source,
+		SourcePort:      o.SourcePort,
+		Destination:     o.Destination,
+		DestinationPort: o.DestinationPort,
+		Target:          o.Target,
+		TargetSubnet:    o.TargetSubnet,
+		NatPort:         o.NatPort,
+		StaticNatPort:   o.StaticNatPort,
+		Poolopts:        o.Poolopts,
+		SourceHashKey:   o.SourceHashKey,
+		Descr:           o.Descr,
+	}
+}
+
+// ToPutItem returns o as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with o.
+func (o *OutboundNatMapping) ToPutItem() *PutFirewallNatOutboundMappingsEndpointRequestItem {
+	if o == nil {
+		return nil
+	}
+	return &PutFirewallNatOutboundMappingsEndpointRequestItem{
+		Interface:       o.Interface,
+		Protocol:        o.Protocol,
+		Disabled:        o.Disabled,
+		Nonat:           o.Nonat,
+		Nosync:          o.Nosync,
+		Source:          o.Source,
+		SourcePort:      o.SourcePort,
+		Destination:     o.Destination,
+		DestinationPort: o.DestinationPort,
+		Target:          o.Target,
+		TargetSubnet:    o.TargetSubnet,
+		NatPort:         o.NatPort,
+		StaticNatPort:   o.StaticNatPort,
+		Poolopts:        o.Poolopts,
+		SourceHashKey:   o.SourceHashKey,
+		Descr:           o.Descr,
+	}
+}
+
+// ToModel copies g into a new OutboundNatMapping, sharing its slices and pointers.
+func (g *GetFirewallNatOutboundMappingEndpointResponseData) ToModel() *OutboundNatMapping {
+	if g == nil {
+		return nil
+	}
+	return &OutboundNatMapping{
+		Interface:       g.Interface,
+		Protocol:        g.Protocol,
+		Disabled:        g.Disabled,
+		Nonat:           g.Nonat,
+		Nosync:          g.Nosync,
+		Source:          g.Source,
+		SourcePort:      g.SourcePort,
+		Destination:     g.Destination,
+		DestinationPort: g.DestinationPort,
+		Target:          g.Target,
+		TargetSubnet:    g.TargetSubnet,
+		NatPort:         g.NatPort,
+		StaticNatPort:   g.StaticNatPort,
+		Poolopts:        g.Poolopts,
+		SourceHashKey:   g.SourceHashKey,
+		Descr:           g.Descr,
+	}
+}
+
+// ToModel copies p into a new OutboundNatMapping, sharing its slices and pointers.
+func (p *PostFirewallNatOutboundMappingEndpointResponseData) ToModel() *OutboundNatMapping {
+	if p == nil {
+		return nil
+	}
+	return &OutboundNatMapping{
+		Interface:       p.Interface,
+		Protocol:        p.Protocol,
+		Disabled:        p.Disabled,
+		Nonat:           p.Nonat,
+		Nosync:          p.Nosync,
+		Source:          p.Source,
+		SourcePort:      p.SourcePort,
+		Destination:     p.Destination,
+		DestinationPort: p.DestinationPort,
+		Target:          p.Target,
+		TargetSubnet:    p.TargetSubnet,
+		NatPort:         p.NatPort,
+		StaticNatPort:   p.StaticNatPort,
+		Poolopts:        p.Poolopts,
+		SourceHashKey:   p.SourceHashKey,
+		Descr:           p.Descr,
+	}
+}
+
+// ToModel copies p into a new OutboundNatMapping, sharing its slices and pointers.
+func (p *PatchFirewallNatOutboundMappingEndpointResponseData) ToModel() *OutboundNatMapping {
+	if p == nil {
+		return nil
+	}
+	return &OutboundNatMapping{
+		Interface:       p.Interface,
+		Protocol:        p.Protocol,
+		Disabled:        p.Disabled,
+		Nonat:           p.Nonat,
+		Nosync:          p.Nosync,
+		Source:          p.Source,
+		SourcePort:      p.SourcePort,
+		Destination:     p.Destination,
+		DestinationPort: p.DestinationPort,
+		Target:          p.Target,
+		TargetSubnet:    p.TargetSubnet,
+		NatPort:         p.NatPort,
+		StaticNatPort:   p.StaticNatPort,
+		Poolopts:        p.Poolopts,
+		SourceHashKey:   p.SourceHashKey,
+		Descr:           p.Descr,
+	}
+}
+
+// ToModel copies g into a new OutboundNatMapping, sharing its slices and pointers.
+func (g *GetFirewallNatOutboundMappingsEndpointResponseDataItem) ToModel() *OutboundNatMapping {
+	if g == nil {
+		return nil
+	}
+	return &OutboundNatMapping{
+		Interface:       g.Interface,
+		Protocol:        g.Protocol,
+		Disabled:        g.Disabled,
+		Nonat:           g.Nonat,
+		Nosync:          g.Nosync,
+		Source:          g.Source,
+		SourcePort:      g.SourcePort,
+		Destination:     g.Destination,
+		DestinationPort: g.DestinationPort,
+		Target:          g.Target,
+		TargetSubnet:    g.TargetSubnet,
+		NatPort:         g.NatPort,
+		StaticNatPort:   g.StaticNatPort,
+		Poolopts:        g.Poolopts,
+		SourceHashKey:   g.SourceHashKey,
+		Descr:           g.Descr,
+	}
+}
+
+// ToPost returns a request that creates a copy of p. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with p.
+func (p *PortForward) ToPost() *PostFirewallNatPortForwardEndpointRequest {
+	if p == nil {
+		return &PostFirewallNatPortForwardEndpointRequest{}
+	}
+	return &PostFirewallNatPortForwardEndpointRequest{
+		Interface:        p.Interface,
+		Ipprotocol:       p.Ipprotocol,
+		Protocol:         p.Protocol,
+		Source:           p.Source,
+		SourcePort:       p.SourcePort,
+		Destination:      p.Destination,
+		DestinationPort:  p.DestinationPort,
+		Target:           p.Target,
+		LocalPort:        p.LocalPort,
+		Disabled:         p.Disabled,
+		Nordr:            p.Nordr,
+		Nosync:           p.Nosync,
+		Descr:            p.Descr,
+		Natreflection:    p.Natreflection,
+		AssociatedRuleID: p.AssociatedRuleID,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with p. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with p.
+func (p *PortForward) ToPatch(id int) *PatchFirewallNatPortForwardEndpointRequest {
+	if p == nil {
+		return &PatchFirewallNatPortForwardEndpointRequest{ID: id}
+	}
+	return &PatchFirewallNatPortForwardEndpointRequest{
+		ID:               id,
+		Interface:        p.Interface,
+		Ipprotocol:       p.Ipprotocol,
+		Protocol:         p.Protocol,
+		Source:           p.Source,
+		SourcePort:       p.SourcePort,
+		Destination:      p.Destination,
+		DestinationPort:  p.DestinationPort,
+		Target:           p.Target,
+		LocalPort:        p.LocalPort,
+		Disabled:         p.Disabled,
+		Nordr:            p.Nordr,
+		Nosync:           p.Nosync,
+		Descr:            p.Descr,
+		Natreflection:    p.Natreflection,
+		AssociatedRuleID: p.AssociatedRuleID,
+	}
+}
+
+// ToPutItem returns p as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with p.
+func (p *PortForward) ToPutItem() *PutFirewallNatPortForwardsEndpointRequestItem {
+	if p == nil {
+		return nil
+	}
+	return &PutFirewallNatPortForwardsEndpointRequestItem{
+		Interface:        p.Interface,
+		Ipprotocol:       p.Ipprotocol,
+		Protocol:         p.Protocol,
+		Source:           p.Source,
+		SourcePort:       p.SourcePort,
+		Destination:      p.Destination,
+		DestinationPort:  p.DestinationPort,
+		Target:           p.Target,
+		LocalPort:        p.LocalPort,
+		Disabled:         p.Disabled,
+		Nordr:            p.Nordr,
+		Nosync:           p.Nosync,
+		Descr:            p.Descr,
+		Natreflection:    p.Natreflection,
+		AssociatedRuleID: p.AssociatedRuleID,
+	}
+}
+
+// ToModel copies g into a new PortForward, sharing its slices and pointers.
+func (g *GetFirewallNatPortForwardEndpointResponseData) ToModel() *PortForward {
+	if g == nil {
+		return nil
+	}
+	return &PortForward{
+		Interface:        g.Interface,
+		Ipprotocol:       g.Ipprotocol,
+		Protocol:         g.Protocol,
+		Source:           g.Source,
+		SourcePort:       g.SourcePort,
+		Destination:      g.Destination,
+		DestinationPort:  g.DestinationPort,
+		Target:           g.Target,
+		LocalPort:        g.LocalPort,
+		Disabled:         g.Disabled,
+		Nordr:            g.Nordr,
+		Nosync:           g.Nosync,
+		Descr:            g.Descr,
+		Natreflection:    g.Natreflection,
+		AssociatedRuleID: g.AssociatedRuleID,
+		CreatedTime:      g.CreatedTime,
+		CreatedBy:        g.CreatedBy,
+		UpdatedTime:      g.UpdatedTime,
+		UpdatedBy:        g.UpdatedBy,
+	}
+}
+
+// ToModel copies p into a new PortForward, sharing its slices and pointers.
+func (p *PostFirewallNatPortForwardEndpointResponseData) ToModel() *PortForward {
+	if p == nil {
+		return nil
+	}
+	return &PortForward{
+		Interface:        p.Interface,
+		Ipprotocol:       p.Ipprotocol,
+		Protocol:         p.Protocol,
+		Source:           p.Source,
+		SourcePort:       p.SourcePort,
+		Destination:      p.Destination,
+		DestinationPort:  p.DestinationPort,
+		Target:           p.Target,
+		LocalPort:        p.LocalPort,
+		Disabled:         p.Disabled,
+		Nordr:            p.Nordr,
+		Nosync:           p.Nosync,
+		Descr:            p.Descr,
+		Natreflection:    p.Natreflection,
+		AssociatedRuleID: p.AssociatedRuleID,
+		CreatedTime:      p.CreatedTime,
+		CreatedBy:        p.CreatedBy,
+		UpdatedTime:      p.UpdatedTime,
+		UpdatedBy:        p.UpdatedBy,
+	}
+}
+
+// ToModel copies p into a new PortForward, sharing its slices and pointers.
+func (p *PatchFirewallNatPortForwardEndpointResponseData) ToModel() *PortForward {
+	if p == nil {
+		return nil
+	}
+	return &PortForward{
+		Interface:        p.Interface,
+		Ipprotocol:       p.Ipprotocol,
+		Protocol:         p.Protocol,
+		Source:           p.Source,
+		SourcePort:       p.SourcePort,
+		Destination:      p.Destination,
+		DestinationPort:  p.DestinationPort,
+		Target:           p.Target,
+		LocalPort:        p.LocalPort,
+		Disabled:         p.Disabled,
+		Nordr:            p.Nordr,
+		Nosync:           p.Nosync,
+		Descr:            p.Descr,
+		Natreflection:    p.Natreflection,
+		AssociatedRuleID: p.AssociatedRuleID,
+		CreatedTime:      p.CreatedTime,
+		CreatedBy:        p.CreatedBy,
+		UpdatedTime:      p.UpdatedTime,
+		UpdatedBy:        p.UpdatedBy,
+	}
+}
+
+// ToModel copies g into a new PortForward, sharing its slices and pointers.
+func (g *GetFirewallNatPortForwardsEndpointResponseDataItem) ToModel() *PortForward {
+	if g == nil {
+		return nil
+	}
+	return &PortForward{
+		Interface:        g.Interface,
+		Ipprotocol:       g.Ipprotocol,
+		Protocol:         g.Protocol,
+		Source:           g.Source,
+		SourcePort:       g.SourcePort,
+		Destination:      g.Destination,
+		DestinationPort:  g.DestinationPort,
+		Target:           g.Target,
+		LocalPort:        g.LocalPort,
+		Disabled:         g.Disabled,
+		Nordr:            g.Nordr,
+		Nosync:           g.Nosync,
+		Descr:            g.Descr,
+		Natreflection:    g.Natreflection,
+		AssociatedRuleID: g.AssociatedRuleID,
+		CreatedTime:      g.CreatedTime,
+		CreatedBy:        g.CreatedBy,
+		UpdatedTime:      g.UpdatedTime,
+		UpdatedBy:        g.UpdatedBy,
+	}
+}
+
+// ToPost returns a request that creates a copy of r. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with r.
+func (r *RestapiAccessListEntry) ToPost() *PostSystemRestapiAccessListEntryEndpointRequest {
+	if r == nil {
+		return &PostSystemRestapiAccessListEntryEndpointRequest{}
+	}
+	return &PostSystemRestapiAccessListEntryEndpointRequest{
+		Type:    r.Type,
+		Weight:  r.Weight,
+		Network: r.Network,
+		Users:   r.Users,
+		Sched:   r.Sched,
+		Descr:   r.Descr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with r. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with r.
+func (r *RestapiAccessListEntry) ToPatch(id int) *PatchSystemRestapiAccessListEntryEndpointRequest {
+	if r == nil {
+		return &PatchSystemRestapiAccessListEntryEndpointRequest{ID: id}
+	}
+	return &PatchSystemRestapiAccessListEntryEndpointRequest{
+		ID:      id,
+		Type:    r.Type,
+		Weight:  r.Weight,
+		Network: r.Network,
+		Users:   r.Users,
+		Sched:   r.Sched,
+		Descr:   r.Descr,
+	}
+}
+
+// ToPutItem returns r as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with r.
+func (r *RestapiAccessListEntry) ToPutItem() *PutSystemRestapiAccessListEndpointRequestItem {
+	if r == nil {
+		return nil
+	}
+	return &PutSystemRestapiAccessListEndpointRequestItem{
+		Type:    r.Type,
+		Weight:  r.Weight,
+		Network: r.Network,
+		Users:   r.Users,
+		Sched:   r.Sched,
+		Descr:   r.Descr,
+	}
+}
+
+// ToModel copies g into a new RestapiAccessListEntry, sharing its slices and pointers.
+func (g *GetSystemRestapiAccessListEntryEndpointResponseData) ToModel() *RestapiAccessListEntry {
+	if g == nil {
+		return nil
+	}
+	return &RestapiAccessListEntry{
+		Type:    g.Type,
+		Weight:  g.Weight,
+		Network: g.Network,
+		Users:   g.Users,
+		Sched:   g.Sched,
+		Descr:   g.Descr,
+	}
+}
+
+// ToModel copies p into a new RestapiAccessListEntry, sharing its slices and pointers.
+func (p *PostSystemRestapiAccessListEntryEndpointResponseData) ToModel() *RestapiAccessListEntry {
+	if p == nil {
+		return nil
+	}
+	return &RestapiAccessListEntry{
+		Type:    p.Type,
+		Weight:  p.Weight,
+		Network: p.Network,
+		Users:   p.Users,
+		Sched:   p.Sched,
+		Descr:   p.Descr,
+	}
+}
+
+// ToModel copies p into a new RestapiAccessListEntry, sharing its slices and pointers.
+func (p *PatchSystemRestapiAccessListEntryEndpointResponseData) ToModel() *RestapiAccessListEntry {
+	if p == nil {
+		return nil
+	}
+	return &RestapiAccessListEntry{
+		Type:    p.Type,
+		Weight:  p.Weight,
+		Network: p.Network,
+		Users:   p.Users,
+		Sched:   p.Sched,
+		Descr:   p.Descr,
+	}
+}
+
+// ToModel copies g into a new RestapiAccessListEntry, sharing its slices and pointers.
+func (g *GetSystemRestapiAccessListEndpointResponseDataItem) ToModel() *RestapiAccessListEntry {
+	if g == nil {
+		return nil
+	}
+	return &RestapiAccessListEntry{
+		Type:    g.Type,
+		Weight:  g.Weight,
+		Network: g.Network,
+		Users:   g.Users,
+		Sched:   g.Sched,
+		Descr:   g.Descr,
+	}
+}
+
+// ToPost returns a request that creates a copy of r. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with r.
+func (r *RoutingGatewayGroupPriority) ToPost(parentID int) *PostRoutingGatewayGroupPriorityEndpointRequest {
+	if r == nil {
+		return &PostRoutingGatewayGroupPriorityEndpointRequest{ParentID: parentID}
+	}
+	return &PostRoutingGatewayGroupPriorityEndpointRequest{
+		ParentID:  parentID,
+		Gateway:   r.Gateway,
+		Tier:      r.Tier,
+		VirtualIP: r.VirtualIP,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with r. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with r.
+func (r *RoutingGatewayGroupPriority) ToPatch(parentID, id int) *PatchRoutingGatewayGroupPriorityEndpointRequest {
+	if r == nil {
+		return &PatchRoutingGatewayGroupPriorityEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchRoutingGatewayGroupPriorityEndpointRequest{
+		ParentID:  parentID,
+		ID:        id,
+		Gateway:   r.Gateway,
+		Tier:      r.Tier,
+		VirtualIP: r.VirtualIP,
+	}
+}
+
+// ToModel copies g into a new RoutingGatewayGroupPriority, sharing its slices and pointers.
+func (g *GetRoutingGatewayGroupPriorityEndpointResponseData) ToModel() *RoutingGatewayGroupPriority {
+	if g == nil {
+		return nil
+	}
+	return &RoutingGatewayGroupPriority{
+		Gateway:   g.Gateway,
+		Tier:      g.Tier,
+		VirtualIP: g.VirtualIP,
+	}
+}
+
+// ToModel copies p into a new RoutingGatewayGroupPriority, sharing its slices and pointers.
+func (p *PostRoutingGatewayGroupPriorityEndpointResponseData) ToModel() *RoutingGatewayGroupPriority {
+	if p == nil {
+		return nil
+	}
+	return &RoutingGatewayGroupPriority{
+		Gateway:   p.Gateway,
+		Tier:      p.Tier,
+		VirtualIP: p.VirtualIP,
+	}
+}
+
+// ToModel copies p into a new RoutingGatewayGroupPriority, sharing its slices and pointers.
+func (p *PatchRoutingGatewayGroupPriorityEndpointResponseData) ToModel() *RoutingGatewayGroupPriority {
+	if p == nil {
+		return nil
+	}
+	return &RoutingGatewayGroupPriority{
+		Gateway:   p.Gateway,
+		Tier:      p.Tier,
+		VirtualIP: p.VirtualIP,
+	}
+}
+
+// ToModel copies g into a new RoutingGatewayGroupPriority, sharing its slices and pointers.
+func (g *GetRoutingGatewayGroupPrioritiesEndpointResponseDataItem) ToModel() *RoutingGatewayGroupPriority {
+	if g == nil {
+		return nil
+	}
+	return &RoutingGatewayGroupPriority{
+		Gateway:   g.Gateway,
+		Tier:      g.Tier,
+		VirtualIP: g.VirtualIP,
+	}
+}
+
+// ToPost returns a request that creates a copy of r. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with r.
+func (r *RoutingGatewayGroup) ToPost() *PostRoutingGatewayGroupEndpointRequest {
+	if r == nil {
+		return &PostRoutingGatewayGroupEndpointRequest{}
+	}
+	return &PostRoutingGatewayGroupEndpointRequest{
+		Name:       r.Name,
+		Trigger:    r.Trigger,
+		Descr:      r.Descr,
+		Ipprotocol: r.Ipprotocol,
+		Priorities: r.Priorities,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with r. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with r.
+func (r *RoutingGatewayGroup) ToPatch(id int) *PatchRoutingGatewayGroupEndpointRequest {
+	if r == nil {
+		return &PatchRoutingGatewayGroupEndpointRequest{ID: id}
+	}
+	return &PatchRoutingGatewayGroupEndpointRequest{
+		ID:         id,
+		Name:       r.Name,
+		Trigger:    r.Trigger,
+		Descr:      r.Descr,
+		Ipprotocol: r.Ipprotocol,
+		Priorities: r.Priorities,
+	}
+}
+
+// ToModel copies g into a new RoutingGatewayGroup, sharing its slices and pointers.
+func (g *GetRoutingGatewayGroupEndpointResponseData) ToModel() *RoutingGatewayGroup {
+	if g == nil {
+		return nil
+	}
+	return &RoutingGatewayGroup{
+		Name:       g.Name,
+		Trigger:    g.Trigger,
+		Descr:      g.Descr,
+		Ipprotocol: g.Ipprotocol,
+		Priorities: g.Priorities,
+	}
+}
+
+// ToModel copies p into a new RoutingGatewayGroup, sharing its slices and pointers.
+func (p *PostRoutingGatewayGroupEndpointResponseData) ToModel() *RoutingGatewayGroup {
+	if p == nil {
+		return nil
+	}
+	return &RoutingGatewayGroup{
+		Name:       p.Name,
+		Trigger:    p.Trigger,
+		Descr:      p.Descr,
+		Ipprotocol: p.Ipprotocol,
+		Priorities: p.Priorities,
+	}
+}
+
+// ToModel copies p into a new RoutingGatewayGroup, sharing its slices and pointers.
+func (p *PatchRoutingGatewayGroupEndpointResponseData) ToModel() *RoutingGatewayGroup {
+	if p == nil {
+		return nil
+	}
+	return &RoutingGatewayGroup{
+		Name:       p.Name,
+		Trigger:    p.Trigger,
+		Descr:      p.Descr,
+		Ipprotocol: p.Ipprotocol,
+		Priorities: p.Priorities,
+	}
+}
+
+// ToModel copies g into a new RoutingGatewayGroup, sharing its slices and pointers.
+func (g *GetRoutingGatewayGroupsEndpointResponseDataItem) ToModel() *RoutingGatewayGroup {
+	if g == nil {
+		return nil
+	}
+	return &RoutingGatewayGroup{
+		Name:       g.Name,
+		Trigger:    g.Trigger,
+		Descr:      g.Descr,
+		Ipprotocol: g.Ipprotocol,
+		Priorities: g.Priorities,
+	}
+}
+
+// ToPost returns a request that creates a copy of r. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with r.
+func (r *RoutingGateway) ToPost() *PostRoutingGatewayEndpointRequest {
+	if r == nil {
+		return &PostRoutingGatewayEndpointRequest{}
+	}
+	return &PostRoutingGatewayEndpointRequest{
+		Name:                      r.Name,
+		Descr:                     r.Descr,
+		Disabled:                  r.Disabled,
+		Ipprotocol:                r.Ipprotocol,
+		Interface:                 r.Interface,
+		Gateway:                   r.Gateway,
+		MonitorDisable:            r.MonitorDisable,
+		Monitor:                   r.Monitor,
+		ActionDisable:             r.ActionDisable,
+		ForceDown:                 r.ForceDown,
+		DpingerDontAddStaticRoute: r.DpingerDontAddStaticRoute,
+		GwDownKillStates:          r.GwDownKillStates,
+		Nonlocalgateway:           r.Nonlocalgateway,
+		Weight:                    r.Weight,
+		DataPayload:               r.DataPayload,
+		Latencylow:                r.Latencylow,
+		Latencyhigh:               r.Latencyhigh,
+		Losslow:                   r.Losslow,
+		Losshigh:                  r.Losshigh,
+		Interval:                  r.Interval,
+		LossInterval:              r.LossInterval,
+		TimePeriod:                r.TimePeriod,
+		AlertInterval:             r.AlertInterval,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with r. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with r.
+func (r *RoutingGateway) ToPatch(id int) *PatchRoutingGatewayEndpointRequest {
+	if r == nil {
+		return &PatchRoutingGatewayEndpointRequest{ID: id}
+	}
+	return &PatchRoutingGatewayEndpointRequest{
+		ID:                        id,
+		Name:                      r.Name,
+		Descr:                     r.Descr,
+		Disabled:                  r.Disabled,
+		Ipprotocol:                r.Ipprotocol,
+		Interface:                 r.Interface,
+		Gateway:                   r.Gateway,
+		MonitorDisable:            r.MonitorDisable,
+		Monitor:                   r.Monitor,
+		ActionDisable:             r.ActionDisable,
+		ForceDown:                 r.ForceDown,
+		DpingerDontAddStaticRoute: r.DpingerDontAddStaticRoute,
+		GwDownKillStates:          r.GwDownKillStates,
+		Nonlocalgateway:           r.Nonlocalgateway,
+		Weight:                    r.Weight,
+		DataPayload:               r.DataPayload,
+		Latencylow:                r.Latencylow,
+		Latencyhigh:               r.Latencyhigh,
+		Losslow:                   r.Losslow,
+		Losshigh:                  r.Losshigh,
+		Interval:                  r.Interval,
+		LossInterval:              r.LossInterval,
+		TimePeriod:                r.TimePeriod,
+		AlertInterval:             r.AlertInterval,
+	}
+}
+
+// ToModel copies g into a new RoutingGateway, sharing its slices and pointers.
+func (g *GetRoutingGatewayEndpointResponseData) ToModel() *RoutingGateway {
+	if g == nil {
+		return nil
+	}
+	return &RoutingGateway{
+		Name:                      g.Name,
+		Descr:                     g.Descr,
+		Disabled:                  g.Disabled,
+		Ipprotocol:                g.Ipprotocol,
+		Interface:                 g.Interface,
+		Gateway:                   g.Gateway,
+		MonitorDisable:            g.MonitorDisable,
+		Monitor:                   g.Monitor,
+		ActionDisable:             g.ActionDisable,
+		ForceDown:                 g.ForceDown,
+		DpingerDontAddStaticRoute: g.DpingerDontAddStaticRoute,
+		GwDownKillStates:          g.GwDownKillStates,
+		Nonlocalgateway:           g.Nonlocalgateway,
+		Weight:                    g.Weight,
+		DataPayload:               g.DataPayload,
+		Latencylow:                g.Latencylow,
+		Latencyhigh:               g.Latencyhigh,
+		Losslow:                   g.Losslow,
+		Losshigh:                  g.Losshigh,
+		Interval:                  g.Interval,
+		LossInterval:              g.LossInterval,
+		TimePeriod:                g.TimePeriod,
+		AlertInterval:             g.AlertInterval,
+	}
+}
+
+// ToModel copies p into a new RoutingGateway, sharing its slices and pointers.
+func (p *PostRoutingGatewayEndpointResponseData) ToModel() *RoutingGateway {
+	if p == nil {
+		return nil
+	}
+	return &RoutingGateway{
+		Name:                      p.Name,
+		Descr:                     p.Descr,
+		Disabled:                  p.Disabled,
+		Ipprotocol:                p.Ipprotocol,
+		Interface:                 p.Interface,
+		Gateway:                   p.Gateway,
+		MonitorDisable:            p.MonitorDisable,
+		Monitor:                   p.Monitor,
+		ActionDisable:             p.ActionDisable,
+		ForceDown:                 p.ForceDown,
+		DpingerDontAddStaticRoute: p.DpingerDontAddStaticRoute,
+		GwDownKillStates:          p.GwDownKillStates,
+		Nonlocalgateway:           p.Nonlocalgateway,
+		Weight:                    p.Weight,
+		DataPayload:               p.DataPayload,
+		Latencylow:                p.Latencylow,
+		Latencyhigh:               p.Latencyhigh,
+		Losslow:                   p.Losslow,
+		Losshigh:                  p.Losshigh,
+		Interval:                  p.Interval,
+		LossInterval:              p.LossInterval,
+		TimePeriod:                p.TimePeriod,
+		AlertInterval:             p.AlertInterval,
+	}
+}
+
+// ToModel copies p into a new RoutingGateway, sharing its slices and pointers.
+func (p *PatchRoutingGatewayEndpointResponseData) ToModel() *RoutingGateway {
+	if p == nil {
+		return nil
+	}
+	return &RoutingGateway{
+		Name:                      p.Name,
+		Descr:                     p.Descr,
+		Disabled:                  p.Disabled,
+		Ipprotocol:                p.Ipprotocol,
+		Interface:                 p.Interface,
+		Gateway:                   p.Gateway,
+		MonitorDisable:            p.MonitorDisable,
+		Monitor:                   p.Monitor,
+		ActionDisable:             p.ActionDisable,
+		ForceDown:                 p.ForceDown,
+		DpingerDontAddStaticRoute: p.DpingerDontAddStaticRoute,
+		GwDownKillStates:          p.GwDownKillStates,
+		Nonlocalgateway:           p.Nonlocalgateway,
+		Weight:                    p.Weight,
+		DataPayload:               p.DataPayload,
+		Latencylow:                p.Latencylow,
+		Latencyhigh:               p.Latencyhigh,
+		Losslow:                   p.Losslow,
+		Losshigh:                  p.Losshigh,
+		Interval:                  p.Interval,
+		LossInterval:              p.LossInterval,
+		TimePeriod:                p.TimePeriod,
+		AlertInterval:             p.AlertInterval,
+	}
+}
+
+// ToModel copies g into a new RoutingGateway, sharing its slices and pointers.
+func (g *GetRoutingGatewaysEndpointResponseDataItem) ToModel() *RoutingGateway {
+	if g == nil {
+		return nil
+	}
+	return &RoutingGateway{
+		Name:                      g.Name,
+		Descr:                     g.Descr,
+		Disabled:                  g.Disabled,
+		Ipprotocol:                g.Ipprotocol,
+		Interface:                 g.Interface,
+		Gateway:                   g.Gateway,
+		MonitorDisable:            g.MonitorDisable,
+		Monitor:                   g.Monitor,
+		ActionDisable:             g.ActionDisable,
+		ForceDown:                 g.ForceDown,
+		DpingerDontAddStaticRoute: g.DpingerDontAddStaticRoute,
+		GwDownKillStates:          g.GwDownKillStates,
+		Nonlocalgateway:           g.Nonlocalgateway,
+		Weight:                    g.Weight,
+		DataPayload:               g.DataPayload,
+		Latencylow:                g.Latencylow,
+		Latencyhigh:               g.Latencyhigh,
+		Losslow:                   g.Losslow,
+		Losshigh:                  g.Losshigh,
+		Interval:                  g.Interval,
+		LossInterval:              g.LossInterval,
+		TimePeriod:                g.TimePeriod,
+		AlertInterval:             g.AlertInterval,
+	}
+}
+
+// ToPost returns a request that creates a copy of s. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with s.
+func (s *ServiceWatchdog) ToPost() *PostServicesServiceWatchdogEndpointRequest {
+	if s == nil {
+		return &PostServicesServiceWatchdogEndpointRequest{}
+	}
+	return &PostServicesServiceWatchdogEndpointRequest{
+		Name:        s.Name,
+		Description: s.Description,
+		Notify:      s.Notify,
+		Enabled:     s.Enabled,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with s. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with s.
+func (s *ServiceWatchdog) ToPatch(id int) *PatchServicesServiceWatchdogEndpointRequest {
+	if s == nil {
+		return &PatchServicesServiceWatchdogEndpointRequest{ID: id}
+	}
+	return &PatchServicesServiceWatchdogEndpointRequest{
+		ID:          id,
+		Name:        s.Name,
+		Description: s.Description,
+		Notify:      s.Notify,
+		Enabled:     s.Enabled,
+	}
+}
+
+// ToPutItem returns s as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with s.
+func (s *ServiceWatchdog) ToPutItem() *PutServicesServiceWatchdogsEndpointRequestItem {
+	if s == nil {
+		return nil
+	}
+	return &PutServicesServiceWatchdogsEndpointRequestItem{
+		Name:        s.Name,
+		Description: s.Description,
+		Notify:      s.Notify,
+		Enabled:     s.Enabled,
+	}
+}
+
+// ToModel copies g into a new ServiceWatchdog, sharing its slices and pointers.
+func (g *GetServicesServiceWatchdogEndpointResponseData) ToModel() *ServiceWatchdog {
+	if g == nil {
+		return nil
+	}
+	return &ServiceWatchdog{
+		Name:        g.Name,
+		Description: g.Description,
+		Notify:      g.Notify,
+		Enabled:     g.Enabled,
+	}
+}
+
+// ToModel copies p into a new ServiceWatchdog, sharing its slices and pointers.
+func (p *PostServicesServiceWatchdogEndpointResponseData) ToModel() *ServiceWatchdog {
+	if p == nil {
+		return nil
+	}
+	return &ServiceWatchdog{
+		Name:        p.Name,
+		Description: p.Description,
+		Notify:      p.Notify,
+		Enabled:     p.Enabled,
+	}
+}
+
+// ToModel copies p into a new ServiceWatchdog, sharing its slices and pointers.
+func (p *PatchServicesServiceWatchdogEndpointResponseData) ToModel() *ServiceWatchdog {
+	if p == nil {
+		return nil
+	}
+	return &ServiceWatchdog{
+		Name:        p.Name,
+		Description: p.Description,
+		Notify:      p.Notify,
+		Enabled:     p.Enabled,
+	}
+}
+
+// ToModel copies g into a new ServiceWatchdog, sharing its slices and pointers.
+func (g *GetServicesServiceWatchdogsEndpointResponseDataItem) ToModel() *ServiceWatchdog {
+	if g == nil {
+		return nil
+	}
+	return &ServiceWatchdog{
+		Name:        g.Name,
+		Description: g.Description,
+		Notify:      g.Notify,
+		Enabled:     g.Enabled,
+	}
+}
+
+// ToPost returns a request that creates a copy of s. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with s.
+func (s *StaticRoute) ToPost() *PostRoutingStaticRouteEndpointRequest {
+	if s == nil {
+		return &PostRoutingStaticRouteEndpointRequest{}
+	}
+	return &PostRoutingStaticRouteEndpointRequest{
+		Network:  s.Network,
+		Gateway:  s.Gateway,
+		Descr:    s.Descr,
+		Disabled: s.Disabled,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with s. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with s.
+func (s *StaticRoute) ToPatch(id int) *PatchRoutingStaticRouteEndpointRequest {
+	if s == nil {
+		return &PatchRoutingStaticRouteEndpointRequest{ID: id}
+	}
+	return &PatchRoutingStaticRouteEndpointRequest{
+		ID:       id,
+		Network:  s.Network,
+		Gateway:  s.Gateway,
+		Descr:    s.Descr,
+		Disabled: s.Disabled,
+	}
+}
+
+// ToModel copies g into a new StaticRoute, sharing its slices and pointers.
+func (g *GetRoutingStaticRouteEndpointResponseData) ToModel() *StaticRoute {
+	if g == nil {
+		return nil
+	}
+	return &StaticRoute{
+		Network:  g.Network,
+		Gateway:  g.Gateway,
+		Descr:    g.Descr,
+		Disabled: g.Disabled,
+	}
+}
+
+// ToModel copies p into a new StaticRoute, sharing its slices and pointers.
+func (p *PostRoutingStaticRouteEndpointResponseData) ToModel() *StaticRoute {
+	if p == nil {
+		return nil
+	}
+	return &StaticRoute{
+		Network:  p.Network,
+		Gateway:  p.Gateway,
+		Descr:    p.Descr,
+		Disabled: p.Disabled,
+	}
+}
+
+// ToModel copies p into a new StaticRoute, sharing its slices and pointers.
+func (p *PatchRoutingStaticRouteEndpointResponseData) ToModel() *StaticRoute {
+	if p == nil {
+		return nil
+	}
+	return &StaticRoute{
+		Network:  p.Network,
+		Gateway:  p.Gateway,
+		Descr:    p.Descr,
+		Disabled: p.Disabled,
+	}
+}
+
+// ToModel copies g into a new StaticRoute, sharing its slices and pointers.
+func (g *GetRoutingStaticRoutesEndpointResponseDataItem) ToModel() *StaticRoute {
+	if g == nil {
+		return nil
+	}
+	return &StaticRoute{
+		Network:  g.Network,
+		Gateway:  g.Gateway,
+		Descr:    g.Descr,
+		Disabled: g.Disabled,
+	}
+}
+
+// ToPost returns a request that creates a copy of s. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with s.
+func (s *SystemTunable) ToPost() *PostSystemTunableEndpointRequest {
+	if s == nil {
+		return &PostSystemTunableEndpointRequest{}
+	}
+	return &PostSystemTunableEndpointRequest{
+		Tunable: s.Tunable,
+		Value:   s.Value,
+		Descr:   s.Descr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with s. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with s.
+func (s *SystemTunable) ToPatch(id int) *PatchSystemTunableEndpointRequest {
+	if s == nil {
+		return &PatchSystemTunableEndpointRequest{ID: id}
+	}
+	return &PatchSystemTunableEndpointRequest{
+		ID:      id,
+		Tunable: s.Tunable,
+		Value:   s.Value,
+		Descr:   s.Descr,
+	}
+}
+
+// ToPutItem returns s as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with s.
+func (s *SystemTunable) ToPutItem() *PutSystemTunablesEndpointRequestItem {
+	if s == nil {
+		return nil
+	}
+	return &PutSystemTunablesEndpointRequestItem{
+		Tunable: s.Tunable,
+		Value:   s.Value,
+		Descr:   s.Descr,
+	}
+}
+
+// ToModel copies g into a new SystemTunable, sharing its slices and pointers.
+func (g *GetSystemTunableEndpointResponseData) ToModel() *SystemTunable {
+	if g == nil {
+		return nil
+	}
+	return &SystemTunable{
+		Tunable: g.Tunable,
+		Value:   g.Value,
+		Descr:   g.Descr,
+	}
+}
+
+// ToModel copies p into a new SystemTunable, sharing its slices and pointers.
+func (p *PostSystemTunableEndpointResponseData) ToModel() *SystemTunable {
+	if p == nil {
+		return nil
+	}
+	return &SystemTunable{
+		Tunable: p.Tunable,
+		Value:   p.Value,
+		Descr:   p.Descr,
+	}
+}
+
+// ToModel copies p into a new SystemTunable, sharing its slices and pointers.
+func (p *PatchSystemTunableEndpointResponseData) ToModel() *SystemTunable {
+	if p == nil {
+		return nil
+	}
+	return &SystemTunable{
+		Tunable: p.Tunable,
+		Value:   p.Value,
+		Descr:   p.Descr,
+	}
+}
+
+// ToModel copies g into a new SystemTunable, sharing its slices and pointers.
+func (g *GetSystemTunablesEndpointResponseDataItem) ToModel() *SystemTunable {
+	if g == nil {
+		return nil
+	}
+	return &SystemTunable{
+		Tunable: g.Tunable,
+		Value:   g.Value,
+		Descr:   g.Descr,
+	}
+}
+
+// ToPost returns a request that creates a copy of t. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with t.
+func (t *TrafficShaperLimiterBandwidth) ToPost(parentID int) *PostFirewallTrafficShaperLimiterBandwidthEndpointRequest {
+	if t == nil {
+		return &PostFirewallTrafficShaperLimiterBandwidthEndpointRequest{ParentID: parentID}
+	}
+	return &PostFirewallTrafficShaperLimiterBandwidthEndpointRequest{
+		ParentID: parentID,
+		Bw:       t.Bw,
+		Bwscale:  t.Bwscale,
+		Bwsched:  t.Bwsched,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with t. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with t.
+func (t *TrafficShaperLimiterBandwidth) ToPatch(parentID, id int) *PatchFirewallTrafficShaperLimiterBandwidthEndpointRequest {
+	if t == nil {
+		return &PatchFirewallTrafficShaperLimiterBandwidthEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchFirewallTrafficShaperLimiterBandwidthEndpointRequest{
+		ParentID: parentID,
+		ID:       id,
+		Bw:       t.Bw,
+		Bwscale:  t.Bwscale,
+		Bwsched:  t.Bwsched,
+	}
+}
+
+// ToModel copies g into a new TrafficShaperLimiterBandwidth, sharing its slices and pointers.
+func (g *GetFirewallTrafficShaperLimiterBandwidthEndpointResponseData) ToModel() *TrafficShaperLimiterBandwidth {
+	if g == nil {
+		return nil
+	}
+	return &TrafficShaperLimiterBandwidth{
+		Bw:      g.Bw,
+		Bwscale: g.Bwscale,
+		Bwsched: g.Bwsched,
+	}
+}
+
+// ToModel copies p into a new TrafficShaperLimiterBandwidth, sharing its slices and pointers.
+func (p *PostFirewallTrafficShaperLimiterBandwidthEndpointResponseData) ToModel() *TrafficShaperLimiterBandwidth {
+	if p == nil {
+		return nil
+	}
+	return &TrafficShaperLimiterBandwidth{
+		Bw:      p.Bw,
+		Bwscale: p.Bwscale,
+		Bwsched: p.Bwsched,
+	}
+}
+
+// ToModel copies p into a new TrafficShaperLimiterBandwidth, sharing its slices and pointers.
+func (p *PatchFirewallTrafficShaperLimiterBandwidthEndpointResponseData) ToModel() *TrafficShaperLimiterBandwidth {
+	if p == nil {
+		return nil
+	}
+	return &TrafficShaperLimiterBandwidth{
+		Bw:      p.Bw,
+		Bwscale: p.Bwscale,
+		Bwsched: p.Bwsched,
+	}
+}
+
+// ToModel copies g into a new TrafficShaperLimiterBandwidth, sharing its slices and pointers.
+func (g *GetFirewallTrafficShaperLimiterBandwidthsEndpointResponseDataItem) ToModel() *TrafficShaperLimiterBandwidth {
+	if g == nil {
+		return nil
+	}
+	return &TrafficShaperLimiterBandwidth{
+		Bw:      g.Bw,
+		Bwscale: g.Bwscale,
+		Bwsched: g.Bwsched,
+	}
+}
+
+// ToPost returns a request that creates a copy of t. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with t.
+func (t *TrafficShaperLimiterQueue) ToPost(parentID int) *PostFirewallTrafficShaperLimiterQueueEndpointRequest {
+	if t == nil {
+		return &PostFirewallTrafficShaperLimiterQueueEndpointRequest{ParentID: parentID}
+	}
+	return &PostFirewallTrafficShaperLimiterQueueEndpointRequest{
+		ParentID:           parentID,
+		Name:               t.Name,
+		Number:             t.Number,
+		Enabled:            t.Enabled,
+		Mask:               t.Mask,
+		Maskbits:           t.Maskbits,
+		Maskbitsv6:         t.Maskbitsv6,
+		Qlimit:             t.Qlimit,
+		Ecn:                t.Ecn,
+		Description:        t.Description,
+		Aqm:                t.Aqm,
+		ParamCodelTarget:   t.ParamCodelTarget,
+		ParamCodelInterval: t.ParamCodelInterval,
+		ParamPieTarget:     t.ParamPieTarget,
+		ParamPieTupdate:    t.ParamPieTupdate,
+		ParamPieAlpha:      t.ParamPieAlpha,
+		ParamPieBeta:       t.ParamPieBeta,
+		ParamPieMaxBurst:   t.ParamPieMaxBurst,
+		ParamPieMaxEcnth:   t.ParamPieMaxEcnth,
+		PieOnoff:           t.PieOnoff,
+		PieCapdrop:         t.PieCapdrop,
+		PieQdelay:          t.PieQdelay,
+		PiePderand:         t.PiePderand,
+		ParamRedWQ:         t.ParamRedWQ,
+		ParamRedMinTh:      t.ParamRedMinTh,
+		ParamRedMaxTh:      t.ParamRedMaxTh,
+		ParamRedMaxP:       t.ParamRedMaxP,
+		ParamGredWQ:        t.ParamGredWQ,
+		ParamGredMinTh:     t.ParamGredMinTh,
+		ParamGredMaxTh:     t.ParamGredMaxTh,
+		ParamGredMaxP:      t.ParamGredMaxP,
+		Weight:             t.Weight,
+		Plr:                t.Plr,
+		Buckets:            t.Buckets,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with t. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with t.
+func (t *TrafficShaperLimiterQueue) ToPatch(parentID, id int) *PatchFirewallTrafficShaperLimiterQueueEndpointRequest {
+	if t == nil {
+		return &PatchFirewallTrafficShaperLimiterQueueEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchFirewallTrafficShaperLimiterQueueEndpointRequest{
+		ParentID:           parentID,
+		ID:                 id,
+		Name:               t.Name,
+		Number:             t.Number,
+		Enabled:            t.Enabled,
+		Mask:               t.Mask,
+		Maskbits:           t.Maskbits,
+		Maskbitsv6:         t.Maskbitsv6,
+		Qlimit:             t.Qlimit,
+		Ecn:                t.Ecn,
+		Description:        t.Description,
+		Aqm:                t.Aqm,
+		ParamCodelTarget:   t.ParamCodelTarget,
+		ParamCodelInterval: t.ParamCodelInterval,
+		ParamPieTarget:     t.ParamPieTarget,
+		ParamPieTupdate:    t.ParamPieTupdate,
+		ParamPieAlpha:      t.ParamPieAlpha,
+		ParamPieBeta:       t.ParamPieBeta,
+		ParamPieMaxBurst:   t.ParamPieMaxBurst,
+		ParamPieMaxEcnth:   t.ParamPieMaxEcnth,
+		PieOnoff:           t.PieOnoff,
+		PieCapdrop:         t.PieCapdrop,
+		PieQdelay:          t.PieQdelay,
+		PiePderand:         t.PiePderand,
+		ParamRedWQ:         t.ParamRedWQ,
+		ParamRedMinTh:      t.ParamRedMinTh,
+		ParamRedMaxTh:      t.ParamRedMaxTh,
+		ParamRedMaxP:       t.ParamRedMaxP,
+		ParamGredWQ:        t.ParamGredWQ,
+		ParamGredMinTh:     t.ParamGredMinTh,
+		ParamGredMaxTh:     t.ParamGredMaxTh,
+		ParamGredMaxP:      t.ParamGredMaxP,
+		Weight:             t.Weight,
+		Plr:                t.Plr,
+		Buckets:            t.Buckets,
+	}
+}
+
+// ToModel copies g into a new TrafficShaperLimiterQueue, sharing its slices and pointers.
+func (g *GetFirewallTrafficShaperLimiterQueueEndpointResponseData) ToModel() *TrafficShaperLimiterQueue {
+	if g == nil {
+		return nil
+	}
+	return &TrafficShaperLimiterQueue{
+		Name:               g.Name,
+		Number:             g.Number,
+		Enabled:            g.Enabled,
+		Mask:               g.Mask,
+		Maskbits:           g.Maskbits,
+		Maskbitsv6:         g.Maskbitsv6,
+		Qlimit:             g.Qlimit,
+		Ecn:                g.Ecn,
+		Description:        g.Description,
+		Aqm:                g.Aqm,
+		ParamCodelTarget:   g.ParamCodelTarget,
+		ParamCodelInterval: g.ParamCodelInterval,
+		ParamPieTarget:     g.ParamPieTarget,
+		ParamPieTupdate:    g.ParamPieTupdate,
+		ParamPieAlpha:      g.ParamPieAlpha,
+		ParamPieBeta:       g.ParamPieBeta,
+		ParamPieMaxBurst:   g.ParamPieMaxBurst,
+		ParamPieMaxEcnth:   g.ParamPieMaxEcnth,
+		PieOnoff:           g.PieOnoff,
+		PieCapdrop:         g.PieCapdrop,
+		PieQdelay:          g.PieQdelay,
+		PiePderand:         g.PiePderand,
+		ParamRedWQ:         g.ParamRedWQ,
+		ParamRedMinTh:      g.ParamRedMinTh,
+		ParamRedMaxTh:      g.ParamRedMaxTh,
+		ParamRedMaxP:       g.ParamRedMaxP,
+		ParamGredWQ:        g.ParamGredWQ,
+		ParamGredMinTh:     g.ParamGredMinTh,
+		ParamGredMaxTh:     g.ParamGredMaxTh,
+		ParamGredMaxP:      g.ParamGredMaxP,
+		Weight:             g.Weight,
+		Plr:                g.Plr,
+		Buckets:            g.Buckets,
+	}
+}
+
+// ToModel copies p into a new TrafficShaperLimiterQueue, sharing its slices and pointers.
+func (p *PostFirewallTrafficShaperLimiterQueueEndpointResponseData) ToModel() *TrafficShaperLimiterQueue {
+	if p == nil {
+		return nil
+	}
+	return &TrafficShaperLimiterQueue{
+		Name:               p.Name,
+		Number:             p.Number,
+		Enabled:            p.Enabled,
+		Mask:               p.Mask,
+		Maskbits:           p.Maskbits,
+		Maskbitsv6:         p.Maskbitsv6,
+		Qlimit:             p.Qlimit,
+		Ecn:                p.Ecn,
+		Description:        p.Description,
+		Aqm:                p.Aqm,
+		ParamCodelTarget:   p.ParamCodelTarget,
+		ParamCodelInterval: p.ParamCodelInterval,
+		ParamPieTarget:     p.ParamPieTarget,
+		ParamPieTupdate:    p.ParamPieTupdate,
+		ParamPieAlpha:      p.ParamPieAlpha,
+		ParamPieBeta:       p.ParamPieBeta,
+		ParamPieMaxBurst:   p.ParamPieMaxBurst,
+		ParamPieMaxEcnth:   p.ParamPieMaxEcnth,
+		PieOnoff:           p.PieOnoff,
+		PieCapdrop:         p.PieCapdrop,
+		PieQdelay:          p.PieQdelay,
+		PiePderand:         p.PiePderand,
+		ParamRedWQ:         p.ParamRedWQ,
+		ParamRedMinTh:      p.ParamRedMinTh,
+		ParamRedMaxTh:      p.ParamRedMaxTh,
+		ParamRedMaxP:       p.ParamRedMaxP,
+		ParamGredWQ:        p.ParamGredWQ,
+		ParamGredMinTh:     p.ParamGredMinTh,
+		ParamGredMaxTh:     p.ParamGredMaxTh,
+		ParamGredMaxP:      p.ParamGredMaxP,
+		Weight:             p.Weight,
+		Plr:                p.Plr,
+		Buckets:            p.Buckets,
+	}
+}
+
+// ToModel copies p into a new TrafficShaperLimiterQueue, sharing its slices and pointers.
+func (p *PatchFirewallTrafficShaperLimiterQueueEndpointResponseData) ToModel() *TrafficShaperLimiterQueue {
+	if p == nil {
+		return nil
+	}
+	return &TrafficShaperLimiterQueue{
+		Name:               p.Name,
+		Number:             p.Number,
+		Enabled:            p.Enabled,
+		Mask:               p.Mask,
+		Maskbits:           p.Maskbits,
+		Maskbitsv6:         p.Maskbitsv6,
+		Qlimit:             p.Qlimit,
+		Ecn:                p.Ecn,
+		Description:        p.Description,
+		Aqm:                p.Aqm,
+		ParamCodelTarget:   p.ParamCodelTarget,
+		ParamCodelInterval: p.ParamCodelInterval,
+		ParamPieTarget:     p.ParamPieTarget,
+		ParamPieTupdate:    p.ParamPieTupdate,
+		ParamPieAlpha:      p.ParamPieAlpha,
+		ParamPieBeta:       p.ParamPieBeta,
+		ParamPieMaxBurst:   p.ParamPieMaxBurst,
+		ParamPieMaxEcnth:   p.ParamPieMaxEcnth,
+		PieOnoff:           p.PieOnoff,
+		PieCapdrop:         p.PieCapdrop,
+		PieQdelay:          p.PieQdelay,
+		PiePderand:         p.PiePderand,
+		ParamRedWQ:         p.ParamRedWQ,
+		ParamRedMinTh:      p.ParamRedMinTh,
+		ParamRedMaxTh:      p.ParamRedMaxTh,
+		ParamRedMaxP:       p.ParamRedMaxP,
+		ParamGredWQ:        p.ParamGredWQ,
+		ParamGredMinTh:     p.ParamGredMinTh,
+		ParamGredMaxTh:     p.ParamGredMaxTh,
+		ParamGredMaxP:      p.ParamGredMaxP,
+		Weight:             p.Weight,
+		Plr:                p.Plr,
+		Buckets:            p.Buckets,
+	}
+}
+
+// ToModel copies g into a new TrafficShaperLimiterQueue, sharing its slices and pointers.
+func (g *GetFirewallTrafficShaperLimiterQueuesEndpointResponseDataItem) ToModel() *TrafficShaperLimiterQueue {
+	if g == nil {
+		return nil
+	}
+	return &TrafficShaperLimiterQueue{
+		Name:               g.Name,
+		Number:             g.Number,
+		Enabled:            g.Enabled,
+		Mask:               g.Mask,
+		Maskbits:           g.Maskbits,
+		Maskbitsv6:         g.Maskbitsv6,
+		Qlimit:             g.Qlimit,
+		Ecn:                g.Ecn,
+		Description:        g.Description,
+		Aqm:                g.Aqm,
+		ParamCodelTarget:   g.ParamCodelTarget,
+		ParamCodelInterval: g.ParamCodelInterval,
+		ParamPieTarget:     g.ParamPieTarget,
+		ParamPieTupdate:    g.ParamPieTupdate,
+		ParamPieAlpha:      g.ParamPieAlpha,
+		ParamPieBeta:       g.ParamPieBeta,
+		ParamPieMaxBurst:   g.ParamPieMaxBurst,
+		ParamPieMaxEcnth:   g.ParamPieMaxEcnth,
+		PieOnoff:           g.PieOnoff,
+		PieCapdrop:         g.PieCapdrop,
+		PieQdelay:          g.PieQdelay,
+		PiePderand:         g.PiePderand,
+		ParamRedWQ:         g.ParamRedWQ,
+		ParamRedMinTh:      g.ParamRedMinTh,
+		ParamRedMaxTh:      g.ParamRedMaxTh,
+		ParamRedMaxP:       g.ParamRedMaxP,
+		ParamGredWQ:        g.ParamGredWQ,
+		ParamGredMinTh:     g.ParamGredMinTh,
+		ParamGredMaxTh:     g.ParamGredMaxTh,
+		ParamGredMaxP:      g.ParamGredMaxP,
+		Weight:             g.Weight,
+		Plr:                g.Plr,
+		Buckets:            g.Buckets,
+	}
+}
+
+// ToPost returns a request that creates a copy of t. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with t.
+func (t *TrafficShaperLimiter) ToPost() *PostFirewallTrafficShaperLimiterEndpointRequest {
+	if t == nil {
+		return &PostFirewallTrafficShaperLimiterEndpointRequest{}
+	}
+	return &PostFirewallTrafficShaperLimiterEndpointRequest{
+		Name:                 t.Name,
+		Number:               t.Number,
+		Enabled:              t.Enabled,
+		Mask:                 t.Mask,
+		Maskbits:             t.Maskbits,
+		Maskbitsv6:           t.Maskbitsv6,
+		Qlimit:               t.Qlimit,
+		Ecn:                  t.Ecn,
+		Description:          t.Description,
+		Aqm:                  t.Aqm,
+		Sched:                t.Sched,
+		ParamCodelTarget:     t.ParamCodelTarget,
+		ParamCodelInterval:   t.ParamCodelInterval,
+		ParamPieTarget:       t.ParamPieTarget,
+		ParamPieTupdate:      t.ParamPieTupdate,
+		ParamPieAlpha:        t.ParamPieAlpha,
+		ParamPieBeta:         t.ParamPieBeta,
+		ParamPieMaxBurst:     t.ParamPieMaxBurst,
+		ParamPieMaxEcnth:     t.ParamPieMaxEcnth,
+		PieOnoff:             t.PieOnoff,
+		PieCapdrop:           t.PieCapdrop,
+		PieQdelay:            t.PieQdelay,
+		PiePderand:           t.PiePderand,
+		ParamRedWQ:           t.ParamRedWQ,
+		ParamRedMinTh:        t.ParamRedMinTh,
+		ParamRedMaxTh:        t.ParamRedMaxTh,
+		ParamRedMaxP:         t.ParamRedMaxP,
+		ParamGredWQ:          t.ParamGredWQ,
+		ParamGredMinTh:       t.ParamGredMinTh,
+		ParamGredMaxTh:       t.ParamGredMaxTh,
+		ParamGredMaxP:        t.ParamGredMaxP,
+		ParamFqCodelTarget:   t.ParamFqCodelTarget,
+		ParamFqCodelInterval: t.ParamFqCodelInterval,
+		ParamFqCodelQuantum:  t.ParamFqCodelQuantum,
+		ParamFqCodelLimit:    t.ParamFqCodelLimit,
+		ParamFqCodelFlows:    t.ParamFqCodelFlows,
+		ParamFqPieTarget:     t.ParamFqPieTarget,
+		ParamFqPieTupdate:    t.ParamFqPieTupdate,
+		ParamFqPieAlpha:      t.ParamFqPieAlpha,
+		ParamFqPieBeta:       t.ParamFqPieBeta,
+		ParamFqPieMaxBurst:   t.ParamFqPieMaxBurst,
+		ParamFqPieMaxEcnth:   t.ParamFqPieMaxEcnth,
+		ParamFqPieQuantum:    t.ParamFqPieQuantum,
+		ParamFqPieLimit:      t.ParamFqPieLimit,
+		ParamFqPieFlows:      t.ParamFqPieFlows,
+		Delay:                t.Delay,
+		Plr:                  t.Plr,
+		Buckets:              t.Buckets,
+		Bandwidth:            t.Bandwidth,
+		Queue:                t.Queue,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with t. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with t.
+func (t *TrafficShaperLimiter) ToPatch(id int) *PatchFirewallTrafficShaperLimiterEndpointRequest {
+	if t == nil {
+		return &PatchFirewallTrafficShaperLimiterEndpointRequest{ID: id}
+	}
+	return &PatchFirewallTrafficShaperLimiterEndpointRequest{
+		ID:                   id,
+		Name:                 t.Name,
+		Number:               t.Number,
+		Enabled:              t.Enabled,
+		Mask:                 t.Mask,
+		Maskbits:             t.Maskbits,
+		Maskbitsv6:           t.Maskbitsv6,
+		Qlimit:               t.Qlimit,
+		Ecn:                  t.Ecn,
+		Description:          t.Description,
+		Aqm:                  t.Aqm,
+		Sched:                t.Sched,
+		ParamCodelTarget:     t.ParamCodelTarget,
+		ParamCodelInterval:   t.ParamCodelInterval,
+		ParamPieTarget:       t.ParamPieTarget,
+		ParamPieTupdate:      t.ParamPieTupdate,
+		ParamPieAlpha:        t.ParamPieAlpha,
+		ParamPieBeta:         t.ParamPieBeta,
+		ParamPieMaxBurst:     t.ParamPieMaxBurst,
+		ParamPieMaxEcnth:     t.ParamPieMaxEcnth,
+		PieOnoff:             t.PieOnoff,
+		PieCapdrop:           t.PieCapdrop,
+		PieQdelay:            t.PieQdelay,
+		PiePderand:           t.PiePderand,
+		ParamRedWQ:           t.ParamRedWQ,
+		ParamRedMinTh:        t.ParamRedMinTh,
+		ParamRedMaxTh:        t.ParamRedMaxTh,
+		ParamRedMaxP:         t.ParamRedMaxP,
+		ParamGredWQ:          t.ParamGredWQ,
+		ParamGredMinTh:       t.ParamGredMinTh,
+		ParamGredMaxTh:       t.ParamGredMaxTh,
+		ParamGredMaxP:        t.ParamGredMaxP,
+		ParamFqCodelTarget:   t.ParamFqCodelTarget,
+		ParamFqCodelInterval: t.ParamFqCodelInterval,
+		ParamFqCodelQuantum:  t.ParamFqCodelQuantum,
+		ParamFqCodelLimit:    t.ParamFqCodelLimit,
+		ParamFqCodelFlows:    t.ParamFqCodelFlows,
+		ParamFqPieTarget:     t.ParamFqPieTarget,
+		ParamFqPieTupdate:    t.ParamFqPieTupdate,
+		ParamFqPieAlpha:      t.ParamFqPieAlpha,
+		ParamFqPieBeta:       t.ParamFqPieBeta,
+		ParamFqPieMaxBurst:   t.ParamFqPieMaxBurst,
+		ParamFqPieMaxEcnth:   t.ParamFqPieMaxEcnth,
+		ParamFqPieQuantum:    t.ParamFqPieQuantum,
+		ParamFqPieLimit:      t.ParamFqPieLimit,
+		ParamFqPieFlows:      t.ParamFqPieFlows,
+		Delay:                t.Delay,
+		Plr:                  t.Plr,
+		Buckets:              t.Buckets,
+		Bandwidth:            t.Bandwidth,
+		Queue:                t.Queue,
+	}
+}
+
+// ToPutItem returns t as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with t.
+func (t *TrafficShaperLimiter) ToPutItem() *PutFirewallTrafficShaperLimitersEndpointRequestItem {
+	if t == nil {
+		return nil
+	}
+	return &PutFirewallTrafficShaperLimitersEndpointRequestItem{
+		Name:                 t.Name,
+		Number:               t.Number,
+		Enabled:              t.Enabled,
+		Mask:                 t.Mask,
+		Maskbits:             t.Maskbits,
+		Maskbitsv6:           t.Maskbitsv6,
+		Qlimit:               t.Qlimit,
+		Ecn:                  t.Ecn,
+		Description:          t.Description,
+		Aqm:                  t.Aqm,
+		Sched:                t.Sched,
+		ParamCodelTarget:     t.ParamCodelTarget,
+		ParamCodelInterval:   t.ParamCodelInterval,
+		ParamPieTarget:       t.ParamPieTarget,
+		ParamPieTupdate:      t.ParamPieTupdate,
+		ParamPieAlpha:        t.ParamPieAlpha,
+		ParamPieBeta:         t.ParamPieBeta,
+		ParamPieMaxBurst:     t.ParamPieMaxBurst,
+		ParamPieMaxEcnth:     t.ParamPieMaxEcnth,
+		PieOnoff:             t.PieOnoff,
+		PieCapdrop:           t.PieCapdrop,
+		PieQdelay:            t.PieQdelay,
+		PiePderand:           t.PiePderand,
+		ParamRedWQ:           t.ParamRedWQ,
+		ParamRedMinTh:        t.ParamRedMinTh,
+		ParamRedMaxTh:        t.ParamRedMaxTh,
+		ParamRedMaxP:         t.ParamRedMaxP,
+		ParamGredWQ:          t.ParamGredWQ,
+		ParamGredMinTh:       t.ParamGredMinTh,
+		ParamGredMaxTh:       t.ParamGredMaxTh,
+		ParamGredMaxP:        t.ParamGredMaxP,
+		ParamFqCodelTarget:   t.ParamFqCodelTarget,
+		ParamFqCodelInterval: t.ParamFqCodelInterval,
+		ParamFqCodelQuantum:  t.ParamFqCodelQuantum,
+		ParamFqCodelLimit:    t.ParamFqCodelLimit,
+		ParamFqCodelFlows:    t.ParamFqCodelFlows,
+		ParamFqPieTarget:     t.ParamFqPieTarget,
+		ParamFqPieTupdate:    t.ParamFqPieTupdate,
+		ParamFqPieAlpha:      t.ParamFqPieAlpha,
+		ParamFqPieBeta:       t.ParamFqPieBeta,
+		ParamFqPieMaxBurst:   t.ParamFqPieMaxBurst,
+		ParamFqPieMaxEcnth:   t.ParamFqPieMaxEcnth,
+		ParamFqPieQuantum:    t.ParamFqPieQuantum,
+		ParamFqPieLimit:      t.ParamFqPieLimit,
+		ParamFqPieFlows:      t.ParamFqPieFlows,
+		Delay:                t.Delay,
+		Plr:                  t.Plr,
+		Buckets:              t.Buckets,
+		Bandwidth:            t.Bandwidth,
+		Queue:                t.Queue,
+	}
+}
+
+// ToModel copies g into a new TrafficShaperLimiter, sharing its slices and pointers.
+func (g *GetFirewallTrafficShaperLimiterEndpointResponseData) ToModel() *TrafficShaperLimiter {
+	if g == nil {
+		return nil
+	}
+	return &TrafficShaperLimiter{
+		Name:                 g.Name,
+		Number:               g.Number,
+		Enabled:              g.Enabled,
+		Mask:                 g.Mask,
+		Maskbits:             g.Maskbits,
+		Maskbitsv6:           g.Maskbitsv6,
+		Qlimit:               g.Qlimit,
+		Ecn:                  g.Ecn,
+		Description:          g.Description,
+		Aqm:                  g.Aqm,
+		Sched:                g.Sched,
+		ParamCodelTarget:     g.ParamCodelTarget,
+		ParamCodelInterval:   g.ParamCodelInterval,
+		ParamPieTarget:       g.ParamPieTarget,
+		ParamPieTupdate:      g.ParamPieTupdate,
+		ParamPieAlpha:        g.ParamPieAlpha,
+		ParamPieBeta:         g.ParamPieBeta,
+		ParamPieMaxBurst:     g.ParamPieMaxBurst,
+		ParamPieMaxEcnth:     g.ParamPieMaxEcnth,
+		PieOnoff:             g.PieOnoff,
+		PieCapdrop:           g.PieCapdrop,
+		PieQdelay:            g.PieQdelay,
+		PiePderand:           g.PiePderand,
+		ParamRedWQ:           g.ParamRedWQ,
+		ParamRedMinTh:        g.ParamRedMinTh,
+		ParamRedMaxTh:        g.ParamRedMaxTh,
+		ParamRedMaxP:         g.ParamRedMaxP,
+		ParamGredWQ:          g.ParamGredWQ,
+		ParamGredMinTh:       g.ParamGredMinTh,
+		ParamGredMaxTh:       g.ParamGredMaxTh,
+		ParamGredMaxP:        g.ParamGredMaxP,
+		ParamFqCodelTarget:   g.ParamFqCodelTarget,
+		ParamFqCodelInterval: g.ParamFqCodelInterval,
+		ParamFqCodelQuantum:  g.ParamFqCodelQuantum,
+		ParamFqCodelLimit:    g.ParamFqCodelLimit,
+		ParamFqCodelFlows:    g.ParamFqCodelFlows,
+		ParamFqPieTarget:     g.ParamFqPieTarget,
+		ParamFqPieTupdate:    g.ParamFqPieTupdate,
+		ParamFqPieAlpha:      g.ParamFqPieAlpha,
+		ParamFqPieBeta:       g.ParamFqPieBeta,
+		ParamFqPieMaxBurst:   g.ParamFqPieMaxBurst,
+		ParamFqPieMaxEcnth:   g.ParamFqPieMaxEcnth,
+		ParamFqPieQuantum:    g.ParamFqPieQuantum,
+		ParamFqPieLimit:      g.ParamFqPieLimit,
+		ParamFqPieFlows:      g.ParamFqPieFlows,
+		Delay:                g.Delay,
+		Plr:                  g.Plr,
+		Buckets:              g.Buckets,
+		Bandwidth:            g.Bandwidth,
+		Queue:                g.Queue,
+	}
+}
+
+// ToModel copies p into a new TrafficShaperLimiter, sharing its slices and pointers.
+func (p *PostFirewallTrafficShaperLimiterEndpointResponseData) ToModel() *TrafficShaperLimiter {
+	if p == nil {
+		return nil
+	}
+	return &TrafficShaperLimiter{
+		Name:                 p.Name,
+		Number:               p.Number,
+		Enabled:              p.Enabled,
+		Mask:                 p.Mask,
+		Maskbits:             p.Maskbits,
+		Maskbitsv6:           p.Maskbitsv6,
+		Qlimit:               p.Qlimit,
+		Ecn:                  p.Ecn,
+		Description:          p.Description,
+		Aqm:                  p.Aqm,
+		Sched:                p.Sched,
+		ParamCodelTarget:     p.ParamCodelTarget,
+		ParamCodelInterval:   p.ParamCodelInterval,
+		ParamPieTarget:       p.ParamPieTarget,
+		ParamPieTupdate:      p.ParamPieTupdate,
+		ParamPieAlpha:        p.ParamPieAlpha,
+		ParamPieBeta:         p.ParamPieBeta,
+		ParamPieMaxBurst:     p.ParamPieMaxBurst,
+		ParamPieMaxEcnth:     p.ParamPieMaxEcnth,
+		PieOnoff:             p.PieOnoff,
+		PieCapdrop:           p.PieCapdrop,
+		PieQdelay:            p.PieQdelay,
+		PiePderand:           p.PiePderand,
+		ParamRedWQ:           p.ParamRedWQ,
+		ParamRedMinTh:        p.ParamRedMinTh,
+		ParamRedMaxTh:        p.ParamRedMaxTh,
+		ParamRedMaxP:         p.ParamRedMaxP,
+		ParamGredWQ:          p.ParamGredWQ,
+		ParamGredMinTh:       p.ParamGredMinTh,
+		ParamGredMaxTh:       p.ParamGredMaxTh,
+		ParamGredMaxP:        p.ParamGredMaxP,
+		ParamFqCodelTarget:   p.ParamFqCodelTarget,
+		ParamFqCodelInterval: p.ParamFqCodelInterval,
+		ParamFqCodelQuantum:  p.ParamFqCodelQuantum,
+		ParamFqCodelLimit:    p.ParamFqCodelLimit,
+		ParamFqCodelFlows:    p.ParamFqCodelFlows,
+		ParamFqPieTarget:     p.ParamFqPieTarget,
+		ParamFqPieTupdate:    p.ParamFqPieTupdate,
+		ParamFqPieAlpha:      p.ParamFqPieAlpha,
+		ParamFqPieBeta:       p.ParamFqPieBeta,
+		ParamFqPieMaxBurst:   p.ParamFqPieMaxBurst,
+		ParamFqPieMaxEcnth:   p.ParamFqPieMaxEcnth,
+		ParamFqPieQuantum:    p.ParamFqPieQuantum,
+		ParamFqPieLimit:      p.ParamFqPieLimit,
+		ParamFqPieFlows:      p.ParamFqPieFlows,
+		Delay:                p.Delay,
+		Plr:                  p.Plr,
+		Buckets:              p.Buckets,
+		Bandwidth:            p.Bandwidth,
+		Queue:                p.Queue,
+	}
+}
+
+// ToModel copies p into a new TrafficShaperLimiter, sharing its slices and pointers.
+func (p *PatchFirewallTrafficShaperLimiterEndpointResponseData) ToModel() *TrafficShaperLimiter {
+	if p == nil {
+		return nil
+	}
+	return &TrafficShaperLimiter{
+		Name:                 p.Name,
+		Number:               p.Number,
+		Enabled:              p.Enabled,
+		Mask:                 p.Mask,
+		Maskbits:             p.Maskbits,
+		Maskbitsv6:           p.Maskbitsv6,
+		Qlimit:               p.Qlimit,
+		Ecn:                  p.Ecn,
+		Description:          p.Description,
+		Aqm:                  p.Aqm,
+		Sched:                p.Sched,
+		ParamCodelTarget:     p.ParamCodelTarget,
+		ParamCodelInterval:   p.ParamCodelInterval,
+		ParamPieTarget:       p.ParamPieTarget,
+		ParamPieTupdate:      p.ParamPieTupdate,
+		ParamPieAlpha:        p.ParamPieAlpha,
+		ParamPieBeta:         p.ParamPieBeta,
+		ParamPieMaxBurst:     p.ParamPieMaxBurst,
+		ParamPieMaxEcnth:     p.ParamPieMaxEcnth,
+		PieOnoff:             p.PieOnoff,
+		PieCapdrop:           p.PieCapdrop,
+		PieQdelay:            p.PieQdelay,
+		PiePderand:           p.PiePderand,
+		ParamRedWQ:           p.ParamRedWQ,
+		ParamRedMinTh:        p.ParamRedMinTh,
+		ParamRedMaxTh:        p.ParamRedMaxTh,
+		ParamRedMaxP:         p.ParamRedMaxP,
+		ParamGredWQ:          p.ParamGredWQ,
+		ParamGredMinTh:       p.ParamGredMinTh,
+		ParamGredMaxTh:       p.ParamGredMaxTh,
+		ParamGredMaxP:        p.ParamGredMaxP,
+		ParamFqCodelTarget:   p.ParamFqCodelTarget,
+		ParamFqCodelInterval: p.ParamFqCodelInterval,
+		ParamFqCodelQuantum:  p.ParamFqCodelQuantum,
+		ParamFqCodelLimit:    p.ParamFqCodelLimit,
+		ParamFqCodelFlows:    p.ParamFqCodelFlows,
+		ParamFqPieTarget:     p.ParamFqPieTarget,
+		ParamFqPieTupdate:    p.ParamFqPieTupdate,
+		ParamFqPieAlpha:      p.ParamFqPieAlpha,
+		ParamFqPieBeta:       p.ParamFqPieBeta,
+		ParamFqPieMaxBurst:   p.ParamFqPieMaxBurst,
+		ParamFqPieMaxEcnth:   p.ParamFqPieMaxEcnth,
+		ParamFqPieQuantum:    p.ParamFqPieQuantum,
+		ParamFqPieLimit:      p.ParamFqPieLimit,
+		ParamFqPieFlows:      p.ParamFqPieFlows,
+		Delay:                p.Delay,
+		Plr:                  p.Plr,
+		Buckets:              p.Buckets,
+		Bandwidth:            p.Bandwidth,
+		Queue:                p.Queue,
+	}
+}
+
+// ToModel copies g into a new TrafficShaperLimiter, sharing its slices and pointers.
+func (g *GetFirewallTrafficShaperLimitersEndpointResponseDataItem) ToModel() *TrafficShaperLimiter {
+	if g == nil {
+		return nil
+	}
+	return &TrafficShaperLimiter{
+		Name:                 g.Name,
+		Number:               g.Number,
+		Enabled:              g.Enabled,
+		Mask:                 g.Mask,
+		Maskbits:             g.Maskbits,
+		Maskbitsv6:           g.Maskbitsv6,
+		Qlimit:               g.Qlimit,
+		Ecn:                  g.Ecn,
+		Description:          g.Description,
+		Aqm:                  g.Aqm,
+		Sched:                g.Sched,
+		ParamCodelTarget:     g.ParamCodelTarget,
+		ParamCodelInterval:   g.ParamCodelInterval,
+		ParamPieTarget:       g.ParamPieTarget,
+		ParamPieTupdate:      g.ParamPieTupdate,
+		ParamPieAlpha:        g.ParamPieAlpha,
+		ParamPieBeta:         g.ParamPieBeta,
+		ParamPieMaxBurst:     g.ParamPieMaxBurst,
+		ParamPieMaxEcnth:     g.ParamPieMaxEcnth,
+		PieOnoff:             g.PieOnoff,
+		PieCapdrop:           g.PieCapdrop,
+		PieQdelay:            g.PieQdelay,
+		PiePderand:           g.PiePderand,
+		ParamRedWQ:           g.ParamRedWQ,
+		ParamRedMinTh:        g.ParamRedMinTh,
+		ParamRedMaxTh:        g.ParamRedMaxTh,
+		ParamRedMaxP:         g.ParamRedMaxP,
+		ParamGredWQ:          g.ParamGredWQ,
+		ParamGredMinTh:       g.ParamGredMinTh,
+		ParamGredMaxTh:       g.ParamGredMaxTh,
+		ParamGredMaxP:        g.ParamGredMaxP,
+		ParamFqCodelTarget:   g.ParamFqCodelTarget,
+		ParamFqCodelInterval: g.ParamFqCodelInterval,
+		ParamFqCodelQuantum:  g.ParamFqCodelQuantum,
+		ParamFqCodelLimit:    g.ParamFqCodelLimit,
+		ParamFqCodelFlows:    g.ParamFqCodelFlows,
+		ParamFqPieTarget:     g.ParamFqPieTarget,
+		ParamFqPieTupdate:    g.ParamFqPieTupdate,
+		ParamFqPieAlpha:      g.ParamFqPieAlpha,
+		ParamFqPieBeta:       g.ParamFqPieBeta,
+		ParamFqPieMaxBurst:   g.ParamFqPieMaxBurst,
+		ParamFqPieMaxEcnth:   g.ParamFqPieMaxEcnth,
+		ParamFqPieQuantum:    g.ParamFqPieQuantum,
+		ParamFqPieLimit:      g.ParamFqPieLimit,
+		ParamFqPieFlows:      g.ParamFqPieFlows,
+		Delay:                g.Delay,
+		Plr:                  g.Plr,
+		Buckets:              g.Buckets,
+		Bandwidth:            g.Bandwidth,
+		Queue:                g.Queue,
+	}
+}
+
+// ToPost returns a request that creates a copy of t. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with t.
+func (t *TrafficShaperQueue) ToPost(parentID int) *PostFirewallTrafficShaperQueueEndpointRequest {
+	if t == nil {
+		return &PostFirewallTrafficShaperQueueEndpointRequest{ParentID: parentID}
+	}
+	return &PostFirewallTrafficShaperQueueEndpointRequest{
+		ParentID:      parentID,
+		Interface:     t.Interface,
+		Enabled:       t.Enabled,
+		Name:          t.Name,
+		Priority:      t.Priority,
+		Qlimit:        t.Qlimit,
+		Description:   t.Description,
+		Default:       t.Default,
+		Red:           t.Red,
+		Rio:           t.Rio,
+		Ecn:           t.Ecn,
+		Codel:         t.Codel,
+		Bandwidthtype: t.Bandwidthtype,
+		Bandwidth:     t.Bandwidth,
+		Buckets:       t.Buckets,
+		Hogs:          t.Hogs,
+		Borrow:        t.Borrow,
+		Upperlimit:    t.Upperlimit,
+		UpperlimitM1:  t.UpperlimitM1,
+		UpperlimitD:   t.UpperlimitD,
+		UpperlimitM2:  t.UpperlimitM2,
+		Realtime:      t.Realtime,
+		RealtimeM1:    t.RealtimeM1,
+		RealtimeD:     t.RealtimeD,
+		RealtimeM2:    t.RealtimeM2,
+		Linkshare:     t.Linkshare,
+		LinkshareM1:   t.LinkshareM1,
+		LinkshareD:    t.LinkshareD,
+		LinkshareM2:   t.LinkshareM2,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with t. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with t.
+func (t *TrafficShaperQueue) ToPatch(parentID, id int) *PatchFirewallTrafficShaperQueueEndpointRequest {
+	if t == nil {
+		return &PatchFirewallTrafficShaperQueueEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchFirewallTrafficShaperQueueEndpointRequest{
+		ParentID:      parentID,
+		ID:            id,
+		Interface:     t.Interface,
+		Enabled:       t.Enabled,
+		Name:          t.Name,
+		Priority:      t.Priority,
+		Qlimit:        t.Qlimit,
+		Description:   t.Description,
+		Default:       t.Default,
+		Red:           t.Red,
+		Rio:           t.Rio,
+		Ecn:           t.Ecn,
+		Codel:         t.Codel,
+		Bandwidthtype: t.Bandwidthtype,
+		Bandwidth:     t.Bandwidth,
+		Buckets:       t.Buckets,
+		Hogs:          t.Hogs,
+		Borrow:        t.Borrow,
+		Upperlimit:    t.Upperlimit,
+		UpperlimitM1:  t.UpperlimitM1,
+		UpperlimitD:   t.UpperlimitD,
+		UpperlimitM2:  t.UpperlimitM2,
+		Realtime:      t.Realtime,
+		RealtimeM1:    t.RealtimeM1,
+		RealtimeD:     t.RealtimeD,
+		RealtimeM2:    t.RealtimeM2,
+		Linkshare:     t.Linkshare,
+		LinkshareM1:   t.LinkshareM1,
+		LinkshareD:    t.LinkshareD,
+		LinkshareM2:   t.LinkshareM2,
+	}
+}
+
+// ToModel copies g into a new TrafficShaperQueue, sharing its slices and pointers.
+func (g *GetFirewallTrafficShaperQueueEndpointResponseData) ToModel() *TrafficShaperQueue {
+	if g == nil {
+		return nil
+	}
+	return &TrafficShaperQueue{
+		Interface:     g.Interface,
+		Enabled:       g.Enabled,
+		Name:          g.Name,
+		Priority:      g.Priority,
+		Qlimit:        g.Qlimit,
+		Description:   g.Description,
+		Default:       g.Default,
+		Red:           g.Red,
+		Rio:           g.Rio,
+		Ecn:           g.Ecn,
+		Codel:         g.Codel,
+		Bandwidthtype: g.Bandwidthtype,
+		Bandwidth:     g.Bandwidth,
+		Buckets:       g.Buckets,
+		Hogs:          g.Hogs,
+		Borrow:        g.Borrow,
+		Upperlimit:    g.Upperlimit,
+		UpperlimitM1:  g.UpperlimitM1,
+		UpperlimitD:   g.UpperlimitD,
+		UpperlimitM2:  g.UpperlimitM2,
+		Realtime:      g.Realtime,
+		RealtimeM1:    g.RealtimeM1,
+		RealtimeD:     g.RealtimeD,
+		RealtimeM2:    g.RealtimeM2,
+		Linkshare:     g.Linkshare,
+		LinkshareM1:   g.LinkshareM1,
+		LinkshareD:    g.LinkshareD,
+		LinkshareM2:   g.LinkshareM2,
+	}
+}
+
+// ToModel copies p into a new TrafficShaperQueue, sharing its slices and pointers.
+func (p *PostFirewallTrafficShaperQueueEndpointResponseData) ToModel() *TrafficShaperQueue {
+	if p == nil {
+		return nil
+	}
+	return &TrafficShaperQueue{
+		Interface:     p.Interface,
+		Enabled:       p.Enabled,
+		Name:          p.Name,
+		Priority:      p.Priority,
+		Qlimit:        p.Qlimit,
+		Description:   p.Description,
+		Default:       p.Default,
+		Red:           p.Red,
+		Rio:           p.Rio,
+		Ecn:           p.Ecn,
+		Codel:         p.Codel,
+		Bandwidthtype: p.Bandwidthtype,
+		Bandwidth:     p.Bandwidth,
+		Buckets:       p.Buckets,
+		Hogs:          p.Hogs,
+		Borrow:        p.Borrow,
+		Upperlimit:    p.Upperlimit,
+		UpperlimitM1:  p.UpperlimitM1,
+		UpperlimitD:   p.UpperlimitD,
+		UpperlimitM2:  p.UpperlimitM2,
+		Realtime:      p.Realtime,
+		RealtimeM1:    p.RealtimeM1,
+		RealtimeD:     p.RealtimeD,
+		RealtimeM2:    p.RealtimeM2,
+		Linkshare:     p.Linkshare,
+		LinkshareM1:   p.LinkshareM1,
+		LinkshareD:    p.LinkshareD,
+		LinkshareM2:   p.LinkshareM2,
+	}
+}
+
+// ToModel copies p into a new TrafficShaperQueue, sharing its slices and pointers.
+func (p *PatchFirewallTrafficShaperQueueEndpointResponseData) ToModel() *TrafficShaperQueue {
+	if p == nil {
+		return nil
+	}
+	return &TrafficShaperQueue{
+		Interface:     p.Interface,
+		Enabled:       p.Enabled,
+		Name:          p.Name,
+		Priority:      p.Priority,
+		Qlimit:        p.Qlimit,
+		Description:   p.Description,
+		Default:       p.Default,
+		Red:           p.Red,
+		Rio:           p.Rio,
+		Ecn:           p.Ecn,
+		Codel:         p.Codel,
+		Bandwidthtype: p.Bandwidthtype,
+		Bandwidth:     p.Bandwidth,
+		Buckets:       p.Buckets,
+		Hogs:          p.Hogs,
+		Borrow:        p.Borrow,
+		Upperlimit:    p.Upperlimit,
+		UpperlimitM1:  p.UpperlimitM1,
+		UpperlimitD:   p.UpperlimitD,
+		UpperlimitM2:  p.UpperlimitM2,
+		Realtime:      p.Realtime,
+		RealtimeM1:    p.RealtimeM1,
+		RealtimeD:     p.RealtimeD,
+		RealtimeM2:    p.RealtimeM2,
+		Linkshare:     p.Linkshare,
+		LinkshareM1:   p.LinkshareM1,
+		LinkshareD:    p.LinkshareD,
+		LinkshareM2:   p.LinkshareM2,
+	}
+}
+
+// ToModel copies g into a new TrafficShaperQueue, sharing its slices and pointers.
+func (g *GetFirewallTrafficShaperQueuesEndpointResponseDataItem) ToModel() *TrafficShaperQueue {
+	if g == nil {
+		return nil
+	}
+	return &TrafficShaperQueue{
+		Interface:     g.Interface,
+		Enabled:       g.Enabled,
+		Name:          g.Name,
+		Priority:      g.Priority,
+		Qlimit:        g.Qlimit,
+		Description:   g.Description,
+		Default:       g.Default,
+		Red:           g.Red,
+		Rio:           g.Rio,
+		Ecn:           g.Ecn,
+		Codel:         g.Codel,
+		Bandwidthtype: g.Bandwidthtype,
+		Bandwidth:     g.Bandwidth,
+		Buckets:       g.Buckets,
+		Hogs:          g.Hogs,
+		Borrow:        g.Borrow,
+		Upperlimit:    g.Upperlimit,
+		UpperlimitM1:  g.UpperlimitM1,
+		UpperlimitD:   g.UpperlimitD,
+		UpperlimitM2:  g.UpperlimitM2,
+		Realtime:      g.Realtime,
+		RealtimeM1:    g.RealtimeM1,
+		RealtimeD:     g.RealtimeD,
+		RealtimeM2:    g.RealtimeM2,
+		Linkshare:     g.Linkshare,
+		LinkshareM1:   g.LinkshareM1,
+		LinkshareD:    g.LinkshareD,
+		LinkshareM2:   g.LinkshareM2,
+	}
+}
+
+// ToPost returns a request that creates a copy of t. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with t.
+func (t *TrafficShaper) ToPost() *PostFirewallTrafficShaperEndpointRequest {
+	if t == nil {
+		return &PostFirewallTrafficShaperEndpointRequest{}
+	}
+	return &PostFirewallTrafficShaperEndpointRequest{
+		Enabled:       t.Enabled,
+		Interface:     t.Interface,
+		Name:          t.Name,
+		Scheduler:     t.Scheduler,
+		Bandwidthtype: t.Bandwidthtype,
+		Bandwidth:     t.Bandwidth,
+		Qlimit:        t.Qlimit,
+		Tbrconfig:     t.Tbrconfig,
+		Queue:         t.Queue,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with t. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with t.
+func (t *TrafficShaper) ToPatch(id int) *PatchFirewallTrafficShaperEndpointRequest {
+	if t == nil {
+		return &PatchFirewallTrafficShaperEndpointRequest{ID: id}
+	}
+	return &PatchFirewallTrafficShaperEndpointRequest{
+		ID:            id,
+		Enabled:       t.Enabled,
+		Interface:     t.Interface,
+		Name:          t.Name,
+		Scheduler:     t.Scheduler,
+		Bandwidthtype: t.Bandwidthtype,
+		Bandwidth:     t.Bandwidth,
+		Qlimit:        t.Qlimit,
+		Tbrconfig:     t.Tbrconfig,
+		Queue:         t.Queue,
+	}
+}
+
+// ToPutItem returns t as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with t.
+func (t *TrafficShaper) ToPutItem() *PutFirewallTrafficShapersEndpointRequestItem {
+	if t == nil {
+		return nil
+	}
+	return &PutFirewallTrafficShapersEndpointRequestItem{
+		Enabled:       t.Enabled,
+		Interface:     t.Interface,
+		Name:          t.Name,
+		Scheduler:     t.Scheduler,
+		Bandwidthtype: t.Bandwidthtype,
+		Bandwidth:     t.Bandwidth,
+		Qlimit:        t.Qlimit,
+		Tbrconfig:     t.Tbrconfig,
+		Queue:         t.Queue,
+	}
+}
+
+// ToModel copies g into a new TrafficShaper, sharing its slices and pointers.
+func (g *GetFirewallTrafficShaperEndpointResponseData) ToModel() *TrafficShaper {
+	if g == nil {
+		return nil
+	}
+	return &TrafficShaper{
+		Enabled:       g.Enabled,
+		Interface:     g.Interface,
+		Name:          g.Name,
+		Scheduler:     g.Scheduler,
+		Bandwidthtype: g.Bandwidthtype,
+		Bandwidth:     g.Bandwidth,
+		Qlimit:        g.Qlimit,
+		Tbrconfig:     g.Tbrconfig,
+		Queue:         g.Queue,
+	}
+}
+
+// ToModel copies p into a new TrafficShaper, sharing its slices and pointers.
+func (p *PostFirewallTrafficShaperEndpointResponseData) ToModel() *TrafficShaper {
+	if p == nil {
+		return nil
+	}
+	return &TrafficShaper{
+		Enabled:       p.Enabled,
+		Interface:     p.Interface,
+		Name:          p.Name,
+		Scheduler:     p.Scheduler,
+		Bandwidthtype: p.Bandwidthtype,
+		Bandwidth:     p.Bandwidth,
+		Qlimit:        p.Qlimit,
+		Tbrconfig:     p.Tbrconfig,
+		Queue:         p.Queue,
+	}
+}
+
+// ToModel copies p into a new TrafficShaper, sharing its slices and pointers.
+func (p *PatchFirewallTrafficShaperEndpointResponseData) ToModel() *TrafficShaper {
+	if p == nil {
+		return nil
+	}
+	return &TrafficShaper{
+		Enabled:       p.Enabled,
+		Interface:     p.Interface,
+		Name:          p.Name,
+		Scheduler:     p.Scheduler,
+		Bandwidthtype: p.Bandwidthtype,
+		Bandwidth:     p.Bandwidth,
+		Qlimit:        p.Qlimit,
+		Tbrconfig:     p.Tbrconfig,
+		Queue:         p.Queue,
+	}
+}
+
+// ToModel copies g into a new TrafficShaper, sharing its slices and pointers.
+func (g *GetFirewallTrafficShapersEndpointResponseDataItem) ToModel() *TrafficShaper {
+	if g == nil {
+		return nil
+	}
+	return &TrafficShaper{
+		Enabled:       g.Enabled,
+		Interface:     g.Interface,
+		Name:          g.Name,
+		Scheduler:     g.Scheduler,
+		Bandwidthtype: g.Bandwidthtype,
+		Bandwidth:     g.Bandwidth,
+		Qlimit:        g.Qlimit,
+		Tbrconfig:     g.Tbrconfig,
+		Queue:         g.Queue,
+	}
+}
+
+// ToPost returns a request that creates a copy of u. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with u.
+func (u *UserGroup) ToPost() *PostUserGroupEndpointRequest {
+	if u == nil {
+		return &PostUserGroupEndpointRequest{}
+	}
+	return &PostUserGroupEndpointRequest{
+		Name:        u.Name,
+		Gid:         u.Gid,
+		Scope:       u.Scope,
+		Description: u.Description,
+		Member:      u.Member,
+		Priv:        u.Priv,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with u. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with u.
+func (u *UserGroup) ToPatch(id int) *PatchUserGroupEndpointRequest {
+	if u == nil {
+		return &PatchUserGroupEndpointRequest{ID: id}
+	}
+	return &PatchUserGroupEndpointRequest{
+		ID:          id,
+		Name:        u.Name,
+		Gid:         u.Gid,
+		Scope:       u.Scope,
+		Description: u.Description,
+		Member:      u.Member,
+		Priv:        u.Priv,
+	}
+}
+
+// ToPutItem returns u as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with u.
+func (u *UserGroup) ToPutItem() *PutUserGroupsEndpointRequestItem {
+	if u == nil {
+		return nil
+	}
+	return &PutUserGroupsEndpointRequestItem{
+		Name:        u.Name,
+		Gid:         u.Gid,
+		Scope:       u.Scope,
+		Description: u.Description,
+		Member:      u.Member,
+		Priv:        u.Priv,
+	}
+}
+
+// ToModel copies g into a new UserGroup, sharing its slices and pointers.
+func (g *GetUserGroupEndpointResponseData) ToModel() *UserGroup {
+	if g == nil {
+		return nil
+	}
+	return &UserGroup{
+		Name:        g.Name,
+		Gid:         g.Gid,
+		Scope:       g.Scope,
+		Description: g.Description,
+		Member:      g.Member,
+		Priv:        g.Priv,
+	}
+}
+
+// ToModel copies p into a new UserGroup, sharing its slices and pointers.
+func (p *PostUserGroupEndpointResponseData) ToModel() *UserGroup {
+	if p == nil {
+		return nil
+	}
+	return &UserGroup{
+		Name:        p.Name,
+		Gid:         p.Gid,
+		Scope:       p.Scope,
+		Description: p.Description,
+		Member:      p.Member,
+		Priv:        p.Priv,
+	}
+}
+
+// ToModel copies p into a new UserGroup, sharing its slices and pointers.
+func (p *PatchUserGroupEndpointResponseData) ToModel() *UserGroup {
+	if p == nil {
+		return nil
+	}
+	return &UserGroup{
+		Name:        p.Name,
+		Gid:         p.Gid,
+		Scope:       p.Scope,
+		Description: p.Description,
+		Member:      p.Member,
+		Priv:        p.Priv,
+	}
+}
+
+// ToModel copies g into a new UserGroup, sharing its slices and pointers.
+func (g *GetUserGroupsEndpointResponseDataItem) ToModel() *UserGroup {
+	if g == nil {
+		return nil
+	}
+	return &UserGroup{
+		Name:        g.Name,
+		Gid:         g.Gid,
+		Scope:       g.Scope,
+		Description: g.Description,
+		Member:      g.Member,
+		Priv:        g.Priv,
+	}
+}
+
+// ToPost returns a request that creates a copy of u. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with u.
+func (u *User) ToPost() *PostUserEndpointRequest {
+	if u == nil {
+		return &PostUserEndpointRequest{}
+	}
+	return &PostUserEndpointRequest{
+		Name:           u.Name,
+		Password:       u.Password,
+		UID:            u.UID,
+		Scope:          u.Scope,
+		Priv:           u.Priv,
+		Disabled:       u.Disabled,
+		Descr:          u.Descr,
+		Expires:        u.Expires,
+		Cert:           u.Cert,
+		Authorizedkeys: u.Authorizedkeys,
+		Ipsecpsk:       u.Ipsecpsk,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with u. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with u.
+func (u *User) ToPatch(id int) *PatchUserEndpointRequest {
+	if u == nil {
+		return &PatchUserEndpointRequest{ID: id}
+	}
+	return &PatchUserEndpointRequest{
+		ID:             id,
+		Name:           u.Name,
+		Password:       u.Password,
+		UID:            u.UID,
+		Scope:          u.Scope,
+		Priv:           u.Priv,
+		Disabled:       u.Disabled,
+		Descr:          u.Descr,
+		Expires:        u.Expires,
+		Cert:           u.Cert,
+		Authorizedkeys: u.Authorizedkeys,
+		Ipsecpsk:       u.Ipsecpsk,
+	}
+}
+
+// ToModel copies g into a new User, sharing its slices and pointers.
+func (g *GetUserEndpointResponseData) ToModel() *User {
+	if g == nil {
+		return nil
+	}
+	return &User{
+		Name:           g.Name,
+		Password:       g.Password,
+		UID:            g.UID,
+		Scope:          g.Scope,
+		Priv:           g.Priv,
+		Disabled:       g.Disabled,
+		Descr:          g.Descr,
+		Expires:        g.Expires,
+		Cert:           g.Cert,
+		Authorizedkeys: g.Authorizedkeys,
+		Ipsecpsk:       g.Ipsecpsk,
+	}
+}
+
+// ToModel copies p into a new User, sharing its slices and pointers.
+func (p *PostUserEndpointResponseData) ToModel() *User {
+	if p == nil {
+		return nil
+	}
+	return &User{
+		Name:           p.Name,
+		Password:       p.Password,
+		UID:            p.UID,
+		Scope:          p.Scope,
+		Priv:           p.Priv,
+		Disabled:       p.Disabled,
+		Descr:          p.Descr,
+		Expires:        p.Expires,
+		Cert:           p.Cert,
+		Authorizedkeys: p.Authorizedkeys,
+		Ipsecpsk:       p.Ipsecpsk,
+	}
+}
+
+// ToModel copies p into a new User, sharing its slices and pointers.
+func (p *PatchUserEndpointResponseData) ToModel() *User {
+	if p == nil {
+		return nil
+	}
+	return &User{
+		Name:           p.Name,
+		Password:       p.Password,
+		UID:            p.UID,
+		Scope:          p.Scope,
+		Priv:           p.Priv,
+		Disabled:       p.Disabled,
+		Descr:          p.Descr,
+		Expires:        p.Expires,
+		Cert:           p.Cert,
+		Authorizedkeys: p.Authorizedkeys,
+		Ipsecpsk:       p.Ipsecpsk,
+	}
+}
+
+// ToModel copies g into a new User, sharing its slices and pointers.
+func (g *GetUsersEndpointResponseDataItem) ToModel() *User {
+	if g == nil {
+		return nil
+	}
+	return &User{
+		Name:           g.Name,
+		Password:       g.Password,
+		UID:            g.UID,
+		Scope:          g.Scope,
+		Priv:           g.Priv,
+		Disabled:       g.Disabled,
+		Descr:          g.Descr,
+		Expires:        g.Expires,
+		Cert:           g.Cert,
+		Authorizedkeys: g.Authorizedkeys,
+		Ipsecpsk:       g.Ipsecpsk,
+	}
+}
+
+// ToPost returns a request that creates a copy of v. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with v.
+func (v *VirtualIP) ToPost() *PostFirewallVirtualIPEndpointRequest {
+	if v == nil {
+		return &PostFirewallVirtualIPEndpointRequest{}
+	}
+	return &PostFirewallVirtualIPEndpointRequest{
+		Uniqid:     v.Uniqid,
+		Mode:       v.Mode,
+		Interface:  v.Interface,
+		Type:       v.Type,
+		Subnet:     v.Subnet,
+		SubnetBits: v.SubnetBits,
+		Descr:      v.Descr,
+		Noexpand:   v.Noexpand,
+		Vhid:       v.Vhid,
+		Advbase:    v.Advbase,
+		Advskew:    v.Advskew,
+		Password:   v.Password,
+		CarpStatus: v.CarpStatus,
+		CarpMode:   v.CarpMode,
+		CarpPeer:   v.CarpPeer,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with v. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with v.
+func (v *VirtualIP) ToPatch(id int) *PatchFirewallVirtualIPEndpointRequest {
+	if v == nil {
+		return &PatchFirewallVirtualIPEndpointRequest{ID: id}
+	}
+	return &PatchFirewallVirtualIPEndpointRequest{
+		ID:         id,
+		Uniqid:     v.Uniqid,
+		Mode:       v.Mode,
+		Interface:  v.Interface,
+		Type:       v.Type,
+		Subnet:     v.Subnet,
+		SubnetBits: v.SubnetBits,
+		Descr:      v.Descr,
+		Noexpand:   v.Noexpand,
+		Vhid:       v.Vhid,
+		Advbase:    v.Advbase,
+		Advskew:    v.Advskew,
+		Password:   v.Password,
+		CarpStatus: v.CarpStatus,
+		CarpMode:   v.CarpMode,
+		CarpPeer:   v.CarpPeer,
+	}
+}
+
+// ToModel copies g into a new VirtualIP, sharing its slices and pointers.
+func (g *GetFirewallVirtualIPEndpointResponseData) ToModel() *VirtualIP {
+	if g == nil {
+		return nil
+	}
+	return &VirtualIP{
+		Uniqid:     g.Uniqid,
+		Mode:       g.Mode,
+		Interface:  g.Interface,
+		Type:       g.Type,
+		Subnet:     g.Subnet,
+		SubnetBits: g.SubnetBits,
+		Descr:      g.Descr,
+		Noexpand:   g.Noexpand,
+		Vhid:       g.Vhid,
+		Advbase:    g.Advbase,
+		Advskew:    g.Advskew,
+		Password:   g.Password,
+		CarpStatus: g.CarpStatus,
+		CarpMode:   g.CarpMode,
+		CarpPeer:   g.CarpPeer,
+	}
+}
+
+// ToModel copies p into a new VirtualIP, sharing its slices and pointers.
+func (p *PostFirewallVirtualIPEndpointResponseData) ToModel() *VirtualIP {
+	if p == nil {
+		return nil
+	}
+	return &VirtualIP{
+		Uniqid:     p.Uniqid,
+		Mode:       p.Mode,
+		Interface:  p.Interface,
+		Type:       p.Type,
+		Subnet:     p.Subnet,
+		SubnetBits: p.SubnetBits,
+		Descr:      p.Descr,
+		Noexpand:   p.Noexpand,
+		Vhid:       p.Vhid,
+		Advbase:    p.Advbase,
+		Advskew:    p.Advskew,
+		Password:   p.Password,
+		CarpStatus: p.CarpStatus,
+		CarpMode:   p.CarpMode,
+		CarpPeer:   p.CarpPeer,
+	}
+}
+
+// ToModel copies p into a new VirtualIP, sharing its slices and pointers.
+func (p *PatchFirewallVirtualIPEndpointResponseData) ToModel() *VirtualIP {
+	if p == nil {
+		return nil
+	}
+	return &VirtualIP{
+		Uniqid:     p.Uniqid,
+		Mode:       p.Mode,
+		Interface:  p.Interface,
+		Type:       p.Type,
+		Subnet:     p.Subnet,
+		SubnetBits: p.SubnetBits,
+		Descr:      p.Descr,
+		Noexpand:   p.Noexpand,
+		Vhid:       p.Vhid,
+		Advbase:    p.Advbase,
+		Advskew:    p.Advskew,
+		Password:   p.Password,
+		CarpStatus: p.CarpStatus,
+		CarpMode:   p.CarpMode,
+		CarpPeer:   p.CarpPeer,
+	}
+}
+
+// ToModel copies g into a new VirtualIP, sharing its slices and pointers.
+func (g *GetFirewallVirtualIPsEndpointResponseDataItem) ToModel() *VirtualIP {
+	if g == nil {
+		return nil
+	}
+	return &VirtualIP{
+		Uniqid:     g.Uniqid,
+		Mode:       g.Mode,
+		Interface:  g.Interface,
+		Type:       g.Type,
+		Subnet:     g.Subnet,
+		SubnetBits: g.SubnetBits,
+		Descr:      g.Descr,
+		Noexpand:   g.Noexpand,
+		Vhid:       g.Vhid,
+		Advbase:    g.Advbase,
+		Advskew:    g.Advskew,
+		Password:   g.Password,
+		CarpStatus: g.CarpStatus,
+		CarpMode:   g.CarpMode,
+		CarpPeer:   g.CarpPeer,
+	}
+}
+
+// ToPost returns a request that creates a copy of w. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with w.
+func (w *WireGuardPeerAllowedIP) ToPost(parentID int) *PostVpnWireGuardPeerAllowedIPEndpointRequest {
+	if w == nil {
+		return &PostVpnWireGuardPeerAllowedIPEndpointRequest{ParentID: parentID}
+	}
+	return &PostVpnWireGuardPeerAllowedIPEndpointRequest{
+		ParentID: parentID,
+		Address:  w.Address,
+		Mask:     w.Mask,
+		Descr:    w.Descr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with w. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with w.
+func (w *WireGuardPeerAllowedIP) ToPatch(parentID, id int) *PatchVpnWireGuardPeerAllowedIPEndpointRequest {
+	if w == nil {
+		return &PatchVpnWireGuardPeerAllowedIPEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchVpnWireGuardPeerAllowedIPEndpointRequest{
+		ParentID: parentID,
+		ID:       id,
+		Address:  w.Address,
+		Mask:     w.Mask,
+		Descr:    w.Descr,
+	}
+}
+
+// ToModel copies g into a new WireGuardPeerAllowedIP, sharing its slices and pointers.
+func (g *GetVpnWireGuardPeerAllowedIPEndpointResponseData) ToModel() *WireGuardPeerAllowedIP {
+	if g == nil {
+		return nil
+	}
+	return &WireGuardPeerAllowedIP{
+		Address: g.Address,
+		Mask:    g.Mask,
+		Descr:   g.Descr,
+	}
+}
+
+// ToModel copies p into a new WireGuardPeerAllowedIP, sharing its slices and pointers.
+func (p *PostVpnWireGuardPeerAllowedIPEndpointResponseData) ToModel() *WireGuardPeerAllowedIP {
+	if p == nil {
+		return nil
+	}
+	return &WireGuardPeerAllowedIP{
+		Address: p.Address,
+		Mask:    p.Mask,
+		Descr:   p.Descr,
+	}
+}
+
+// ToModel copies p into a new WireGuardPeerAllowedIP, sharing its slices and pointers.
+func (p *PatchVpnWireGuardPeerAllowedIPEndpointResponseData) ToModel() *WireGuardPeerAllowedIP {
+	if p == nil {
+		return nil
+	}
+	return &WireGuardPeerAllowedIP{
+		Address: p.Address,
+		Mask:    p.Mask,
+		Descr:   p.Descr,
+	}
+}
+
+// ToModel copies g into a new WireGuardPeerAllowedIP, sharing its slices and pointers.
+func (g *GetVpnWireGuardPeerAllowedIPsEndpointResponseDataItem) ToModel() *WireGuardPeerAllowedIP {
+	if g == nil {
+		return nil
+	}
+	return &WireGuardPeerAllowedIP{
+		Address: g.Address,
+		Mask:    g.Mask,
+		Descr:   g.Descr,
+	}
+}
+
+// ToPost returns a request that creates a copy of w. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with w.
+func (w *WireGuardPeer) ToPost() *PostVpnWireGuardPeerEndpointRequest {
+	if w == nil {
+		return &PostVpnWireGuardPeerEndpointRequest{}
+	}
+	return &PostVpnWireGuardPeerEndpointRequest{
+		Enabled:             w.Enabled,
+		Tun:                 w.Tun,
+		Endpoint:            w.Endpoint,
+		Port:                w.Port,
+		Descr:               w.Descr,
+		Persistentkeepalive: w.Persistentkeepalive,
+		Publickey:           w.Publickey,
+		Presharedkey:        w.Presharedkey,
+		Allowedips:          w.Allowedips,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with w. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with w.
+func (w *WireGuardPeer) ToPatch(id int) *PatchVpnWireGuardPeerEndpointRequest {
+	if w == nil {
+		return &PatchVpnWireGuardPeerEndpointRequest{ID: id}
+	}
+	return &PatchVpnWireGuardPeerEndpointRequest{
+		ID:                  id,
+		Enabled:             w.Enabled,
+		Tun:                 w.Tun,
+		Endpoint:            w.Endpoint,
+		Port:                w.Port,
+		Descr:               w.Descr,
+		Persistentkeepalive: w.Persistentkeepalive,
+		Publickey:           w.Publickey,
+		Presharedkey:        w.Presharedkey,
+		Allowedips:          w.Allowedips,
+	}
+}
+
+// ToPutItem returns w as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with w.
+func (w *WireGuardPeer) ToPutItem() *PutVpnWireGuardPeersEndpointRequestItem {
+	if w == nil {
+		return nil
+	}
+	return &PutVpnWireGuardPeersEndpointRequestItem{
+		Enabled:             w.Enabled,
+		Tun:                 w.Tun,
+		Endpoint:            w.Endpoint,
+		Port:                w.Port,
+		Descr:               w.Descr,
+		Persistentkeepalive: w.Persistentkeepalive,
+		Publickey:           w.Publickey,
+		Presharedkey:        w.Presharedkey,
+		Allowedips:          w.Allowedips,
+	}
+}
+
+// ToModel copies g into a new WireGuardPeer, sharing its slices and pointers.
+func (g *GetVpnWireGuardPeerEndpointResponseData) ToModel() *WireGuardPeer {
+	if g == nil {
+		return nil
+	}
+	return &WireGuardPeer{
+		Enabled:             g.Enabled,
+		Tun:                 g.Tun,
+		Endpoint:            g.Endpoint,
+		Port:                g.Port,
+		Descr:               g.Descr,
+		Persistentkeepalive: g.Persistentkeepalive,
+		Publickey:           g.Publickey,
+		Presharedkey:        g.Presharedkey,
+		Allowedips:          g.Allowedips,
+	}
+}
+
+// ToModel copies p into a new WireGuardPeer, sharing its slices and pointers.
+func (p *PostVpnWireGuardPeerEndpointResponseData) ToModel() *WireGuardPeer {
+	if p == nil {
+		return nil
+	}
+	return &WireGuardPeer{
+		Enabled:             p.Enabled,
+		Tun:                 p.Tun,
+		Endpoint:            p.Endpoint,
+		Port:                p.Port,
+		Descr:               p.Descr,
+		Persistentkeepalive: p.Persistentkeepalive,
+		Publickey:           p.Publickey,
+		Presharedkey:        p.Presharedkey,
+		Allowedips:          p.Allowedips,
+	}
+}
+
+// ToModel copies p into a new WireGuardPeer, sharing its slices and pointers.
+func (p *PatchVpnWireGuardPeerEndpointResponseData) ToModel() *WireGuardPeer {
+	if p == nil {
+		return nil
+	}
+	return &WireGuardPeer{
+		Enabled:             p.Enabled,
+		Tun:                 p.Tun,
+		Endpoint:            p.Endpoint,
+		Port:                p.Port,
+		Descr:               p.Descr,
+		Persistentkeepalive: p.Persistentkeepalive,
+		Publickey:           p.Publickey,
+		Presharedkey:        p.Presharedkey,
+		Allowedips:          p.Allowedips,
+	}
+}
+
+// ToModel copies g into a new WireGuardPeer, sharing its slices and pointers.
+func (g *GetVpnWireGuardPeersEndpointResponseDataItem) ToModel() *WireGuardPeer {
+	if g == nil {
+		return nil
+	}
+	return &WireGuardPeer{
+		Enabled:             g.Enabled,
+		Tun:                 g.Tun,
+		Endpoint:            g.Endpoint,
+		Port:                g.Port,
+		Descr:               g.Descr,
+		Persistentkeepalive: g.Persistentkeepalive,
+		Publickey:           g.Publickey,
+		Presharedkey:        g.Presharedkey,
+		Allowedips:          g.Allowedips,
+	}
+}
+
+// ToPost returns a request that creates a copy of w. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with w.
+func (w *WireGuardTunnelAddress) ToPost(parentID int) *PostVpnWireGuardTunnelAddressEndpointRequest {
+	if w == nil {
+		return &PostVpnWireGuardTunnelAddressEndpointRequest{ParentID: parentID}
+	}
+	return &PostVpnWireGuardTunnelAddressEndpointRequest{
+		ParentID: parentID,
+		Address:  w.Address,
+		Mask:     w.Mask,
+		Descr:    w.Descr,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// parentID and id with w. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with w.
+func (w *WireGuardTunnelAddress) ToPatch(parentID, id int) *PatchVpnWireGuardTunnelAddressEndpointRequest {
+	if w == nil {
+		return &PatchVpnWireGuardTunnelAddressEndpointRequest{ParentID: parentID, ID: id}
+	}
+	return &PatchVpnWireGuardTunnelAddressEndpointRequest{
+		ParentID: parentID,
+		ID:       id,
+		Address:  w.Address,
+		Mask:     w.Mask,
+		Descr:    w.Descr,
+	}
+}
+
+// ToModel copies g into a new WireGuardTunnelAddress, sharing its slices and pointers.
+func (g *GetVpnWireGuardTunnelAddressEndpointResponseData) ToModel() *WireGuardTunnelAddress {
+	if g == nil {
+		return nil
+	}
+	return &WireGuardTunnelAddress{
+		Address: g.Address,
+		Mask:    g.Mask,
+		Descr:   g.Descr,
+	}
+}
+
+// ToModel copies p into a new WireGuardTunnelAddress, sharing its slices and pointers.
+func (p *PostVpnWireGuardTunnelAddressEndpointResponseData) ToModel() *WireGuardTunnelAddress {
+	if p == nil {
+		return nil
+	}
+	return &WireGuardTunnelAddress{
+		Address: p.Address,
+		Mask:    p.Mask,
+		Descr:   p.Descr,
+	}
+}
+
+// ToModel copies p into a new WireGuardTunnelAddress, sharing its slices and pointers.
+func (p *PatchVpnWireGuardTunnelAddressEndpointResponseData) ToModel() *WireGuardTunnelAddress {
+	if p == nil {
+		return nil
+	}
+	return &WireGuardTunnelAddress{
+		Address: p.Address,
+		Mask:    p.Mask,
+		Descr:   p.Descr,
+	}
+}
+
+// ToModel copies g into a new WireGuardTunnelAddress, sharing its slices and pointers.
+func (g *GetVpnWireGuardTunnelAddressesEndpointResponseDataItem) ToModel() *WireGuardTunnelAddress {
+	if g == nil {
+		return nil
+	}
+	return &WireGuardTunnelAddress{
+		Address: g.Address,
+		Mask:    g.Mask,
+		Descr:   g.Descr,
+	}
+}
+
+// ToPost returns a request that creates a copy of w. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with w.
+func (w *WireGuardTunnel) ToPost() *PostVpnWireGuardTunnelEndpointRequest {
+	if w == nil {
+		return &PostVpnWireGuardTunnelEndpointRequest{}
+	}
+	return &PostVpnWireGuardTunnelEndpointRequest{
+		Name:       w.Name,
+		Enabled:    w.Enabled,
+		Descr:      w.Descr,
+		Listenport: w.Listenport,
+		Publickey:  w.Publickey,
+		Privatekey: w.Privatekey,
+		Mtu:        w.Mtu,
+		Addresses:  w.Addresses,
+	}
+}
+
+// ToPatch returns a request that overwrites the object identified by
+// id with w. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with w.
+func (w *WireGuardTunnel) ToPatch(id int) *PatchVpnWireGuardTunnelEndpointRequest {
+	if w == nil {
+		return &PatchVpnWireGuardTunnelEndpointRequest{ID: id}
+	}
+	return &PatchVpnWireGuardTunnelEndpointRequest{
+		ID:         id,
+		Name:       w.Name,
+		Enabled:    w.Enabled,
+		Descr:      w.Descr,
+		Listenport: w.Listenport,
+		Publickey:  w.Publickey,
+		Privatekey: w.Privatekey,
+		Mtu:        w.Mtu,
+		Addresses:  w.Addresses,
+	}
+}
+
+// ToPutItem returns w as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with w.
+func (w *WireGuardTunnel) ToPutItem() *PutVpnWireGuardTunnelsEndpointRequestItem {
+	if w == nil {
+		return nil
+	}
+	return &PutVpnWireGuardTunnelsEndpointRequestItem{
+		Name:       w.Name,
+		Enabled:    w.Enabled,
+		Descr:      w.Descr,
+		Listenport: w.Listenport,
+		Publickey:  w.Publickey,
+		Privatekey: w.Privatekey,
+		Mtu:        w.Mtu,
+		Addresses:  w.Addresses,
+	}
+}
+
+// ToModel copies g into a new WireGuardTunnel, sharing its slices and pointers.
+func (g *GetVpnWireGuardTunnelEndpointResponseData) ToModel() *WireGuardTunnel {
+	if g == nil {
+		return nil
+	}
+	return &WireGuardTunnel{
+		Name:       g.Name,
+		Enabled:    g.Enabled,
+		Descr:      g.Descr,
+		Listenport: g.Listenport,
+		Publickey:  g.Publickey,
+		Privatekey: g.Privatekey,
+		Mtu:        g.Mtu,
+		Addresses:  g.Addresses,
+	}
+}
+
+// ToModel copies p into a new WireGuardTunnel, sharing its slices and pointers.
+func (p *PostVpnWireGuardTunnelEndpointResponseData) ToModel() *WireGuardTunnel {
+	if p == nil {
+		return nil
+	}
+	return &WireGuardTunnel{
+		Name:       p.Name,
+		Enabled:    p.Enabled,
+		Descr:      p.Descr,
+		Listenport: p.Listenport,
+		Publickey:  p.Publickey,
+		Privatekey: p.Privatekey,
+		Mtu:        p.Mtu,
+		Addresses:  p.Addresses,
+	}
+}
+
+// ToModel copies p into a new WireGuardTunnel, sharing its slices and pointers.
+func (p *PatchVpnWireGuardTunnelEndpointResponseData) ToModel() *WireGuardTunnel {
+	if p == nil {
+		return nil
+	}
+	return &WireGuardTunnel{
+		Name:       p.Name,
+		Enabled:    p.Enabled,
+		Descr:      p.Descr,
+		Listenport: p.Listenport,
+		Publickey:  p.Publickey,
+		Privatekey: p.Privatekey,
+		Mtu:        p.Mtu,
+		Addresses:  p.Addresses,
+	}
+}
+
+// ToModel copies g into a new WireGuardTunnel, sharing its slices and pointers.
+func (g *GetVpnWireGuardTunnelsEndpointResponseDataItem) ToModel() *WireGuardTunnel {
+	if g == nil {
+		return nil
+	}
+	return &WireGuardTunnel{
+		Name:       g.Name,
+		Enabled:    g.Enabled,
+		Descr:      g.Descr,
+		Listenport: g.Listenport,
+		Publickey:  g.Publickey,
+		Privatekey: g.Privatekey,
+		Mtu:        g.Mtu,
+		Addresses:  g.Addresses,
+	}
+}
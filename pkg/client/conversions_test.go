@@ -0,0 +1,53 @@
+package pfclientapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	pfclientapi "github.com/danielmichaels/go-pfrest/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversionsDropReadOnlyFields(t *testing.T) {
+	const body = `{"code":200,"data":[{"id":3,"type":"pass","interface":["lan"],"source":"any","descr":"allow lan","tracker":1700000000,"created_time":1700000001,"updated_by":"admin@10.0.0.1"}]}`
+	var response pfclientapi.GetFirewallRulesEndpointResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &response))
+
+	rule := response.Data[0].ToModel()
+	assert.Equal(t, "allow lan", *rule.Descr)
+	assert.Equal(t, []string{"lan"}, rule.Interface)
+	assert.Equal(t, 1700000000, *rule.Tracker, "models keep read-only fields")
+
+	patch := rule.ToPatch(3)
+	assert.Equal(t, 3, patch.ID)
+	assert.Equal(t, rule.Source, patch.Source)
+	assert.Equal(t, rule.Type, patch.Type)
+	raw, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":3,"type":"pass","interface":["lan"],"source":"any","descr":"allow lan"}`, string(raw))
+
+	for _, request := range []any{rule.ToPost(), rule.ToPutItem()} {
+		raw, err := json.Marshal(request)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":"pass","interface":["lan"],"source":"any","descr":"allow lan"}`, string(raw))
+	}
+}
+
+func TestConversionsOfNestedModels(t *testing.T) {
+	mapping := &pfclientapi.DhcpServerStaticMapping{Mac: pfclientapi.String("00:11:22:33:44:55")}
+	patch := mapping.ToPatch(1, 2)
+	assert.Equal(t, 1, patch.ParentID)
+	assert.Equal(t, 2, patch.ID)
+	assert.Equal(t, mapping.Mac, patch.Mac)
+	assert.Equal(t, 1, mapping.ToPost(1).ParentID)
+}
+
+func TestConversionsOfNil(t *testing.T) {
+	var rule *pfclientapi.FirewallRule
+	assert.Equal(t, &pfclientapi.PatchFirewallRuleEndpointRequest{ID: 3}, rule.ToPatch(3))
+	assert.Nil(t, rule.ToPutItem())
+
+	var item *pfclientapi.GetFirewallRulesEndpointResponseDataItem
+	assert.Nil(t, item.ToModel())
+}
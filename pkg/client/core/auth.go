@@ -0,0 +1,430 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultExecTimeout bounds a run of an ExecCredentials command.
+const defaultExecTimeout = 30 * time.Second
+
+// Authenticator authenticates outgoing requests, e.g. by setting the
+// Authorization header. Only the headers for the selected mode are sent.
+type Authenticator interface {
+	Authenticate(request *http.Request) error
+}
+
+// Reauthenticator is implemented by an Authenticator whose credentials can
+// go stale. When a request is rejected with a 401, Reauthenticate discards
+// the credentials used for it and reports whether a single retry with fresh
+// credentials is worthwhile.
+type Reauthenticator interface {
+	Authenticator
+	Reauthenticate(request *http.Request) bool
+}
+
+// Credentials are the secrets used to authenticate with pfSense. Providers
+// that decode JSON use the field names shown here.
+type Credentials struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	APIKey   string `json:"api_key,omitempty"`
+	Token    string `json:"token,omitempty"`
+}
+
+// CredentialsProvider supplies credentials, which may change over time.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// StaticCredentials is a CredentialsProvider that never changes.
+type StaticCredentials Credentials
+
+// Credentials implements CredentialsProvider.
+func (s StaticCredentials) Credentials(context.Context) (Credentials, error) {
+	return Credentials(s), nil
+}
+
+// EnvCredentials is a CredentialsProvider that reads the environment on
+// every call: <Prefix>USERNAME, <Prefix>PASSWORD, <Prefix>API_KEY and
+// <Prefix>TOKEN. The prefix defaults to "PFREST_".
+type EnvCredentials struct {
+	Prefix string
+}
+
+// Credentials implements CredentialsProvider.
+func (e EnvCredentials) Credentials(context.Context) (Credentials, error) {
+	prefix := e.Prefix
+	if prefix == "" {
+		prefix = "PFREST_"
+	}
+	return Credentials{
+		Username: os.Getenv(prefix + "USERNAME"),
+		Password: os.Getenv(prefix + "PASSWORD"),
+		APIKey:   os.Getenv(prefix + "API_KEY"),
+		Token:    os.Getenv(prefix + "TOKEN"),
+	}, nil
+}
+
+// FileCredentials is a CredentialsProvider backed by a file, such as a
+// mounted secret. The file is re-read whenever its modification time or
+// size changes, so rotated secrets are picked up without a restart.
+//
+// By default the file holds a JSON encoded Credentials object. If Field is
+// set to one of "username", "password", "api_key" or "token", the file
+// instead holds that single value, with surrounding whitespace ignored.
+type FileCredentials struct {
+	Path  string
+	Field string
+
+	mu          sync.Mutex
+	modTime     time.Time
+	size        int64
+	credentials Credentials
+}
+
+// Credentials implements CredentialsProvider.
+func (f *FileCredentials) Credentials(context.Context) (Credentials, error) {
+	info, err := os.Stat(f.Path)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.credentials, nil
+	}
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return Credentials{}, err
+	}
+	credentials, err := decodeCredentials(data, f.Field)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("%s: %w", f.Path, err)
+	}
+	f.modTime, f.size, f.credentials = info.ModTime(), info.Size(), credentials
+	return credentials, nil
+}
+
+// Invalidate forces the file to be re-read on the next call.
+func (f *FileCredentials) Invalidate() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.modTime, f.size = time.Time{}, 0
+}
+
+// ExecCredentials is a CredentialsProvider that runs an external command,
+// such as a secrets manager CLI, and decodes its standard output as a JSON
+// Credentials object. The object may also carry an RFC 3339 "expires_at"
+// field, after which the command is run again.
+//
+// Results are cached until they expire, TTL elapses, or the credentials
+// are rejected by the server. Concurrent callers share a single run of
+// the command, and each stops waiting for it when its context is done.
+type ExecCredentials struct {
+	Command string
+	Args    []string
+	// TTL bounds how long credentials without an expiry are cached.
+	// Zero caches them until they are rejected.
+	TTL time.Duration
+	// Timeout bounds each run of the command, which callers may share and
+	// which therefore outlives their contexts. It defaults to 30 seconds.
+	Timeout time.Duration
+
+	mu          sync.Mutex
+	expiry      time.Time
+	cached      bool
+	credentials Credentials
+	run         *execRun
+}
+
+// execRun is a run of the command shared by concurrent callers.
+type execRun struct {
+	done        chan struct{}
+	credentials Credentials
+	err         error
+}
+
+// Credentials implements CredentialsProvider.
+func (e *ExecCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	e.mu.Lock()
+	if e.cached && (e.expiry.IsZero() || time.Now().Before(e.expiry)) {
+		credentials := e.credentials
+		e.mu.Unlock()
+		return credentials, nil
+	}
+	run := e.run
+	if run == nil {
+		run = &execRun{done: make(chan struct{})}
+		e.run = run
+		timeout := e.Timeout
+		if timeout <= 0 {
+			timeout = defaultExecTimeout
+		}
+		go func() {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+			defer cancel()
+			e.execute(ctx, run)
+		}()
+	}
+	e.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return Credentials{}, ctx.Err()
+	case <-run.done:
+		return run.credentials, run.err
+	}
+}
+
+// execute runs the command, caches its credentials and completes run.
+func (e *ExecCredentials) execute(ctx context.Context, run *execRun) {
+	credentials, expiry, err := e.exec(ctx)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err == nil {
+		e.credentials, e.cached, e.expiry = credentials, true, expiry
+	}
+	e.run = nil
+	run.credentials, run.err = credentials, err
+	close(run.done)
+}
+
+// exec runs the command and returns its credentials and their expiry,
+// which is zero if they don't expire.
+func (e *ExecCredentials) exec(ctx context.Context) (Credentials, time.Time, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.Command, e.Args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return Credentials{}, time.Time{}, fmt.Errorf("%s: %w: %s", e.Command, err, msg)
+		}
+		return Credentials{}, time.Time{}, fmt.Errorf("%s: %w", e.Command, err)
+	}
+	var output struct {
+		Credentials
+		ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return Credentials{}, time.Time{}, fmt.Errorf("%s: invalid credentials: %w", e.Command, err)
+	}
+
+	var expiry time.Time
+	if output.ExpiresAt != nil {
+		expiry = *output.ExpiresAt
+	} else if e.TTL > 0 {
+		expiry = time.Now().Add(e.TTL)
+	}
+	return output.Credentials, expiry, nil
+}
+
+// Invalidate forces the command to be run again on the next call.
+func (e *ExecCredentials) Invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cached = false
+}
+
+// decodeCredentials decodes a JSON Credentials object or, if field is set,
+// a single raw credential value.
+func decodeCredentials(data []byte, field string) (Credentials, error) {
+	var credentials Credentials
+	value := strings.TrimSpace(string(data))
+	switch field {
+	case "":
+		if err := json.Unmarshal(data, &credentials); err != nil {
+			return Credentials{}, fmt.Errorf("invalid credentials: %w", err)
+		}
+	case "username":
+		credentials.Username = value
+	case "password":
+		credentials.Password = value
+	case "api_key":
+		credentials.APIKey = value
+	case "token":
+		credentials.Token = value
+	default:
+		return Credentials{}, fmt.Errorf("unknown credentials field %q", field)
+	}
+	return credentials, nil
+}
+
+// BasicAuth authenticates with the username and password from Credentials.
+type BasicAuth struct {
+	Credentials CredentialsProvider
+}
+
+// Authenticate implements Authenticator.
+func (b *BasicAuth) Authenticate(request *http.Request) error {
+	credentials, err := b.Credentials.Credentials(request.Context())
+	if err != nil {
+		return err
+	}
+	if credentials.Username == "" || credentials.Password == "" {
+		return errors.New("basic auth: missing username or password")
+	}
+	request.SetBasicAuth(credentials.Username, credentials.Password)
+	return nil
+}
+
+// Reauthenticate implements Reauthenticator.
+func (b *BasicAuth) Reauthenticate(*http.Request) bool {
+	return invalidateProvider(b.Credentials)
+}
+
+// APIKeyAuth authenticates with the API key from Credentials, sent in the
+// x-api-key header.
+type APIKeyAuth struct {
+	Credentials CredentialsProvider
+}
+
+// Authenticate implements Authenticator.
+func (a *APIKeyAuth) Authenticate(request *http.Request) error {
+	credentials, err := a.Credentials.Credentials(request.Context())
+	if err != nil {
+		return err
+	}
+	if credentials.APIKey == "" {
+		return errors.New("api key auth: missing API key")
+	}
+	request.Header.Set("x-api-key", credentials.APIKey)
+	return nil
+}
+
+// Reauthenticate implements Reauthenticator.
+func (a *APIKeyAuth) Reauthenticate(*http.Request) bool {
+	return invalidateProvider(a.Credentials)
+}
+
+// BearerAuth authenticates with a token from Source, sent in the
+// Authorization header. If Source has an Invalidate(token string) method,
+// as *JWTTokenSource does, a rejected token is discarded and the request
+// is retried once with a new one.
+type BearerAuth struct {
+	Source TokenSource
+}
+
+// Authenticate implements Authenticator.
+func (b *BearerAuth) Authenticate(request *http.Request) error {
+	token, err := b.Source.Token(request.Context())
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Reauthenticate implements Reauthenticator.
+func (b *BearerAuth) Reauthenticate(request *http.Request) bool {
+	source, ok := b.Source.(interface{ Invalidate(token string) })
+	if !ok {
+		return false
+	}
+	source.Invalidate(strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer "))
+	return true
+}
+
+func (b *BearerAuth) bind(baseURL string, client HTTPClient, errorDecoder ErrorDecoder) {
+	if source, ok := b.Source.(binder); ok {
+		source.bind(baseURL, client, errorDecoder)
+	}
+}
+
+// StaticToken is a TokenSource that always returns the same token.
+type StaticToken string
+
+// Token implements TokenSource.
+func (s StaticToken) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// ProviderToken is a TokenSource that returns the Token field of the
+// given credentials, e.g. a pre-issued JWT kept in a rotated file.
+type ProviderToken struct {
+	Credentials CredentialsProvider
+}
+
+// Token implements TokenSource.
+func (p *ProviderToken) Token(ctx context.Context) (string, error) {
+	credentials, err := p.Credentials.Credentials(ctx)
+	if err != nil {
+		return "", err
+	}
+	if credentials.Token == "" {
+		return "", errors.New("bearer auth: missing token")
+	}
+	return credentials.Token, nil
+}
+
+// Invalidate implements the optional invalidation used by BearerAuth.
+func (p *ProviderToken) Invalidate(string) {
+	invalidateProvider(p.Credentials)
+}
+
+// invalidateProvider discards any cached credentials held by the provider
+// and reports whether it had any to discard.
+func invalidateProvider(provider CredentialsProvider) bool {
+	invalidator, ok := provider.(interface{ Invalidate() })
+	if ok {
+		invalidator.Invalidate()
+	}
+	return ok
+}
+
+// binder is implemented by authenticators that need to reach the pfSense
+// API themselves, e.g. to acquire a JWT.
+type binder interface {
+	bind(baseURL string, client HTTPClient, errorDecoder ErrorDecoder)
+}
+
+// authMiddleware authenticates every attempt. The given (unwrapped)
+// client and error decoder are used by authenticators that issue their own
+// requests. If the authenticator supports it, a 401 response is retried
+// exactly once with fresh credentials.
+func authMiddleware(auth Authenticator, client HTTPClient, errorDecoder ErrorDecoder) Middleware {
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+			if b, ok := auth.(binder); ok {
+				b.bind(apiBaseURL(request), client, errorDecoder)
+			}
+			retry, err := rewind(request)
+			if err != nil {
+				return nil, err
+			}
+			if err := auth.Authenticate(request); err != nil {
+				return nil, err
+			}
+			response, err := next.Do(request)
+			if err != nil || response.StatusCode != http.StatusUnauthorized || !rewindable(request) {
+				return response, err
+			}
+			reauth, ok := auth.(Reauthenticator)
+			if !ok || !reauth.Reauthenticate(request) {
+				return response, nil
+			}
+
+			// The credentials were rejected, e.g. because they rotated or
+			// the token expired early. Try once more with fresh ones.
+			_, _ = io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+			if err := auth.Authenticate(retry); err != nil {
+				return nil, err
+			}
+			return next.Do(retry)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCircuitThreshold = 5
+	defaultCircuitCooldown  = 30 * time.Second
+)
+
+// ErrCircuitOpen is matched through errors.Is by the [*CircuitOpenError]
+// returned for calls rejected by an open circuit breaker.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError is returned, without sending the request, while the
+// circuit breaker for a firewall is open.
+type CircuitOpenError struct {
+	// BaseURL identifies the firewall.
+	BaseURL string
+	// RetryAt is when the breaker lets a trial request through.
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker for %s is open until %s", e.BaseURL, e.RetryAt.Format(time.RFC3339))
+}
+
+// Is reports whether target is ErrCircuitOpen.
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests.
+	CircuitOpen
+	// CircuitHalfOpen lets a single trial request through, which closes
+	// the circuit if it succeeds and opens it again if it fails.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// CircuitBreaker configures a circuit breaker for each firewall, i.e. each
+// base URL, that a client talks to. A breaker opens after Threshold
+// consecutive attempts fail with a transport error or a 5xx response, and
+// then rejects requests with a [*CircuitOpenError] until Cooldown has
+// passed. It then half-opens and lets one trial request through.
+//
+// Open breakers are not retried, so a caller fails fast instead of waiting
+// out the retrier's back-off against a firewall that is down.
+type CircuitBreaker struct {
+	// Threshold is the number of consecutive failures that opens the
+	// circuit. It defaults to 5.
+	Threshold int
+	// Cooldown is how long the circuit stays open. It defaults to 30
+	// seconds.
+	Cooldown time.Duration
+	// OnStateChange, if set, is called on every state change, after the
+	// breaker is unlocked, so it may use the client. Changes made by
+	// concurrent requests may be reported out of order.
+	OnStateChange func(baseURL string, from, to CircuitState)
+}
+
+// circuitBreakers holds the breaker state of each base URL.
+type circuitBreakers struct {
+	config CircuitBreaker
+	// source is the configuration the breakers were built from.
+	source *CircuitBreaker
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// stateChange is a state change of a circuit, reported to OnStateChange
+// once the breaker is unlocked.
+type stateChange struct {
+	baseURL  string
+	from, to CircuitState
+}
+
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	// probing is set while the half-open trial request is in flight.
+	probing bool
+}
+
+func newCircuitBreakers(source *CircuitBreaker) *circuitBreakers {
+	config := *source
+	if config.Threshold <= 0 {
+		config.Threshold = defaultCircuitThreshold
+	}
+	if config.Cooldown <= 0 {
+		config.Cooldown = defaultCircuitCooldown
+	}
+	return &circuitBreakers{
+		config:   config,
+		source:   source,
+		circuits: make(map[string]*circuit),
+	}
+}
+
+// Middleware returns middleware that rejects attempts while the circuit of
+// the request's base URL is open, and records the outcome of the others.
+func (b *circuitBreakers) Middleware() Middleware {
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+			baseURL := apiBaseURL(request)
+			if err := b.allow(baseURL, time.Now()); err != nil {
+				return nil, err
+			}
+			response, err := next.Do(request)
+			if cancelled(request, err) {
+				b.release(baseURL)
+			} else {
+				b.record(baseURL, failed(response, err))
+			}
+			return response, err
+		})
+	}
+}
+
+// cancelled reports whether an attempt was cut short by its caller, which
+// says nothing about the firewall either way.
+func cancelled(request *http.Request, err error) bool {
+	return err != nil && (request.Context().Err() != nil ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded))
+}
+
+// failed reports whether a completed attempt counts against the circuit.
+func failed(response *http.Response, err error) bool {
+	return err != nil || response.StatusCode >= http.StatusInternalServerError
+}
+
+func (b *circuitBreakers) allow(baseURL string, now time.Time) error {
+	var changes []stateChange
+	defer b.notify(&changes)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuits[baseURL]
+	if c == nil {
+		c = new(circuit)
+		b.circuits[baseURL] = c
+	}
+	switch c.state {
+	case CircuitOpen:
+		retryAt := c.openedAt.Add(b.config.Cooldown)
+		if now.Before(retryAt) {
+			return &CircuitOpenError{BaseURL: baseURL, RetryAt: retryAt}
+		}
+		changes = b.setState(changes, baseURL, c, CircuitHalfOpen)
+		c.probing = true
+	case CircuitHalfOpen:
+		if c.probing {
+			return &CircuitOpenError{BaseURL: baseURL, RetryAt: now}
+		}
+		c.probing = true
+	}
+	return nil
+}
+
+// release ends a cancelled attempt without recording an outcome. A
+// cancelled half-open trial lets the next request try again, but neither
+// closes the circuit nor resets the failure count.
+func (b *circuitBreakers) release(baseURL string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c := b.circuits[baseURL]; c.state == CircuitHalfOpen {
+		c.probing = false
+	}
+}
+
+func (b *circuitBreakers) record(baseURL string, failure bool) {
+	var changes []stateChange
+	defer b.notify(&changes)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuits[baseURL]
+	if c.state == CircuitHalfOpen {
+		c.probing = false
+	}
+	if !failure {
+		c.failures = 0
+		changes = b.setState(changes, baseURL, c, CircuitClosed)
+		return
+	}
+	c.failures++
+	if c.state == CircuitHalfOpen || c.failures >= b.config.Threshold {
+		c.openedAt = time.Now()
+		changes = b.setState(changes, baseURL, c, CircuitOpen)
+	}
+}
+
+// setState moves the circuit to the given state and appends the change,
+// if any, to changes. The caller must hold b.mu.
+func (b *circuitBreakers) setState(changes []stateChange, baseURL string, c *circuit, state CircuitState) []stateChange {
+	if c.state == state {
+		return changes
+	}
+	changes = append(changes, stateChange{baseURL: baseURL, from: c.state, to: state})
+	c.state = state
+	return changes
+}
+
+// notify reports the changes to OnStateChange. It is deferred before
+// b.mu is locked, so that it runs once the breaker is unlocked.
+func (b *circuitBreakers) notify(changes *[]stateChange) {
+	if b.config.OnStateChange == nil {
+		return
+	}
+	for _, change := range *changes {
+		b.config.OnStateChange(change.baseURL, change.from, change.to)
+	}
+}
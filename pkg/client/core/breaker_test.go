@@ -0,0 +1,205 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		status  = http.StatusServiceUnavailable
+		calls   int
+		changes []CircuitState
+	)
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls++
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{"id":"123"}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	caller := NewCaller(
+		&CallerParams{
+			Client: server.Client(),
+			Options: &RequestOptions{
+				MaxAttempts:    5,
+				RetryBaseDelay: time.Millisecond,
+				CircuitBreaker: &CircuitBreaker{
+					Threshold: 3,
+					Cooldown:  50 * time.Millisecond,
+					OnStateChange: func(baseURL string, _, to CircuitState) {
+						assert.Equal(t, server.URL, baseURL)
+						changes = append(changes, to)
+					},
+				},
+			},
+		},
+	)
+	call := func() error {
+		var response *Response
+		return caller.Call(
+			context.Background(),
+			&CallParams{URL: server.URL + "/api/v2/status/system", Method: http.MethodGet, Response: &response},
+		)
+	}
+	requests := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls
+	}
+
+	// The third failed attempt opens the circuit and the fourth fails fast.
+	err := call()
+	var openErr *CircuitOpenError
+	require.ErrorAs(t, err, &openErr)
+	assert.True(t, errors.Is(err, ErrCircuitOpen))
+	assert.Equal(t, server.URL, openErr.BaseURL)
+	assert.Equal(t, 3, requests())
+
+	// While open, nothing reaches the server.
+	require.ErrorIs(t, call(), ErrCircuitOpen)
+	assert.Equal(t, 3, requests())
+
+	// After the cooldown a failed trial request opens it again.
+	time.Sleep(60 * time.Millisecond)
+	require.ErrorIs(t, call(), ErrCircuitOpen)
+	assert.Equal(t, 4, requests())
+
+	// A successful trial request closes it.
+	mu.Lock()
+	status = http.StatusOK
+	mu.Unlock()
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, call())
+	require.NoError(t, call())
+	assert.Equal(t, 6, requests())
+
+	assert.Equal(t, []CircuitState{
+		CircuitOpen,
+		CircuitHalfOpen,
+		CircuitOpen,
+		CircuitHalfOpen,
+		CircuitClosed,
+	}, changes)
+}
+
+func TestCircuitBreakerFailures(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "https://pfsense/api/v2/firewall/rule", nil)
+	assert.False(t, failed(&http.Response{StatusCode: http.StatusNotFound}, nil))
+	assert.True(t, failed(&http.Response{StatusCode: http.StatusBadGateway}, nil))
+	assert.True(t, failed(nil, errors.New("connection refused")))
+	assert.False(t, cancelled(request, errors.New("connection refused")))
+	assert.True(t, cancelled(request, context.Canceled))
+	assert.False(t, cancelled(request, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.True(t, cancelled(request.WithContext(ctx), errors.New("read: connection reset")))
+}
+
+func TestCircuitBreakerCancelledProbe(t *testing.T) {
+	breakers := newCircuitBreakers(&CircuitBreaker{Threshold: 1, Cooldown: time.Millisecond})
+	const baseURL = "https://pfsense"
+	state := func() (CircuitState, int) {
+		breakers.mu.Lock()
+		defer breakers.mu.Unlock()
+		c := breakers.circuits[baseURL]
+		return c.state, c.failures
+	}
+	release := make(chan struct{})
+	probe := breakers.Middleware()(HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+		select {
+		case <-release:
+			return nil, errors.New("connection refused")
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		}
+	}))
+	newRequest := func(ctx context.Context) *http.Request {
+		return httptest.NewRequest(http.MethodGet, baseURL+"/api/v2/status/system", nil).WithContext(ctx)
+	}
+
+	close(release)
+	_, err := probe.Do(newRequest(context.Background()))
+	require.Error(t, err)
+	open, failures := state()
+	require.Equal(t, CircuitOpen, open)
+	require.Equal(t, 1, failures)
+
+	// The trial request after the cooldown is cancelled before the
+	// firewall answers.
+	time.Sleep(5 * time.Millisecond)
+	release = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := probe.Do(newRequest(ctx))
+		done <- err
+	}()
+	require.Eventually(t, func() bool {
+		current, _ := state()
+		return current == CircuitHalfOpen
+	}, time.Second, time.Millisecond)
+	_, err = probe.Do(newRequest(context.Background()))
+	require.ErrorIs(t, err, ErrCircuitOpen, "only one trial request at a time")
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	current, failures := state()
+	assert.Equal(t, CircuitHalfOpen, current, "a cancelled trial does not close the circuit")
+	assert.Equal(t, 1, failures, "a cancelled trial does not reset the failures")
+
+	// The next request is a new trial, and a failed one opens the circuit
+	// again.
+	close(release)
+	_, err = probe.Do(newRequest(context.Background()))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrCircuitOpen)
+	current, _ = state()
+	assert.Equal(t, CircuitOpen, current)
+}
+
+func TestCircuitBreakerStateChangeCallback(t *testing.T) {
+	const baseURL = "https://pfsense"
+	var (
+		breakers *circuitBreakers
+		errs     []error
+	)
+	breakers = newCircuitBreakers(&CircuitBreaker{
+		Threshold: 1,
+		Cooldown:  time.Minute,
+		OnStateChange: func(baseURL string, _, _ CircuitState) {
+			// The callback may use the breaker, e.g. by sending a request.
+			errs = append(errs, breakers.allow(baseURL, time.Now()))
+		},
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, breakers.allow(baseURL, time.Now()))
+		breakers.record(baseURL, true)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the callback deadlocked on the breaker")
+	}
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrCircuitOpen)
+}
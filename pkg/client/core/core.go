@@ -7,8 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
+	"sync"
+	"time"
 )
 
 const (
@@ -92,35 +95,156 @@ func (a *APIError) Error() string {
 type ErrorDecoder func(statusCode int, body io.Reader) error
 
 // Caller calls APIs and deserializes their response, if any.
+//
+// A Caller holds the client-level options. It is shared by the root client
+// and all of its sub-clients, so options applied to it with Apply take
+// effect for every service at once.
 type Caller struct {
-	client  HTTPClient
-	retrier *Retrier
+	mu      sync.RWMutex
+	options *RequestOptions
+	state   *callerState
+
+	errorDecoder ErrorDecoder
+}
+
+// callerState is derived from the client-level options. It is replaced,
+// never modified, so calls in flight keep a consistent view.
+type callerState struct {
+	client     HTTPClient
+	retrier    *Retrier
+	middleware []Middleware
+	auth       Authenticator
+	limiter    *rateLimiter
+	breakers   *circuitBreakers
+	telemetry  *telemetry
+	logger     *slog.Logger
+	curl       io.Writer
+	har        *HARRecorder
+	writeLock  bool
 }
 
 // CallerParams represents the parameters used to constrcut a new *Caller.
 type CallerParams struct {
 	Client      HTTPClient
 	MaxAttempts uint
+
+	// Options are the client-level request options, if any.
+	Options *RequestOptions
+	// ErrorDecoder, if set, decodes the error responses of requests the
+	// caller issues itself, such as acquiring a JWT.
+	ErrorDecoder ErrorDecoder
 }
 
 // NewCaller returns a new *Caller backed by the given parameters.
 func NewCaller(params *CallerParams) *Caller {
-	var httpClient HTTPClient = http.DefaultClient
+	options := params.Options.clone()
 	if params.Client != nil {
-		httpClient = params.Client
+		options.HTTPClient = params.Client
 	}
-	var retryOptions []RetryOption
 	if params.MaxAttempts > 0 {
-		retryOptions = append(retryOptions, WithMaxAttempts(params.MaxAttempts))
+		options.MaxAttempts = params.MaxAttempts
 	}
 	return &Caller{
-		client:  httpClient,
-		retrier: NewRetrier(retryOptions...),
+		options:      options,
+		state:        newCallerState(options, nil),
+		errorDecoder: params.ErrorDecoder,
+	}
+}
+
+// Apply applies the given options on top of the caller's client-level
+// options, e.g. to rotate credentials or to point every service at another
+// base URL. Calls already in flight are not affected.
+func (c *Caller) Apply(opts ...RequestOption) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	options := c.options.clone()
+	for _, opt := range opts {
+		opt.applyRequestOptions(options)
+	}
+	c.options = options
+	c.state = newCallerState(options, c.state)
+}
+
+// BaseURL returns the client-level base URL. It is read separately from
+// Header, so the two may come from either side of a concurrent Apply; use
+// Snapshot to read both.
+func (c *Caller) BaseURL() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.options.BaseURL
+}
+
+// Header returns a copy of the client-level headers. See BaseURL.
+func (c *Caller) Header() http.Header {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.options.ToHeader()
+}
+
+// Snapshot returns the client-level base URL and a copy of the headers,
+// both from the same set of options.
+func (c *Caller) Snapshot() (baseURL string, header http.Header) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.options.BaseURL, c.options.ToHeader()
+}
+
+func (c *Caller) current() *callerState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state
+}
+
+// newCallerState derives the caller state from the options. The rate
+// limiter and circuit breakers of the previous state, if any, are kept
+// while their configuration is unchanged, so that applying unrelated
+// options doesn't refill the bucket or close an open circuit.
+func newCallerState(options *RequestOptions, previous *callerState) *callerState {
+	var httpClient HTTPClient = http.DefaultClient
+	if options.HTTPClient != nil {
+		httpClient = options.HTTPClient
+	}
+	var retryOptions []RetryOption
+	if options.MaxAttempts > 0 {
+		retryOptions = append(retryOptions, WithMaxAttempts(options.MaxAttempts))
+	}
+	retryOptions = append(retryOptions, options.retryOptions()...)
+	var limiter *rateLimiter
+	if options.RateLimit > 0 {
+		if previous != nil && previous.limiter != nil &&
+			previous.limiter.rate == options.RateLimit &&
+			previous.limiter.burst == float64(max(options.RateLimitBurst, 1)) {
+			limiter = previous.limiter
+		} else {
+			limiter = newRateLimiter(options.RateLimit, options.RateLimitBurst)
+		}
+	}
+	var breakers *circuitBreakers
+	if options.CircuitBreaker != nil {
+		if previous != nil && previous.breakers != nil && previous.breakers.source == options.CircuitBreaker {
+			breakers = previous.breakers
+		} else {
+			breakers = newCircuitBreakers(options.CircuitBreaker)
+		}
+	}
+	return &callerState{
+		client:     httpClient,
+		retrier:    NewRetrier(retryOptions...),
+		middleware: options.Middleware,
+		auth:       options.Authenticator,
+		limiter:    limiter,
+		breakers:   breakers,
+		telemetry:  newTelemetry(options.Tracer, options.Meter),
+		logger:     options.Logger,
+		curl:       options.Curl,
+		har:        options.HAR,
+		writeLock:  options.WriteLock,
 	}
 }
 
 // CallParams represents the parameters used to issue an API call.
 type CallParams struct {
+	Endpoint           Endpoint
 	URL                string
 	Method             string
 	MaxAttempts        uint
@@ -130,10 +254,27 @@ type CallParams struct {
 	Response           interface{}
 	ResponseIsOptional bool
 	ErrorDecoder       ErrorDecoder
+
+	// Options are the request-scoped options, if any.
+	Options *RequestOptions
 }
 
 // Call issues an API call according to the given call parameters.
-func (c *Caller) Call(ctx context.Context, params *CallParams) error {
+func (c *Caller) Call(ctx context.Context, params *CallParams) (err error) {
+	ctx = WithEndpoint(ctx, params.Endpoint)
+	state := c.current()
+	if state.telemetry == nil {
+		return c.call(ctx, state, params, nil)
+	}
+	observation := new(callObservation)
+	ctx, finish := state.telemetry.start(ctx, params, observation)
+	defer func() { finish(err) }()
+	return c.call(ctx, state, params, observation)
+}
+
+// call issues the API call, recording its attempts in observation if it
+// is non-nil.
+func (c *Caller) call(ctx context.Context, state *callerState, params *CallParams, observation *callObservation) error {
 	req, err := newRequest(ctx, params.URL, params.Method, params.Headers, params.Request)
 	if err != nil {
 		return err
@@ -144,23 +285,89 @@ func (c *Caller) Call(ctx context.Context, params *CallParams) error {
 		return err
 	}
 
-	client := c.client
+	client := state.client
 	if params.Client != nil {
 		// Use the HTTP client scoped to the request.
 		client = params.Client
 	}
 
+	// Client-level middleware wraps request-level middleware, which in
+	// turn wraps authentication and the HTTP client. The raw response is
+	// recorded outside all of them, as the caller will see it.
+	middleware := state.middleware
+	if params.Options != nil && params.Options.RawResponse != nil {
+		*params.Options.RawResponse = RawResponse{}
+		middleware = append([]Middleware{recordRawResponse(params.Options.RawResponse, time.Now())}, middleware...)
+	}
+	if observation != nil {
+		middleware = append([]Middleware{observation.observe()}, middleware...)
+	}
+	if params.Options != nil && len(params.Options.Middleware) > 0 {
+		middleware = append(middleware[:len(middleware):len(middleware)], params.Options.Middleware...)
+	}
+	auth := state.auth
+	if params.Options != nil && params.Options.Authenticator != nil {
+		// Request-level credentials replace the client's.
+		auth = params.Options.Authenticator
+	}
+	if auth != nil {
+		middleware = append(middleware[:len(middleware):len(middleware)], authMiddleware(auth, client, c.errorDecoder))
+	}
+	if state.breakers != nil {
+		// Rejected attempts don't take a rate limit token.
+		middleware = append(middleware[:len(middleware):len(middleware)], state.breakers.Middleware())
+	}
+	if state.limiter != nil {
+		// Every attempt, including retries, takes a token.
+		middleware = append(middleware[:len(middleware):len(middleware)], state.limiter.Middleware())
+	}
+	logger := state.logger
+	if params.Options != nil && params.Options.Logger != nil {
+		logger = params.Options.Logger
+	}
+	if !discardLogger(ctx, logger) {
+		// Logged last, so that the headers set by authentication are
+		// shown, redacted.
+		middleware = append(middleware[:len(middleware):len(middleware)], logAttempts(logger))
+	}
+	curl, har := state.curl, state.har
+	if params.Options != nil && params.Options.Curl != nil {
+		curl = params.Options.Curl
+	}
+	if params.Options != nil && params.Options.HAR != nil {
+		har = params.Options.HAR
+	}
+	if curl != nil {
+		middleware = append(middleware[:len(middleware):len(middleware)], writeCurl(curl))
+	}
+	if har != nil {
+		middleware = append(middleware[:len(middleware):len(middleware)], har.Middleware())
+	}
+	client = chainMiddleware(client, middleware...)
+
 	var retryOptions []RetryOption
 	if params.MaxAttempts > 0 {
 		retryOptions = append(retryOptions, WithMaxAttempts(params.MaxAttempts))
 	}
+	retryOptions = append(retryOptions, params.Options.retryOptions()...)
 
-	resp, err := c.retrier.Run(
+	var unlock func()
+	if state.writeLock && isWrite(req.Method) {
+		// Hold the lock across retries, so that a write is finished
+		// before the next one starts.
+		if unlock, err = lockWrites(ctx, apiBaseURL(req)); err != nil {
+			return err
+		}
+	}
+	resp, err := state.retrier.Run(
 		client.Do,
 		req,
 		params.ErrorDecoder,
 		retryOptions...,
 	)
+	if unlock != nil {
+		unlock()
+	}
 	if err != nil {
 		return err
 	}
@@ -178,6 +385,10 @@ func (c *Caller) Call(ctx context.Context, params *CallParams) error {
 		return decodeError(resp, params.ErrorDecoder)
 	}
 
+	if params.Options != nil && params.Options.ResponseDecoder != nil {
+		return params.Options.ResponseDecoder(resp.Body)
+	}
+
 	// Mutate the response parameter in-place.
 	if params.Response != nil {
 		if writer, ok := params.Response.(io.Writer); ok {
@@ -227,6 +438,8 @@ func newRequest(
 }
 
 // newRequestBody returns a new io.Reader that represents the HTTP request body.
+// JSON bodies are backed by a *bytes.Reader, so the resulting *http.Request
+// has GetBody set and can be replayed by the *Retrier.
 func newRequestBody(request interface{}) (io.Reader, error) {
 	var requestBody io.Reader
 	if request != nil {
@@ -11,6 +11,7 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -141,6 +142,142 @@ func TestCall(t *testing.T) {
 	}
 }
 
+func TestCallRetryReplaysBody(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPatch, r.Method)
+
+				bytes, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				bodies = append(bodies, string(bytes))
+
+				if len(bodies) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, err = w.Write(bytes)
+				require.NoError(t, err)
+			},
+		),
+	)
+	defer server.Close()
+
+	caller := NewCaller(
+		&CallerParams{
+			Client: server.Client(),
+			Options: &RequestOptions{
+				RetryBaseDelay: time.Millisecond,
+			},
+		},
+	)
+	var response *Response
+	err := caller.Call(
+		context.Background(),
+		&CallParams{
+			URL:      server.URL,
+			Method:   http.MethodPatch,
+			Request:  &Request{Id: "123"},
+			Response: &response,
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, &Response{Id: "123"}, response)
+	assert.Equal(t, []string{`{"id":"123"}`, `{"id":"123"}`}, bodies)
+}
+
+func TestCallRawResponse(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("X-Call", fmt.Sprint(calls))
+				if calls == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`{"id":"123","extra":true}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	caller := NewCaller(
+		&CallerParams{
+			Client: server.Client(),
+			Options: &RequestOptions{
+				RetryBaseDelay: time.Millisecond,
+			},
+		},
+	)
+	var (
+		raw      RawResponse
+		response *Response
+	)
+	err := caller.Call(
+		context.Background(),
+		&CallParams{
+			URL:      server.URL,
+			Method:   http.MethodGet,
+			Response: &response,
+			Options:  &RequestOptions{RawResponse: &raw},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, &Response{Id: "123"}, response)
+	assert.Equal(t, http.StatusOK, raw.StatusCode)
+	assert.Equal(t, "2", raw.Header.Get("X-Call"))
+	assert.JSONEq(t, `{"id":"123","extra":true}`, string(raw.Body))
+	assert.Equal(t, 2, raw.Attempts)
+	assert.Positive(t, raw.Elapsed)
+
+	// Error responses are recorded too.
+	calls = 0
+	err = caller.Call(
+		context.Background(),
+		&CallParams{
+			URL:         server.URL,
+			Method:      http.MethodGet,
+			MaxAttempts: 1,
+			Response:    &response,
+			Options:     &RequestOptions{RawResponse: &raw},
+		},
+	)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, raw.StatusCode)
+	assert.Equal(t, 1, raw.Attempts)
+}
+
+func TestCallerSnapshot(t *testing.T) {
+	caller := NewCaller(&CallerParams{})
+	target := func(name string) []RequestOption {
+		return []RequestOption{
+			&BaseURLOption{BaseURL: "https://" + name},
+			&HTTPHeaderOption{HTTPHeader: http.Header{"X-Firewall": {name}}},
+		}
+	}
+	caller.Apply(target("lab")...)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range 1000 {
+			caller.Apply(target([]string{"lab", "edge"}[i%2])...)
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		baseURL, header := caller.Snapshot()
+		require.Equal(t, "https://"+header.Get("X-Firewall"), baseURL)
+	}
+}
+
 func TestMergeHeaders(t *testing.T) {
 	t.Run("both empty", func(t *testing.T) {
 		merged := MergeHeaders(make(http.Header), make(http.Header))
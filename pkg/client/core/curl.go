@@ -0,0 +1,79 @@
+package core
+
+import (
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// curlCredentials maps the credential headers to the environment variables
+// that a curl command reads them from, as used by pfrest.NewClientFromEnv.
+var curlCredentials = map[string]string{
+	"X-Api-Key": "$PFREST_API_KEY",
+}
+
+// CurlCommand returns a curl command equivalent to the request. Credentials
+// are read from the PFREST_* environment variables instead of being
+// written out, and secret body fields and query parameters are replaced
+// with "[REDACTED]".
+func CurlCommand(request *http.Request) string {
+	var b strings.Builder
+	b.WriteString("curl")
+	if request.Method != http.MethodGet {
+		b.WriteString(" -X " + request.Method)
+	}
+	b.WriteString(" " + shellQuote(redactURL(request.URL)))
+
+	names := make([]string, 0, len(request.Header))
+	for name := range request.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range request.Header[name] {
+			b.WriteString(" \\\n  ")
+			b.WriteString(curlHeader(name, value))
+		}
+	}
+	if body := requestBody(request); body != "" {
+		b.WriteString(" \\\n  --data-raw " + shellQuote(body))
+	}
+	return b.String()
+}
+
+// curlHeader returns the curl argument that sends the header.
+func curlHeader(name, value string) string {
+	if variable, ok := curlCredentials[name]; ok {
+		return `-H "` + name + `: ` + variable + `"`
+	}
+	switch {
+	case name == "Authorization" && strings.HasPrefix(value, "Basic "):
+		return `-u "$PFREST_USERNAME:$PFREST_PASSWORD"`
+	case name == "Authorization" && strings.HasPrefix(value, "Bearer "):
+		return `-H "Authorization: Bearer $PFREST_TOKEN"`
+	}
+	for _, secret := range secretHeaders {
+		if name == secret {
+			value = redacted
+		}
+	}
+	return "-H " + shellQuote(name+": "+value)
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// writeCurl returns middleware that writes a curl command for every
+// attempt to w.
+func writeCurl(w io.Writer) Middleware {
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+			// A single write, so that concurrent commands don't interleave.
+			_, _ = io.WriteString(w, CurlCommand(request)+"\n")
+			return next.Do(request)
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package core
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+)
+
+// modulePath is the module path of the SDK, used to find its version.
+const modulePath = "github.com/danielmichaels/go-pfrest"
+
+// HARRecorder records every attempt of the calls made through it as an
+// entry of an HTTP Archive (HAR 1.2), which browsers and many other tools
+// can open. Credential headers, URL passwords, and the body fields and
+// query parameters that IsSecretField matches are redacted, in requests
+// and responses alike. Secrets are recognized by name, so review an
+// archive before attaching it to a public bug report.
+//
+// A HARRecorder is safe for concurrent use.
+type HARRecorder struct {
+	mu      sync.Mutex
+	entries []harEntry
+}
+
+// NewHARRecorder returns an empty HARRecorder.
+func NewHARRecorder() *HARRecorder {
+	return new(HARRecorder)
+}
+
+// Len returns the number of entries recorded so far.
+func (h *HARRecorder) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.entries)
+}
+
+// Reset discards the recorded entries.
+func (h *HARRecorder) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.entries = nil
+}
+
+// WriteTo writes the recorded entries to w as a HAR 1.2 document.
+func (h *HARRecorder) WriteTo(w io.Writer) (int64, error) {
+	h.mu.Lock()
+	entries := append([]harEntry{}, h.entries...)
+	h.mu.Unlock()
+
+	data, err := json.MarshalIndent(harDocument{
+		Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: "go-pfrest", Version: sdkVersion()},
+			Pages:   []struct{}{},
+			Entries: entries,
+		},
+	}, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(append(data, '\n'))
+	return int64(n), err
+}
+
+// Middleware returns middleware that records every attempt.
+func (h *HARRecorder) Middleware() Middleware {
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+			timings := new(harTimer)
+			request = request.WithContext(httptrace.WithClientTrace(request.Context(), timings.trace()))
+			entry := harEntry{
+				StartedDateTime: time.Now().Format(time.RFC3339Nano),
+				Request:         harRequestOf(request),
+				Cache:           struct{}{},
+			}
+			if endpoint, ok := EndpointFromContext(request.Context()); ok {
+				entry.Comment = endpoint.String()
+			}
+			timings.start = time.Now()
+
+			response, err := next.Do(request)
+			if err != nil {
+				entry.Response = harResponse{
+					HTTPVersion: request.Proto,
+					Cookies:     []harNameValue{},
+					Headers:     []harNameValue{},
+					Content:     harContent{MimeType: "x-unknown"},
+					HeadersSize: -1,
+					BodySize:    -1,
+					Comment:     err.Error(),
+				}
+				h.add(entry, timings)
+				return response, err
+			}
+			body, err := io.ReadAll(response.Body)
+			response.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			response.Body = io.NopCloser(bytes.NewReader(body))
+			timings.end = time.Now()
+
+			entry.Response = harResponseOf(response, body)
+			h.add(entry, timings)
+			return response, nil
+		})
+	}
+}
+
+func (h *HARRecorder) add(entry harEntry, timings *harTimer) {
+	if timings.end.IsZero() {
+		timings.end = time.Now()
+	}
+	entry.Timings = timings.timings()
+	entry.Time = float64(timings.end.Sub(timings.start)) / float64(time.Millisecond)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.entries = append(h.entries, entry)
+}
+
+// sdkVersion returns the version of the SDK module in the running binary.
+func sdkVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	if info.Main.Path == modulePath {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			return dep.Version
+		}
+	}
+	return "(devel)"
+}
+
+// harTimer collects the phases of a single attempt through httptrace.
+type harTimer struct {
+	mu sync.Mutex
+
+	start        time.Time
+	dnsStart     time.Time
+	dnsDone      time.Time
+	connectStart time.Time
+	connectDone  time.Time
+	tlsStart     time.Time
+	tlsDone      time.Time
+	gotConn      time.Time
+	wroteRequest time.Time
+	firstByte    time.Time
+	end          time.Time
+}
+
+func (t *harTimer) set(field *time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	*field = time.Now()
+}
+
+func (t *harTimer) trace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { t.set(&t.dnsStart) },
+		DNSDone:              func(httptrace.DNSDoneInfo) { t.set(&t.dnsDone) },
+		ConnectStart:         func(string, string) { t.set(&t.connectStart) },
+		ConnectDone:          func(string, string, error) { t.set(&t.connectDone) },
+		TLSHandshakeStart:    func() { t.set(&t.tlsStart) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { t.set(&t.tlsDone) },
+		GotConn:              func(httptrace.GotConnInfo) { t.set(&t.gotConn) },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { t.set(&t.wroteRequest) },
+		GotFirstResponseByte: func() { t.set(&t.firstByte) },
+	}
+}
+
+// timings returns the HAR timings, in milliseconds. Phases that didn't
+// happen, such as DNS for a reused connection, are -1.
+func (t *harTimer) timings() harTimings {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	span := func(from, to time.Time) float64 {
+		if from.IsZero() || to.IsZero() || to.Before(from) {
+			return -1
+		}
+		return float64(to.Sub(from)) / float64(time.Millisecond)
+	}
+	required := func(from, to time.Time) float64 {
+		return max(span(from, to), 0)
+	}
+	timings := harTimings{
+		Blocked: -1,
+		DNS:     span(t.dnsStart, t.dnsDone),
+		Connect: span(t.connectStart, t.connectDone),
+		SSL:     span(t.tlsStart, t.tlsDone),
+		Send:    required(t.gotConn, t.wroteRequest),
+		Wait:    required(t.wroteRequest, t.firstByte),
+		Receive: required(t.firstByte, t.end),
+	}
+	if timings.Connect >= 0 && timings.SSL >= 0 {
+		// HAR counts the TLS handshake as part of connecting.
+		timings.Connect = span(t.connectStart, t.tlsDone)
+	}
+	return timings
+}
+
+func harRequestOf(request *http.Request) harRequest {
+	r := harRequest{
+		Method:      request.Method,
+		URL:         redactURL(request.URL),
+		HTTPVersion: request.Proto,
+		Cookies:     []harNameValue{},
+		Headers:     harHeaders(request.Header),
+		QueryString: harQuery(request.URL.Query()),
+		HeadersSize: -1,
+		BodySize:    0,
+	}
+	if body := requestBody(request); body != "" {
+		r.PostData = &harPostData{MimeType: request.Header.Get(contentTypeHeader), Text: body}
+		r.BodySize = max(request.ContentLength, -1)
+	}
+	return r
+}
+
+func harResponseOf(response *http.Response, body []byte) harResponse {
+	return harResponse{
+		Status:      response.StatusCode,
+		StatusText:  http.StatusText(response.StatusCode),
+		HTTPVersion: response.Proto,
+		Cookies:     []harNameValue{},
+		Headers:     harHeaders(response.Header),
+		Content: harContent{
+			Size:     int64(len(body)),
+			MimeType: response.Header.Get(contentTypeHeader),
+			Text:     redactBody(body),
+		},
+		RedirectURL: response.Header.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    int64(len(body)),
+	}
+}
+
+// harQuery returns the query parameters with secret values replaced.
+func harQuery(query url.Values) []harNameValue {
+	for name, values := range query {
+		if IsSecretField(name) {
+			for i := range values {
+				values[i] = redacted
+			}
+		}
+	}
+	return harNameValues(query)
+}
+
+func harHeaders(header http.Header) []harNameValue {
+	return harNameValues(redactHeader(header))
+}
+
+// harNameValues returns the values sorted by name, so that archives of the
+// same traffic are identical.
+func harNameValues(values map[string][]string) []harNameValue {
+	pairs := []harNameValue{}
+	for name, list := range values {
+		for _, value := range list {
+			pairs = append(pairs, harNameValue{Name: name, Value: value})
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].Name < pairs[j].Name })
+	return pairs
+}
+
+// The HAR 1.2 format, see http://www.softwareishard.com/blog/har-12-spec/.
+type (
+	harDocument struct {
+		Log harLog `json:"log"`
+	}
+	harLog struct {
+		Version string     `json:"version"`
+		Creator harCreator `json:"creator"`
+		Pages   []struct{} `json:"pages"`
+		Entries []harEntry `json:"entries"`
+	}
+	harCreator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+	harEntry struct {
+		StartedDateTime string      `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         harRequest  `json:"request"`
+		Response        harResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         harTimings  `json:"timings"`
+		Comment         string      `json:"comment,omitempty"`
+	}
+	harRequest struct {
+		Method      string         `json:"method"`
+		URL         string         `json:"url"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []harNameValue `json:"cookies"`
+		Headers     []harNameValue `json:"headers"`
+		QueryString []harNameValue `json:"queryString"`
+		PostData    *harPostData   `json:"postData,omitempty"`
+		HeadersSize int64          `json:"headersSize"`
+		BodySize    int64          `json:"bodySize"`
+	}
+	harPostData struct {
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+	}
+	harResponse struct {
+		Status      int            `json:"status"`
+		StatusText  string         `json:"statusText"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []harNameValue `json:"cookies"`
+		Headers     []harNameValue `json:"headers"`
+		Content     harContent     `json:"content"`
+		RedirectURL string         `json:"redirectURL"`
+		HeadersSize int64          `json:"headersSize"`
+		BodySize    int64          `json:"bodySize"`
+		Comment     string         `json:"comment,omitempty"`
+	}
+	harContent struct {
+		Size     int64  `json:"size"`
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text,omitempty"`
+	}
+	harNameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	harTimings struct {
+		Blocked float64 `json:"blocked"`
+		DNS     float64 `json:"dns"`
+		Connect float64 `json:"connect"`
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+		SSL     float64 `json:"ssl"`
+	}
+)
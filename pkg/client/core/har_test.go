@@ -0,0 +1,130 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHARRecorder(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(contentTypeHeader, contentType)
+				if r.Method == http.MethodPost {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"code":400,"response_id":"FIELD_IS_REQUIRED"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"id":"123","privatekey":"server-secret"}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	recorder := NewHARRecorder()
+	caller := NewCaller(
+		&CallerParams{
+			Client: server.Client(),
+			Options: &RequestOptions{
+				Authenticator: &APIKeyAuth{Credentials: StaticCredentials{APIKey: "api-secret"}},
+				HAR:           recorder,
+			},
+		},
+	)
+	var response *Response
+	require.NoError(t, caller.Call(
+		context.Background(),
+		&CallParams{
+			Endpoint: Endpoint{Service: "Vpn", Name: "GetVpnWireGuardTunnelEndpoint"},
+			URL:      server.URL + "/api/v2/vpn/wireguard/tunnel?id=0",
+			Method:   http.MethodGet,
+			Response: &response,
+		},
+	))
+	assert.Equal(t, &Response{Id: "123"}, response)
+	require.Error(t, caller.Call(
+		context.Background(),
+		&CallParams{
+			URL:     server.URL + "/api/v2/user",
+			Method:  http.MethodPost,
+			Request: map[string]string{"password": "user-secret"},
+		},
+	))
+	assert.Equal(t, 2, recorder.Len())
+
+	var out bytes.Buffer
+	_, err := recorder.WriteTo(&out)
+	require.NoError(t, err)
+	for _, secret := range []string{"api-secret", "user-secret", "server-secret"} {
+		assert.NotContains(t, out.String(), secret)
+	}
+
+	var document harDocument
+	require.NoError(t, json.Unmarshal(out.Bytes(), &document))
+	assert.Equal(t, "1.2", document.Log.Version)
+	assert.Equal(t, "go-pfrest", document.Log.Creator.Name)
+	require.Len(t, document.Log.Entries, 2)
+
+	get := document.Log.Entries[0]
+	assert.Equal(t, "Vpn.GetVpnWireGuardTunnelEndpoint", get.Comment)
+	assert.Equal(t, http.MethodGet, get.Request.Method)
+	assert.Equal(t, []harNameValue{{Name: "id", Value: "0"}}, get.Request.QueryString)
+	assert.Contains(t, get.Request.Headers, harNameValue{Name: "X-Api-Key", Value: redacted})
+	assert.Equal(t, http.StatusOK, get.Response.Status)
+	assert.Equal(t, `{"id":"123","privatekey":"[REDACTED]"}`, get.Response.Content.Text)
+	assert.GreaterOrEqual(t, get.Time, 0.0)
+	assert.GreaterOrEqual(t, get.Timings.Wait, 0.0)
+
+	post := document.Log.Entries[1]
+	require.NotNil(t, post.Request.PostData)
+	assert.Equal(t, `{"password":"[REDACTED]"}`, post.Request.PostData.Text)
+	assert.Equal(t, http.StatusBadRequest, post.Response.Status)
+
+	recorder.Reset()
+	assert.Equal(t, 0, recorder.Len())
+}
+
+func TestHARRecorderRedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set(contentTypeHeader, contentType)
+				_, _ = w.Write([]byte(`{"code":200,"data":{"token":"jwt-secret","tls":"key-secret"}}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	recorder := NewHARRecorder()
+	caller := NewCaller(&CallerParams{Client: server.Client(), Options: &RequestOptions{HAR: recorder}})
+	require.NoError(t, caller.Call(
+		context.Background(),
+		&CallParams{
+			URL:     server.URL + "/api/v2/vpn/openvpn/server?api_key=query-secret",
+			Method:  http.MethodPost,
+			Request: map[string]string{"tls": "static-key-secret", "descr": "vpn"},
+		},
+	))
+
+	var out bytes.Buffer
+	_, err := recorder.WriteTo(&out)
+	require.NoError(t, err)
+	for _, secret := range []string{"jwt-secret", "key-secret", "static-key-secret", "query-secret"} {
+		assert.NotContains(t, out.String(), secret)
+	}
+
+	var document harDocument
+	require.NoError(t, json.Unmarshal(out.Bytes(), &document))
+	require.Len(t, document.Log.Entries, 1)
+	entry := document.Log.Entries[0]
+	assert.Equal(t, `{"descr":"vpn","tls":"[REDACTED]"}`, entry.Request.PostData.Text)
+	assert.Equal(t, []harNameValue{{Name: "api_key", Value: redacted}}, entry.Request.QueryString)
+	assert.Equal(t, `{"code":200,"data":{"tls":"[REDACTED]","token":"[REDACTED]"}}`, entry.Response.Content.Text)
+}
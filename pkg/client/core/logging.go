@@ -0,0 +1,212 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// redacted replaces secrets in logged headers and bodies.
+const redacted = "[REDACTED]"
+
+// secretHeaders are the headers that carry credentials.
+var secretHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"X-Api-Key",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// redactHeader returns a copy of header with credentials replaced.
+func redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	for _, name := range secretHeaders {
+		if _, ok := header[name]; ok {
+			header[name] = []string{redacted}
+		}
+	}
+	return header
+}
+
+// redactBody returns the JSON body with the fields that IsSecretField
+// matches replaced. A body that is not JSON can't be inspected, so only its
+// type is kept.
+func redactBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return "[" + strings.TrimSpace(http.DetectContentType(body)) + " body omitted]"
+	}
+	out, err := json.Marshal(redactValue(value, IsSecretField))
+	if err != nil {
+		return "[body omitted]"
+	}
+	return string(out)
+}
+
+// redactValue replaces the strings held by the fields that secret matches,
+// at any depth of a decoded JSON value. Empty strings are kept, so that it
+// is still visible that a secret is unset.
+func redactValue(value any, secret func(name string) bool) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, field := range value {
+			if secret(key) {
+				value[key] = redactSecret(field, secret)
+			} else {
+				value[key] = redactValue(field, secret)
+			}
+		}
+	case []any:
+		for i, item := range value {
+			value[i] = redactValue(item, secret)
+		}
+	}
+	return value
+}
+
+// redactSecret replaces the value of a secret field, or the strings in it
+// if it is a list.
+func redactSecret(value any, secret func(name string) bool) any {
+	switch value := value.(type) {
+	case string:
+		if value != "" {
+			return redacted
+		}
+	case []any:
+		for i, item := range value {
+			value[i] = redactSecret(item, secret)
+		}
+	case map[string]any:
+		return redactValue(value, secret)
+	}
+	return value
+}
+
+// redactURL returns the URL with the password of its user info and the
+// values of secret query parameters replaced.
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	changed := false
+	for name, values := range query {
+		if IsSecretField(name) {
+			for i := range values {
+				values[i] = redacted
+			}
+			changed = true
+		}
+	}
+	if changed {
+		copied := *u
+		copied.RawQuery = query.Encode()
+		u = &copied
+	}
+	return u.Redacted()
+}
+
+// logAttempts returns middleware that logs every attempt of a single call
+// at debug level, and every retry at warn level. Bodies are only read when
+// debug logging is enabled.
+func logAttempts(logger *slog.Logger) Middleware {
+	var (
+		attempt  int
+		previous string
+	)
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+			ctx := request.Context()
+			attempt++
+			attrs := []slog.Attr{
+				slog.String("method", request.Method),
+				slog.String("url", redactURL(request.URL)),
+				slog.Int("attempt", attempt),
+			}
+			if endpoint, ok := EndpointFromContext(ctx); ok && endpoint.Name != "" {
+				attrs = append(attrs, slog.String("endpoint", endpoint.String()))
+			}
+			if attempt > 1 {
+				logger.LogAttrs(ctx, slog.LevelWarn, "pfrest: retrying request",
+					append(attrs, slog.String("previous", previous))...)
+			}
+			debug := logger.Enabled(ctx, slog.LevelDebug)
+			if debug {
+				logger.LogAttrs(ctx, slog.LevelDebug, "pfrest: request",
+					append(attrs,
+						slog.Any("header", redactHeader(request.Header)),
+						slog.String("body", requestBody(request)),
+					)...)
+			}
+
+			start := time.Now()
+			response, err := next.Do(request)
+			duration := time.Since(start)
+			if err != nil {
+				previous = err.Error()
+				if debug {
+					logger.LogAttrs(ctx, slog.LevelDebug, "pfrest: request failed",
+						append(attrs,
+							slog.Duration("duration", duration),
+							slog.String("error", err.Error()),
+						)...)
+				}
+				return response, err
+			}
+			previous = response.Status
+			if !debug {
+				return response, nil
+			}
+			body, err := io.ReadAll(response.Body)
+			response.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			response.Body = io.NopCloser(bytes.NewReader(body))
+			logger.LogAttrs(ctx, slog.LevelDebug, "pfrest: response",
+				append(attrs,
+					slog.Int("status", response.StatusCode),
+					slog.Duration("duration", duration),
+					slog.Any("header", redactHeader(response.Header)),
+					slog.String("body", redactBody(body)),
+				)...)
+			return response, nil
+		})
+	}
+}
+
+// requestBody returns the redacted request body, read from a fresh copy so
+// the request itself is left untouched.
+func requestBody(request *http.Request) string {
+	if request.Body == nil || request.Body == http.NoBody {
+		return ""
+	}
+	if request.GetBody == nil {
+		return "[body omitted]"
+	}
+	body, err := request.GetBody()
+	if err != nil {
+		return "[body omitted]"
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "[body omitted]"
+	}
+	return redactBody(data)
+}
+
+// discardLogger reports whether logger is nil or would drop warnings,
+// which makes the logging middleware unnecessary.
+func discardLogger(ctx context.Context, logger *slog.Logger) bool {
+	return logger == nil || !logger.Enabled(ctx, slog.LevelWarn)
+}
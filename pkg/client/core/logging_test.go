@@ -0,0 +1,149 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		desc string
+		body string
+		want string
+	}{
+		{
+			desc: "nested fields",
+			body: `{"name":"vpn","password":"hunter2","peers":[{"PrivateKey":"abc","port":51820}]}`,
+			want: `{"name":"vpn","password":"[REDACTED]","peers":[{"PrivateKey":"[REDACTED]","port":51820}]}`,
+		},
+		{
+			desc: "all secret fields",
+			body: `{"presharedkey":"a","prv":"b","key":"c","descr":"d"}`,
+			want: `{"descr":"d","key":"[REDACTED]","presharedkey":"[REDACTED]","prv":"[REDACTED]"}`,
+		},
+		{
+			desc: "secret field names",
+			body: `{"data":{"token":"jwt"},"passphrase":"a","auth_pass":"b","ha_sync_password":"c","tls":"d","binary_data":"e"}`,
+			want: `{"auth_pass":"[REDACTED]","binary_data":"[REDACTED]","data":{"token":"[REDACTED]"},"ha_sync_password":"[REDACTED]","passphrase":"[REDACTED]","tls":"[REDACTED]"}`,
+		},
+		{
+			desc: "flags and unset secrets",
+			body: `{"use_token":true,"password":"","keys":["a"],"secret":["b",""]}`,
+			want: `{"keys":["a"],"password":"","secret":["[REDACTED]",""],"use_token":true}`,
+		},
+		{
+			desc: "not JSON",
+			body: "password=hunter2",
+			want: "[text/plain; charset=utf-8 body omitted]",
+		},
+		{
+			desc: "empty",
+			body: "",
+			want: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.Equal(t, test.want, redactBody([]byte(test.body)))
+		})
+	}
+}
+
+func TestCallLogger(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				if calls == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`{"id":"123","privatekey":"server-secret"}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	var logs bytes.Buffer
+	caller := NewCaller(
+		&CallerParams{
+			Client: server.Client(),
+			Options: &RequestOptions{
+				RetryBaseDelay: time.Millisecond,
+				Authenticator:  &APIKeyAuth{Credentials: StaticCredentials{APIKey: "api-secret"}},
+				Logger:         slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+			},
+		},
+	)
+	var response *Response
+	err := caller.Call(
+		context.Background(),
+		&CallParams{
+			URL:      server.URL + "/api/v2/user",
+			Method:   http.MethodPut,
+			Request:  map[string]string{"name": "admin", "password": "user-secret"},
+			Response: &response,
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, &Response{Id: "123"}, response)
+
+	out := logs.String()
+	for _, secret := range []string{"api-secret", "user-secret", "server-secret"} {
+		assert.NotContains(t, out, secret)
+	}
+	assert.Contains(t, out, `"X-Api-Key":["[REDACTED]"]`)
+	assert.Contains(t, out, `"level":"WARN","msg":"pfrest: retrying request"`)
+	assert.Contains(t, out, `"attempt":2`)
+	assert.Contains(t, out, `"status":503`)
+	assert.Contains(t, out, `"status":200`)
+}
+
+func TestCallLoggerRedactsTokens(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"code":200,"data":{"token":"jwt-secret"}}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	var logs, curl bytes.Buffer
+	caller := NewCaller(
+		&CallerParams{
+			Client: server.Client(),
+			Options: &RequestOptions{
+				Logger: slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				Curl:   &curl,
+			},
+		},
+	)
+	url := strings.Replace(server.URL, "http://", "http://admin:url-secret@", 1) + "/api/v2/auth/jwt?token=query-secret"
+	require.NoError(t, caller.Call(
+		context.Background(),
+		&CallParams{
+			URL:     url,
+			Method:  http.MethodPost,
+			Request: map[string]string{"passphrase": "request-secret"},
+		},
+	))
+
+	for _, out := range []string{logs.String(), curl.String()} {
+		for _, secret := range []string{"jwt-secret", "url-secret", "query-secret", "request-secret"} {
+			assert.NotContains(t, out, secret)
+		}
+	}
+	assert.Contains(t, logs.String(), `"body":"{\"code\":200,\"data\":{\"token\":\"[REDACTED]\"}}"`)
+	assert.Contains(t, curl.String(), `'{"passphrase":"[REDACTED]"}'`)
+}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Endpoint identifies the generated method that issued a call.
+type Endpoint struct {
+	// Service is the name of the sub-client, e.g. "Firewall".
+	Service string
+	// Name is the name of the generated method, e.g. "GetFirewallRulesEndpoint".
+	Name string
+	// Path is the path of the endpoint, e.g. "/api/v2/firewall/rules".
+	Path string
+	// Deferred is set on mutations whose changes only take effect once the
+	// subsystem's apply endpoint is called, e.g. POST /api/v2/firewall/apply
+	// after PatchFirewallRuleEndpoint.
+	Deferred bool
+}
+
+// String returns the fully qualified endpoint name, e.g.
+// "Firewall.GetFirewallRulesEndpoint".
+func (e Endpoint) String() string {
+	if e.Service == "" {
+		return e.Name
+	}
+	return e.Service + "." + e.Name
+}
+
+type endpointContextKey struct{}
+
+// WithEndpoint returns a copy of ctx that carries the given endpoint.
+func WithEndpoint(ctx context.Context, endpoint Endpoint) context.Context {
+	return context.WithValue(ctx, endpointContextKey{}, endpoint)
+}
+
+// EndpointFromContext returns the endpoint that issued the request with
+// the given context, if any. Every request sent through a *Caller carries
+// one, so middleware can read it from (*http.Request).Context.
+func EndpointFromContext(ctx context.Context) (Endpoint, bool) {
+	endpoint, ok := ctx.Value(endpointContextKey{}).(Endpoint)
+	return endpoint, ok
+}
+
+// HTTPClientFunc adapts an ordinary function to the HTTPClient interface.
+type HTTPClientFunc func(*http.Request) (*http.Response, error)
+
+// Do implements HTTPClient.
+func (f HTTPClientFunc) Do(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+// Middleware wraps an HTTPClient with additional behavior. It is applied
+// to every attempt, so retried requests pass through it again.
+type Middleware func(next HTTPClient) HTTPClient
+
+// Interceptor observes or alters a single attempt of a call to the given
+// endpoint. It must call next to send the request, and may inspect or
+// replace the request and response on either side of it.
+type Interceptor func(endpoint Endpoint, request *http.Request, next HTTPClient) (*http.Response, error)
+
+// Middleware adapts the Interceptor into a Middleware.
+func (i Interceptor) Middleware() Middleware {
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+			endpoint, _ := EndpointFromContext(request.Context())
+			return i(endpoint, request, next)
+		})
+	}
+}
+
+// BodyRewriter rewrites the fields of a JSON object request body, e.g. to
+// send a field that the generated request type omits or declares with
+// another type.
+type BodyRewriter func(fields map[string]json.RawMessage) error
+
+// Middleware adapts the BodyRewriter into a Middleware. Requests without
+// a body are sent unchanged.
+func (r BodyRewriter) Middleware() Middleware {
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+			if request.GetBody == nil {
+				return next.Do(request)
+			}
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			data, err := io.ReadAll(body)
+			body.Close()
+			if err != nil {
+				return nil, err
+			}
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(data, &fields); err != nil {
+				return nil, fmt.Errorf("rewrite request body: %w", err)
+			}
+			if fields == nil {
+				fields = make(map[string]json.RawMessage)
+			}
+			if err := r(fields); err != nil {
+				return nil, fmt.Errorf("rewrite request body: %w", err)
+			}
+			if data, err = json.Marshal(fields); err != nil {
+				return nil, err
+			}
+			request = request.Clone(request.Context())
+			request.Body = io.NopCloser(bytes.NewReader(data))
+			request.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(data)), nil
+			}
+			request.ContentLength = int64(len(data))
+			return next.Do(request)
+		})
+	}
+}
+
+// chainMiddleware wraps the client in the given middleware. The first
+// middleware is the outermost, so it sees the request first and the
+// response last.
+func chainMiddleware(client HTTPClient, middleware ...Middleware) HTTPClient {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		client = middleware[i](client)
+	}
+	return client
+}
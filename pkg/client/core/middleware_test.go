@@ -0,0 +1,117 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallMiddleware(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "client,request", r.Header.Get("X-Trace"))
+				w.WriteHeader(http.StatusNoContent)
+			},
+		),
+	)
+	defer server.Close()
+
+	var order []string
+	tag := func(name string) Middleware {
+		return func(next HTTPClient) HTTPClient {
+			return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				if trace := request.Header.Get("X-Trace"); trace != "" {
+					name = trace + "," + name
+				}
+				request.Header.Set("X-Trace", name)
+				return next.Do(request)
+			})
+		}
+	}
+
+	var endpoint Endpoint
+	interceptor := Interceptor(func(e Endpoint, request *http.Request, next HTTPClient) (*http.Response, error) {
+		endpoint = e
+		response, err := next.Do(request)
+		if err == nil {
+			order = append(order, "interceptor")
+		}
+		return response, err
+	})
+
+	caller := NewCaller(
+		&CallerParams{
+			Client: server.Client(),
+			Options: &RequestOptions{
+				Middleware: []Middleware{tag("client")},
+			},
+		},
+	)
+	err := caller.Call(
+		context.Background(),
+		&CallParams{
+			Endpoint: Endpoint{Service: "Firewall", Name: "GetFirewallRulesEndpoint"},
+			URL:      server.URL,
+			Method:   http.MethodGet,
+			Options: &RequestOptions{
+				Middleware: []Middleware{tag("request"), interceptor.Middleware()},
+			},
+			ResponseIsOptional: true,
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"client", "request", "interceptor"}, order)
+	assert.Equal(t, "Firewall.GetFirewallRulesEndpoint", endpoint.String())
+}
+
+func TestBodyRewriter(t *testing.T) {
+	var bodies []string
+	send := BodyRewriter(func(fields map[string]json.RawMessage) error {
+		if _, ok := fields["fail"]; ok {
+			return errors.New("cannot rewrite")
+		}
+		fields["id"] = json.RawMessage(`"wan"`)
+		return nil
+	}).Middleware()(HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+		var body []byte
+		if request.Body != nil {
+			body, _ = io.ReadAll(request.Body)
+		}
+		bodies = append(bodies, string(body))
+		assert.Equal(t, int64(len(body)), request.ContentLength)
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+	newRequest := func(method, body string) *http.Request {
+		var reader io.Reader
+		if body != "" {
+			reader = strings.NewReader(body)
+		}
+		request, err := http.NewRequest(method, "https://pfsense/api/v2/interface", reader)
+		require.NoError(t, err)
+		return request
+	}
+
+	for _, body := range []string{`{"descr":"Uplink"}`, `null`} {
+		_, err := send.Do(newRequest(http.MethodPatch, body))
+		require.NoError(t, err, body)
+	}
+	_, err := send.Do(newRequest(http.MethodGet, ""))
+	require.NoError(t, err)
+	assert.Equal(t, []string{`{"descr":"Uplink","id":"wan"}`, `{"id":"wan"}`, ``}, bodies)
+
+	_, err = send.Do(newRequest(http.MethodPatch, `{"fail":true}`))
+	assert.EqualError(t, err, "rewrite request body: cannot rewrite")
+	_, err = send.Do(newRequest(http.MethodPatch, `[1]`))
+	assert.ErrorContains(t, err, "rewrite request body: json: cannot unmarshal array")
+	assert.Len(t, bodies, 3, "bodies that can't be rewritten are not sent")
+}
@@ -0,0 +1,113 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// defaultPageSize is the number of objects fetched per page when neither
+// the request's Limit nor option.WithPageSize sets one.
+const defaultPageSize = 100
+
+// PageFunc fetches the page of objects starting at offset.
+type PageFunc[T any] func(ctx context.Context, limit, offset int) ([]T, error)
+
+// PageParams configures Paginate.
+type PageParams struct {
+	// Limit is the request's Limit, used as the page size if the options
+	// don't set one.
+	Limit *int
+	// Offset is the request's Offset, where iteration starts.
+	Offset *int
+	// Options are the request options of the iterator, if any.
+	Options *RequestOptions
+}
+
+// Paginate returns an iterator over every object returned by fetch, which
+// is called a page at a time until it returns a page shorter than the page
+// size. Iteration stops at the first error, which is yielded with the zero
+// value of T. A page longer than the page size is an error: the server
+// ignored limit and offset, and fetching further pages would return the
+// same objects again.
+//
+// With option.WithPrefetch, the next page is fetched while the current
+// one is being consumed.
+func Paginate[T any](ctx context.Context, params PageParams, fetch PageFunc[T]) iter.Seq2[T, error] {
+	pageSize := defaultPageSize
+	if params.Limit != nil && *params.Limit > 0 {
+		pageSize = *params.Limit
+	}
+	if params.Options != nil && params.Options.PageSize > 0 {
+		pageSize = params.Options.PageSize
+	}
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	prefetch := params.Options != nil && params.Options.Prefetch
+
+	return func(yield func(T, error) bool) {
+		var zero T
+		// Cancelling stops a prefetch that the consumer won't wait for.
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		next := startPage(ctx, fetch, pageSize, offset, prefetch)
+		for {
+			items, err := next()
+			if err == nil {
+				err = ctx.Err()
+			}
+			if err == nil && len(items) > pageSize {
+				err = fmt.Errorf("pagination: the server returned %d objects for a page of %d at offset %d; it doesn't support limit and offset", len(items), pageSize, offset)
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if len(items) == 0 {
+				return
+			}
+			offset += len(items)
+			last := len(items) < pageSize
+			if !last {
+				next = startPage(ctx, fetch, pageSize, offset, prefetch)
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if last {
+				return
+			}
+		}
+	}
+}
+
+// startPage returns a function that returns the page at offset. If
+// prefetch is set, the page is fetched in the background right away.
+func startPage[T any](ctx context.Context, fetch PageFunc[T], limit, offset int, prefetch bool) func() ([]T, error) {
+	if !prefetch {
+		return func() ([]T, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return fetch(ctx, limit, offset)
+		}
+	}
+	type page struct {
+		items []T
+		err   error
+	}
+	result := make(chan page, 1)
+	go func() {
+		items, err := fetch(ctx, limit, offset)
+		result <- page{items: items, err: err}
+	}()
+	return func() ([]T, error) {
+		p := <-result
+		return p.items, p.err
+	}
+}
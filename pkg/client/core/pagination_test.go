@@ -0,0 +1,157 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pageCall records a single call to a PageFunc.
+type pageCall struct {
+	limit  int
+	offset int
+}
+
+// newTestPages returns a PageFunc over the integers [0, total) and the
+// calls made to it.
+func newTestPages(total int) (PageFunc[int], func() []pageCall) {
+	var (
+		mu    sync.Mutex
+		calls []pageCall
+	)
+	fetch := func(_ context.Context, limit, offset int) ([]int, error) {
+		mu.Lock()
+		calls = append(calls, pageCall{limit: limit, offset: offset})
+		mu.Unlock()
+		var items []int
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, i)
+		}
+		return items, nil
+	}
+	return fetch, func() []pageCall {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]pageCall(nil), calls...)
+	}
+}
+
+func collect(t *testing.T, seq func(func(int, error) bool)) []int {
+	var items []int
+	for item, err := range seq {
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestPaginate(t *testing.T) {
+	t.Run("stops on short page", func(t *testing.T) {
+		fetch, calls := newTestPages(7)
+		limit := 3
+		items := collect(t, Paginate(context.Background(), PageParams{Limit: &limit}, fetch))
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, items)
+		assert.Equal(t, []pageCall{{3, 0}, {3, 3}, {3, 6}}, calls())
+	})
+
+	t.Run("exact multiple of page size", func(t *testing.T) {
+		fetch, calls := newTestPages(4)
+		items := collect(t, Paginate(context.Background(), PageParams{Options: &RequestOptions{PageSize: 2}}, fetch))
+		assert.Equal(t, []int{0, 1, 2, 3}, items)
+		assert.Equal(t, []pageCall{{2, 0}, {2, 2}, {2, 4}}, calls())
+	})
+
+	t.Run("starts at offset", func(t *testing.T) {
+		fetch, _ := newTestPages(5)
+		offset := 3
+		items := collect(t, Paginate(context.Background(), PageParams{Offset: &offset}, fetch))
+		assert.Equal(t, []int{3, 4}, items)
+	})
+
+	t.Run("prefetch", func(t *testing.T) {
+		fetch, calls := newTestPages(5)
+		seq := Paginate(context.Background(), PageParams{Options: &RequestOptions{PageSize: 2, Prefetch: true}}, fetch)
+		for item, err := range seq {
+			require.NoError(t, err)
+			if item == 0 {
+				// The second page was requested before the first was consumed.
+				assert.Eventually(t, func() bool { return len(calls()) == 2 }, time.Second, time.Millisecond)
+			}
+		}
+		assert.Equal(t, []pageCall{{2, 0}, {2, 2}, {2, 4}}, calls())
+	})
+
+	t.Run("break", func(t *testing.T) {
+		fetch, calls := newTestPages(10)
+		for item := range Paginate(context.Background(), PageParams{Options: &RequestOptions{PageSize: 2}}, fetch) {
+			if item == 1 {
+				break
+			}
+		}
+		assert.Len(t, calls(), 1)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		errPage := errors.New("page failed")
+		fetch := func(_ context.Context, limit, offset int) ([]int, error) {
+			if offset > 0 {
+				return nil, errPage
+			}
+			return make([]int, limit), nil
+		}
+		var errs []error
+		for _, err := range Paginate(context.Background(), PageParams{Options: &RequestOptions{PageSize: 2}}, fetch) {
+			errs = append(errs, err)
+		}
+		assert.Equal(t, []error{nil, nil, errPage}, errs)
+	})
+
+	t.Run("server ignores limit and offset", func(t *testing.T) {
+		var calls int
+		fetch := func(_ context.Context, _, _ int) ([]int, error) {
+			calls++
+			return []int{0, 1, 2, 3, 4}, nil
+		}
+		var errs []error
+		for item, err := range Paginate(context.Background(), PageParams{Options: &RequestOptions{PageSize: 2}}, fetch) {
+			assert.Zero(t, item)
+			errs = append(errs, err)
+		}
+		require.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "pagination: the server returned 5 objects for a page of 2 at offset 0; it doesn't support limit and offset")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops on empty page", func(t *testing.T) {
+		var calls int
+		fetch := func(_ context.Context, _, _ int) ([]int, error) {
+			calls++
+			return nil, nil
+		}
+		assert.Empty(t, collect(t, Paginate(context.Background(), PageParams{Limit: new(int)}, fetch)))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		fetch, calls := newTestPages(10)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var err error
+		for item, itemErr := range Paginate(ctx, PageParams{Options: &RequestOptions{PageSize: 2}}, fetch) {
+			if itemErr != nil {
+				err = itemErr
+				break
+			}
+			if item == 1 {
+				cancel()
+			}
+		}
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Len(t, calls(), 1)
+	})
+}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// reservedQueryParams are the query parameters that list and bulk-delete
+// requests set from their own fields, and so can't be used as filters.
+var reservedQueryParams = map[string]bool{
+	"limit":      true,
+	"offset":     true,
+	"sort_by":    true,
+	"sort_order": true,
+	"sort_flags": true,
+	"query":      true,
+}
+
+// AddQueryFilters adds the filters encoded in query, e.g.
+// "interface=lan&descr__contains=web", to params. pfSense reads each filter
+// from its own query parameter rather than from a single "query" parameter.
+//
+// A query that isn't made of name=value pairs, e.g. "lan", is sent
+// unchanged as the "query" parameter. A filter named after a reserved
+// parameter such as limit or offset is an error, since it would be mixed
+// up with the request's own parameter.
+func AddQueryFilters(params url.Values, query string) error {
+	if query == "" {
+		return nil
+	}
+	if !isFilterQuery(query) {
+		params.Add("query", query)
+		return nil
+	}
+	filters, err := url.ParseQuery(query)
+	if err != nil {
+		return fmt.Errorf("invalid query filters %q: %w", query, err)
+	}
+	for name := range filters {
+		if reservedQueryParams[name] {
+			return fmt.Errorf("invalid query filters %q: %q is a reserved parameter, not a field", query, name)
+		}
+	}
+	for name, values := range filters {
+		for _, value := range values {
+			params.Add(name, value)
+		}
+	}
+	return nil
+}
+
+// isFilterQuery reports whether every part of query is a name=value pair.
+func isFilterQuery(query string) bool {
+	for part := range strings.SplitSeq(query, "&") {
+		if part != "" && !strings.Contains(part, "=") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddQueryFilters(t *testing.T) {
+	for _, test := range []struct {
+		query string
+		want  url.Values
+	}{
+		{"interface=lan&descr__contains=web", url.Values{"interface": {"lan"}, "descr__contains": {"web"}}},
+		{"descr=a%20b", url.Values{"descr": {"a b"}}},
+		{"lan", url.Values{"query": {"lan"}}},
+		{"wan&lan", url.Values{"query": {"wan&lan"}}},
+		{"interface=lan&web", url.Values{"query": {"interface=lan&web"}}},
+		{"", url.Values{}},
+	} {
+		params := url.Values{"limit": {"5"}}
+		require.NoError(t, AddQueryFilters(params, test.query), test.query)
+		test.want.Set("limit", "5")
+		assert.Equal(t, test.want, params, test.query)
+	}
+
+	for _, query := range []string{"limit=1", "interface=lan&offset=10", "sort_by=descr", "query=lan"} {
+		params := url.Values{"limit": {"5"}}
+		err := AddQueryFilters(params, query)
+		assert.ErrorContains(t, err, "is a reserved parameter, not a field", query)
+		assert.Equal(t, url.Values{"limit": {"5"}}, params, "%s: nothing is added", query)
+	}
+
+	assert.ErrorContains(t, AddQueryFilters(url.Values{}, "descr=100%"), `invalid query filters "descr=100%"`)
+}
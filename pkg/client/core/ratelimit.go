@@ -0,0 +1,97 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket that admits rate requests per second on
+// average, with bursts of up to burst requests.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(max(burst, 1)),
+		tokens: float64(max(burst, 1)),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be issued, or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	// Take the token now, even if it is only available later, so that
+	// waiters are admitted in order.
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		// Hand back the token this waiter will never use.
+		l.mu.Lock()
+		l.tokens = min(l.burst, l.tokens+1)
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// Middleware returns middleware that waits for the limiter before every
+// attempt.
+func (l *rateLimiter) Middleware() Middleware {
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+			if err := l.Wait(request.Context()); err != nil {
+				return nil, err
+			}
+			return next.Do(request)
+		})
+	}
+}
+
+// writeLocks serializes writes to each pfSense base URL across every
+// Caller in the process, since the firewall serializes them anyway.
+var writeLocks sync.Map // map[string]chan struct{}
+
+// isWrite reports whether a request with the given method modifies the
+// pfSense configuration.
+func isWrite(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// lockWrites waits until no other write to the given base URL is in
+// progress, or ctx is done. The returned function releases the lock.
+func lockWrites(ctx context.Context, baseURL string) (func(), error) {
+	value, _ := writeLocks.LoadOrStore(baseURL, make(chan struct{}, 1))
+	lock := value.(chan struct{})
+	select {
+	case lock <- struct{}{}:
+		return func() { <-lock }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
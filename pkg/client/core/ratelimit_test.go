@@ -0,0 +1,135 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("allows burst then waits", func(t *testing.T) {
+		limiter := newRateLimiter(20, 2)
+		start := time.Now()
+		for range 4 {
+			require.NoError(t, limiter.Wait(context.Background()))
+		}
+		// Two tokens up front, then two more at 50ms each.
+		elapsed := time.Since(start)
+		assert.GreaterOrEqual(t, elapsed, 90*time.Millisecond)
+		assert.Less(t, elapsed, time.Second)
+	})
+
+	t.Run("cancelled wait returns the token", func(t *testing.T) {
+		limiter := newRateLimiter(1, 1)
+		require.NoError(t, limiter.Wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		assert.Greater(t, limiter.tokens, -1.0)
+	})
+}
+
+// concurrencyServer counts the most requests it has handled at once.
+type concurrencyServer struct {
+	active  atomic.Int32
+	maximum atomic.Int32
+}
+
+func (s *concurrencyServer) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	active := s.active.Add(1)
+	defer s.active.Add(-1)
+	for {
+		maximum := s.maximum.Load()
+		if active <= maximum || s.maximum.CompareAndSwap(maximum, active) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	_, _ = w.Write([]byte(`{"id":"123"}`))
+}
+
+func TestCallWriteLock(t *testing.T) {
+	call := func(t *testing.T, method string, writeLock bool) int32 {
+		handler := new(concurrencyServer)
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		caller := NewCaller(
+			&CallerParams{
+				Client:  server.Client(),
+				Options: &RequestOptions{WriteLock: writeLock},
+			},
+		)
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				var response *Response
+				assert.NoError(t, caller.Call(
+					context.Background(),
+					&CallParams{
+						URL:      server.URL + "/api/v2/firewall/rule",
+						Method:   method,
+						Response: &response,
+					},
+				))
+			}()
+		}
+		wg.Wait()
+		return handler.maximum.Load()
+	}
+
+	t.Run("serializes writes", func(t *testing.T) {
+		assert.Equal(t, int32(1), call(t, http.MethodPatch, true))
+	})
+
+	t.Run("reads stay concurrent", func(t *testing.T) {
+		assert.Greater(t, call(t, http.MethodGet, true), int32(1))
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		assert.Greater(t, call(t, http.MethodPatch, false), int32(1))
+	})
+}
+
+func TestCallRateLimit(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				_, _ = w.Write([]byte(`{"id":"123"}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	caller := NewCaller(
+		&CallerParams{
+			Client:  server.Client(),
+			Options: &RequestOptions{RateLimit: 20, RateLimitBurst: 1},
+		},
+	)
+	start := time.Now()
+	for range 3 {
+		var response *Response
+		require.NoError(t, caller.Call(
+			context.Background(),
+			&CallParams{URL: server.URL, Method: http.MethodGet, Response: &response},
+		))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	assert.Equal(t, int32(3), calls.Load())
+}
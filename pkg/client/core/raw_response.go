@@ -0,0 +1,50 @@
+package core
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RawResponse records the HTTP response behind a single call, including
+// responses that are decoded into an error.
+type RawResponse struct {
+	// StatusCode is the status code of the final attempt.
+	StatusCode int
+	// Header holds the response headers of the final attempt.
+	Header http.Header
+	// Body is the undecoded response body of the final attempt.
+	Body []byte
+	// Elapsed is the time from the start of the call until the final
+	// response body was read, including any retries.
+	Elapsed time.Duration
+	// Attempts is the number of responses received, including retried ones.
+	Attempts int
+}
+
+// recordRawResponse returns middleware that buffers every response body
+// and copies the latest response into raw.
+func recordRawResponse(raw *RawResponse, start time.Time) Middleware {
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+			response, err := next.Do(request)
+			if err != nil {
+				return response, err
+			}
+			body, err := io.ReadAll(response.Body)
+			response.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			response.Body = io.NopCloser(bytes.NewReader(body))
+
+			raw.StatusCode = response.StatusCode
+			raw.Header = response.Header.Clone()
+			raw.Body = body
+			raw.Elapsed = time.Since(start)
+			raw.Attempts++
+			return response, nil
+		})
+	}
+}
@@ -3,9 +3,10 @@
 package core
 
 import (
-	base64 "encoding/base64"
-	fmt "fmt"
+	io "io"
+	slog "log/slog"
 	http "net/http"
+	time "time"
 )
 
 // RequestOption adapts the behavior of the client or an individual request.
@@ -22,9 +23,56 @@ type RequestOptions struct {
 	HTTPClient  HTTPClient
 	HTTPHeader  http.Header
 	MaxAttempts uint
-	Username    string
-	Password    string
-	APIKey      string
+
+	// MaxRetryDelay caps the delay between retry attempts, including
+	// delays requested by the server through the Retry-After header.
+	MaxRetryDelay time.Duration
+	// RetryBaseDelay is the base delay of the exponential back-off.
+	RetryBaseDelay time.Duration
+	// RetryJitter is the fraction of each delay that is randomized, if set.
+	RetryJitter *float64
+	// RetryPolicy decides which failed attempts are retried.
+	RetryPolicy RetryPolicy
+	// Middleware wraps the HTTP client for every attempt, outermost first.
+	Middleware []Middleware
+	// Authenticator authenticates every attempt. It is set by the
+	// option.With*Auth and option.WithAPIKey options; the last one wins.
+	Authenticator Authenticator
+	// RawResponse, if set, is filled with the HTTP response of the call.
+	// It is only honoured as a request-level option.
+	RawResponse *RawResponse
+	// PageSize is the number of objects fetched per page by the All*
+	// iterators.
+	PageSize int
+	// Prefetch makes the All* iterators fetch the next page while the
+	// current one is consumed.
+	Prefetch bool
+	// ResponseDecoder, if set, consumes the body of a successful response
+	// instead of it being decoded into the endpoint's response type. It is
+	// only honoured as a request-level option.
+	ResponseDecoder ResponseDecoder
+	// RateLimit is the average number of requests per second, and
+	// RateLimitBurst the largest burst, allowed by the client. They are
+	// only honoured as client-level options.
+	RateLimit      float64
+	RateLimitBurst int
+	// WriteLock serializes POST, PUT, PATCH and DELETE calls to each base
+	// URL. It is only honoured as a client-level option.
+	WriteLock bool
+	// CircuitBreaker, if set, enables a circuit breaker for each base URL.
+	// It is only honoured as a client-level option.
+	CircuitBreaker *CircuitBreaker
+	// Tracer and Meter, if set, trace and record metrics for every call.
+	// They are only honoured as client-level options.
+	Tracer Tracer
+	Meter  Meter
+	// Logger, if set, logs every request and response at debug level and
+	// every retry at warn level, with credentials redacted.
+	Logger *slog.Logger
+	// Curl, if set, receives an equivalent curl command for every request.
+	Curl io.Writer
+	// HAR, if set, records every request and response.
+	HAR *HARRecorder
 }
 
 // NewRequestOptions returns a new *RequestOptions value.
@@ -42,20 +90,53 @@ func NewRequestOptions(opts ...RequestOption) *RequestOptions {
 }
 
 // ToHeader maps the configured request options into a http.Header used
-// for the request(s).
+// for the request(s). Credentials are not included; the Authenticator
+// adds the headers for the selected auth mode to each attempt.
 func (r *RequestOptions) ToHeader() http.Header {
-	header := r.cloneHeader()
-	if r.Username != "" && r.Password != "" {
-		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(r.Username+": "+r.Password)))
+	return r.cloneHeader()
+}
+
+// retryOptions maps the configured request options into the equivalent
+// *Retrier options. It is safe to call on a nil *RequestOptions.
+func (r *RequestOptions) retryOptions() []RetryOption {
+	if r == nil {
+		return nil
+	}
+	var opts []RetryOption
+	if r.MaxRetryDelay > 0 {
+		opts = append(opts, WithMaxRetryDelay(r.MaxRetryDelay))
+	}
+	if r.RetryBaseDelay > 0 {
+		opts = append(opts, WithRetryBaseDelay(r.RetryBaseDelay))
+	}
+	if r.RetryJitter != nil {
+		opts = append(opts, WithRetryJitter(*r.RetryJitter))
 	}
-	header.Set("x-api-key", fmt.Sprintf("%v", r.APIKey))
-	return header
+	if r.RetryPolicy != nil {
+		opts = append(opts, WithRetryPolicy(r.RetryPolicy))
+	}
+	return opts
 }
 
 func (r *RequestOptions) cloneHeader() http.Header {
 	return r.HTTPHeader.Clone()
 }
 
+// clone returns a copy of the options that can be modified without
+// affecting r. It is safe to call on a nil *RequestOptions.
+func (r *RequestOptions) clone() *RequestOptions {
+	if r == nil {
+		return NewRequestOptions()
+	}
+	clone := *r
+	clone.HTTPHeader = r.cloneHeader()
+	if clone.HTTPHeader == nil {
+		clone.HTTPHeader = make(http.Header)
+	}
+	clone.Middleware = append([]Middleware(nil), r.Middleware...)
+	return &clone
+}
+
 // BaseURLOption implements the RequestOption interface.
 type BaseURLOption struct {
 	BaseURL string
@@ -92,6 +173,52 @@ func (m *MaxAttemptsOption) applyRequestOptions(opts *RequestOptions) {
 	opts.MaxAttempts = m.MaxAttempts
 }
 
+// MaxRetryDelayOption implements the RequestOption interface.
+type MaxRetryDelayOption struct {
+	MaxRetryDelay time.Duration
+}
+
+func (m *MaxRetryDelayOption) applyRequestOptions(opts *RequestOptions) {
+	opts.MaxRetryDelay = m.MaxRetryDelay
+}
+
+// RetryBaseDelayOption implements the RequestOption interface.
+type RetryBaseDelayOption struct {
+	RetryBaseDelay time.Duration
+}
+
+func (r *RetryBaseDelayOption) applyRequestOptions(opts *RequestOptions) {
+	opts.RetryBaseDelay = r.RetryBaseDelay
+}
+
+// RetryJitterOption implements the RequestOption interface.
+type RetryJitterOption struct {
+	RetryJitter float64
+}
+
+func (r *RetryJitterOption) applyRequestOptions(opts *RequestOptions) {
+	jitter := r.RetryJitter
+	opts.RetryJitter = &jitter
+}
+
+// RetryPolicyOption implements the RequestOption interface.
+type RetryPolicyOption struct {
+	RetryPolicy RetryPolicy
+}
+
+func (r *RetryPolicyOption) applyRequestOptions(opts *RequestOptions) {
+	opts.RetryPolicy = r.RetryPolicy
+}
+
+// MiddlewareOption implements the RequestOption interface.
+type MiddlewareOption struct {
+	Middleware []Middleware
+}
+
+func (m *MiddlewareOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Middleware = append(opts.Middleware, m.Middleware...)
+}
+
 // BasicAuthOption implements the RequestOption interface.
 type BasicAuthOption struct {
 	Username string
@@ -99,8 +226,9 @@ type BasicAuthOption struct {
 }
 
 func (b *BasicAuthOption) applyRequestOptions(opts *RequestOptions) {
-	opts.Username = b.Username
-	opts.Password = b.Password
+	opts.Authenticator = &BasicAuth{
+		Credentials: StaticCredentials{Username: b.Username, Password: b.Password},
+	}
 }
 
 // APIKeyOption implements the RequestOption interface.
@@ -109,5 +237,126 @@ type APIKeyOption struct {
 }
 
 func (a *APIKeyOption) applyRequestOptions(opts *RequestOptions) {
-	opts.APIKey = a.APIKey
+	opts.Authenticator = &APIKeyAuth{
+		Credentials: StaticCredentials{APIKey: a.APIKey},
+	}
+}
+
+// AuthenticatorOption implements the RequestOption interface.
+type AuthenticatorOption struct {
+	Authenticator Authenticator
+}
+
+func (a *AuthenticatorOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Authenticator = a.Authenticator
+}
+
+// RawResponseOption implements the RequestOption interface.
+type RawResponseOption struct {
+	RawResponse *RawResponse
+}
+
+func (r *RawResponseOption) applyRequestOptions(opts *RequestOptions) {
+	opts.RawResponse = r.RawResponse
+}
+
+// PageSizeOption implements the RequestOption interface.
+type PageSizeOption struct {
+	PageSize int
+}
+
+func (p *PageSizeOption) applyRequestOptions(opts *RequestOptions) {
+	opts.PageSize = p.PageSize
+}
+
+// PrefetchOption implements the RequestOption interface.
+type PrefetchOption struct {
+	Prefetch bool
+}
+
+func (p *PrefetchOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Prefetch = p.Prefetch
+}
+
+// ResponseDecoderOption implements the RequestOption interface.
+type ResponseDecoderOption struct {
+	ResponseDecoder ResponseDecoder
+}
+
+func (r *ResponseDecoderOption) applyRequestOptions(opts *RequestOptions) {
+	opts.ResponseDecoder = r.ResponseDecoder
+}
+
+// RateLimitOption implements the RequestOption interface.
+type RateLimitOption struct {
+	RateLimit      float64
+	RateLimitBurst int
+}
+
+func (r *RateLimitOption) applyRequestOptions(opts *RequestOptions) {
+	opts.RateLimit = r.RateLimit
+	opts.RateLimitBurst = r.RateLimitBurst
+}
+
+// WriteLockOption implements the RequestOption interface.
+type WriteLockOption struct {
+	WriteLock bool
+}
+
+func (w *WriteLockOption) applyRequestOptions(opts *RequestOptions) {
+	opts.WriteLock = w.WriteLock
+}
+
+// CircuitBreakerOption implements the RequestOption interface.
+type CircuitBreakerOption struct {
+	CircuitBreaker CircuitBreaker
+}
+
+func (c *CircuitBreakerOption) applyRequestOptions(opts *RequestOptions) {
+	opts.CircuitBreaker = &c.CircuitBreaker
+}
+
+// TracerOption implements the RequestOption interface.
+type TracerOption struct {
+	Tracer Tracer
+}
+
+func (t *TracerOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Tracer = t.Tracer
+}
+
+// MeterOption implements the RequestOption interface.
+type MeterOption struct {
+	Meter Meter
+}
+
+func (m *MeterOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Meter = m.Meter
+}
+
+// LoggerOption implements the RequestOption interface.
+type LoggerOption struct {
+	Logger *slog.Logger
+}
+
+func (l *LoggerOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Logger = l.Logger
+}
+
+// CurlOption implements the RequestOption interface.
+type CurlOption struct {
+	Curl io.Writer
+}
+
+func (c *CurlOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Curl = c.Curl
+}
+
+// HAROption implements the RequestOption interface.
+type HAROption struct {
+	HAR *HARRecorder
+}
+
+func (h *HAROption) applyRequestOptions(opts *RequestOptions) {
+	opts.HAR = h.HAR
 }
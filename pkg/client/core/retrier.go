@@ -1,14 +1,20 @@
 package core
 
 import (
+	"context"
 	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"math/big"
 	"net/http"
+	"strconv"
 	"time"
 )
 
 const (
 	defaultRetryAttempts = 2
+	defaultRetryJitter   = 0.25
 	minRetryDelay        = 500 * time.Millisecond
 	maxRetryDelay        = 5000 * time.Millisecond
 )
@@ -19,6 +25,77 @@ type RetryOption func(*retryOptions)
 // RetryFunc is a retriable HTTP function call (i.e. *http.Client.Do).
 type RetryFunc func(*http.Request) (*http.Response, error)
 
+// RetryPolicy decides whether a failed attempt should be retried.
+//
+// The statusCode is zero when the attempt failed with a transport error,
+// in which case err is non-nil.
+type RetryPolicy interface {
+	ShouldRetry(method string, statusCode int, err error) bool
+}
+
+// RetryPolicyFunc adapts an ordinary function to the RetryPolicy interface.
+type RetryPolicyFunc func(method string, statusCode int, err error) bool
+
+// ShouldRetry implements RetryPolicy.
+func (f RetryPolicyFunc) ShouldRetry(method string, statusCode int, err error) bool {
+	return f(method, statusCode, err)
+}
+
+// DefaultRetryPolicy is the RetryPolicy used when none is configured.
+//
+// Requests other than POST are retried on 408, 409, 429 and 5xx
+// responses, and, unlike before retry policies were configurable, on
+// transport errors such as a connection reset while pfSense reloads.
+// POST requests may have been applied before they failed, and a 429 from
+// a proxy in front of pfSense doesn't prove otherwise, so they are never
+// retried; a policy that wraps this one can opt them in. Requests are
+// never retried when the server's certificate is rejected, since another
+// attempt gets the same certificate.
+var DefaultRetryPolicy RetryPolicy = RetryPolicyFunc(defaultShouldRetry)
+
+func defaultShouldRetry(method string, statusCode int, err error) bool {
+	if !isIdempotent(method) {
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) && !isCertificateError(err)
+	}
+	return statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusConflict ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
+// certificateError is implemented by errors that reject the server's
+// certificate outside crypto/x509, such as pfrest.CertificateMismatchError
+// for a certificate that doesn't match its pinned fingerprint.
+type certificateError interface {
+	CertificateError() bool
+}
+
+// isCertificateError reports whether err rejects the server's certificate.
+func isCertificateError(err error) bool {
+	var (
+		verification *tls.CertificateVerificationError
+		unknown      x509.UnknownAuthorityError
+		hostname     x509.HostnameError
+		invalid      x509.CertificateInvalidError
+		certificate  certificateError
+	)
+	return errors.As(err, &verification) ||
+		errors.As(err, &unknown) ||
+		errors.As(err, &hostname) ||
+		errors.As(err, &invalid) ||
+		errors.As(err, &certificate) && certificate.CertificateError()
+}
+
+// isIdempotent reports whether replaying a request with the given method
+// is safe. PATCH is included because pfSense applies it as a field-wise
+// replacement, not a relative update.
+func isIdempotent(method string) bool {
+	return method != http.MethodPost
+}
+
 // WithMaxAttempts configures the maximum number of attempts
 // of the *Retrier.
 func WithMaxAttempts(attempts uint) RetryOption {
@@ -27,24 +104,55 @@ func WithMaxAttempts(attempts uint) RetryOption {
 	}
 }
 
+// WithMaxRetryDelay configures the maximum delay between attempts. This
+// also caps any delay requested by the server via the Retry-After header.
+func WithMaxRetryDelay(delay time.Duration) RetryOption {
+	return func(opts *retryOptions) {
+		opts.maxDelay = delay
+	}
+}
+
+// WithRetryBaseDelay configures the base delay of the exponential back-off.
+func WithRetryBaseDelay(delay time.Duration) RetryOption {
+	return func(opts *retryOptions) {
+		opts.baseDelay = delay
+	}
+}
+
+// WithRetryJitter configures the fraction of each delay, between 0 and 1,
+// that is randomly subtracted from it. A jitter of 0 disables randomization.
+func WithRetryJitter(jitter float64) RetryOption {
+	return func(opts *retryOptions) {
+		opts.jitter = &jitter
+	}
+}
+
+// WithRetryPolicy configures the RetryPolicy used to decide which failed
+// attempts are retried.
+func WithRetryPolicy(policy RetryPolicy) RetryOption {
+	return func(opts *retryOptions) {
+		opts.policy = policy
+	}
+}
+
 // Retrier retries failed requests a configurable number of times with an
 // exponential back-off between each retry.
 type Retrier struct {
-	attempts uint
+	options retryOptions
 }
 
 // NewRetrier constructs a new *Retrier with the given options, if any.
 func NewRetrier(opts ...RetryOption) *Retrier {
-	options := new(retryOptions)
-	for _, opt := range opts {
-		opt(options)
-	}
-	attempts := uint(defaultRetryAttempts)
-	if options.attempts > 0 {
-		attempts = options.attempts
+	jitter := defaultRetryJitter
+	options := retryOptions{
+		attempts:  defaultRetryAttempts,
+		maxDelay:  maxRetryDelay,
+		baseDelay: minRetryDelay,
+		jitter:    &jitter,
+		policy:    DefaultRetryPolicy,
 	}
 	return &Retrier{
-		attempts: attempts,
+		options: options.with(opts...),
 	}
 }
 
@@ -58,15 +166,8 @@ func (r *Retrier) Run(
 	errorDecoder ErrorDecoder,
 	opts ...RetryOption,
 ) (*http.Response, error) {
-	options := new(retryOptions)
-	for _, opt := range opts {
-		opt(options)
-	}
-	maxRetryAttempts := r.attempts
-	if options.attempts > 0 {
-		maxRetryAttempts = options.attempts
-	}
 	var (
+		options       = r.options.with(opts...)
 		retryAttempt  uint
 		previousError error
 	)
@@ -74,7 +175,7 @@ func (r *Retrier) Run(
 		fn,
 		request,
 		errorDecoder,
-		maxRetryAttempts,
+		&options,
 		retryAttempt,
 		previousError,
 	)
@@ -84,11 +185,11 @@ func (r *Retrier) run(
 	fn RetryFunc,
 	request *http.Request,
 	errorDecoder ErrorDecoder,
-	maxRetryAttempts uint,
+	options *retryOptions,
 	retryAttempt uint,
 	previousError error,
 ) (*http.Response, error) {
-	if retryAttempt >= maxRetryAttempts {
+	if retryAttempt >= options.attempts {
 		return nil, previousError
 	}
 
@@ -97,26 +198,47 @@ func (r *Retrier) run(
 		return nil, err
 	}
 
-	response, err := fn(request)
+	// Every attempt sends its own copy of the request, so that a retry
+	// replays the original body rather than the drained reader.
+	attempt, err := rewind(request)
 	if err != nil {
 		return nil, err
 	}
 
-	if r.shouldRetry(response) {
+	response, err := fn(attempt)
+	if err != nil {
+		// An open circuit fails fast rather than waiting out the back-off.
+		if errors.Is(err, ErrCircuitOpen) {
+			return nil, err
+		}
+		if !rewindable(request) || !options.policy.ShouldRetry(request.Method, 0, err) {
+			return nil, err
+		}
+		if err := r.wait(request, nil, retryAttempt, options); err != nil {
+			return nil, err
+		}
+		return r.run(
+			fn,
+			request,
+			errorDecoder,
+			options,
+			retryAttempt+1,
+			err,
+		)
+	}
+
+	if r.shouldRetry(request, response, options) {
 		defer response.Body.Close()
 
-		delay, err := r.retryDelay(retryAttempt)
-		if err != nil {
+		if err := r.wait(request, response, retryAttempt, options); err != nil {
 			return nil, err
 		}
 
-		time.Sleep(delay)
-
 		return r.run(
 			fn,
 			request,
 			errorDecoder,
-			maxRetryAttempts,
+			options,
 			retryAttempt+1,
 			decodeError(response, errorDecoder),
 		)
@@ -127,40 +249,150 @@ func (r *Retrier) run(
 
 // shouldRetry returns true if the request should be retried based on the given
 // response status code.
-func (r *Retrier) shouldRetry(response *http.Response) bool {
-	return response.StatusCode == http.StatusTooManyRequests ||
-		response.StatusCode == http.StatusRequestTimeout ||
-		response.StatusCode == http.StatusConflict ||
-		response.StatusCode >= http.StatusInternalServerError
+func (r *Retrier) shouldRetry(request *http.Request, response *http.Response, options *retryOptions) bool {
+	if response.StatusCode < http.StatusBadRequest || !rewindable(request) {
+		return false
+	}
+	return options.policy.ShouldRetry(request.Method, response.StatusCode, nil)
 }
 
-// retryDelay calculates the delay time in milliseconds based on the retry attempt.
-func (r *Retrier) retryDelay(retryAttempt uint) (time.Duration, error) {
+// rewindable reports whether the request's body can be replayed.
+func rewindable(request *http.Request) bool {
+	return request.Body == nil || request.Body == http.NoBody || request.GetBody != nil
+}
+
+// rewind returns a copy of the request with a fresh body, if any.
+func rewind(request *http.Request) (*http.Request, error) {
+	clone := request.Clone(request.Context())
+	if request.Body == nil || request.Body == http.NoBody || request.GetBody == nil {
+		// Without GetBody the body can only be sent once, so the
+		// original reader is handed to the first and only attempt.
+		return clone, nil
+	}
+	body, err := request.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone.Body = body
+	return clone, nil
+}
+
+// wait blocks for the retry delay, but gives up as soon as the request's
+// context is done.
+func (r *Retrier) wait(request *http.Request, response *http.Response, retryAttempt uint, options *retryOptions) error {
+	delay, err := r.retryDelay(response, retryAttempt, options)
+	if err != nil {
+		return err
+	}
+	timer := time.NewTimer(delay)
+	select {
+	case <-request.Context().Done():
+		timer.Stop()
+		return request.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryDelay calculates the delay time based on the response's Retry-After
+// header or, if absent, the retry attempt. The delay never exceeds the
+// configured maximum.
+func (r *Retrier) retryDelay(response *http.Response, retryAttempt uint, options *retryOptions) (time.Duration, error) {
+	maxDelay := options.maxDelay
+	if response != nil {
+		if delay, ok := retryAfter(response, time.Now(), maxDelay); ok {
+			return delay, nil
+		}
+	}
+
 	// Apply exponential backoff.
-	delay := minRetryDelay + minRetryDelay*time.Duration(retryAttempt*retryAttempt)
+	baseDelay := options.baseDelay
+	delay := baseDelay + baseDelay*time.Duration(retryAttempt*retryAttempt)
+
+	// Do not allow the number to exceed maxDelay.
+	if delay > maxDelay {
+		delay = maxDelay
+	}
 
-	// Do not allow the number to exceed maxRetryDelay.
-	if delay > maxRetryDelay {
-		delay = maxRetryDelay
+	jitterRange := int64(float64(delay) * *options.jitter)
+	if jitterRange <= 0 {
+		return delay, nil
 	}
 
-	// Apply some itter by randomizing the value in the range of 75%-100%.
-	max := big.NewInt(int64(delay / 4))
-	jitter, err := rand.Int(rand.Reader, max)
+	// Apply some jitter by randomizing the value in the range of (1-jitter)-100%.
+	jitter, err := rand.Int(rand.Reader, big.NewInt(jitterRange))
 	if err != nil {
 		return 0, err
 	}
 
 	delay -= time.Duration(jitter.Int64())
 
-	// Never sleep less than the base sleep seconds.
-	if delay < minRetryDelay {
-		delay = minRetryDelay
+	// Never sleep less than the base sleep seconds, unless the
+	// configured maximum is lower still.
+	if delay < baseDelay {
+		delay = min(baseDelay, maxDelay)
 	}
 
 	return delay, nil
 }
 
+// retryAfter parses the response's Retry-After header, which is either a
+// number of seconds or an HTTP date, and clamps it to maxDelay. It reports
+// false if the header is absent or malformed.
+func retryAfter(response *http.Response, now time.Time, maxDelay time.Duration) (time.Duration, bool) {
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	// A number of seconds too large for an int64 is clamped like any other
+	// that exceeds maxDelay, rather than treated as malformed.
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil || errors.Is(err, strconv.ErrRange) {
+		if seconds < 0 {
+			return 0, false
+		}
+		// Compare in seconds, since converting to a Duration can overflow.
+		if time.Duration(seconds) > maxDelay/time.Second {
+			return maxDelay, true
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return min(max(date.Sub(now), 0), maxDelay), true
+}
+
 type retryOptions struct {
-	attempts uint
+	attempts  uint
+	maxDelay  time.Duration
+	baseDelay time.Duration
+	jitter    *float64
+	policy    RetryPolicy
+}
+
+// with returns a copy of the options with the given overrides applied.
+// Zero values in the overrides leave the current setting in place.
+func (o retryOptions) with(opts ...RetryOption) retryOptions {
+	overrides := new(retryOptions)
+	for _, opt := range opts {
+		opt(overrides)
+	}
+	if overrides.attempts > 0 {
+		o.attempts = overrides.attempts
+	}
+	if overrides.maxDelay > 0 {
+		o.maxDelay = overrides.maxDelay
+	}
+	if overrides.baseDelay > 0 {
+		o.baseDelay = overrides.baseDelay
+	}
+	if overrides.jitter != nil {
+		jitter := min(max(*overrides.jitter, 0), 1)
+		o.jitter = &jitter
+	}
+	if overrides.policy != nil {
+		o.policy = overrides.policy
+	}
+	return o
 }
@@ -0,0 +1,198 @@
+package core
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		giveHeader  string
+		wantDelay   time.Duration
+		wantOK      bool
+	}{
+		{description: "absent"},
+		{description: "seconds", giveHeader: "3", wantDelay: 3 * time.Second, wantOK: true},
+		{description: "negative seconds", giveHeader: "-1"},
+		{description: "http date", giveHeader: now.Add(2 * time.Second).Format(http.TimeFormat), wantDelay: 2 * time.Second, wantOK: true},
+		{description: "http date in the past", giveHeader: now.Add(-time.Minute).Format(http.TimeFormat), wantOK: true},
+		{description: "malformed", giveHeader: "soon"},
+		{description: "seconds above the maximum", giveHeader: "120", wantDelay: time.Minute, wantOK: true},
+		{description: "seconds that overflow a duration", giveHeader: "9223372037", wantDelay: time.Minute, wantOK: true},
+		{description: "seconds that overflow an int64", giveHeader: "99999999999999999999", wantDelay: time.Minute, wantOK: true},
+		{description: "http date after the maximum", giveHeader: now.Add(time.Hour).Format(http.TimeFormat), wantDelay: time.Minute, wantOK: true},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			response := &http.Response{Header: make(http.Header)}
+			if test.giveHeader != "" {
+				response.Header.Set("Retry-After", test.giveHeader)
+			}
+			delay, ok := retryAfter(response, now, time.Minute)
+			assert.Equal(t, test.wantOK, ok)
+			assert.Equal(t, test.wantDelay, delay)
+		})
+	}
+}
+
+func TestRetrierHonoursRetryAfter(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if attempts == 1 {
+					w.Header().Set("Retry-After", "60")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+		),
+	)
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	// The server asks for a minute, but the configured maximum wins.
+	start := time.Now()
+	retrier := NewRetrier(WithMaxRetryDelay(10 * time.Millisecond))
+	response, err := retrier.Run(server.Client().Do, request, nil)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 2, attempts)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestRetrierStopsOnCancel(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "60")
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+		),
+	)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+	retrier := NewRetrier(WithMaxRetryDelay(time.Minute))
+	_, err = retrier.Run(server.Client().Do, request, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	tests := []struct {
+		description string
+		giveMethod  string
+		giveStatus  int
+		giveError   error
+		wantRetry   bool
+	}{
+		{description: "GET 500", giveMethod: http.MethodGet, giveStatus: 500, wantRetry: true},
+		{description: "GET 409", giveMethod: http.MethodGet, giveStatus: 409, wantRetry: true},
+		{description: "GET 404", giveMethod: http.MethodGet, giveStatus: 404},
+		{description: "GET transport error", giveMethod: http.MethodGet, giveError: errors.New("connection reset"), wantRetry: true},
+		{description: "GET cancelled", giveMethod: http.MethodGet, giveError: context.Canceled},
+		{description: "PATCH 503", giveMethod: http.MethodPatch, giveStatus: 503, wantRetry: true},
+		{description: "POST 500", giveMethod: http.MethodPost, giveStatus: 500},
+		{description: "POST 409", giveMethod: http.MethodPost, giveStatus: 409},
+		{description: "POST 429", giveMethod: http.MethodPost, giveStatus: 429},
+		{description: "DELETE 429", giveMethod: http.MethodDelete, giveStatus: 429, wantRetry: true},
+		{description: "POST transport error", giveMethod: http.MethodPost, giveError: errors.New("connection reset")},
+		{description: "GET unknown authority", giveMethod: http.MethodGet, giveError: &url.Error{Op: "Get", Err: x509.UnknownAuthorityError{}}},
+		{description: "GET hostname mismatch", giveMethod: http.MethodGet, giveError: &tls.CertificateVerificationError{Err: x509.HostnameError{Host: "pfsense"}}},
+		{description: "PATCH expired certificate", giveMethod: http.MethodPatch, giveError: x509.CertificateInvalidError{Reason: x509.Expired}},
+		{description: "GET pin mismatch", giveMethod: http.MethodGet, giveError: fmt.Errorf("handshake: %w", pinMismatchError{})},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			assert.Equal(t, test.wantRetry, DefaultRetryPolicy.ShouldRetry(test.giveMethod, test.giveStatus, test.giveError))
+		})
+	}
+}
+
+// pinMismatchError is a certificate error from outside crypto/x509.
+type pinMismatchError struct{}
+
+func (pinMismatchError) Error() string          { return "certificate does not match any pinned fingerprint" }
+func (pinMismatchError) CertificateError() bool { return true }
+
+func TestRetrierTransportErrors(t *testing.T) {
+	for method, wantAttempts := range map[string]int{
+		http.MethodGet:    3,
+		http.MethodPut:    3,
+		http.MethodPatch:  3,
+		http.MethodDelete: 3,
+		http.MethodPost:   1,
+	} {
+		t.Run(method, func(t *testing.T) {
+			attempts := 0
+			reset := errors.New("connection reset by peer")
+			request, err := http.NewRequest(method, "http://pfsense.invalid/api/v2/firewall/rule", nil)
+			require.NoError(t, err)
+
+			retrier := NewRetrier(WithMaxAttempts(3), WithRetryBaseDelay(time.Millisecond), WithRetryJitter(0))
+			_, err = retrier.Run(func(*http.Request) (*http.Response, error) {
+				attempts++
+				return nil, reset
+			}, request, nil)
+			assert.ErrorIs(t, err, reset)
+			assert.Equal(t, wantAttempts, attempts)
+		})
+	}
+}
+
+func TestRetrierCustomPolicy(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+		),
+	)
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodPost, server.URL, nil)
+	require.NoError(t, err)
+
+	retrier := NewRetrier(
+		WithMaxAttempts(3),
+		WithRetryBaseDelay(time.Millisecond),
+		WithRetryJitter(0),
+		WithRetryPolicy(RetryPolicyFunc(func(method string, statusCode int, err error) bool {
+			return statusCode >= http.StatusInternalServerError
+		})),
+	)
+	_, err = retrier.Run(server.Client().Do, request, nil)
+	var apiError *APIError
+	require.ErrorAs(t, err, &apiError)
+	assert.Equal(t, http.StatusInternalServerError, apiError.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
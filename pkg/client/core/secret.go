@@ -0,0 +1,56 @@
+package core
+
+import "strings"
+
+// The pfSense spec has no marker for secret fields, so they are
+// recognized by name. The same rules pick the fields that models mask in
+// their String methods, and the body fields that are redacted from logs,
+// curl commands and HAR archives.
+var (
+	secretNames = map[string]bool{
+		"key":       true,
+		"pass":      true,
+		"prv":       true,
+		"tls":       true,
+		"keypaste":  true,
+		"proxypass": true,
+		// The ACME account's RSA private key.
+		"accountkey": true,
+		// The NTP server authentication key.
+		"serverauthkey": true,
+		// The IPsec pre-shared key of a mobile user.
+		"ipsecpsk": true,
+		// The stored hash of a REST API key, which an attacker can test
+		// guesses against offline.
+		"hash": true,
+		// PKCS#12 archives and OpenVPN client exports, both of which embed
+		// a private key.
+		"binary_data": true,
+	}
+	secretSubstrings = []string{
+		"password", "passwd", "passphrase", "secret", "token",
+		"apikey", "api_key", "privatekey", "presharedkey", "pre_shared_key",
+	}
+	secretSuffixes = []string{"_pass", "_key", "_authdata", "_pem_b64"}
+)
+
+// IsSecretField reports whether the JSON field with the given name,
+// matched case-insensitively, holds a secret, such as a password, a
+// private key or an API token.
+func IsSecretField(name string) bool {
+	name = strings.ToLower(name)
+	if secretNames[name] {
+		return true
+	}
+	for _, substring := range secretSubstrings {
+		if strings.Contains(name, substring) {
+			return true
+		}
+	}
+	for _, suffix := range secretSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
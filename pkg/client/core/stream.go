@@ -0,0 +1,94 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ResponseDecoder consumes a successful response body in place of the
+// default JSON decoding into the endpoint's response type.
+type ResponseDecoder func(body io.Reader) error
+
+// DecodeData reads a pfSense response envelope from r and calls fn with
+// each element of its data array as soon as it is decoded, so the array is
+// never held in memory as a whole. Other envelope fields are skipped. If
+// fn returns an error, decoding stops and that error is returned.
+func DecodeData[T any](r io.Reader, fn func(T) error) error {
+	decoder := json.NewDecoder(r)
+	if err := expectDelim(decoder, '{'); err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("expected a response, but the server responded with nothing")
+		}
+		return err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		if key, _ := token.(string); key != "data" {
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := decodeArray(decoder, fn); err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, '}')
+}
+
+// decodeArray decodes the array, or null, at the decoder's position
+// element by element.
+func decodeArray[T any](decoder *json.Decoder, fn func(T) error) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the data field to be an array, got %v", token)
+	}
+	for decoder.More() {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, ']')
+}
+
+func expectDelim(decoder *json.Decoder, want json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("expected %v in the response, got %v", want, token)
+	}
+	return nil
+}
+
+// Send returns a callback for the Stream* methods that sends each item on
+// ch, giving up when ctx is done. The caller owns ch and closes it once
+// the stream returns.
+func Send[T any](ctx context.Context, ch chan<- T) func(T) error {
+	return func(item T) error {
+		select {
+		case ch <- item:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testState mirrors the shape of a pfSense firewall state.
+type testState struct {
+	Interface    *string `json:"interface,omitempty"`
+	Protocol     *string `json:"protocol,omitempty"`
+	Direction    *string `json:"direction,omitempty"`
+	Source       *string `json:"source,omitempty"`
+	Destination  *string `json:"destination,omitempty"`
+	State        *string `json:"state,omitempty"`
+	Age          *string `json:"age,omitempty"`
+	ExpiresIn    *string `json:"expires_in,omitempty"`
+	PacketsTotal *int    `json:"packets_total,omitempty"`
+	BytesTotal   *int    `json:"bytes_total,omitempty"`
+	ID           *int    `json:"id,omitempty"`
+}
+
+func TestDecodeData(t *testing.T) {
+	body := `{"code":200,"status":"ok","data":[{"id":1},{"id":2},{"id":3}],"_links":{"self":{"href":"/"}}}`
+	var ids []int
+	err := DecodeData(strings.NewReader(body), func(state *testState) error {
+		ids = append(ids, *state.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+
+	t.Run("null data", func(t *testing.T) {
+		err := DecodeData(strings.NewReader(`{"data":null}`), func(*testState) error {
+			t.Fatal("unexpected item")
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("callback error", func(t *testing.T) {
+		errStop := errors.New("stop")
+		var calls int
+		err := DecodeData(strings.NewReader(body), func(*testState) error {
+			calls++
+			return errStop
+		})
+		assert.ErrorIs(t, err, errStop)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		for _, body := range []string{``, `[]`, `{"data":{}}`, `{"data":[{"id":1}`} {
+			err := DecodeData(strings.NewReader(body), func(*testState) error { return nil })
+			assert.Error(t, err, body)
+		}
+	})
+}
+
+func TestCallResponseDecoder(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":[{"id":1},{"id":2}]}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	var (
+		ch       = make(chan *testState, 2)
+		response *struct{ Data []*testState }
+	)
+	caller := NewCaller(&CallerParams{Client: server.Client()})
+	err := caller.Call(
+		context.Background(),
+		&CallParams{
+			URL:      server.URL,
+			Method:   http.MethodGet,
+			Response: &response,
+			Options: &RequestOptions{
+				ResponseDecoder: func(body io.Reader) error {
+					return DecodeData(body, Send(context.Background(), ch))
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	close(ch)
+	assert.Nil(t, response)
+	var ids []int
+	for state := range ch {
+		ids = append(ids, *state.ID)
+	}
+	assert.Equal(t, []int{1, 2}, ids)
+}
+
+// newStatesBody returns a response envelope holding n firewall states.
+func newStatesBody(n int) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(`{"code":200,"status":"ok","response_id":"SUCCESS","message":"","data":[`)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, `{"interface":"igb0","protocol":"tcp","direction":"out","source":"10.0.%d.%d:%d","destination":"192.0.2.%d:443","state":"ESTABLISHED:ESTABLISHED","age":"01:02:03","expires_in":"23:59:58","packets_total":%d,"bytes_total":%d,"id":%d}`,
+			i/256%256, i%256, 1024+i%60000, i%256, i*3, i*1500, i)
+	}
+	buf.WriteString(`],"_links":[]}`)
+	return buf.Bytes()
+}
+
+// BenchmarkDecodeStates compares decoding a large states response as a
+// whole against streaming it. Streaming allocates far fewer bytes per
+// response, and its live heap stays at a single item.
+func BenchmarkDecodeStates(b *testing.B) {
+	body := newStatesBody(100_000)
+
+	b.Run("whole", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(body)))
+		for i := 0; i < b.N; i++ {
+			var response struct {
+				Data []*testState `json:"data"`
+			}
+			if err := json.NewDecoder(bytes.NewReader(body)).Decode(&response); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("stream", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(body)))
+		for i := 0; i < b.N; i++ {
+			err := DecodeData(bytes.NewReader(body), func(*testState) error { return nil })
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
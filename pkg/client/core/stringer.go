@@ -1,6 +1,10 @@
 package core
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
 
 // StringifyJSON returns a pretty JSON string representation of
 // the given value.
@@ -11,3 +15,21 @@ func StringifyJSON(value interface{}) (string, error) {
 	}
 	return string(bytes), nil
 }
+
+// StringifyRedactedJSON is like StringifyJSON, but replaces the values of
+// the named fields, at any depth, with "[REDACTED]".
+func StringifyRedactedJSON(value interface{}, fields map[string]bool) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return "", err
+	}
+	return StringifyJSON(redactValue(decoded, func(name string) bool {
+		return fields[strings.ToLower(name)]
+	}))
+}
@@ -0,0 +1,196 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Attribute keys set on spans and metrics. They follow the OpenTelemetry
+// semantic conventions where one exists.
+const (
+	AttributeEndpoint   = "pfrest.endpoint"
+	AttributeMethod     = "http.request.method"
+	AttributePath       = "url.template"
+	AttributeStatusCode = "http.response.status_code"
+	AttributeRetryCount = "http.request.resend_count"
+	AttributeResponseID = "pfsense.response_id"
+)
+
+// Metric names recorded through a Meter.
+const (
+	// MetricRequests counts calls.
+	MetricRequests = "pfrest.client.requests"
+	// MetricErrors counts calls that returned an error.
+	MetricErrors = "pfrest.client.errors"
+	// MetricRetries counts retried attempts.
+	MetricRetries = "pfrest.client.retries"
+	// MetricDuration is a histogram of call latency in seconds, including
+	// retries.
+	MetricDuration = "pfrest.client.duration"
+)
+
+// Attribute is a key-value pair describing a call. Values are strings or
+// ints.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// Tracer starts a span for every call, named after the generated method,
+// e.g. "Firewall.PatchFirewallRuleEndpoint". It is small enough to adapt
+// to OpenTelemetry or any other tracing library.
+type Tracer interface {
+	// Start starts a span as a child of any span in ctx, and returns a
+	// context carrying it. Middleware sees the returned context on every
+	// attempt, e.g. to propagate the trace in request headers.
+	Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// SetAttributes adds attributes known once the call has finished: the
+	// status code, retry count and pfSense response ID.
+	SetAttributes(attributes ...Attribute)
+	// RecordError records the error returned by the call.
+	RecordError(err error)
+	// End ends the span.
+	End()
+}
+
+// Meter creates the instruments that record call metrics, e.g. backed by
+// OpenTelemetry or Prometheus. Instruments are created once per client
+// configuration, not per call.
+type Meter interface {
+	Counter(name, description, unit string) Counter
+	Histogram(name, description, unit string) Histogram
+}
+
+// Counter is a monotonic counter.
+type Counter interface {
+	Add(ctx context.Context, value float64, attributes ...Attribute)
+}
+
+// Histogram records a distribution of values.
+type Histogram interface {
+	Record(ctx context.Context, value float64, attributes ...Attribute)
+}
+
+// telemetry holds the tracer and instruments of a caller.
+type telemetry struct {
+	tracer   Tracer
+	requests Counter
+	errors   Counter
+	retries  Counter
+	duration Histogram
+}
+
+func newTelemetry(tracer Tracer, meter Meter) *telemetry {
+	if tracer == nil && meter == nil {
+		return nil
+	}
+	t := &telemetry{tracer: tracer}
+	if meter != nil {
+		t.requests = meter.Counter(MetricRequests, "Number of pfSense API calls.", "{call}")
+		t.errors = meter.Counter(MetricErrors, "Number of pfSense API calls that failed.", "{call}")
+		t.retries = meter.Counter(MetricRetries, "Number of retried pfSense API requests.", "{request}")
+		t.duration = meter.Histogram(MetricDuration, "Duration of pfSense API calls, including retries.", "s")
+	}
+	return t
+}
+
+// callObservation is what is learnt about a call from its attempts.
+type callObservation struct {
+	attempts   int
+	statusCode int
+	responseID string
+}
+
+// observe returns middleware that records every attempt of a call.
+func (o *callObservation) observe() Middleware {
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(request *http.Request) (*http.Response, error) {
+			o.attempts++
+			response, err := next.Do(request)
+			if err != nil {
+				return response, err
+			}
+			o.statusCode = response.StatusCode
+			o.responseID = ""
+			if response.StatusCode >= http.StatusBadRequest {
+				// Error bodies are small and read in full by the error
+				// decoder anyway.
+				body, err := io.ReadAll(response.Body)
+				response.Body.Close()
+				if err != nil {
+					return nil, err
+				}
+				response.Body = io.NopCloser(bytes.NewReader(body))
+				var fields struct {
+					ResponseID string `json:"response_id"`
+				}
+				if json.Unmarshal(body, &fields) == nil {
+					o.responseID = fields.ResponseID
+				}
+			}
+			return response, nil
+		})
+	}
+}
+
+// start starts the span for a call. The returned function finishes the
+// span and records the call's metrics.
+func (t *telemetry) start(ctx context.Context, params *CallParams, observation *callObservation) (context.Context, func(error)) {
+	attributes := []Attribute{
+		{Key: AttributeEndpoint, Value: params.Endpoint.String()},
+		{Key: AttributeMethod, Value: params.Method},
+	}
+	if params.Endpoint.Path != "" {
+		attributes = append(attributes, Attribute{Key: AttributePath, Value: params.Endpoint.Path})
+	}
+	var span Span
+	if t.tracer != nil {
+		name := params.Endpoint.String()
+		if name == "" {
+			name = params.Method
+		}
+		ctx, span = t.tracer.Start(ctx, name, attributes...)
+	}
+	start := time.Now()
+
+	return ctx, func(err error) {
+		var results []Attribute
+		if observation.statusCode != 0 {
+			results = append(results, Attribute{Key: AttributeStatusCode, Value: observation.statusCode})
+		}
+		if observation.responseID != "" {
+			results = append(results, Attribute{Key: AttributeResponseID, Value: observation.responseID})
+		}
+		retries := max(observation.attempts-1, 0)
+
+		if span != nil {
+			span.SetAttributes(append(results, Attribute{Key: AttributeRetryCount, Value: retries})...)
+			if err != nil {
+				span.RecordError(err)
+			}
+			span.End()
+		}
+		if t.requests == nil {
+			return
+		}
+		// The retry count is left off the metrics to bound their
+		// cardinality.
+		attributes := append(attributes, results...)
+		t.requests.Add(ctx, 1, attributes...)
+		t.duration.Record(ctx, time.Since(start).Seconds(), attributes...)
+		if retries > 0 {
+			t.retries.Add(ctx, float64(retries), attributes...)
+		}
+		if err != nil {
+			t.errors.Add(ctx, 1, attributes...)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSpan records what a Tracer's span was told.
+type testSpan struct {
+	name       string
+	attributes map[string]any
+	err        error
+	ended      bool
+}
+
+func (s *testSpan) SetAttributes(attributes ...Attribute) {
+	for _, attribute := range attributes {
+		s.attributes[attribute.Key] = attribute.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) { s.err = err }
+
+func (s *testSpan) End() { s.ended = true }
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	span := &testSpan{name: name, attributes: make(map[string]any)}
+	span.SetAttributes(attributes...)
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+// testMeter sums the values recorded by each instrument.
+type testMeter struct {
+	mu     sync.Mutex
+	totals map[string]float64
+}
+
+func (m *testMeter) Counter(name, _, _ string) Counter { return testInstrument{m, name} }
+
+func (m *testMeter) Histogram(name, _, _ string) Histogram { return testInstrument{m, name} }
+
+type testInstrument struct {
+	meter *testMeter
+	name  string
+}
+
+func (i testInstrument) Add(_ context.Context, value float64, _ ...Attribute) {
+	i.meter.mu.Lock()
+	defer i.meter.mu.Unlock()
+	i.meter.totals[i.name] += value
+}
+
+func (i testInstrument) Record(ctx context.Context, value float64, attributes ...Attribute) {
+	i.Add(ctx, value, attributes...)
+}
+
+func TestCallTelemetry(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(`{"code":503,"response_id":"SERVICE_UNAVAILABLE","message":"busy"}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	var (
+		tracer = new(testTracer)
+		meter  = &testMeter{totals: make(map[string]float64)}
+	)
+	caller := NewCaller(
+		&CallerParams{
+			Client: server.Client(),
+			Options: &RequestOptions{
+				MaxAttempts:    3,
+				RetryBaseDelay: time.Millisecond,
+				Tracer:         tracer,
+				Meter:          meter,
+			},
+		},
+	)
+	var response *Response
+	err := caller.Call(
+		context.Background(),
+		&CallParams{
+			Endpoint: Endpoint{Service: "Firewall", Name: "PatchFirewallRuleEndpoint", Path: "/api/v2/firewall/rule"},
+			URL:      server.URL + "/api/v2/firewall/rule",
+			Method:   http.MethodPatch,
+			Response: &response,
+		},
+	)
+	require.Error(t, err)
+	assert.Equal(t, 3, calls)
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, "Firewall.PatchFirewallRuleEndpoint", span.name)
+	assert.Equal(t, map[string]any{
+		AttributeEndpoint:   "Firewall.PatchFirewallRuleEndpoint",
+		AttributeMethod:     http.MethodPatch,
+		AttributePath:       "/api/v2/firewall/rule",
+		AttributeStatusCode: http.StatusServiceUnavailable,
+		AttributeRetryCount: 2,
+		AttributeResponseID: "SERVICE_UNAVAILABLE",
+	}, span.attributes)
+	assert.Equal(t, err, span.err)
+	assert.True(t, span.ended)
+
+	assert.Equal(t, 1.0, meter.totals[MetricRequests])
+	assert.Equal(t, 1.0, meter.totals[MetricErrors])
+	assert.Equal(t, 2.0, meter.totals[MetricRetries])
+	assert.Greater(t, meter.totals[MetricDuration], 0.0)
+}
@@ -0,0 +1,271 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// jwtPath is the pfSense endpoint that exchanges basic credentials
+	// for a JWT.
+	jwtPath = "/api/v2/auth/jwt"
+
+	// defaultTokenRefreshBefore is how long before its expiry a cached
+	// token is considered stale.
+	defaultTokenRefreshBefore = 30 * time.Second
+
+	// defaultTokenTimeout bounds a token request.
+	defaultTokenTimeout = 30 * time.Second
+)
+
+// TokenSource supplies bearer tokens.
+type TokenSource interface {
+	// Token returns a valid token, acquiring or refreshing it if needed.
+	Token(ctx context.Context) (string, error)
+}
+
+// JWTTokenSource is a TokenSource that exchanges a username and password
+// for a JWT using the /api/v2/auth/jwt endpoint. Tokens are cached and
+// refreshed shortly before the expiry in their exp claim. Concurrent
+// callers share a single in-flight refresh.
+//
+// A JWTTokenSource is safe for concurrent use.
+type JWTTokenSource struct {
+	// BaseURL is the pfSense base URL, e.g. "https://192.168.1.1". When
+	// used through option.WithJWTAuth it defaults to the base URL of the
+	// request being authenticated, and follows it when the client is
+	// pointed at another firewall, discarding the cached token.
+	BaseURL  string
+	Username string
+	Password string
+	// Credentials, if set, supplies the username and password instead of
+	// the fields above, e.g. from a rotated secret file.
+	Credentials CredentialsProvider
+	// Client issues the token requests. When used through
+	// option.WithJWTAuth it defaults to the client's current HTTP client.
+	Client HTTPClient
+	// RefreshBefore is how long before expiry a token is refreshed.
+	// It defaults to 30 seconds.
+	RefreshBefore time.Duration
+	// Timeout bounds each token request, which callers may share and
+	// which therefore outlives their contexts. It defaults to 30 seconds.
+	Timeout time.Duration
+
+	mu      sync.Mutex
+	token   string
+	expiry  time.Time
+	refresh *tokenRefresh
+	// boundURL and boundClient record that BaseURL and Client were filled
+	// in by bind rather than set by the user, so bind may change them.
+	boundURL    string
+	boundClient bool
+	// errorDecoder, filled in by bind, decodes failed token requests like
+	// the client's own error responses.
+	errorDecoder ErrorDecoder
+}
+
+// tokenRefresh is a token request shared by concurrent callers.
+type tokenRefresh struct {
+	done   chan struct{}
+	token  string
+	expiry time.Time
+	err    error
+}
+
+// Token implements TokenSource.
+func (s *JWTTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	if s.token != "" && !s.stale(time.Now()) {
+		token := s.token
+		s.mu.Unlock()
+		return token, nil
+	}
+	refresh := s.refresh
+	if refresh == nil {
+		refresh = &tokenRefresh{done: make(chan struct{})}
+		s.refresh = refresh
+		// The refresh outlives the caller that started it, since other
+		// callers may be waiting on it, but not the timeout, so that a
+		// hung request doesn't hold up every later caller.
+		timeout := s.Timeout
+		if timeout <= 0 {
+			timeout = defaultTokenTimeout
+		}
+		go func() {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+			defer cancel()
+			s.acquire(ctx, refresh)
+		}()
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-refresh.done:
+		return refresh.token, refresh.err
+	}
+}
+
+// Invalidate discards the given token if it is still cached, so that the
+// next call to Token acquires a new one.
+func (s *JWTTokenSource) Invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == token {
+		s.token = ""
+		s.expiry = time.Time{}
+	}
+}
+
+// stale reports whether the cached token is due for a refresh. The
+// caller must hold s.mu.
+func (s *JWTTokenSource) stale(now time.Time) bool {
+	if s.expiry.IsZero() {
+		return false
+	}
+	refreshBefore := s.RefreshBefore
+	if refreshBefore <= 0 {
+		refreshBefore = defaultTokenRefreshBefore
+	}
+	return !now.Add(refreshBefore).Before(s.expiry)
+}
+
+// bind fills in the base URL and HTTP client of the request being
+// authenticated, unless the user set them. When the base URL changes, e.g.
+// after the client was pointed at another firewall, the token of the old
+// one is discarded and callers no longer wait on its refresh.
+func (s *JWTTokenSource) bind(baseURL string, client HTTPClient, errorDecoder ErrorDecoder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errorDecoder = errorDecoder
+	if s.BaseURL == "" || (s.BaseURL == s.boundURL && s.BaseURL != baseURL) {
+		if s.BaseURL != "" {
+			s.token = ""
+			s.expiry = time.Time{}
+			s.refresh = nil
+		}
+		s.BaseURL = baseURL
+		s.boundURL = baseURL
+	}
+	if s.Client == nil || s.boundClient {
+		s.Client = client
+		s.boundClient = true
+	}
+}
+
+func (s *JWTTokenSource) acquire(ctx context.Context, refresh *tokenRefresh) {
+	s.mu.Lock()
+	baseURL, client, errorDecoder := s.BaseURL, s.Client, s.errorDecoder
+	s.mu.Unlock()
+
+	refresh.token, refresh.expiry, refresh.err = s.requestToken(ctx, baseURL, client, errorDecoder)
+
+	s.mu.Lock()
+	// A token of a firewall the source has since been rebound from is
+	// handed to the callers that waited for it, but not cached.
+	if refresh.err == nil && s.BaseURL == baseURL {
+		s.token = refresh.token
+		s.expiry = refresh.expiry
+	}
+	if s.refresh == refresh {
+		s.refresh = nil
+	}
+	s.mu.Unlock()
+
+	close(refresh.done)
+}
+
+// requestToken exchanges the configured credentials for a new JWT issued
+// by the firewall at baseURL.
+func (s *JWTTokenSource) requestToken(ctx context.Context, baseURL string, client HTTPClient, errorDecoder ErrorDecoder) (string, time.Time, error) {
+	endpointURL := strings.TrimSuffix(baseURL, "/") + jwtPath
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpointURL, bytes.NewReader([]byte("{}")))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	request.Header.Set(contentTypeHeader, contentType)
+	credentials := Credentials{Username: s.Username, Password: s.Password}
+	if s.Credentials != nil {
+		if credentials, err = s.Credentials.Credentials(ctx); err != nil {
+			return "", time.Time{}, err
+		}
+	}
+	request.SetBasicAuth(credentials.Username, credentials.Password)
+
+	response, err := client.Do(request)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return "", time.Time{}, decodeError(response, errorDecoder)
+	}
+	var body struct {
+		Data struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return "", time.Time{}, err
+	}
+	if body.Data.Token == "" {
+		return "", time.Time{}, errors.New("the server responded without a JWT")
+	}
+	expiry, err := jwtExpiry(body.Data.Token)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return body.Data.Token, expiry, nil
+}
+
+// jwtExpiry decodes the exp claim of the given JWT. The signature is not
+// verified; the token is only ever sent back to the server that issued it.
+// A token without an exp claim has a zero expiry and never goes stale.
+func jwtExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("malformed JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("malformed JWT payload: %w", err)
+	}
+	var claims struct {
+		Exp *json.Number `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("malformed JWT claims: %w", err)
+	}
+	if claims.Exp == nil {
+		return time.Time{}, nil
+	}
+	exp, err := claims.Exp.Float64()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("malformed JWT exp claim: %w", err)
+	}
+	return time.Unix(int64(exp), 0), nil
+}
+
+// apiBaseURL returns the base URL of the given pfSense API request, i.e.
+// everything before the /api/ path.
+func apiBaseURL(request *http.Request) string {
+	endpointURL := request.URL.String()
+	if i := strings.Index(endpointURL, "/api/"); i >= 0 {
+		return endpointURL[:i]
+	}
+	return request.URL.Scheme + "://" + request.URL.Host
+}
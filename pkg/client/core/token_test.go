@@ -0,0 +1,163 @@
+package core
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTExpiry(t *testing.T) {
+	expiry, err := jwtExpiry(newTestJWT(1700000000))
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1700000000, 0), expiry)
+
+	_, err = jwtExpiry("not-a-jwt")
+	assert.Error(t, err)
+}
+
+func TestJWTTokenSource(t *testing.T) {
+	var (
+		issued  atomic.Int32
+		revoked atomic.Bool
+	)
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == jwtPath {
+					username, password, ok := r.BasicAuth()
+					assert.True(t, ok)
+					assert.Equal(t, "admin", username)
+					assert.Equal(t, "pfsense", password)
+
+					n := issued.Add(1)
+					_, _ = fmt.Fprintf(w, `{"data":{"token":%q}}`, newTestJWT(time.Now().Add(time.Hour).Unix()+int64(n)))
+					return
+				}
+				if revoked.CompareAndSwap(true, false) {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				assert.Contains(t, r.Header.Get("Authorization"), "Bearer ")
+				w.WriteHeader(http.StatusNoContent)
+			},
+		),
+	)
+	defer server.Close()
+
+	caller := NewCaller(
+		&CallerParams{
+			Client: server.Client(),
+			Options: &RequestOptions{
+				Authenticator: &BearerAuth{
+					Source: &JWTTokenSource{Username: "admin", Password: "pfsense"},
+				},
+			},
+		},
+	)
+	call := func() error {
+		return caller.Call(
+			context.Background(),
+			&CallParams{
+				URL:                server.URL + "/api/v2/system/version",
+				Method:             http.MethodGet,
+				ResponseIsOptional: true,
+			},
+		)
+	}
+
+	t.Run("concurrent callers share one token", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, call())
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), issued.Load())
+	})
+
+	t.Run("401 refreshes once", func(t *testing.T) {
+		revoked.Store(true)
+		require.NoError(t, call())
+		assert.Equal(t, int32(2), issued.Load())
+	})
+}
+
+func TestJWTTokenSourceRefreshesBeforeExpiry(t *testing.T) {
+	var issued atomic.Int32
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				issued.Add(1)
+				// Expires within the default refresh window.
+				_, _ = fmt.Fprintf(w, `{"data":{"token":%q}}`, newTestJWT(time.Now().Add(10*time.Second).Unix()))
+			},
+		),
+	)
+	defer server.Close()
+
+	source := &JWTTokenSource{BaseURL: server.URL, Client: server.Client()}
+	for range 2 {
+		_, err := source.Token(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), issued.Load())
+}
+
+// newTestJWT returns an unsigned JWT with the given exp claim.
+func newTestJWT(exp int64) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." +
+		encode([]byte(fmt.Sprintf(`{"exp":%d}`, exp))) + "." +
+		encode([]byte("signature"))
+}
+
+func TestJWTTokenSourceHungRequest(t *testing.T) {
+	var (
+		requests atomic.Int32
+		release  = make(chan struct{})
+	)
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) == 1 {
+					// The first request never answers.
+					select {
+					case <-release:
+					case <-r.Context().Done():
+					}
+					return
+				}
+				_, _ = fmt.Fprintf(w, `{"data":{"token":%q}}`, newTestJWT(time.Now().Add(time.Hour).Unix()))
+			},
+		),
+	)
+	defer server.Close()
+	defer close(release)
+
+	source := &JWTTokenSource{BaseURL: server.URL, Username: "admin", Password: "pfsense", Timeout: 100 * time.Millisecond}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	_, err := source.Token(ctx)
+	cancel()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// A later caller waits on the hung request only until it times out.
+	_, err = source.Token(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err, "the source recovers")
+	assert.NotEmpty(t, token)
+	assert.Equal(t, int32(2), requests.Load())
+}
@@ -6,7 +6,6 @@ import (
 	bytes "bytes"
 	context "context"
 	json "encoding/json"
-	errors "errors"
 	fmt "fmt"
 	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
 	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
@@ -17,22 +16,27 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	caller *core.Caller
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
 	options := core.NewRequestOptions(opts...)
 	return &Client{
-		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
 				Client:      options.HTTPClient,
 				MaxAttempts: options.MaxAttempts,
+				Options:     options,
 			},
 		),
-		header: options.ToHeader(),
+	}
+}
+
+// NewClientWithCaller returns a client that issues its calls through the
+// given caller, sharing its options with every other client that does.
+func NewClientWithCaller(caller *core.Caller) *Client {
+	return &Client{
+		caller: caller,
 	}
 }
 
@@ -44,10 +48,7 @@ func (c *Client) GetDiagnosticsArpTableEndpoint(
 ) (*pkgclient.GetDiagnosticsArpTableEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -70,20 +71,22 @@ func (c *Client) GetDiagnosticsArpTableEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -178,9 +181,11 @@ func (c *Client) GetDiagnosticsArpTableEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "GetDiagnosticsArpTableEndpoint", Path: "/api/v2/diagnostics/arp_table"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -200,10 +205,7 @@ func (c *Client) DeleteDiagnosticsArpTableEndpoint(
 ) (*pkgclient.DeleteDiagnosticsArpTableEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -217,20 +219,22 @@ func (c *Client) DeleteDiagnosticsArpTableEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -325,9 +329,11 @@ func (c *Client) DeleteDiagnosticsArpTableEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "DeleteDiagnosticsArpTableEndpoint", Path: "/api/v2/diagnostics/arp_table"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -347,10 +353,7 @@ func (c *Client) GetDiagnosticsArpTableEntryEndpoint(
 ) (*pkgclient.GetDiagnosticsArpTableEntryEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -364,14 +367,14 @@ func (c *Client) GetDiagnosticsArpTableEntryEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -466,9 +469,11 @@ func (c *Client) GetDiagnosticsArpTableEntryEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "GetDiagnosticsArpTableEntryEndpoint", Path: "/api/v2/diagnostics/arp_table/entry"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -488,10 +493,7 @@ func (c *Client) DeleteDiagnosticsArpTableEntryEndpoint(
 ) (*pkgclient.DeleteDiagnosticsArpTableEntryEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -505,14 +507,14 @@ func (c *Client) DeleteDiagnosticsArpTableEntryEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -607,9 +609,11 @@ func (c *Client) DeleteDiagnosticsArpTableEntryEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "DeleteDiagnosticsArpTableEntryEndpoint", Path: "/api/v2/diagnostics/arp_table/entry"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -629,23 +633,20 @@ func (c *Client) PostDiagnosticsCommandPromptEndpoint(
 ) (*pkgclient.PostDiagnosticsCommandPromptEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/diagnostics/command_prompt"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -740,9 +741,11 @@ func (c *Client) PostDiagnosticsCommandPromptEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "PostDiagnosticsCommandPromptEndpoint", Path: "/api/v2/diagnostics/command_prompt"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -763,10 +766,7 @@ func (c *Client) GetDiagnosticsConfigHistoryRevisionEndpoint(
 ) (*pkgclient.GetDiagnosticsConfigHistoryRevisionEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -780,14 +780,14 @@ func (c *Client) GetDiagnosticsConfigHistoryRevisionEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -882,9 +882,11 @@ func (c *Client) GetDiagnosticsConfigHistoryRevisionEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "GetDiagnosticsConfigHistoryRevisionEndpoint", Path: "/api/v2/diagnostics/config_history/revision"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -904,10 +906,7 @@ func (c *Client) DeleteDiagnosticsConfigHistoryRevisionEndpoint(
 ) (*pkgclient.DeleteDiagnosticsConfigHistoryRevisionEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -921,14 +920,14 @@ func (c *Client) DeleteDiagnosticsConfigHistoryRevisionEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1023,9 +1022,11 @@ func (c *Client) DeleteDiagnosticsConfigHistoryRevisionEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "DeleteDiagnosticsConfigHistoryRevisionEndpoint", Path: "/api/v2/diagnostics/config_history/revision"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1045,10 +1046,7 @@ func (c *Client) GetDiagnosticsConfigHistoryRevisionsEndpoint(
 ) (*pkgclient.GetDiagnosticsConfigHistoryRevisionsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1071,20 +1069,22 @@ func (c *Client) GetDiagnosticsConfigHistoryRevisionsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1179,9 +1179,11 @@ func (c *Client) GetDiagnosticsConfigHistoryRevisionsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "GetDiagnosticsConfigHistoryRevisionsEndpoint", Path: "/api/v2/diagnostics/config_history/revisions"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1201,10 +1203,7 @@ func (c *Client) DeleteDiagnosticsConfigHistoryRevisionsEndpoint(
 ) (*pkgclient.DeleteDiagnosticsConfigHistoryRevisionsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1218,20 +1217,22 @@ func (c *Client) DeleteDiagnosticsConfigHistoryRevisionsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1326,9 +1327,11 @@ func (c *Client) DeleteDiagnosticsConfigHistoryRevisionsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "DeleteDiagnosticsConfigHistoryRevisionsEndpoint", Path: "/api/v2/diagnostics/config_history/revisions"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1348,23 +1351,20 @@ func (c *Client) PostDiagnosticsHaltSystemEndpoint(
 ) (*pkgclient.PostDiagnosticsHaltSystemEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/diagnostics/halt_system"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1459,9 +1459,11 @@ func (c *Client) PostDiagnosticsHaltSystemEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "PostDiagnosticsHaltSystemEndpoint", Path: "/api/v2/diagnostics/halt_system"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1482,23 +1484,20 @@ func (c *Client) PostDiagnosticsPingEndpoint(
 ) (*pkgclient.PostDiagnosticsPingEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/diagnostics/ping"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1593,9 +1592,11 @@ func (c *Client) PostDiagnosticsPingEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "PostDiagnosticsPingEndpoint", Path: "/api/v2/diagnostics/ping"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1616,23 +1617,20 @@ func (c *Client) PostDiagnosticsRebootEndpoint(
 ) (*pkgclient.PostDiagnosticsRebootEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/diagnostics/reboot"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1727,9 +1725,11 @@ func (c *Client) PostDiagnosticsRebootEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "PostDiagnosticsRebootEndpoint", Path: "/api/v2/diagnostics/reboot"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1750,10 +1750,7 @@ func (c *Client) GetDiagnosticsTableEndpoint(
 ) (*pkgclient.GetDiagnosticsTableEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1767,14 +1764,14 @@ func (c *Client) GetDiagnosticsTableEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1869,9 +1866,11 @@ func (c *Client) GetDiagnosticsTableEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "GetDiagnosticsTableEndpoint", Path: "/api/v2/diagnostics/table"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1891,10 +1890,7 @@ func (c *Client) DeleteDiagnosticsTableEndpoint(
 ) (*pkgclient.DeleteDiagnosticsTableEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1908,14 +1904,14 @@ func (c *Client) DeleteDiagnosticsTableEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2010,9 +2006,11 @@ func (c *Client) DeleteDiagnosticsTableEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "DeleteDiagnosticsTableEndpoint", Path: "/api/v2/diagnostics/table"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2032,10 +2030,7 @@ func (c *Client) GetDiagnosticsTablesEndpoint(
 ) (*pkgclient.GetDiagnosticsTablesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2058,20 +2053,22 @@ func (c *Client) GetDiagnosticsTablesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2166,9 +2163,11 @@ func (c *Client) GetDiagnosticsTablesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Diagnostics", Name: "GetDiagnosticsTablesEndpoint", Path: "/api/v2/diagnostics/tables"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -0,0 +1,125 @@
+package pfclientapi
+
+import (
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	io "io"
+	http "net/http"
+	regexp "regexp"
+)
+
+// Sentinel errors matched by [*Error] through errors.Is, e.g.
+//
+//	if errors.Is(err, pfclientapi.ErrNotFound) {
+//		// create it instead
+//	}
+var (
+	ErrBadRequest       = errors.New("bad request")
+	ErrUnauthorized     = errors.New("unauthorized")
+	ErrNotFound         = errors.New("not found")
+	ErrConflict         = errors.New("conflict")
+	ErrFailedDependency = errors.New("failed dependency")
+)
+
+var sentinelErrors = map[int]error{
+	http.StatusBadRequest:       ErrBadRequest,
+	http.StatusUnauthorized:     ErrUnauthorized,
+	http.StatusNotFound:         ErrNotFound,
+	http.StatusConflict:         ErrConflict,
+	http.StatusFailedDependency: ErrFailedDependency,
+}
+
+// Error is an error response from the pfSense REST API. Every generated
+// error decoder produces one, reachable with errors.As from the typed
+// errors such as *NotFoundError:
+//
+//	var apiErr *pfclientapi.Error
+//	if errors.As(err, &apiErr) && apiErr.ResponseID == "FIELD_MUST_BE_UNIQUE" {
+//		// the object already exists
+//	}
+type Error struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"code"`
+	// Status is the HTTP status text reported by pfSense, e.g. "not found".
+	Status string `json:"status"`
+	// ResponseID identifies the kind of error, e.g.
+	// "MODEL_OBJECT_NOT_FOUND". It is stable across pfSense releases and
+	// is the field to branch on.
+	ResponseID string `json:"response_id"`
+	// Message is the human-readable description of the error.
+	Message string `json:"message"`
+	// Field is the name of the offending request field, if pfSense
+	// reported one.
+	Field string `json:"-"`
+	// Body is the raw response body.
+	Body json.RawMessage `json:"-"`
+}
+
+// NewError decodes an error response body. A body that is not a pfSense
+// error object is kept as the message.
+func NewError(statusCode int, body []byte) *Error {
+	e := &Error{Body: json.RawMessage(body)}
+	var decoded struct {
+		Error
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		*e = decoded.Error
+		e.Body = json.RawMessage(body)
+		e.Field = errorField(decoded.Data, e.Message)
+	} else {
+		e.Message = string(body)
+	}
+	e.StatusCode = statusCode
+	return e
+}
+
+// DecodeError decodes an error response of any endpoint into a
+// *core.APIError wrapping an *Error, as the endpoints' own decoders do for
+// the status codes they don't declare. The root client uses it for the
+// requests it issues itself, such as acquiring a JWT.
+func DecodeError(statusCode int, body io.Reader) error {
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	return core.NewAPIError(statusCode, NewError(statusCode, raw))
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	if e.ResponseID == "" {
+		return e.Message
+	}
+	if e.Message == "" {
+		return e.ResponseID
+	}
+	return fmt.Sprintf("%s: %s", e.ResponseID, e.Message)
+}
+
+// Is reports whether target is the sentinel error for e's status code.
+func (e *Error) Is(target error) bool {
+	sentinel, ok := sentinelErrors[e.StatusCode]
+	return ok && sentinel == target
+}
+
+// fieldMessage matches the field name in validation messages such as
+// "Field `interface` is required.".
+var fieldMessage = regexp.MustCompile("^Field `([^`]+)`")
+
+// errorField returns the offending field from the error's data object or,
+// failing that, its message.
+func errorField(data json.RawMessage, message string) string {
+	var fields struct {
+		Field string `json:"field"`
+	}
+	if json.Unmarshal(data, &fields) == nil && fields.Field != "" {
+		return fields.Field
+	}
+	if m := fieldMessage.FindStringSubmatch(message); m != nil {
+		return m[1]
+	}
+	return ""
+}
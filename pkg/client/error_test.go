@@ -0,0 +1,58 @@
+package pfclientapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pfclientapi "github.com/danielmichaels/go-pfrest/pkg/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/firewall"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewError(t *testing.T) {
+	e := pfclientapi.NewError(400, []byte(`{"code":400,"status":"bad request","response_id":"FIELD_IS_REQUIRED","message":"Field `+"`interface`"+` is required.","data":[]}`))
+	assert.Equal(t, 400, e.StatusCode)
+	assert.Equal(t, "bad request", e.Status)
+	assert.Equal(t, "FIELD_IS_REQUIRED", e.ResponseID)
+	assert.Equal(t, "interface", e.Field)
+	assert.Equal(t, "FIELD_IS_REQUIRED: Field `interface` is required.", e.Error())
+	assert.ErrorIs(t, e, pfclientapi.ErrBadRequest)
+	assert.NotErrorIs(t, e, pfclientapi.ErrNotFound)
+
+	e = pfclientapi.NewError(409, []byte(`{"response_id":"FIELD_MUST_BE_UNIQUE","message":"Already exists.","data":{"field":"name"}}`))
+	assert.Equal(t, "name", e.Field)
+	assert.ErrorIs(t, e, pfclientapi.ErrConflict)
+
+	e = pfclientapi.NewError(502, []byte("<html>Bad Gateway</html>"))
+	assert.Equal(t, "<html>Bad Gateway</html>", e.Message)
+	assert.Equal(t, "<html>Bad Gateway</html>", e.Error())
+}
+
+func TestGeneratedErrorDecoder(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"code":404,"status":"not found","response_id":"MODEL_OBJECT_NOT_FOUND","message":"Object with ID 7 does not exist.","data":[]}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	c := firewall.NewClient(option.WithBaseURL(server.URL), option.WithMaxAttempts(1))
+	_, err := c.DeleteFirewallRuleEndpoint(context.Background(), &pfclientapi.DeleteFirewallRuleEndpointRequest{ID: pfclientapi.String("7")})
+	require.Error(t, err)
+
+	assert.ErrorIs(t, err, pfclientapi.ErrNotFound)
+	var notFound *pfclientapi.NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+	var apiErr *pfclientapi.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 404, apiErr.StatusCode)
+	assert.Equal(t, "MODEL_OBJECT_NOT_FOUND", apiErr.ResponseID)
+	assert.Equal(t, "Object with ID 7 does not exist.", apiErr.Message)
+}
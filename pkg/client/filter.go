@@ -0,0 +1,116 @@
+package pfclientapi
+
+import (
+	fmt "fmt"
+	url "net/url"
+)
+
+// FilterBuilder builds the server-side filters of a list or bulk-delete
+// request, e.g.
+//
+//	request := &pfclientapi.GetFirewallRulesEndpointRequest{
+//		Query: pfclientapi.Filter().
+//			Field("interface").Eq("lan").
+//			Field("descr").Contains("web").
+//			Query(),
+//	}
+//
+// All filters must match for an object to be returned.
+type FilterBuilder struct {
+	values url.Values
+}
+
+// Filter returns an empty FilterBuilder.
+func Filter() *FilterBuilder {
+	return &FilterBuilder{values: make(url.Values)}
+}
+
+// Field starts a filter on the named field.
+func (f *FilterBuilder) Field(name string) *FieldFilter {
+	return &FieldFilter{builder: f, name: name}
+}
+
+// Values returns the filters as query parameters.
+func (f *FilterBuilder) Values() url.Values {
+	return f.values
+}
+
+// String returns the filters as an encoded query string, e.g.
+// "descr__contains=web&interface=lan".
+func (f *FilterBuilder) String() string {
+	return f.values.Encode()
+}
+
+// Query returns the filters in the form expected by the Query field of
+// list and bulk-delete requests, or nil if there are none.
+func (f *FilterBuilder) Query() *string {
+	if len(f.values) == 0 {
+		return nil
+	}
+	query := f.String()
+	return &query
+}
+
+// FieldFilter is a filter on a single field, completed by one of its
+// operator methods.
+type FieldFilter struct {
+	builder *FilterBuilder
+	name    string
+}
+
+// Eq matches objects whose field equals value.
+func (f *FieldFilter) Eq(value any) *FilterBuilder {
+	return f.add("", value)
+}
+
+// StartsWith matches objects whose field starts with value.
+func (f *FieldFilter) StartsWith(value string) *FilterBuilder {
+	return f.add("startswith", value)
+}
+
+// EndsWith matches objects whose field ends with value.
+func (f *FieldFilter) EndsWith(value string) *FilterBuilder {
+	return f.add("endswith", value)
+}
+
+// Contains matches objects whose field contains value.
+func (f *FieldFilter) Contains(value any) *FilterBuilder {
+	return f.add("contains", value)
+}
+
+// Lt matches objects whose field is less than value.
+func (f *FieldFilter) Lt(value any) *FilterBuilder {
+	return f.add("lt", value)
+}
+
+// Lte matches objects whose field is less than or equal to value.
+func (f *FieldFilter) Lte(value any) *FilterBuilder {
+	return f.add("lte", value)
+}
+
+// Gt matches objects whose field is greater than value.
+func (f *FieldFilter) Gt(value any) *FilterBuilder {
+	return f.add("gt", value)
+}
+
+// Gte matches objects whose field is greater than or equal to value.
+func (f *FieldFilter) Gte(value any) *FilterBuilder {
+	return f.add("gte", value)
+}
+
+// Regex matches objects whose field matches the regular expression
+// pattern.
+func (f *FieldFilter) Regex(pattern string) *FilterBuilder {
+	return f.add("regex", pattern)
+}
+
+// add records the filter as the parameter <field>__<operator>, or just
+// <field> for an exact match.
+func (f *FieldFilter) add(operator string, value any) *FilterBuilder {
+	name := f.name
+	if operator != "" {
+		name += "__" + operator
+	}
+	f.builder.values.Add(name, fmt.Sprint(value))
+	return f.builder
+}
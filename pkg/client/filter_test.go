@@ -0,0 +1,64 @@
+package pfclientapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	pfclientapi "github.com/danielmichaels/go-pfrest/pkg/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/firewall"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	filter := pfclientapi.Filter().
+		Field("interface").Eq("lan").
+		Field("descr").Contains("web").
+		Field("descr").StartsWith("allow").
+		Field("descr").EndsWith("rule").
+		Field("tracker").Gt(10).
+		Field("tracker").Lte(20).
+		Field("source").Regex("^10\\.")
+	assert.Equal(t, url.Values{
+		"interface":         {"lan"},
+		"descr__contains":   {"web"},
+		"descr__startswith": {"allow"},
+		"descr__endswith":   {"rule"},
+		"tracker__gt":       {"10"},
+		"tracker__lte":      {"20"},
+		"source__regex":     {"^10\\."},
+	}, filter.Values())
+	assert.Nil(t, pfclientapi.Filter().Query())
+}
+
+func TestFilterQueryExpansion(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				_, _ = w.Write([]byte(`{"code":200,"data":[]}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	c := firewall.NewClient(option.WithBaseURL(server.URL))
+	_, err := c.GetFirewallRulesEndpoint(
+		context.Background(),
+		&pfclientapi.GetFirewallRulesEndpointRequest{
+			Limit: pfclientapi.Int(5),
+			Query: pfclientapi.Filter().Field("interface").Eq("lan").Field("descr").Contains("web").Query(),
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"limit":           {"5"},
+		"interface":       {"lan"},
+		"descr__contains": {"web"},
+	}, query)
+}
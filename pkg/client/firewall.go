@@ -2510,11 +2510,11 @@ func (o *OutboundNatMapping) UnmarshalJSON(data []byte) error {
 
 func (o *OutboundNatMapping) String() string {
 	if len(o._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(o._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(o._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(o); err == nil {
+	if value, err := core.StringifyRedactedJSON(o, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", o)
@@ -3757,11 +3757,11 @@ func (v *VirtualIP) UnmarshalJSON(data []byte) error {
 
 func (v *VirtualIP) String() string {
 	if len(v._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(v._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(v._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(v); err == nil {
+	if value, err := core.StringifyRedactedJSON(v, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", v)
@@ -4418,11 +4418,11 @@ func (d *DeleteFirewallNatOutboundMappingEndpointResponse) UnmarshalJSON(data []
 
 func (d *DeleteFirewallNatOutboundMappingEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -4479,11 +4479,11 @@ func (d *DeleteFirewallNatOutboundMappingEndpointResponseData) UnmarshalJSON(dat
 
 func (d *DeleteFirewallNatOutboundMappingEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -4518,11 +4518,11 @@ func (d *DeleteFirewallNatOutboundMappingsEndpointResponse) UnmarshalJSON(data [
 
 func (d *DeleteFirewallNatOutboundMappingsEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -4579,11 +4579,11 @@ func (d *DeleteFirewallNatOutboundMappingsEndpointResponseDataItem) UnmarshalJSO
 
 func (d *DeleteFirewallNatOutboundMappingsEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -7408,11 +7408,11 @@ func (d *DeleteFirewallVirtualIPsEndpointResponse) UnmarshalJSON(data []byte) er
 
 func (d *DeleteFirewallVirtualIPsEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -7467,11 +7467,11 @@ func (d *DeleteFirewallVirtualIPsEndpointResponseDataItem) UnmarshalJSON(data []
 
 func (d *DeleteFirewallVirtualIPsEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -7563,11 +7563,11 @@ func (d *DeleteFirewallVirtualIPEndpointResponse) UnmarshalJSON(data []byte) err
 
 func (d *DeleteFirewallVirtualIPEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -7622,11 +7622,11 @@ func (d *DeleteFirewallVirtualIPEndpointResponseData) UnmarshalJSON(data []byte)
 
 func (d *DeleteFirewallVirtualIPEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -8368,11 +8368,11 @@ func (g *GetFirewallNatOutboundMappingEndpointResponse) UnmarshalJSON(data []byt
 
 func (g *GetFirewallNatOutboundMappingEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -8429,11 +8429,11 @@ func (g *GetFirewallNatOutboundMappingEndpointResponseData) UnmarshalJSON(data [
 
 func (g *GetFirewallNatOutboundMappingEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -8524,11 +8524,11 @@ func (g *GetFirewallNatOutboundMappingsEndpointResponse) UnmarshalJSON(data []by
 
 func (g *GetFirewallNatOutboundMappingsEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -8585,11 +8585,11 @@ func (g *GetFirewallNatOutboundMappingsEndpointResponseDataItem) UnmarshalJSON(d
 
 func (g *GetFirewallNatOutboundMappingsEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -12276,11 +12276,11 @@ func (g *GetFirewallVirtualIPsEndpointResponse) UnmarshalJSON(data []byte) error
 
 func (g *GetFirewallVirtualIPsEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -12335,11 +12335,11 @@ func (g *GetFirewallVirtualIPsEndpointResponseDataItem) UnmarshalJSON(data []byt
 
 func (g *GetFirewallVirtualIPsEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -12470,11 +12470,11 @@ func (g *GetFirewallVirtualIPEndpointResponse) UnmarshalJSON(data []byte) error
 
 func (g *GetFirewallVirtualIPEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -12529,11 +12529,11 @@ func (g *GetFirewallVirtualIPEndpointResponseData) UnmarshalJSON(data []byte) er
 
 func (g *GetFirewallVirtualIPEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -12780,11 +12780,11 @@ func (p *PatchFirewallNatOutboundMappingEndpointResponse) UnmarshalJSON(data []b
 
 func (p *PatchFirewallNatOutboundMappingEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -12841,11 +12841,11 @@ func (p *PatchFirewallNatOutboundMappingEndpointResponseData) UnmarshalJSON(data
 
 func (p *PatchFirewallNatOutboundMappingEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -13942,11 +13942,11 @@ func (p *PatchFirewallVirtualIPEndpointResponse) UnmarshalJSON(data []byte) erro
 
 func (p *PatchFirewallVirtualIPEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -14001,11 +14001,11 @@ func (p *PatchFirewallVirtualIPEndpointResponseData) UnmarshalJSON(data []byte)
 
 func (p *PatchFirewallVirtualIPEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -14252,11 +14252,11 @@ func (p *PostFirewallNatOutboundMappingEndpointResponse) UnmarshalJSON(data []by
 
 func (p *PostFirewallNatOutboundMappingEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -14313,11 +14313,11 @@ func (p *PostFirewallNatOutboundMappingEndpointResponseData) UnmarshalJSON(data
 
 func (p *PostFirewallNatOutboundMappingEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -15375,11 +15375,11 @@ func (p *PostFirewallVirtualIPEndpointResponse) UnmarshalJSON(data []byte) error
 
 func (p *PostFirewallVirtualIPEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -15434,11 +15434,11 @@ func (p *PostFirewallVirtualIPEndpointResponseData) UnmarshalJSON(data []byte) e
 
 func (p *PostFirewallVirtualIPEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -15760,11 +15760,11 @@ func (p *PutFirewallNatOutboundMappingsEndpointRequestItem) UnmarshalJSON(data [
 
 func (p *PutFirewallNatOutboundMappingsEndpointRequestItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -15799,11 +15799,11 @@ func (p *PutFirewallNatOutboundMappingsEndpointResponse) UnmarshalJSON(data []by
 
 func (p *PutFirewallNatOutboundMappingsEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -15860,11 +15860,11 @@ func (p *PutFirewallNatOutboundMappingsEndpointResponseDataItem) UnmarshalJSON(d
 
 func (p *PutFirewallNatOutboundMappingsEndpointResponseDataItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6,7 +6,6 @@ import (
 	bytes "bytes"
 	context "context"
 	json "encoding/json"
-	errors "errors"
 	fmt "fmt"
 	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
 	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
@@ -17,22 +16,27 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	caller *core.Caller
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
 	options := core.NewRequestOptions(opts...)
 	return &Client{
-		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
 				Client:      options.HTTPClient,
 				MaxAttempts: options.MaxAttempts,
+				Options:     options,
 			},
 		),
-		header: options.ToHeader(),
+	}
+}
+
+// NewClientWithCaller returns a client that issues its calls through the
+// given caller, sharing its options with every other client that does.
+func NewClientWithCaller(caller *core.Caller) *Client {
+	return &Client{
+		caller: caller,
 	}
 }
 
@@ -43,23 +47,20 @@ func (c *Client) GetFirewallAdvancedSettingsEndpoint(
 ) (*pkgclient.GetFirewallAdvancedSettingsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/advanced_settings"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -154,9 +155,11 @@ func (c *Client) GetFirewallAdvancedSettingsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallAdvancedSettingsEndpoint", Path: "/api/v2/firewall/advanced_settings"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -176,23 +179,20 @@ func (c *Client) PatchFirewallAdvancedSettingsEndpoint(
 ) (*pkgclient.PatchFirewallAdvancedSettingsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/advanced_settings"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -287,9 +287,11 @@ func (c *Client) PatchFirewallAdvancedSettingsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallAdvancedSettingsEndpoint", Path: "/api/v2/firewall/advanced_settings", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -310,10 +312,7 @@ func (c *Client) GetFirewallAliasEndpoint(
 ) (*pkgclient.GetFirewallAliasEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -327,14 +326,14 @@ func (c *Client) GetFirewallAliasEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -429,9 +428,11 @@ func (c *Client) GetFirewallAliasEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallAliasEndpoint", Path: "/api/v2/firewall/alias"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -451,23 +452,20 @@ func (c *Client) PostFirewallAliasEndpoint(
 ) (*pkgclient.PostFirewallAliasEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/alias"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -562,9 +560,11 @@ func (c *Client) PostFirewallAliasEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallAliasEndpoint", Path: "/api/v2/firewall/alias", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -585,10 +585,7 @@ func (c *Client) DeleteFirewallAliasEndpoint(
 ) (*pkgclient.DeleteFirewallAliasEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -605,14 +602,14 @@ func (c *Client) DeleteFirewallAliasEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -707,9 +704,11 @@ func (c *Client) DeleteFirewallAliasEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallAliasEndpoint", Path: "/api/v2/firewall/alias", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -729,23 +728,20 @@ func (c *Client) PatchFirewallAliasEndpoint(
 ) (*pkgclient.PatchFirewallAliasEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/alias"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -840,9 +836,11 @@ func (c *Client) PatchFirewallAliasEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallAliasEndpoint", Path: "/api/v2/firewall/alias", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -863,10 +861,7 @@ func (c *Client) GetFirewallAliasesEndpoint(
 ) (*pkgclient.GetFirewallAliasesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -889,20 +884,22 @@ func (c *Client) GetFirewallAliasesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -997,9 +994,11 @@ func (c *Client) GetFirewallAliasesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallAliasesEndpoint", Path: "/api/v2/firewall/aliases"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1019,23 +1018,20 @@ func (c *Client) PutFirewallAliasesEndpoint(
 ) (*pkgclient.PutFirewallAliasesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/aliases"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1130,9 +1126,11 @@ func (c *Client) PutFirewallAliasesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PutFirewallAliasesEndpoint", Path: "/api/v2/firewall/aliases", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPut,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1153,10 +1151,7 @@ func (c *Client) DeleteFirewallAliasesEndpoint(
 ) (*pkgclient.DeleteFirewallAliasesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1170,20 +1165,22 @@ func (c *Client) DeleteFirewallAliasesEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1278,9 +1275,11 @@ func (c *Client) DeleteFirewallAliasesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallAliasesEndpoint", Path: "/api/v2/firewall/aliases", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1299,23 +1298,20 @@ func (c *Client) GetFirewallApplyEndpoint(
 ) (*pkgclient.GetFirewallApplyEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/apply"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1410,9 +1406,11 @@ func (c *Client) GetFirewallApplyEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallApplyEndpoint", Path: "/api/v2/firewall/apply"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1432,23 +1430,20 @@ func (c *Client) PostFirewallApplyEndpoint(
 ) (*pkgclient.PostFirewallApplyEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/apply"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1543,9 +1538,11 @@ func (c *Client) PostFirewallApplyEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallApplyEndpoint", Path: "/api/v2/firewall/apply"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1566,10 +1563,7 @@ func (c *Client) GetFirewallNatOneToOneMappingEndpoint(
 ) (*pkgclient.GetFirewallNatOneToOneMappingEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1583,14 +1577,14 @@ func (c *Client) GetFirewallNatOneToOneMappingEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1685,9 +1679,11 @@ func (c *Client) GetFirewallNatOneToOneMappingEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallNatOneToOneMappingEndpoint", Path: "/api/v2/firewall/nat/one_to_one/mapping"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1707,23 +1703,20 @@ func (c *Client) PostFirewallNatOneToOneMappingEndpoint(
 ) (*pkgclient.PostFirewallNatOneToOneMappingEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/one_to_one/mapping"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1818,9 +1811,11 @@ func (c *Client) PostFirewallNatOneToOneMappingEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallNatOneToOneMappingEndpoint", Path: "/api/v2/firewall/nat/one_to_one/mapping", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1841,10 +1836,7 @@ func (c *Client) DeleteFirewallNatOneToOneMappingEndpoint(
 ) (*pkgclient.DeleteFirewallNatOneToOneMappingEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1861,14 +1853,14 @@ func (c *Client) DeleteFirewallNatOneToOneMappingEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1963,9 +1955,11 @@ func (c *Client) DeleteFirewallNatOneToOneMappingEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallNatOneToOneMappingEndpoint", Path: "/api/v2/firewall/nat/one_to_one/mapping", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1985,23 +1979,20 @@ func (c *Client) PatchFirewallNatOneToOneMappingEndpoint(
 ) (*pkgclient.PatchFirewallNatOneToOneMappingEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/one_to_one/mapping"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2096,9 +2087,11 @@ func (c *Client) PatchFirewallNatOneToOneMappingEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallNatOneToOneMappingEndpoint", Path: "/api/v2/firewall/nat/one_to_one/mapping", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -2119,10 +2112,7 @@ func (c *Client) GetFirewallNatOneToOneMappingsEndpoint(
 ) (*pkgclient.GetFirewallNatOneToOneMappingsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2145,20 +2135,22 @@ func (c *Client) GetFirewallNatOneToOneMappingsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2253,9 +2245,11 @@ func (c *Client) GetFirewallNatOneToOneMappingsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallNatOneToOneMappingsEndpoint", Path: "/api/v2/firewall/nat/one_to_one/mappings"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2275,23 +2269,20 @@ func (c *Client) PutFirewallNatOneToOneMappingsEndpoint(
 ) (*pkgclient.PutFirewallNatOneToOneMappingsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/one_to_one/mappings"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2386,9 +2377,11 @@ func (c *Client) PutFirewallNatOneToOneMappingsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PutFirewallNatOneToOneMappingsEndpoint", Path: "/api/v2/firewall/nat/one_to_one/mappings", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPut,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -2409,10 +2402,7 @@ func (c *Client) DeleteFirewallNatOneToOneMappingsEndpoint(
 ) (*pkgclient.DeleteFirewallNatOneToOneMappingsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2426,20 +2416,22 @@ func (c *Client) DeleteFirewallNatOneToOneMappingsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2534,9 +2526,11 @@ func (c *Client) DeleteFirewallNatOneToOneMappingsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallNatOneToOneMappingsEndpoint", Path: "/api/v2/firewall/nat/one_to_one/mappings", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2556,10 +2550,7 @@ func (c *Client) GetFirewallNatOutboundMappingEndpoint(
 ) (*pkgclient.GetFirewallNatOutboundMappingEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2573,14 +2564,14 @@ func (c *Client) GetFirewallNatOutboundMappingEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2675,9 +2666,11 @@ func (c *Client) GetFirewallNatOutboundMappingEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallNatOutboundMappingEndpoint", Path: "/api/v2/firewall/nat/outbound/mapping"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2697,23 +2690,20 @@ func (c *Client) PostFirewallNatOutboundMappingEndpoint(
 ) (*pkgclient.PostFirewallNatOutboundMappingEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/outbound/mapping"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2808,9 +2798,11 @@ func (c *Client) PostFirewallNatOutboundMappingEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallNatOutboundMappingEndpoint", Path: "/api/v2/firewall/nat/outbound/mapping", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -2831,10 +2823,7 @@ func (c *Client) DeleteFirewallNatOutboundMappingEndpoint(
 ) (*pkgclient.DeleteFirewallNatOutboundMappingEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2851,14 +2840,14 @@ func (c *Client) DeleteFirewallNatOutboundMappingEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2953,9 +2942,11 @@ func (c *Client) DeleteFirewallNatOutboundMappingEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallNatOutboundMappingEndpoint", Path: "/api/v2/firewall/nat/outbound/mapping", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2975,23 +2966,20 @@ func (c *Client) PatchFirewallNatOutboundMappingEndpoint(
 ) (*pkgclient.PatchFirewallNatOutboundMappingEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/outbound/mapping"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3086,9 +3074,11 @@ func (c *Client) PatchFirewallNatOutboundMappingEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallNatOutboundMappingEndpoint", Path: "/api/v2/firewall/nat/outbound/mapping", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -3109,10 +3099,7 @@ func (c *Client) GetFirewallNatOutboundMappingsEndpoint(
 ) (*pkgclient.GetFirewallNatOutboundMappingsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3135,20 +3122,22 @@ func (c *Client) GetFirewallNatOutboundMappingsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3243,9 +3232,11 @@ func (c *Client) GetFirewallNatOutboundMappingsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallNatOutboundMappingsEndpoint", Path: "/api/v2/firewall/nat/outbound/mappings"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3265,23 +3256,20 @@ func (c *Client) PutFirewallNatOutboundMappingsEndpoint(
 ) (*pkgclient.PutFirewallNatOutboundMappingsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/outbound/mappings"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3376,9 +3364,11 @@ func (c *Client) PutFirewallNatOutboundMappingsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PutFirewallNatOutboundMappingsEndpoint", Path: "/api/v2/firewall/nat/outbound/mappings", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPut,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -3399,10 +3389,7 @@ func (c *Client) DeleteFirewallNatOutboundMappingsEndpoint(
 ) (*pkgclient.DeleteFirewallNatOutboundMappingsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3416,20 +3403,22 @@ func (c *Client) DeleteFirewallNatOutboundMappingsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3524,9 +3513,11 @@ func (c *Client) DeleteFirewallNatOutboundMappingsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallNatOutboundMappingsEndpoint", Path: "/api/v2/firewall/nat/outbound/mappings", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3545,23 +3536,20 @@ func (c *Client) GetFirewallNatOutboundModeEndpoint(
 ) (*pkgclient.GetFirewallNatOutboundModeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/outbound/mode"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3656,9 +3644,11 @@ func (c *Client) GetFirewallNatOutboundModeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallNatOutboundModeEndpoint", Path: "/api/v2/firewall/nat/outbound/mode"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3678,23 +3668,20 @@ func (c *Client) PatchFirewallNatOutboundModeEndpoint(
 ) (*pkgclient.PatchFirewallNatOutboundModeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/outbound/mode"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3789,9 +3776,11 @@ func (c *Client) PatchFirewallNatOutboundModeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallNatOutboundModeEndpoint", Path: "/api/v2/firewall/nat/outbound/mode", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -3812,10 +3801,7 @@ func (c *Client) GetFirewallNatPortForwardEndpoint(
 ) (*pkgclient.GetFirewallNatPortForwardEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3829,14 +3815,14 @@ func (c *Client) GetFirewallNatPortForwardEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3931,9 +3917,11 @@ func (c *Client) GetFirewallNatPortForwardEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallNatPortForwardEndpoint", Path: "/api/v2/firewall/nat/port_forward"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3953,23 +3941,20 @@ func (c *Client) PostFirewallNatPortForwardEndpoint(
 ) (*pkgclient.PostFirewallNatPortForwardEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/port_forward"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4064,9 +4049,11 @@ func (c *Client) PostFirewallNatPortForwardEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallNatPortForwardEndpoint", Path: "/api/v2/firewall/nat/port_forward", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -4087,10 +4074,7 @@ func (c *Client) DeleteFirewallNatPortForwardEndpoint(
 ) (*pkgclient.DeleteFirewallNatPortForwardEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -4107,14 +4091,14 @@ func (c *Client) DeleteFirewallNatPortForwardEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4209,9 +4193,11 @@ func (c *Client) DeleteFirewallNatPortForwardEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallNatPortForwardEndpoint", Path: "/api/v2/firewall/nat/port_forward", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -4231,23 +4217,20 @@ func (c *Client) PatchFirewallNatPortForwardEndpoint(
 ) (*pkgclient.PatchFirewallNatPortForwardEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/port_forward"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4342,9 +4325,11 @@ func (c *Client) PatchFirewallNatPortForwardEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallNatPortForwardEndpoint", Path: "/api/v2/firewall/nat/port_forward", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -4365,10 +4350,7 @@ func (c *Client) GetFirewallNatPortForwardsEndpoint(
 ) (*pkgclient.GetFirewallNatPortForwardsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -4391,20 +4373,22 @@ func (c *Client) GetFirewallNatPortForwardsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4499,9 +4483,11 @@ func (c *Client) GetFirewallNatPortForwardsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallNatPortForwardsEndpoint", Path: "/api/v2/firewall/nat/port_forwards"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -4521,23 +4507,20 @@ func (c *Client) PutFirewallNatPortForwardsEndpoint(
 ) (*pkgclient.PutFirewallNatPortForwardsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/nat/port_forwards"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4632,9 +4615,11 @@ func (c *Client) PutFirewallNatPortForwardsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PutFirewallNatPortForwardsEndpoint", Path: "/api/v2/firewall/nat/port_forwards", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPut,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -4655,10 +4640,7 @@ func (c *Client) DeleteFirewallNatPortForwardsEndpoint(
 ) (*pkgclient.DeleteFirewallNatPortForwardsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -4672,20 +4654,22 @@ func (c *Client) DeleteFirewallNatPortForwardsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4780,9 +4764,11 @@ func (c *Client) DeleteFirewallNatPortForwardsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallNatPortForwardsEndpoint", Path: "/api/v2/firewall/nat/port_forwards", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -4802,10 +4788,7 @@ func (c *Client) GetFirewallRuleEndpoint(
 ) (*pkgclient.GetFirewallRuleEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -4819,14 +4802,14 @@ func (c *Client) GetFirewallRuleEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4921,9 +4904,11 @@ func (c *Client) GetFirewallRuleEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallRuleEndpoint", Path: "/api/v2/firewall/rule"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -4943,23 +4928,20 @@ func (c *Client) PostFirewallRuleEndpoint(
 ) (*pkgclient.PostFirewallRuleEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/rule"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5054,9 +5036,11 @@ func (c *Client) PostFirewallRuleEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallRuleEndpoint", Path: "/api/v2/firewall/rule", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -5077,10 +5061,7 @@ func (c *Client) DeleteFirewallRuleEndpoint(
 ) (*pkgclient.DeleteFirewallRuleEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -5094,14 +5075,14 @@ func (c *Client) DeleteFirewallRuleEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5196,9 +5177,11 @@ func (c *Client) DeleteFirewallRuleEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallRuleEndpoint", Path: "/api/v2/firewall/rule", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -5218,23 +5201,20 @@ func (c *Client) PatchFirewallRuleEndpoint(
 ) (*pkgclient.PatchFirewallRuleEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/rule"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5329,9 +5309,11 @@ func (c *Client) PatchFirewallRuleEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallRuleEndpoint", Path: "/api/v2/firewall/rule", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -5352,10 +5334,7 @@ func (c *Client) GetFirewallRulesEndpoint(
 ) (*pkgclient.GetFirewallRulesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -5378,20 +5357,22 @@ func (c *Client) GetFirewallRulesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5486,9 +5467,11 @@ func (c *Client) GetFirewallRulesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallRulesEndpoint", Path: "/api/v2/firewall/rules"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -5508,23 +5491,20 @@ func (c *Client) PutFirewallRulesEndpoint(
 ) (*pkgclient.PutFirewallRulesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/rules"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5619,9 +5599,11 @@ func (c *Client) PutFirewallRulesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PutFirewallRulesEndpoint", Path: "/api/v2/firewall/rules", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPut,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -5642,10 +5624,7 @@ func (c *Client) DeleteFirewallRulesEndpoint(
 ) (*pkgclient.DeleteFirewallRulesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -5659,20 +5638,22 @@ func (c *Client) DeleteFirewallRulesEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5767,9 +5748,11 @@ func (c *Client) DeleteFirewallRulesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallRulesEndpoint", Path: "/api/v2/firewall/rules", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -5789,10 +5772,7 @@ func (c *Client) GetFirewallScheduleEndpoint(
 ) (*pkgclient.GetFirewallScheduleEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -5806,14 +5786,14 @@ func (c *Client) GetFirewallScheduleEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5908,9 +5888,11 @@ func (c *Client) GetFirewallScheduleEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallScheduleEndpoint", Path: "/api/v2/firewall/schedule"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -5930,23 +5912,20 @@ func (c *Client) PostFirewallScheduleEndpoint(
 ) (*pkgclient.PostFirewallScheduleEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/schedule"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -6041,9 +6020,11 @@ func (c *Client) PostFirewallScheduleEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallScheduleEndpoint", Path: "/api/v2/firewall/schedule"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -6064,10 +6045,7 @@ func (c *Client) DeleteFirewallScheduleEndpoint(
 ) (*pkgclient.DeleteFirewallScheduleEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -6081,14 +6059,14 @@ func (c *Client) DeleteFirewallScheduleEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -6183,9 +6161,11 @@ func (c *Client) DeleteFirewallScheduleEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallScheduleEndpoint", Path: "/api/v2/firewall/schedule"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -6205,23 +6185,20 @@ func (c *Client) PatchFirewallScheduleEndpoint(
 ) (*pkgclient.PatchFirewallScheduleEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/schedule"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -6316,9 +6293,11 @@ func (c *Client) PatchFirewallScheduleEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallScheduleEndpoint", Path: "/api/v2/firewall/schedule"},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -6339,10 +6318,7 @@ func (c *Client) GetFirewallScheduleTimeRangeEndpoint(
 ) (*pkgclient.GetFirewallScheduleTimeRangeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -6359,14 +6335,14 @@ func (c *Client) GetFirewallScheduleTimeRangeEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -6461,9 +6437,11 @@ func (c *Client) GetFirewallScheduleTimeRangeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallScheduleTimeRangeEndpoint", Path: "/api/v2/firewall/schedule/time_range"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -6483,23 +6461,20 @@ func (c *Client) PostFirewallScheduleTimeRangeEndpoint(
 ) (*pkgclient.PostFirewallScheduleTimeRangeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/schedule/time_range"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -6594,9 +6569,11 @@ func (c *Client) PostFirewallScheduleTimeRangeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallScheduleTimeRangeEndpoint", Path: "/api/v2/firewall/schedule/time_range"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -6617,10 +6594,7 @@ func (c *Client) DeleteFirewallScheduleTimeRangeEndpoint(
 ) (*pkgclient.DeleteFirewallScheduleTimeRangeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -6637,14 +6611,14 @@ func (c *Client) DeleteFirewallScheduleTimeRangeEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -6739,9 +6713,11 @@ func (c *Client) DeleteFirewallScheduleTimeRangeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallScheduleTimeRangeEndpoint", Path: "/api/v2/firewall/schedule/time_range"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -6761,23 +6737,20 @@ func (c *Client) PatchFirewallScheduleTimeRangeEndpoint(
 ) (*pkgclient.PatchFirewallScheduleTimeRangeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/schedule/time_range"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -6872,9 +6845,11 @@ func (c *Client) PatchFirewallScheduleTimeRangeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallScheduleTimeRangeEndpoint", Path: "/api/v2/firewall/schedule/time_range"},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -6895,10 +6870,7 @@ func (c *Client) GetFirewallScheduleTimeRangesEndpoint(
 ) (*pkgclient.GetFirewallScheduleTimeRangesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -6921,20 +6893,22 @@ func (c *Client) GetFirewallScheduleTimeRangesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -7029,9 +7003,11 @@ func (c *Client) GetFirewallScheduleTimeRangesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallScheduleTimeRangesEndpoint", Path: "/api/v2/firewall/schedule/time_ranges"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -7051,10 +7027,7 @@ func (c *Client) DeleteFirewallScheduleTimeRangesEndpoint(
 ) (*pkgclient.DeleteFirewallScheduleTimeRangesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -7068,20 +7041,22 @@ func (c *Client) DeleteFirewallScheduleTimeRangesEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -7176,9 +7151,11 @@ func (c *Client) DeleteFirewallScheduleTimeRangesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallScheduleTimeRangesEndpoint", Path: "/api/v2/firewall/schedule/time_ranges"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -7198,10 +7175,7 @@ func (c *Client) GetFirewallSchedulesEndpoint(
 ) (*pkgclient.GetFirewallSchedulesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -7224,20 +7198,22 @@ func (c *Client) GetFirewallSchedulesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -7332,9 +7308,11 @@ func (c *Client) GetFirewallSchedulesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallSchedulesEndpoint", Path: "/api/v2/firewall/schedules"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -7354,23 +7332,20 @@ func (c *Client) PutFirewallSchedulesEndpoint(
 ) (*pkgclient.PutFirewallSchedulesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/schedules"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -7465,9 +7440,11 @@ func (c *Client) PutFirewallSchedulesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PutFirewallSchedulesEndpoint", Path: "/api/v2/firewall/schedules"},
 			URL:          endpointURL,
 			Method:       http.MethodPut,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -7488,10 +7465,7 @@ func (c *Client) DeleteFirewallSchedulesEndpoint(
 ) (*pkgclient.DeleteFirewallSchedulesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -7505,20 +7479,22 @@ func (c *Client) DeleteFirewallSchedulesEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -7613,9 +7589,11 @@ func (c *Client) DeleteFirewallSchedulesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallSchedulesEndpoint", Path: "/api/v2/firewall/schedules"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -7635,10 +7613,7 @@ func (c *Client) GetFirewallStateEndpoint(
 ) (*pkgclient.GetFirewallStateEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -7652,14 +7627,14 @@ func (c *Client) GetFirewallStateEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -7754,9 +7729,11 @@ func (c *Client) GetFirewallStateEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallStateEndpoint", Path: "/api/v2/firewall/state"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -7776,10 +7753,7 @@ func (c *Client) DeleteFirewallStateEndpoint(
 ) (*pkgclient.DeleteFirewallStateEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -7793,14 +7767,14 @@ func (c *Client) DeleteFirewallStateEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -7895,9 +7869,11 @@ func (c *Client) DeleteFirewallStateEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallStateEndpoint", Path: "/api/v2/firewall/state"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -7917,10 +7893,7 @@ func (c *Client) GetFirewallStatesEndpoint(
 ) (*pkgclient.GetFirewallStatesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -7943,20 +7916,22 @@ func (c *Client) GetFirewallStatesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -8051,9 +8026,11 @@ func (c *Client) GetFirewallStatesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallStatesEndpoint", Path: "/api/v2/firewall/states"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -8073,10 +8050,7 @@ func (c *Client) DeleteFirewallStatesEndpoint(
 ) (*pkgclient.DeleteFirewallStatesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -8090,20 +8064,22 @@ func (c *Client) DeleteFirewallStatesEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -8198,9 +8174,11 @@ func (c *Client) DeleteFirewallStatesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallStatesEndpoint", Path: "/api/v2/firewall/states"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -8219,23 +8197,20 @@ func (c *Client) GetFirewallStatesSizeEndpoint(
 ) (*pkgclient.GetFirewallStatesSizeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/states/size"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -8330,9 +8305,11 @@ func (c *Client) GetFirewallStatesSizeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallStatesSizeEndpoint", Path: "/api/v2/firewall/states/size"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -8352,23 +8329,20 @@ func (c *Client) PatchFirewallStatesSizeEndpoint(
 ) (*pkgclient.PatchFirewallStatesSizeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/states/size"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -8463,9 +8437,11 @@ func (c *Client) PatchFirewallStatesSizeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallStatesSizeEndpoint", Path: "/api/v2/firewall/states/size"},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -8486,10 +8462,7 @@ func (c *Client) GetFirewallTrafficShaperEndpoint(
 ) (*pkgclient.GetFirewallTrafficShaperEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -8503,14 +8476,14 @@ func (c *Client) GetFirewallTrafficShaperEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -8605,9 +8578,11 @@ func (c *Client) GetFirewallTrafficShaperEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallTrafficShaperEndpoint", Path: "/api/v2/firewall/traffic_shaper"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -8627,23 +8602,20 @@ func (c *Client) PostFirewallTrafficShaperEndpoint(
 ) (*pkgclient.PostFirewallTrafficShaperEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -8738,9 +8710,11 @@ func (c *Client) PostFirewallTrafficShaperEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallTrafficShaperEndpoint", Path: "/api/v2/firewall/traffic_shaper", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -8761,10 +8735,7 @@ func (c *Client) DeleteFirewallTrafficShaperEndpoint(
 ) (*pkgclient.DeleteFirewallTrafficShaperEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -8781,14 +8752,14 @@ func (c *Client) DeleteFirewallTrafficShaperEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -8883,9 +8854,11 @@ func (c *Client) DeleteFirewallTrafficShaperEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallTrafficShaperEndpoint", Path: "/api/v2/firewall/traffic_shaper", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -8905,23 +8878,20 @@ func (c *Client) PatchFirewallTrafficShaperEndpoint(
 ) (*pkgclient.PatchFirewallTrafficShaperEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -9016,9 +8986,11 @@ func (c *Client) PatchFirewallTrafficShaperEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallTrafficShaperEndpoint", Path: "/api/v2/firewall/traffic_shaper", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -9039,10 +9011,7 @@ func (c *Client) GetFirewallTrafficShaperLimiterBandwidthEndpoint(
 ) (*pkgclient.GetFirewallTrafficShaperLimiterBandwidthEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -9059,14 +9028,14 @@ func (c *Client) GetFirewallTrafficShaperLimiterBandwidthEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -9161,9 +9130,11 @@ func (c *Client) GetFirewallTrafficShaperLimiterBandwidthEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallTrafficShaperLimiterBandwidthEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/bandwidth"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -9183,23 +9154,20 @@ func (c *Client) PostFirewallTrafficShaperLimiterBandwidthEndpoint(
 ) (*pkgclient.PostFirewallTrafficShaperLimiterBandwidthEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper/limiter/bandwidth"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -9294,9 +9262,11 @@ func (c *Client) PostFirewallTrafficShaperLimiterBandwidthEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallTrafficShaperLimiterBandwidthEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/bandwidth", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -9317,10 +9287,7 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterBandwidthEndpoint(
 ) (*pkgclient.DeleteFirewallTrafficShaperLimiterBandwidthEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -9337,14 +9304,14 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterBandwidthEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -9439,9 +9406,11 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterBandwidthEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallTrafficShaperLimiterBandwidthEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/bandwidth", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -9461,23 +9430,20 @@ func (c *Client) PatchFirewallTrafficShaperLimiterBandwidthEndpoint(
 ) (*pkgclient.PatchFirewallTrafficShaperLimiterBandwidthEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper/limiter/bandwidth"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -9572,9 +9538,11 @@ func (c *Client) PatchFirewallTrafficShaperLimiterBandwidthEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallTrafficShaperLimiterBandwidthEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/bandwidth", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -9595,10 +9563,7 @@ func (c *Client) GetFirewallTrafficShaperLimiterBandwidthsEndpoint(
 ) (*pkgclient.GetFirewallTrafficShaperLimiterBandwidthsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -9621,20 +9586,22 @@ func (c *Client) GetFirewallTrafficShaperLimiterBandwidthsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -9729,9 +9696,11 @@ func (c *Client) GetFirewallTrafficShaperLimiterBandwidthsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallTrafficShaperLimiterBandwidthsEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/bandwidths"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -9751,10 +9720,7 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterBandwidthsEndpoint(
 ) (*pkgclient.DeleteFirewallTrafficShaperLimiterBandwidthsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -9768,20 +9734,22 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterBandwidthsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -9876,9 +9844,11 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterBandwidthsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallTrafficShaperLimiterBandwidthsEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/bandwidths", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -9898,10 +9868,7 @@ func (c *Client) GetFirewallTrafficShaperLimiterEndpoint(
 ) (*pkgclient.GetFirewallTrafficShaperLimiterEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -9915,14 +9882,14 @@ func (c *Client) GetFirewallTrafficShaperLimiterEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -10017,9 +9984,11 @@ func (c *Client) GetFirewallTrafficShaperLimiterEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallTrafficShaperLimiterEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -10039,23 +10008,20 @@ func (c *Client) PostFirewallTrafficShaperLimiterEndpoint(
 ) (*pkgclient.PostFirewallTrafficShaperLimiterEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper/limiter"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -10150,9 +10116,11 @@ func (c *Client) PostFirewallTrafficShaperLimiterEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallTrafficShaperLimiterEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -10173,10 +10141,7 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterEndpoint(
 ) (*pkgclient.DeleteFirewallTrafficShaperLimiterEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -10193,14 +10158,14 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -10295,9 +10260,11 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallTrafficShaperLimiterEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -10317,23 +10284,20 @@ func (c *Client) PatchFirewallTrafficShaperLimiterEndpoint(
 ) (*pkgclient.PatchFirewallTrafficShaperLimiterEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper/limiter"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -10428,9 +10392,11 @@ func (c *Client) PatchFirewallTrafficShaperLimiterEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallTrafficShaperLimiterEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -10451,10 +10417,7 @@ func (c *Client) GetFirewallTrafficShaperLimiterQueueEndpoint(
 ) (*pkgclient.GetFirewallTrafficShaperLimiterQueueEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -10471,14 +10434,14 @@ func (c *Client) GetFirewallTrafficShaperLimiterQueueEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -10573,9 +10536,11 @@ func (c *Client) GetFirewallTrafficShaperLimiterQueueEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallTrafficShaperLimiterQueueEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/queue"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -10595,23 +10560,20 @@ func (c *Client) PostFirewallTrafficShaperLimiterQueueEndpoint(
 ) (*pkgclient.PostFirewallTrafficShaperLimiterQueueEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper/limiter/queue"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -10706,9 +10668,11 @@ func (c *Client) PostFirewallTrafficShaperLimiterQueueEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallTrafficShaperLimiterQueueEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/queue", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -10729,10 +10693,7 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterQueueEndpoint(
 ) (*pkgclient.DeleteFirewallTrafficShaperLimiterQueueEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -10752,14 +10713,14 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterQueueEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -10854,9 +10815,11 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterQueueEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallTrafficShaperLimiterQueueEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/queue", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -10876,23 +10839,20 @@ func (c *Client) PatchFirewallTrafficShaperLimiterQueueEndpoint(
 ) (*pkgclient.PatchFirewallTrafficShaperLimiterQueueEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper/limiter/queue"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -10987,9 +10947,11 @@ func (c *Client) PatchFirewallTrafficShaperLimiterQueueEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallTrafficShaperLimiterQueueEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/queue", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -11010,10 +10972,7 @@ func (c *Client) GetFirewallTrafficShaperLimiterQueuesEndpoint(
 ) (*pkgclient.GetFirewallTrafficShaperLimiterQueuesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -11036,20 +10995,22 @@ func (c *Client) GetFirewallTrafficShaperLimiterQueuesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -11144,9 +11105,11 @@ func (c *Client) GetFirewallTrafficShaperLimiterQueuesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallTrafficShaperLimiterQueuesEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/queues"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -11166,10 +11129,7 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterQueuesEndpoint(
 ) (*pkgclient.DeleteFirewallTrafficShaperLimiterQueuesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -11183,20 +11143,22 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterQueuesEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -11291,9 +11253,11 @@ func (c *Client) DeleteFirewallTrafficShaperLimiterQueuesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallTrafficShaperLimiterQueuesEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiter/queues", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -11313,10 +11277,7 @@ func (c *Client) GetFirewallTrafficShaperLimitersEndpoint(
 ) (*pkgclient.GetFirewallTrafficShaperLimitersEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -11339,20 +11300,22 @@ func (c *Client) GetFirewallTrafficShaperLimitersEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -11447,9 +11410,11 @@ func (c *Client) GetFirewallTrafficShaperLimitersEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallTrafficShaperLimitersEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiters"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -11469,23 +11434,20 @@ func (c *Client) PutFirewallTrafficShaperLimitersEndpoint(
 ) (*pkgclient.PutFirewallTrafficShaperLimitersEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper/limiters"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -11580,9 +11542,11 @@ func (c *Client) PutFirewallTrafficShaperLimitersEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PutFirewallTrafficShaperLimitersEndpoint", Path: "/api/v2/firewall/traffic_shaper/limiters", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPut,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -11603,10 +11567,7 @@ func (c *Client) GetFirewallTrafficShaperQueueEndpoint(
 ) (*pkgclient.GetFirewallTrafficShaperQueueEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -11623,14 +11584,14 @@ func (c *Client) GetFirewallTrafficShaperQueueEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -11725,9 +11686,11 @@ func (c *Client) GetFirewallTrafficShaperQueueEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallTrafficShaperQueueEndpoint", Path: "/api/v2/firewall/traffic_shaper/queue"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -11747,23 +11710,20 @@ func (c *Client) PostFirewallTrafficShaperQueueEndpoint(
 ) (*pkgclient.PostFirewallTrafficShaperQueueEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper/queue"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -11858,9 +11818,11 @@ func (c *Client) PostFirewallTrafficShaperQueueEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallTrafficShaperQueueEndpoint", Path: "/api/v2/firewall/traffic_shaper/queue", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -11881,10 +11843,7 @@ func (c *Client) DeleteFirewallTrafficShaperQueueEndpoint(
 ) (*pkgclient.DeleteFirewallTrafficShaperQueueEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -11904,14 +11863,14 @@ func (c *Client) DeleteFirewallTrafficShaperQueueEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -12006,9 +11965,11 @@ func (c *Client) DeleteFirewallTrafficShaperQueueEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallTrafficShaperQueueEndpoint", Path: "/api/v2/firewall/traffic_shaper/queue", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -12028,23 +11989,20 @@ func (c *Client) PatchFirewallTrafficShaperQueueEndpoint(
 ) (*pkgclient.PatchFirewallTrafficShaperQueueEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shaper/queue"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -12139,9 +12097,11 @@ func (c *Client) PatchFirewallTrafficShaperQueueEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallTrafficShaperQueueEndpoint", Path: "/api/v2/firewall/traffic_shaper/queue", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -12162,10 +12122,7 @@ func (c *Client) GetFirewallTrafficShaperQueuesEndpoint(
 ) (*pkgclient.GetFirewallTrafficShaperQueuesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -12188,20 +12145,22 @@ func (c *Client) GetFirewallTrafficShaperQueuesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -12296,9 +12255,11 @@ func (c *Client) GetFirewallTrafficShaperQueuesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallTrafficShaperQueuesEndpoint", Path: "/api/v2/firewall/traffic_shaper/queues"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -12318,10 +12279,7 @@ func (c *Client) DeleteFirewallTrafficShaperQueuesEndpoint(
 ) (*pkgclient.DeleteFirewallTrafficShaperQueuesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -12335,20 +12293,22 @@ func (c *Client) DeleteFirewallTrafficShaperQueuesEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -12443,9 +12403,11 @@ func (c *Client) DeleteFirewallTrafficShaperQueuesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallTrafficShaperQueuesEndpoint", Path: "/api/v2/firewall/traffic_shaper/queues", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -12465,10 +12427,7 @@ func (c *Client) GetFirewallTrafficShapersEndpoint(
 ) (*pkgclient.GetFirewallTrafficShapersEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -12491,20 +12450,22 @@ func (c *Client) GetFirewallTrafficShapersEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -12599,9 +12560,11 @@ func (c *Client) GetFirewallTrafficShapersEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallTrafficShapersEndpoint", Path: "/api/v2/firewall/traffic_shapers"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -12621,23 +12584,20 @@ func (c *Client) PutFirewallTrafficShapersEndpoint(
 ) (*pkgclient.PutFirewallTrafficShapersEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/traffic_shapers"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -12732,9 +12692,11 @@ func (c *Client) PutFirewallTrafficShapersEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PutFirewallTrafficShapersEndpoint", Path: "/api/v2/firewall/traffic_shapers", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPut,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -12755,10 +12717,7 @@ func (c *Client) DeleteFirewallTrafficShapersEndpoint(
 ) (*pkgclient.DeleteFirewallTrafficShapersEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -12772,20 +12731,22 @@ func (c *Client) DeleteFirewallTrafficShapersEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -12880,9 +12841,11 @@ func (c *Client) DeleteFirewallTrafficShapersEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallTrafficShapersEndpoint", Path: "/api/v2/firewall/traffic_shapers", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -12901,23 +12864,20 @@ func (c *Client) GetFirewallVirtualIPApplyEndpoint(
 ) (*pkgclient.GetFirewallVirtualIPApplyEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/virtual_ip/apply"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -13012,9 +12972,11 @@ func (c *Client) GetFirewallVirtualIPApplyEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallVirtualIPApplyEndpoint", Path: "/api/v2/firewall/virtual_ip/apply"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -13034,23 +12996,20 @@ func (c *Client) PostFirewallVirtualIPApplyEndpoint(
 ) (*pkgclient.PostFirewallVirtualIPApplyEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/virtual_ip/apply"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -13145,9 +13104,11 @@ func (c *Client) PostFirewallVirtualIPApplyEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallVirtualIPApplyEndpoint", Path: "/api/v2/firewall/virtual_ip/apply"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -13168,10 +13129,7 @@ func (c *Client) GetFirewallVirtualIPEndpoint(
 ) (*pkgclient.GetFirewallVirtualIPEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -13185,14 +13143,14 @@ func (c *Client) GetFirewallVirtualIPEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -13287,9 +13245,11 @@ func (c *Client) GetFirewallVirtualIPEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallVirtualIPEndpoint", Path: "/api/v2/firewall/virtual_ip"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -13309,23 +13269,20 @@ func (c *Client) PostFirewallVirtualIPEndpoint(
 ) (*pkgclient.PostFirewallVirtualIPEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/virtual_ip"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -13420,9 +13377,11 @@ func (c *Client) PostFirewallVirtualIPEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PostFirewallVirtualIPEndpoint", Path: "/api/v2/firewall/virtual_ip", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -13443,10 +13402,7 @@ func (c *Client) DeleteFirewallVirtualIPEndpoint(
 ) (*pkgclient.DeleteFirewallVirtualIPEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -13463,14 +13419,14 @@ func (c *Client) DeleteFirewallVirtualIPEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -13565,9 +13521,11 @@ func (c *Client) DeleteFirewallVirtualIPEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallVirtualIPEndpoint", Path: "/api/v2/firewall/virtual_ip", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -13587,23 +13545,20 @@ func (c *Client) PatchFirewallVirtualIPEndpoint(
 ) (*pkgclient.PatchFirewallVirtualIPEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/firewall/virtual_ip"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -13698,9 +13653,11 @@ func (c *Client) PatchFirewallVirtualIPEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "PatchFirewallVirtualIPEndpoint", Path: "/api/v2/firewall/virtual_ip", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -13721,10 +13678,7 @@ func (c *Client) GetFirewallVirtualIPsEndpoint(
 ) (*pkgclient.GetFirewallVirtualIPsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -13747,20 +13701,22 @@ func (c *Client) GetFirewallVirtualIPsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -13855,9 +13811,11 @@ func (c *Client) GetFirewallVirtualIPsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "GetFirewallVirtualIPsEndpoint", Path: "/api/v2/firewall/virtual_ips"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -13877,10 +13835,7 @@ func (c *Client) DeleteFirewallVirtualIPsEndpoint(
 ) (*pkgclient.DeleteFirewallVirtualIPsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -13894,20 +13849,22 @@ func (c *Client) DeleteFirewallVirtualIPsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -14002,9 +13959,11 @@ func (c *Client) DeleteFirewallVirtualIPsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Firewall", Name: "DeleteFirewallVirtualIPsEndpoint", Path: "/api/v2/firewall/virtual_ips", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -0,0 +1,445 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package firewall
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	iter "iter"
+)
+
+// AllFirewallAliases iterates over every object returned by GetFirewallAliasesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallAliases(
+	ctx context.Context,
+	request *pkgclient.GetFirewallAliasesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallAliasesEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallAliasesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallAliasesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallAliasesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallNatOneToOneMappings iterates over every object returned by GetFirewallNatOneToOneMappingsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallNatOneToOneMappings(
+	ctx context.Context,
+	request *pkgclient.GetFirewallNatOneToOneMappingsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallNatOneToOneMappingsEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallNatOneToOneMappingsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallNatOneToOneMappingsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallNatOneToOneMappingsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallNatOutboundMappings iterates over every object returned by GetFirewallNatOutboundMappingsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallNatOutboundMappings(
+	ctx context.Context,
+	request *pkgclient.GetFirewallNatOutboundMappingsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallNatOutboundMappingsEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallNatOutboundMappingsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallNatOutboundMappingsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallNatOutboundMappingsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallNatPortForwards iterates over every object returned by GetFirewallNatPortForwardsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallNatPortForwards(
+	ctx context.Context,
+	request *pkgclient.GetFirewallNatPortForwardsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallNatPortForwardsEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallNatPortForwardsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallNatPortForwardsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallNatPortForwardsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallRules iterates over every object returned by GetFirewallRulesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallRules(
+	ctx context.Context,
+	request *pkgclient.GetFirewallRulesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallRulesEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallRulesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallRulesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallRulesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallScheduleTimeRanges iterates over every object returned by GetFirewallScheduleTimeRangesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallScheduleTimeRanges(
+	ctx context.Context,
+	request *pkgclient.GetFirewallScheduleTimeRangesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallScheduleTimeRangesEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallScheduleTimeRangesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallScheduleTimeRangesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallScheduleTimeRangesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallSchedules iterates over every object returned by GetFirewallSchedulesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallSchedules(
+	ctx context.Context,
+	request *pkgclient.GetFirewallSchedulesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallSchedulesEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallSchedulesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallSchedulesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallSchedulesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallStates iterates over every object returned by GetFirewallStatesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallStates(
+	ctx context.Context,
+	request *pkgclient.GetFirewallStatesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallStatesEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallStatesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallStatesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallStatesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallTrafficShaperLimiterBandwidths iterates over every object returned by GetFirewallTrafficShaperLimiterBandwidthsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallTrafficShaperLimiterBandwidths(
+	ctx context.Context,
+	request *pkgclient.GetFirewallTrafficShaperLimiterBandwidthsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallTrafficShaperLimiterBandwidthsEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallTrafficShaperLimiterBandwidthsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallTrafficShaperLimiterBandwidthsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallTrafficShaperLimiterBandwidthsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallTrafficShaperLimiterQueues iterates over every object returned by GetFirewallTrafficShaperLimiterQueuesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallTrafficShaperLimiterQueues(
+	ctx context.Context,
+	request *pkgclient.GetFirewallTrafficShaperLimiterQueuesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallTrafficShaperLimiterQueuesEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallTrafficShaperLimiterQueuesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallTrafficShaperLimiterQueuesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallTrafficShaperLimiterQueuesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallTrafficShaperLimiters iterates over every object returned by GetFirewallTrafficShaperLimitersEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallTrafficShaperLimiters(
+	ctx context.Context,
+	request *pkgclient.GetFirewallTrafficShaperLimitersEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallTrafficShaperLimitersEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallTrafficShaperLimitersEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallTrafficShaperLimitersEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallTrafficShaperLimitersEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallTrafficShaperQueues iterates over every object returned by GetFirewallTrafficShaperQueuesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallTrafficShaperQueues(
+	ctx context.Context,
+	request *pkgclient.GetFirewallTrafficShaperQueuesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallTrafficShaperQueuesEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallTrafficShaperQueuesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallTrafficShaperQueuesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallTrafficShaperQueuesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallTrafficShapers iterates over every object returned by GetFirewallTrafficShapersEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallTrafficShapers(
+	ctx context.Context,
+	request *pkgclient.GetFirewallTrafficShapersEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallTrafficShapersEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallTrafficShapersEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallTrafficShapersEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallTrafficShapersEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallVirtualIPs iterates over every object returned by GetFirewallVirtualIPsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallVirtualIPs(
+	ctx context.Context,
+	request *pkgclient.GetFirewallVirtualIPsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetFirewallVirtualIPsEndpointResponseDataItem, error] {
+	var page pkgclient.GetFirewallVirtualIPsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetFirewallVirtualIPsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetFirewallVirtualIPsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
@@ -0,0 +1,235 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package firewall
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	io "io"
+)
+
+// StreamFirewallAliases calls GetFirewallAliasesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallAliases(
+	ctx context.Context,
+	request *pkgclient.GetFirewallAliasesEndpointRequest,
+	fn func(*pkgclient.GetFirewallAliasesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallAliasesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallNatOneToOneMappings calls GetFirewallNatOneToOneMappingsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallNatOneToOneMappings(
+	ctx context.Context,
+	request *pkgclient.GetFirewallNatOneToOneMappingsEndpointRequest,
+	fn func(*pkgclient.GetFirewallNatOneToOneMappingsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallNatOneToOneMappingsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallNatOutboundMappings calls GetFirewallNatOutboundMappingsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallNatOutboundMappings(
+	ctx context.Context,
+	request *pkgclient.GetFirewallNatOutboundMappingsEndpointRequest,
+	fn func(*pkgclient.GetFirewallNatOutboundMappingsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallNatOutboundMappingsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallNatPortForwards calls GetFirewallNatPortForwardsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallNatPortForwards(
+	ctx context.Context,
+	request *pkgclient.GetFirewallNatPortForwardsEndpointRequest,
+	fn func(*pkgclient.GetFirewallNatPortForwardsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallNatPortForwardsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallRules calls GetFirewallRulesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallRules(
+	ctx context.Context,
+	request *pkgclient.GetFirewallRulesEndpointRequest,
+	fn func(*pkgclient.GetFirewallRulesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallRulesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallScheduleTimeRanges calls GetFirewallScheduleTimeRangesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallScheduleTimeRanges(
+	ctx context.Context,
+	request *pkgclient.GetFirewallScheduleTimeRangesEndpointRequest,
+	fn func(*pkgclient.GetFirewallScheduleTimeRangesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallScheduleTimeRangesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallSchedules calls GetFirewallSchedulesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallSchedules(
+	ctx context.Context,
+	request *pkgclient.GetFirewallSchedulesEndpointRequest,
+	fn func(*pkgclient.GetFirewallSchedulesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallSchedulesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallStates calls GetFirewallStatesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallStates(
+	ctx context.Context,
+	request *pkgclient.GetFirewallStatesEndpointRequest,
+	fn func(*pkgclient.GetFirewallStatesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallStatesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallTrafficShaperLimiterBandwidths calls GetFirewallTrafficShaperLimiterBandwidthsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallTrafficShaperLimiterBandwidths(
+	ctx context.Context,
+	request *pkgclient.GetFirewallTrafficShaperLimiterBandwidthsEndpointRequest,
+	fn func(*pkgclient.GetFirewallTrafficShaperLimiterBandwidthsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallTrafficShaperLimiterBandwidthsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallTrafficShaperLimiterQueues calls GetFirewallTrafficShaperLimiterQueuesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallTrafficShaperLimiterQueues(
+	ctx context.Context,
+	request *pkgclient.GetFirewallTrafficShaperLimiterQueuesEndpointRequest,
+	fn func(*pkgclient.GetFirewallTrafficShaperLimiterQueuesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallTrafficShaperLimiterQueuesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallTrafficShaperLimiters calls GetFirewallTrafficShaperLimitersEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallTrafficShaperLimiters(
+	ctx context.Context,
+	request *pkgclient.GetFirewallTrafficShaperLimitersEndpointRequest,
+	fn func(*pkgclient.GetFirewallTrafficShaperLimitersEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallTrafficShaperLimitersEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallTrafficShaperQueues calls GetFirewallTrafficShaperQueuesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallTrafficShaperQueues(
+	ctx context.Context,
+	request *pkgclient.GetFirewallTrafficShaperQueuesEndpointRequest,
+	fn func(*pkgclient.GetFirewallTrafficShaperQueuesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallTrafficShaperQueuesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallTrafficShapers calls GetFirewallTrafficShapersEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallTrafficShapers(
+	ctx context.Context,
+	request *pkgclient.GetFirewallTrafficShapersEndpointRequest,
+	fn func(*pkgclient.GetFirewallTrafficShapersEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallTrafficShapersEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallVirtualIPs calls GetFirewallVirtualIPsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallVirtualIPs(
+	ctx context.Context,
+	request *pkgclient.GetFirewallVirtualIPsEndpointRequest,
+	fn func(*pkgclient.GetFirewallVirtualIPsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetFirewallVirtualIPsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
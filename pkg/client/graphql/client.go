@@ -11,22 +11,27 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	caller *core.Caller
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
 	options := core.NewRequestOptions(opts...)
 	return &Client{
-		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
 				Client:      options.HTTPClient,
 				MaxAttempts: options.MaxAttempts,
+				Options:     options,
 			},
 		),
-		header: options.ToHeader(),
+	}
+}
+
+// NewClientWithCaller returns a client that issues its calls through the
+// given caller, sharing its options with every other client that does.
+func NewClientWithCaller(caller *core.Caller) *Client {
+	return &Client{
+		caller: caller,
 	}
 }
 
@@ -38,24 +43,23 @@ func (c *Client) PostGraphQlEndpoint(
 ) (*pkgclient.PostGraphQlEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/graphql"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	var response *pkgclient.PostGraphQlEndpointResponse
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:    core.Endpoint{Service: "Graphql", Name: "PostGraphQlEndpoint", Path: "/api/v2/graphql"},
 			URL:         endpointURL,
 			Method:      http.MethodPost,
 			MaxAttempts: options.MaxAttempts,
+			Options:     options,
 			Headers:     headers,
 			Client:      options.HTTPClient,
 			Request:     request,
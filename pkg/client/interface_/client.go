@@ -6,7 +6,6 @@ import (
 	bytes "bytes"
 	context "context"
 	json "encoding/json"
-	errors "errors"
 	fmt "fmt"
 	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
 	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
@@ -17,22 +16,27 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	caller *core.Caller
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
 	options := core.NewRequestOptions(opts...)
 	return &Client{
-		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
 				Client:      options.HTTPClient,
 				MaxAttempts: options.MaxAttempts,
+				Options:     options,
 			},
 		),
-		header: options.ToHeader(),
+	}
+}
+
+// NewClientWithCaller returns a client that issues its calls through the
+// given caller, sharing its options with every other client that does.
+func NewClientWithCaller(caller *core.Caller) *Client {
+	return &Client{
+		caller: caller,
 	}
 }
 
@@ -43,23 +47,20 @@ func (c *Client) GetInterfaceApplyEndpoint(
 ) (*pkgclient.GetInterfaceApplyEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/apply"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -154,9 +155,11 @@ func (c *Client) GetInterfaceApplyEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceApplyEndpoint", Path: "/api/v2/interface/apply"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -176,23 +179,20 @@ func (c *Client) PostInterfaceApplyEndpoint(
 ) (*pkgclient.PostInterfaceApplyEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/apply"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -287,9 +287,11 @@ func (c *Client) PostInterfaceApplyEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PostInterfaceApplyEndpoint", Path: "/api/v2/interface/apply"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -310,10 +312,7 @@ func (c *Client) GetInterfaceAvailableInterfacesEndpoint(
 ) (*pkgclient.GetInterfaceAvailableInterfacesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -336,20 +335,22 @@ func (c *Client) GetInterfaceAvailableInterfacesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -444,9 +445,11 @@ func (c *Client) GetInterfaceAvailableInterfacesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceAvailableInterfacesEndpoint", Path: "/api/v2/interface/available_interfaces"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -466,10 +469,7 @@ func (c *Client) GetInterfaceBridgeEndpoint(
 ) (*pkgclient.GetInterfaceBridgeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -483,14 +483,14 @@ func (c *Client) GetInterfaceBridgeEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -585,9 +585,11 @@ func (c *Client) GetInterfaceBridgeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceBridgeEndpoint", Path: "/api/v2/interface/bridge"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -607,23 +609,20 @@ func (c *Client) PostInterfaceBridgeEndpoint(
 ) (*pkgclient.PostInterfaceBridgeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/bridge"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -718,9 +717,11 @@ func (c *Client) PostInterfaceBridgeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PostInterfaceBridgeEndpoint", Path: "/api/v2/interface/bridge"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -741,10 +742,7 @@ func (c *Client) DeleteInterfaceBridgeEndpoint(
 ) (*pkgclient.DeleteInterfaceBridgeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -758,14 +756,14 @@ func (c *Client) DeleteInterfaceBridgeEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -860,9 +858,11 @@ func (c *Client) DeleteInterfaceBridgeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteInterfaceBridgeEndpoint", Path: "/api/v2/interface/bridge"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -882,23 +882,20 @@ func (c *Client) PatchInterfaceBridgeEndpoint(
 ) (*pkgclient.PatchInterfaceBridgeEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/bridge"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -993,9 +990,11 @@ func (c *Client) PatchInterfaceBridgeEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PatchInterfaceBridgeEndpoint", Path: "/api/v2/interface/bridge"},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1016,10 +1015,7 @@ func (c *Client) GetInterfaceBridgesEndpoint(
 ) (*pkgclient.GetInterfaceBridgesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1042,20 +1038,22 @@ func (c *Client) GetInterfaceBridgesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1150,9 +1148,11 @@ func (c *Client) GetInterfaceBridgesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceBridgesEndpoint", Path: "/api/v2/interface/bridges"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1172,10 +1172,7 @@ func (c *Client) GetInterfaceGreEndpoint(
 ) (*pkgclient.GetInterfaceGreEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1189,14 +1186,14 @@ func (c *Client) GetInterfaceGreEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1291,9 +1288,11 @@ func (c *Client) GetInterfaceGreEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceGreEndpoint", Path: "/api/v2/interface/gre"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1313,23 +1312,20 @@ func (c *Client) PostInterfaceGreEndpoint(
 ) (*pkgclient.PostInterfaceGreEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/gre"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1424,9 +1420,11 @@ func (c *Client) PostInterfaceGreEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PostInterfaceGreEndpoint", Path: "/api/v2/interface/gre"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1447,10 +1445,7 @@ func (c *Client) DeleteInterfaceGreEndpoint(
 ) (*pkgclient.DeleteInterfaceGreEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1464,14 +1459,14 @@ func (c *Client) DeleteInterfaceGreEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1566,9 +1561,11 @@ func (c *Client) DeleteInterfaceGreEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteInterfaceGreEndpoint", Path: "/api/v2/interface/gre"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1588,23 +1585,20 @@ func (c *Client) PatchInterfaceGreEndpoint(
 ) (*pkgclient.PatchInterfaceGreEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/gre"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1699,9 +1693,11 @@ func (c *Client) PatchInterfaceGreEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PatchInterfaceGreEndpoint", Path: "/api/v2/interface/gre"},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1722,10 +1718,7 @@ func (c *Client) GetInterfaceGrEsEndpoint(
 ) (*pkgclient.GetInterfaceGrEsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1748,20 +1741,22 @@ func (c *Client) GetInterfaceGrEsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1856,9 +1851,11 @@ func (c *Client) GetInterfaceGrEsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceGrEsEndpoint", Path: "/api/v2/interface/gres"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1878,10 +1875,7 @@ func (c *Client) DeleteInterfaceGrEsEndpoint(
 ) (*pkgclient.DeleteInterfaceGrEsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1895,20 +1889,22 @@ func (c *Client) DeleteInterfaceGrEsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2003,9 +1999,11 @@ func (c *Client) DeleteInterfaceGrEsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteInterfaceGrEsEndpoint", Path: "/api/v2/interface/gres"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2025,10 +2023,7 @@ func (c *Client) GetInterfaceGroupEndpoint(
 ) (*pkgclient.GetInterfaceGroupEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2042,14 +2037,14 @@ func (c *Client) GetInterfaceGroupEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2144,9 +2139,11 @@ func (c *Client) GetInterfaceGroupEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceGroupEndpoint", Path: "/api/v2/interface/group"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2166,23 +2163,20 @@ func (c *Client) PostInterfaceGroupEndpoint(
 ) (*pkgclient.PostInterfaceGroupEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/group"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2277,9 +2271,11 @@ func (c *Client) PostInterfaceGroupEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PostInterfaceGroupEndpoint", Path: "/api/v2/interface/group"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -2300,10 +2296,7 @@ func (c *Client) DeleteInterfaceGroupEndpoint(
 ) (*pkgclient.DeleteInterfaceGroupEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2317,14 +2310,14 @@ func (c *Client) DeleteInterfaceGroupEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2419,9 +2412,11 @@ func (c *Client) DeleteInterfaceGroupEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteInterfaceGroupEndpoint", Path: "/api/v2/interface/group"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2441,23 +2436,20 @@ func (c *Client) PatchInterfaceGroupEndpoint(
 ) (*pkgclient.PatchInterfaceGroupEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/group"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2552,9 +2544,11 @@ func (c *Client) PatchInterfaceGroupEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PatchInterfaceGroupEndpoint", Path: "/api/v2/interface/group"},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -2575,10 +2569,7 @@ func (c *Client) GetInterfaceGroupsEndpoint(
 ) (*pkgclient.GetInterfaceGroupsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2601,20 +2592,22 @@ func (c *Client) GetInterfaceGroupsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2709,9 +2702,11 @@ func (c *Client) GetInterfaceGroupsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceGroupsEndpoint", Path: "/api/v2/interface/groups"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2731,23 +2726,20 @@ func (c *Client) PutInterfaceGroupsEndpoint(
 ) (*pkgclient.PutInterfaceGroupsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/groups"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2842,9 +2834,11 @@ func (c *Client) PutInterfaceGroupsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PutInterfaceGroupsEndpoint", Path: "/api/v2/interface/groups"},
 			URL:          endpointURL,
 			Method:       http.MethodPut,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -2865,10 +2859,7 @@ func (c *Client) DeleteInterfaceGroupsEndpoint(
 ) (*pkgclient.DeleteInterfaceGroupsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2882,20 +2873,22 @@ func (c *Client) DeleteInterfaceGroupsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2990,9 +2983,11 @@ func (c *Client) DeleteInterfaceGroupsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteInterfaceGroupsEndpoint", Path: "/api/v2/interface/groups"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3012,10 +3007,7 @@ func (c *Client) GetInterfaceLaggEndpoint(
 ) (*pkgclient.GetInterfaceLaggEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3029,14 +3021,14 @@ func (c *Client) GetInterfaceLaggEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3131,9 +3123,11 @@ func (c *Client) GetInterfaceLaggEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceLaggEndpoint", Path: "/api/v2/interface/lagg"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3153,23 +3147,20 @@ func (c *Client) PostInterfaceLaggEndpoint(
 ) (*pkgclient.PostInterfaceLaggEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/lagg"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3264,9 +3255,11 @@ func (c *Client) PostInterfaceLaggEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PostInterfaceLaggEndpoint", Path: "/api/v2/interface/lagg"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -3287,10 +3280,7 @@ func (c *Client) DeleteInterfaceLaggEndpoint(
 ) (*pkgclient.DeleteInterfaceLaggEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3304,14 +3294,14 @@ func (c *Client) DeleteInterfaceLaggEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3406,9 +3396,11 @@ func (c *Client) DeleteInterfaceLaggEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteInterfaceLaggEndpoint", Path: "/api/v2/interface/lagg"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3428,23 +3420,20 @@ func (c *Client) PatchInterfaceLaggEndpoint(
 ) (*pkgclient.PatchInterfaceLaggEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/lagg"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3539,9 +3528,11 @@ func (c *Client) PatchInterfaceLaggEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PatchInterfaceLaggEndpoint", Path: "/api/v2/interface/lagg"},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -3562,10 +3553,7 @@ func (c *Client) GetInterfaceLagGsEndpoint(
 ) (*pkgclient.GetInterfaceLagGsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3588,20 +3576,22 @@ func (c *Client) GetInterfaceLagGsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3696,9 +3686,11 @@ func (c *Client) GetInterfaceLagGsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceLagGsEndpoint", Path: "/api/v2/interface/laggs"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3718,10 +3710,7 @@ func (c *Client) DeleteInterfaceLagGsEndpoint(
 ) (*pkgclient.DeleteInterfaceLagGsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3735,20 +3724,22 @@ func (c *Client) DeleteInterfaceLagGsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3843,9 +3834,11 @@ func (c *Client) DeleteInterfaceLagGsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteInterfaceLagGsEndpoint", Path: "/api/v2/interface/laggs"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3865,10 +3858,7 @@ func (c *Client) GetInterfaceVlanEndpoint(
 ) (*pkgclient.GetInterfaceVlanEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3882,14 +3872,14 @@ func (c *Client) GetInterfaceVlanEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3984,9 +3974,11 @@ func (c *Client) GetInterfaceVlanEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceVlanEndpoint", Path: "/api/v2/interface/vlan"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -4006,23 +3998,20 @@ func (c *Client) PostInterfaceVlanEndpoint(
 ) (*pkgclient.PostInterfaceVlanEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/vlan"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4117,9 +4106,11 @@ func (c *Client) PostInterfaceVlanEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PostInterfaceVlanEndpoint", Path: "/api/v2/interface/vlan"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -4140,10 +4131,7 @@ func (c *Client) DeleteInterfaceVlanEndpoint(
 ) (*pkgclient.DeleteInterfaceVlanEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -4157,14 +4145,14 @@ func (c *Client) DeleteInterfaceVlanEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4259,9 +4247,11 @@ func (c *Client) DeleteInterfaceVlanEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteInterfaceVlanEndpoint", Path: "/api/v2/interface/vlan"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -4281,23 +4271,20 @@ func (c *Client) PatchInterfaceVlanEndpoint(
 ) (*pkgclient.PatchInterfaceVlanEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface/vlan"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4392,9 +4379,11 @@ func (c *Client) PatchInterfaceVlanEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PatchInterfaceVlanEndpoint", Path: "/api/v2/interface/vlan"},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -4415,10 +4404,7 @@ func (c *Client) GetInterfaceVlaNsEndpoint(
 ) (*pkgclient.GetInterfaceVlaNsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -4441,20 +4427,22 @@ func (c *Client) GetInterfaceVlaNsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4549,9 +4537,11 @@ func (c *Client) GetInterfaceVlaNsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetInterfaceVlaNsEndpoint", Path: "/api/v2/interface/vlans"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -4571,10 +4561,7 @@ func (c *Client) DeleteInterfaceVlaNsEndpoint(
 ) (*pkgclient.DeleteInterfaceVlaNsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -4588,20 +4575,22 @@ func (c *Client) DeleteInterfaceVlaNsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4696,9 +4685,11 @@ func (c *Client) DeleteInterfaceVlaNsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteInterfaceVlaNsEndpoint", Path: "/api/v2/interface/vlans"},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -4718,10 +4709,7 @@ func (c *Client) GetNetworkInterfaceEndpoint(
 ) (*pkgclient.GetNetworkInterfaceEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -4735,14 +4723,14 @@ func (c *Client) GetNetworkInterfaceEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4837,9 +4825,11 @@ func (c *Client) GetNetworkInterfaceEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetNetworkInterfaceEndpoint", Path: "/api/v2/interface"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -4859,23 +4849,20 @@ func (c *Client) PostNetworkInterfaceEndpoint(
 ) (*pkgclient.PostNetworkInterfaceEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -4970,9 +4957,11 @@ func (c *Client) PostNetworkInterfaceEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PostNetworkInterfaceEndpoint", Path: "/api/v2/interface", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -4993,10 +4982,7 @@ func (c *Client) DeleteNetworkInterfaceEndpoint(
 ) (*pkgclient.DeleteNetworkInterfaceEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -5013,14 +4999,14 @@ func (c *Client) DeleteNetworkInterfaceEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5115,9 +5101,11 @@ func (c *Client) DeleteNetworkInterfaceEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteNetworkInterfaceEndpoint", Path: "/api/v2/interface", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -5137,23 +5125,20 @@ func (c *Client) PatchNetworkInterfaceEndpoint(
 ) (*pkgclient.PatchNetworkInterfaceEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/interface"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5248,9 +5233,11 @@ func (c *Client) PatchNetworkInterfaceEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "PatchNetworkInterfaceEndpoint", Path: "/api/v2/interface", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -5271,10 +5258,7 @@ func (c *Client) GetNetworkInterfacesEndpoint(
 ) (*pkgclient.GetNetworkInterfacesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -5297,20 +5281,22 @@ func (c *Client) GetNetworkInterfacesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5405,9 +5391,11 @@ func (c *Client) GetNetworkInterfacesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "GetNetworkInterfacesEndpoint", Path: "/api/v2/interfaces"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -5427,10 +5415,7 @@ func (c *Client) DeleteNetworkInterfacesEndpoint(
 ) (*pkgclient.DeleteNetworkInterfacesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -5444,20 +5429,22 @@ func (c *Client) DeleteNetworkInterfacesEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -5552,9 +5539,11 @@ func (c *Client) DeleteNetworkInterfacesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Interface", Name: "DeleteNetworkInterfacesEndpoint", Path: "/api/v2/interfaces", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
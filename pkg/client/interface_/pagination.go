@@ -0,0 +1,228 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package interface_
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	iter "iter"
+)
+
+// AllInterfaceAvailableInterfaces iterates over every object returned by GetInterfaceAvailableInterfacesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllInterfaceAvailableInterfaces(
+	ctx context.Context,
+	request *pkgclient.GetInterfaceAvailableInterfacesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetInterfaceAvailableInterfacesEndpointResponseDataItem, error] {
+	var page pkgclient.GetInterfaceAvailableInterfacesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetInterfaceAvailableInterfacesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetInterfaceAvailableInterfacesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllInterfaceBridges iterates over every object returned by GetInterfaceBridgesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllInterfaceBridges(
+	ctx context.Context,
+	request *pkgclient.GetInterfaceBridgesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetInterfaceBridgesEndpointResponseDataItem, error] {
+	var page pkgclient.GetInterfaceBridgesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetInterfaceBridgesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetInterfaceBridgesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllInterfaceGrEs iterates over every object returned by GetInterfaceGrEsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllInterfaceGrEs(
+	ctx context.Context,
+	request *pkgclient.GetInterfaceGrEsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetInterfaceGrEsEndpointResponseDataItem, error] {
+	var page pkgclient.GetInterfaceGrEsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetInterfaceGrEsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetInterfaceGrEsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllInterfaceGroups iterates over every object returned by GetInterfaceGroupsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllInterfaceGroups(
+	ctx context.Context,
+	request *pkgclient.GetInterfaceGroupsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetInterfaceGroupsEndpointResponseDataItem, error] {
+	var page pkgclient.GetInterfaceGroupsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetInterfaceGroupsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetInterfaceGroupsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllInterfaceLagGs iterates over every object returned by GetInterfaceLagGsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllInterfaceLagGs(
+	ctx context.Context,
+	request *pkgclient.GetInterfaceLagGsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetInterfaceLagGsEndpointResponseDataItem, error] {
+	var page pkgclient.GetInterfaceLagGsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetInterfaceLagGsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetInterfaceLagGsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllInterfaceVlaNs iterates over every object returned by GetInterfaceVlaNsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllInterfaceVlaNs(
+	ctx context.Context,
+	request *pkgclient.GetInterfaceVlaNsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetInterfaceVlaNsEndpointResponseDataItem, error] {
+	var page pkgclient.GetInterfaceVlaNsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetInterfaceVlaNsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetInterfaceVlaNsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllNetworkInterfaces iterates over every object returned by GetNetworkInterfacesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllNetworkInterfaces(
+	ctx context.Context,
+	request *pkgclient.GetNetworkInterfacesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetNetworkInterfacesEndpointResponseDataItem, error] {
+	var page pkgclient.GetNetworkInterfacesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetNetworkInterfacesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetNetworkInterfacesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
@@ -4,7 +4,10 @@ package option
 
 import (
 	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	io "io"
+	slog "log/slog"
 	http "net/http"
+	time "time"
 )
 
 // RequestOption adapts the behavior of an indivdual request.
@@ -40,7 +43,66 @@ func WithMaxAttempts(attempts uint) *core.MaxAttemptsOption {
 	}
 }
 
+// WithMaxRetryDelay caps the delay between retry attempts. Retry-After
+// values sent by the server are honoured up to this limit.
+func WithMaxRetryDelay(delay time.Duration) *core.MaxRetryDelayOption {
+	return &core.MaxRetryDelayOption{
+		MaxRetryDelay: delay,
+	}
+}
+
+// WithRetryBaseDelay sets the base delay of the exponential back-off
+// between retry attempts.
+func WithRetryBaseDelay(delay time.Duration) *core.RetryBaseDelayOption {
+	return &core.RetryBaseDelayOption{
+		RetryBaseDelay: delay,
+	}
+}
+
+// WithRetryJitter sets the fraction of each retry delay, between 0 and 1,
+// that is randomized. A jitter of 0 makes the back-off deterministic.
+func WithRetryJitter(jitter float64) *core.RetryJitterOption {
+	return &core.RetryJitterOption{
+		RetryJitter: jitter,
+	}
+}
+
+// WithRetryPolicy sets the policy that decides which failed attempts are
+// retried. The default, core.DefaultRetryPolicy, never replays a POST that
+// may have been applied.
+func WithRetryPolicy(policy core.RetryPolicy) *core.RetryPolicyOption {
+	return &core.RetryPolicyOption{
+		RetryPolicy: policy,
+	}
+}
+
+// WithMiddleware wraps the HTTP client used for every attempt with the
+// given middleware. Repeated calls compose in order, the first being the
+// outermost. Client-level middleware wraps request-level middleware.
+func WithMiddleware(middleware ...core.Middleware) *core.MiddlewareOption {
+	return &core.MiddlewareOption{
+		Middleware: middleware,
+	}
+}
+
+// WithInterceptor is like WithMiddleware, but the interceptor is also told
+// which endpoint is being called, e.g. "GetFirewallRulesEndpoint".
+func WithInterceptor(interceptor core.Interceptor) *core.MiddlewareOption {
+	return &core.MiddlewareOption{
+		Middleware: []core.Middleware{interceptor.Middleware()},
+	}
+}
+
+// WithBodyRewriter rewrites the fields of the JSON body of every attempt,
+// e.g. to send an empty list that the generated request omits.
+func WithBodyRewriter(rewriter core.BodyRewriter) *core.MiddlewareOption {
+	return &core.MiddlewareOption{
+		Middleware: []core.Middleware{rewriter.Middleware()},
+	}
+}
+
 // WithBasicAuth sets the 'Authorization: Basic <base64>' request header.
+// Only the header for the last configured auth mode is sent.
 func WithBasicAuth(username, password string) *core.BasicAuthOption {
 	return &core.BasicAuthOption{
 		Username: username,
@@ -48,9 +110,180 @@ func WithBasicAuth(username, password string) *core.BasicAuthOption {
 	}
 }
 
-// WithAPIKey sets the apiKey request header.
+// WithAPIKey sets the x-api-key request header. Only the header for the
+// last configured auth mode is sent.
 func WithAPIKey(apiKey string) *core.APIKeyOption {
 	return &core.APIKeyOption{
 		APIKey: apiKey,
 	}
 }
+
+// WithJWTAuth authenticates with a JWT obtained from /api/v2/auth/jwt using
+// the given credentials. The token is acquired on first use, cached, and
+// refreshed shortly before it expires. A request rejected with a 401 is
+// retried once with a fresh token.
+//
+// Pass the same option to every client that should share the token.
+func WithJWTAuth(username, password string) *core.AuthenticatorOption {
+	return WithAuthenticator(
+		&core.BearerAuth{
+			Source: &core.JWTTokenSource{
+				Username: username,
+				Password: password,
+			},
+		},
+	)
+}
+
+// WithBearerAuth sets the 'Authorization: Bearer <token>' request header
+// using a token from the given source, e.g. core.StaticToken.
+func WithBearerAuth(source core.TokenSource) *core.AuthenticatorOption {
+	return WithAuthenticator(
+		&core.BearerAuth{
+			Source: source,
+		},
+	)
+}
+
+// WithAuthenticator authenticates requests with the given Authenticator,
+// e.g. one backed by rotating credentials:
+//
+//	option.WithAuthenticator(&core.APIKeyAuth{
+//		Credentials: &core.FileCredentials{Path: "/run/secrets/pfsense-api-key", Field: "api_key"},
+//	})
+func WithAuthenticator(authenticator core.Authenticator) *core.AuthenticatorOption {
+	return &core.AuthenticatorOption{
+		Authenticator: authenticator,
+	}
+}
+
+// WithRawResponse fills raw with the status code, headers, undecoded body
+// and elapsed time of the call's HTTP response. It is filled for error
+// responses too, so it can be used to inspect what the server actually
+// returned:
+//
+//	var raw core.RawResponse
+//	rules, err := c.Firewall.GetFirewallRulesEndpoint(ctx, request, option.WithRawResponse(&raw))
+//	fmt.Println(raw.StatusCode, raw.Header.Get("Content-Type"), string(raw.Body))
+//
+// Use it as a per-call option only.
+func WithRawResponse(raw *core.RawResponse) *core.RawResponseOption {
+	return &core.RawResponseOption{
+		RawResponse: raw,
+	}
+}
+
+// WithPageSize sets the number of objects the All* iterators fetch per
+// page. It takes precedence over the request's Limit; the default is 100.
+func WithPageSize(size int) *core.PageSizeOption {
+	return &core.PageSizeOption{
+		PageSize: size,
+	}
+}
+
+// WithPrefetch makes the All* iterators fetch the next page in the
+// background while the current one is being consumed.
+func WithPrefetch() *core.PrefetchOption {
+	return &core.PrefetchOption{
+		Prefetch: true,
+	}
+}
+
+// WithResponseDecoder consumes the body of a successful response with the
+// given decoder instead of decoding it into the endpoint's response type,
+// which is then returned empty. The Stream* methods use it to decode large
+// lists item by item.
+func WithResponseDecoder(decoder core.ResponseDecoder) *core.ResponseDecoderOption {
+	return &core.ResponseDecoderOption{
+		ResponseDecoder: decoder,
+	}
+}
+
+// WithRateLimit limits the client to rps requests per second on average,
+// with bursts of up to burst requests. Every attempt counts, including
+// retries. It applies to the client as a whole, across all services.
+func WithRateLimit(rps float64, burst int) *core.RateLimitOption {
+	return &core.RateLimitOption{
+		RateLimit:      rps,
+		RateLimitBurst: burst,
+	}
+}
+
+// WithWriteLock serializes POST, PUT, PATCH and DELETE calls to each pfSense
+// base URL, so that only one runs at a time while reads stay concurrent.
+// pfSense serializes configuration writes itself and may fail concurrent
+// ones with a 409 or 500. The lock is shared by every client in the process
+// that enables it.
+func WithWriteLock() *core.WriteLockOption {
+	return &core.WriteLockOption{
+		WriteLock: true,
+	}
+}
+
+// WithCircuitBreaker enables a circuit breaker for each firewall the client
+// talks to. After breaker.Threshold consecutive transport errors or 5xx
+// responses, calls fail fast with an error matching core.ErrCircuitOpen
+// until breaker.Cooldown has passed, e.g.
+//
+//	option.WithCircuitBreaker(core.CircuitBreaker{
+//		Threshold: 5,
+//		Cooldown:  time.Minute,
+//		OnStateChange: func(baseURL string, from, to core.CircuitState) {
+//			log.Printf("%s: circuit %s", baseURL, to)
+//		},
+//	})
+func WithCircuitBreaker(breaker core.CircuitBreaker) *core.CircuitBreakerOption {
+	return &core.CircuitBreakerOption{
+		CircuitBreaker: breaker,
+	}
+}
+
+// WithTracer starts a span for every call, named after the generated
+// method, e.g. "Firewall.PatchFirewallRuleEndpoint". See core.Tracer for
+// the attributes it carries.
+func WithTracer(tracer core.Tracer) *core.TracerOption {
+	return &core.TracerOption{
+		Tracer: tracer,
+	}
+}
+
+// WithMeter records call counts, errors, retries and latency through the
+// given meter. See the core.Metric* constants for the instruments it creates.
+func WithMeter(meter core.Meter) *core.MeterOption {
+	return &core.MeterOption{
+		Meter: meter,
+	}
+}
+
+// WithLogger logs every request and response at debug level, with their
+// method, URL, status, duration, attempt number, headers and body, and
+// every retry at warn level. The Authorization and X-Api-Key headers and
+// secret body fields such as password and privatekey are always redacted.
+func WithLogger(logger *slog.Logger) *core.LoggerOption {
+	return &core.LoggerOption{
+		Logger: logger,
+	}
+}
+
+// WithCurl writes an equivalent curl command for every request to w, e.g.
+// to attach a reproduction to a bug report. Credentials are read from the
+// PFREST_* environment variables in the command instead of being written
+// out, and secret body fields are redacted.
+func WithCurl(w io.Writer) *core.CurlOption {
+	return &core.CurlOption{
+		Curl: w,
+	}
+}
+
+// WithHAR records every request and response, with their timings, in
+// recorder, which can then be written out as a HAR 1.2 file:
+//
+//	recorder := core.NewHARRecorder()
+//	c := client.NewClient(option.WithHAR(recorder))
+//	// ... make calls ...
+//	recorder.WriteTo(f)
+func WithHAR(recorder *core.HARRecorder) *core.HAROption {
+	return &core.HAROption{
+		HAR: recorder,
+	}
+}
@@ -0,0 +1,13739 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package pfclientapi
+
+import json "encoding/json"
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AcmeAccountKey) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AcmeAccountKeyRegister) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AcmeCertificate) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AcmeCertificateAActionlistItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AcmeCertificateADomainlistItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AcmeCertificateAction) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AcmeCertificateDomain) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AcmeCertificateIssue) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AcmeCertificateRenew) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AcmeSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *ArpTable) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AuthLog) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AuthServer) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AuthenticationError) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AvailableInterface) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *AvailablePackage) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BadRequestErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BindAccessList) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BindAccessListEntriesItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BindAccessListEntry) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BindSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BindSyncRemoteHost) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BindSyncSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BindView) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BindZone) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BindZoneRecord) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *BindZoneRecordsItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *Carp) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *Certificate) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificateAuthority) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificateAuthorityGenerate) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificateAuthorityRenew) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificateGenerate) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificatePkcs12Export) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificateRenew) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificateRevocationList) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificateRevocationListCertItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificateRevocationListRevokedCertificate) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificateSigningRequest) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CertificateSigningRequestSign) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CommandPrompt) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *ConfigHistoryRevision) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *ConflictErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *CronJob) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSForwarderApply) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSForwarderHostOverride) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSForwarderHostOverrideAlias) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSForwarderHostOverrideAliasesItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSResolverAccessList) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSResolverAccessListNetwork) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSResolverAccessListNetworksItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSResolverApply) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSResolverDomainOverride) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSResolverHostOverride) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSResolverHostOverrideAlias) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSResolverHostOverrideAliasesItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DNSResolverSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DefaultGateway) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteAuthKeyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteAuthKeyEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteAuthKeysEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteAuthKeysEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteDiagnosticsArpTableEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteDiagnosticsArpTableEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteDiagnosticsArpTableEntryEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteDiagnosticsArpTableEntryEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteDiagnosticsConfigHistoryRevisionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteDiagnosticsConfigHistoryRevisionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteDiagnosticsConfigHistoryRevisionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteDiagnosticsConfigHistoryRevisionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteDiagnosticsTableEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteDiagnosticsTableEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallAliasesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallAliasesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatOneToOneMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatOneToOneMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatOneToOneMappingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatOneToOneMappingsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatOutboundMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatOutboundMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatOutboundMappingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatOutboundMappingsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatPortForwardEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatPortForwardEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatPortForwardsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallNatPortForwardsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallRuleEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallRuleEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallRulesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallRulesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallScheduleEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallScheduleEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallScheduleTimeRangeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallScheduleTimeRangeEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallScheduleTimeRangesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallScheduleTimeRangesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallSchedulesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallSchedulesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallStateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallStateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallStatesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallStatesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperLimiterBandwidthEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperLimiterBandwidthEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperLimiterBandwidthsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperLimiterBandwidthsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperLimiterEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperLimiterEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperLimiterQueueEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperLimiterQueueEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperLimiterQueuesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperLimiterQueuesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperQueueEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperQueueEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperQueuesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShaperQueuesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShapersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallTrafficShapersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallVirtualIPEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallVirtualIPEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallVirtualIPsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteFirewallVirtualIPsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceBridgeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceBridgeEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceGrEsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceGrEsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceGreEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceGreEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceGroupsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceGroupsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceLagGsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceLagGsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceLaggEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceLaggEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceVlaNsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceVlaNsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceVlanEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteInterfaceVlanEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteNetworkInterfaceEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteNetworkInterfaceEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteNetworkInterfacesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteNetworkInterfacesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewayEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewayEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewayGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewayGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewayGroupPrioritiesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewayGroupPrioritiesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewayGroupPriorityEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewayGroupPriorityEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewayGroupsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewayGroupsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewaysEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingGatewaysEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingStaticRouteEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingStaticRouteEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingStaticRoutesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteRoutingStaticRoutesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeAccountKeyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeAccountKeyEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeAccountKeysEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeAccountKeysEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeCertificateActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeCertificateActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeCertificateDomainEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeCertificateDomainEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeCertificatesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesAcmeCertificatesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindAccessListEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindAccessListEntriesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindAccessListEntriesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindAccessListEntryEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindAccessListEntryEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindAccessListsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindAccessListsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindSyncRemoteHostEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindSyncRemoteHostEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindSyncRemoteHostsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindSyncRemoteHostsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindViewEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindViewEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindViewsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindViewsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindZoneEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindZoneEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindZoneRecordEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindZoneRecordEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindZonesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesBindZonesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesCronJobEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesCronJobEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesCronJobsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesCronJobsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSForwarderHostOverrideAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSForwarderHostOverrideAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSForwarderHostOverrideAliasesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSForwarderHostOverrideAliasesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSForwarderHostOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSForwarderHostOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSForwarderHostOverridesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSForwarderHostOverridesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverAccessListEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverAccessListNetworkEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverAccessListNetworkEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverAccessListNetworksEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverAccessListNetworksEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverAccessListsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverAccessListsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverDomainOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverDomainOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverDomainOverridesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverDomainOverridesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverHostOverrideAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverHostOverrideAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverHostOverrideAliasesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverHostOverrideAliasesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverHostOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverHostOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverHostOverridesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDNSResolverHostOverridesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerAddressPoolEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerAddressPoolEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerAddressPoolsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerAddressPoolsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerCustomOptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerCustomOptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerCustomOptionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerCustomOptionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerStaticMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerStaticMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerStaticMappingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesDhcpServerStaticMappingsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusClientEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusClientEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusClientsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusClientsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusInterfaceEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusInterfaceEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusInterfacesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusInterfacesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusUserEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusUserEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusUsersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesFreeRadiusUsersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendACLEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendACLEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendAcLsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendAcLsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendActionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendActionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendErrorFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendErrorFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendErrorFilesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendErrorFilesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyBackendsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFilesResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFilesResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendACLEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendACLEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendAcLsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendAcLsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendActionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendActionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendAddressEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendAddressEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendAddressesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendAddressesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendCertificatesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendCertificatesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendErrorFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendErrorFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendErrorFilesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendErrorFilesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxyFrontendsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxySettingsDNSResolverEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxySettingsDNSResolverEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxySettingsDNSResolversEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxySettingsDNSResolversEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxySettingsEmailMailerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxySettingsEmailMailerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxySettingsEmailMailersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesHaProxySettingsEmailMailersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesNtpTimeServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesNtpTimeServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesNtpTimeServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesNtpTimeServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesServiceWatchdogEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesServiceWatchdogEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesServiceWatchdogsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteServicesServiceWatchdogsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteStatusDhcpServerLeasesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteStatusDhcpServerLeasesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteStatusOpenVpnServerConnectionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteStatusOpenVpnServerConnectionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteStatusOpenVpnServerConnectionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteStatusOpenVpnServerConnectionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCertificateAuthoritiesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCertificateAuthoritiesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCertificateAuthorityEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCertificateAuthorityEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCertificatesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCertificatesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCrLsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCrLsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCrlEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCrlEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCrlRevokedCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemCrlRevokedCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemPackageEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemPackageEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemPackagesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemPackagesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemRestapiAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemRestapiAccessListEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemRestapiAccessListEntryEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemRestapiAccessListEntryEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemTunableEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemTunableEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemTunablesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteSystemTunablesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUserAuthServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUserAuthServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUserAuthServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUserAuthServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUserEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUserEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUserGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUserGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUserGroupsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUserGroupsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUsersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteUsersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnClientEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnClientEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnClientExportConfigEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnClientExportConfigEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnClientExportConfigsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnClientExportConfigsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnClientsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnClientsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpnServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpncsOsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpncsOsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpncsoEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnOpenVpncsoEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardPeerAllowedIPEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardPeerAllowedIPEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardPeerAllowedIPsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardPeerAllowedIPsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardPeerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardPeerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardPeersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardPeersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardTunnelAddressEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardTunnelAddressEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardTunnelAddressesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardTunnelAddressesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardTunnelEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardTunnelEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardTunnelsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpnWireGuardTunnelsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase1EncryptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase1EncryptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase1EncryptionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase1EncryptionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase1EndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase1EndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase1SEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase1SEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase2EncryptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase2EncryptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase2EncryptionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase2EncryptionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase2EndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase2EndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase2SEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DeleteVpniPsecPhase2SEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpLog) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpRelay) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpServer) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpServerAddressPool) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpServerApply) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpServerBackend) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpServerCustomOption) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpServerLease) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpServerNumberoptionsItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpServerPoolItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpServerStaticMapping) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *DhcpServerStaticmapItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *EmailNotificationSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FailedDependencyErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FirewallAdvancedSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FirewallAlias) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FirewallApply) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FirewallLog) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FirewallRule) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FirewallSchedule) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FirewallScheduleTimeRange) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FirewallScheduleTimerangeItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FirewallState) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FirewallStatesSize) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *ForbiddenErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FreeRadiusClient) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FreeRadiusInterface) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *FreeRadiusUser) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetAuthKeysEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetAuthKeysEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsArpTableEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsArpTableEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsArpTableEntryEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsArpTableEntryEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsConfigHistoryRevisionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsConfigHistoryRevisionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsConfigHistoryRevisionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsConfigHistoryRevisionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsTableEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsTableEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsTablesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetDiagnosticsTablesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallAdvancedSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallAliasesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallAliasesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatOneToOneMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatOneToOneMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatOneToOneMappingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatOneToOneMappingsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatOutboundMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatOutboundMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatOutboundMappingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatOutboundMappingsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatOutboundModeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatPortForwardEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatPortForwardEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatPortForwardsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallNatPortForwardsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallRuleEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallRuleEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallRulesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallRulesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallScheduleEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallScheduleEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallScheduleTimeRangeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallScheduleTimeRangeEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallScheduleTimeRangesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallScheduleTimeRangesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallSchedulesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallSchedulesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallStateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallStateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallStatesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallStatesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallStatesSizeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimiterBandwidthEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimiterBandwidthEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimiterBandwidthsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimiterBandwidthsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimiterEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimiterEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimiterQueueEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimiterQueueEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimiterQueuesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimiterQueuesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimitersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperLimitersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperQueueEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperQueueEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperQueuesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShaperQueuesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShapersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallTrafficShapersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallVirtualIPApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallVirtualIPEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallVirtualIPEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallVirtualIPsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetFirewallVirtualIPsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceAvailableInterfacesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceAvailableInterfacesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceBridgeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceBridgeEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceBridgesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceBridgesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceGrEsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceGrEsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceGreEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceGreEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceGroupsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceGroupsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceLagGsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceLagGsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceLaggEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceLaggEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceVlaNsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceVlaNsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceVlanEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetInterfaceVlanEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetNetworkInterfaceEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetNetworkInterfaceEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetNetworkInterfacesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetNetworkInterfacesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayDefaultEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayGroupPrioritiesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayGroupPrioritiesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayGroupPriorityEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayGroupPriorityEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayGroupsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewayGroupsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewaysEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingGatewaysEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingStaticRouteEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingStaticRouteEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingStaticRoutesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetRoutingStaticRoutesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeAccountKeyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeAccountKeyEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeAccountKeyRegistrationsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeAccountKeyRegistrationsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeAccountKeysEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeAccountKeysEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificateActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificateActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificateDomainEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificateDomainEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificateIssuancesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificateIssuancesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificateRenewalsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificateRenewalsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificatesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeCertificatesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesAcmeSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindAccessListEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindAccessListEntriesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindAccessListEntriesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindAccessListEntryEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindAccessListEntryEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindAccessListsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindAccessListsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindSyncRemoteHostEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindSyncRemoteHostEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindSyncRemoteHostsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindSyncRemoteHostsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindSyncSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindViewEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindViewEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindViewsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindViewsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindZoneEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindZoneEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindZoneRecordEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindZoneRecordEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindZonesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesBindZonesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesCronJobEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesCronJobEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesCronJobsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesCronJobsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSForwarderApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSForwarderHostOverrideAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSForwarderHostOverrideAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSForwarderHostOverrideAliasesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSForwarderHostOverrideAliasesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSForwarderHostOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSForwarderHostOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSForwarderHostOverridesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSForwarderHostOverridesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverAccessListEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverAccessListNetworkEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverAccessListNetworkEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverAccessListNetworksEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverAccessListNetworksEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverAccessListsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverAccessListsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverDomainOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverDomainOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverDomainOverridesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverDomainOverridesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverHostOverrideAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverHostOverrideAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverHostOverrideAliasesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverHostOverrideAliasesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverHostOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverHostOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverHostOverridesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverHostOverridesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDNSResolverSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpRelayEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerAddressPoolEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerAddressPoolEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerAddressPoolsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerAddressPoolsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerCustomOptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerCustomOptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerCustomOptionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerCustomOptionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerStaticMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerStaticMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerStaticMappingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServerStaticMappingsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesDhcpServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusClientEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusClientEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusClientsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusClientsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusInterfaceEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusInterfaceEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusInterfacesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusInterfacesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusUserEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusUserEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusUsersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesFreeRadiusUsersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendACLEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendACLEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendAcLsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendAcLsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendActionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendActionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendErrorFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendErrorFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendErrorFilesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendErrorFilesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyBackendsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFilesResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFilesResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendACLEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendACLEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendAcLsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendAcLsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendActionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendActionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendAddressEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendAddressEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendAddressesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendAddressesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendCertificatesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendCertificatesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendErrorFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendErrorFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendErrorFilesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendErrorFilesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxyFrontendsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxySettingsDNSResolverEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxySettingsDNSResolverEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxySettingsDNSResolversEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxySettingsDNSResolversEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxySettingsEmailMailerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxySettingsEmailMailerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxySettingsEmailMailersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxySettingsEmailMailersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesHaProxySettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesNtpSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesNtpTimeServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesNtpTimeServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesNtpTimeServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesNtpTimeServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesSSHEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesServiceWatchdogEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesServiceWatchdogEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesServiceWatchdogsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetServicesServiceWatchdogsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusCarpEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusDhcpServerLeasesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusDhcpServerLeasesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusGatewaysEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusGatewaysEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusIPsecChildSAsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusIPsecChildSAsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusIPsecChildSaEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusIPsecChildSaEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusIPsecSAsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusIPsecSAsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusInterfacesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusInterfacesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsAuthEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsAuthEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsDhcpEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsDhcpEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsFirewallEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsFirewallEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsOpenVpnEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsOpenVpnEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsPackagesRestapiEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsPackagesRestapiEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsSystemEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusLogsSystemEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnClientsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnClientsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnServerConnectionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnServerConnectionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnServerConnectionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnServerConnectionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnServerRouteEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnServerRouteEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnServerRoutesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnServerRoutesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusOpenVpnServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusServicesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusServicesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetStatusSystemEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCertificateAuthoritiesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCertificateAuthoritiesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCertificateAuthorityEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCertificateAuthorityEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCertificatesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCertificatesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemConsoleEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCrLsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCrLsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCrlEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCrlEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCrlRevokedCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemCrlRevokedCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemDNSEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemHostnameEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemNotificationsEmailSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemPackageAvailableEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemPackageAvailableEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemPackageEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemPackageEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemPackagesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemPackagesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemRestapiAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemRestapiAccessListEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemRestapiAccessListEntryEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemRestapiAccessListEntryEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemRestapiSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemRestapiVersionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemTimezoneEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemTunableEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemTunableEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemTunablesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemTunablesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemVersionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetSystemWebGuiSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUserAuthServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUserAuthServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUserAuthServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUserAuthServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUserEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUserEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUserGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUserGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUserGroupsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUserGroupsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUsersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetUsersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnClientEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnClientEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnClientExportConfigEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnClientExportConfigEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnClientExportConfigsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnClientExportConfigsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnClientsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnClientsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpnServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpncsOsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpncsOsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpncsoEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnOpenVpncsoEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardPeerAllowedIPEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardPeerAllowedIPEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardPeerAllowedIPsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardPeerAllowedIPsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardPeerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardPeerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardPeersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardPeersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardTunnelAddressEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardTunnelAddressEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardTunnelAddressesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardTunnelAddressesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardTunnelEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardTunnelEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardTunnelsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpnWireGuardTunnelsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase1EncryptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase1EncryptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase1EncryptionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase1EncryptionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase1EndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase1EndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase1SEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase1SEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase2EncryptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase2EncryptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase2EncryptionsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase2EncryptionsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase2EndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase2EndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase2SEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *GetVpniPsecPhase2SEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyApply) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyBackend) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyBackendACL) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyBackendACLsItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyBackendAction) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyBackendActionsItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyBackendErrorFile) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyBackendErrorfilesItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyBackendServer) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyBackendServersItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyDNSResolver) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyEmailMailer) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFile) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontend) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontendAActionitemsItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontendACL) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontendAErrorfilesItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontendAExtaddrItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontendAction) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontendAddress) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontendCertificate) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontendErrorFile) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontendHaACLsItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxyFrontendHaCertificatesItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxySettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxySettingsDNSResolversItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *HaProxySettingsEmailMailersItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *IPsecApply) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *IPsecChildSaStatus) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *IPsecPhase1) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *IPsecPhase1Encryption) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *IPsecPhase1EncryptionItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *IPsecPhase2) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *IPsecPhase2Encryption) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *IPsecPhase2EncryptionAlgorithmOptionItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *IPsecSaStatus) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *IPsecSaStatusChildSasItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *InterfaceApply) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *InterfaceBridge) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *InterfaceGre) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *InterfaceGroup) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *InterfaceLagg) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *InterfaceStats) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *InterfaceVlan) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *InternalServerErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *LogSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *MediaTypeError) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *MethodNotAllowedErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *NetworkInterface) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *NotAcceptableErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *NotFoundErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *NtpSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *NtpTimeServer) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OneToOneNatMapping) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnClient) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnClientExport) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnClientExportConfig) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnClientSpecificOverride) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnClientStatus) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnLog) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnServer) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnServerConnectionStatus) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnServerRouteStatus) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnServerStatus) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnServerStatusConnsItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OpenVpnServerStatusRoutesItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OutboundNatMapping) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *OutboundNatMode) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *Package) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallAdvancedSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallNatOneToOneMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallNatOneToOneMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallNatOutboundMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallNatOutboundMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallNatOutboundModeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallNatPortForwardEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallNatPortForwardEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallRuleEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallRuleEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallScheduleEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallScheduleEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallScheduleTimeRangeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallScheduleTimeRangeEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallStatesSizeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallTrafficShaperEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallTrafficShaperEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallTrafficShaperLimiterBandwidthEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallTrafficShaperLimiterBandwidthEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallTrafficShaperLimiterEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallTrafficShaperLimiterEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallTrafficShaperLimiterQueueEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallTrafficShaperLimiterQueueEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallTrafficShaperQueueEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallTrafficShaperQueueEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallVirtualIPEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchFirewallVirtualIPEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchInterfaceBridgeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchInterfaceBridgeEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchInterfaceGreEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchInterfaceGreEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchInterfaceGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchInterfaceGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchInterfaceLaggEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchInterfaceLaggEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchInterfaceVlanEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchInterfaceVlanEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchNetworkInterfaceEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchNetworkInterfaceEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchRoutingGatewayDefaultEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchRoutingGatewayEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchRoutingGatewayEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchRoutingGatewayGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchRoutingGatewayGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchRoutingGatewayGroupPriorityEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchRoutingGatewayGroupPriorityEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchRoutingStaticRouteEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchRoutingStaticRouteEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesAcmeAccountKeyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesAcmeAccountKeyEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesAcmeCertificateActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesAcmeCertificateActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesAcmeCertificateDomainEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesAcmeCertificateDomainEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesAcmeCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesAcmeCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesAcmeSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindAccessListEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindAccessListEntryEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindAccessListEntryEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindSyncRemoteHostEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindSyncRemoteHostEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindSyncSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindViewEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindViewEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindZoneEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindZoneEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindZoneRecordEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesBindZoneRecordEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesCronJobEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesCronJobEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSForwarderHostOverrideAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSForwarderHostOverrideAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSForwarderHostOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSForwarderHostOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverAccessListEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverAccessListNetworkEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverAccessListNetworkEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverDomainOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverDomainOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverHostOverrideAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverHostOverrideAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverHostOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverHostOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDNSResolverSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDhcpRelayEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDhcpServerAddressPoolEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDhcpServerAddressPoolEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDhcpServerBackendEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDhcpServerCustomOptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDhcpServerCustomOptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDhcpServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDhcpServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDhcpServerStaticMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesDhcpServerStaticMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesFreeRadiusClientEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesFreeRadiusClientEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesFreeRadiusInterfaceEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesFreeRadiusInterfaceEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesFreeRadiusUserEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesFreeRadiusUserEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyBackendACLEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyBackendACLEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyBackendActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyBackendActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyBackendEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyBackendEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyBackendErrorFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyBackendErrorFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyBackendServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyBackendServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendACLEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendACLEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendAddressEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendAddressEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendErrorFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxyFrontendErrorFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxySettingsDNSResolverEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxySettingsDNSResolverEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxySettingsEmailMailerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxySettingsEmailMailerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesHaProxySettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesNtpSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesNtpTimeServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesNtpTimeServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesSSHEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesServiceWatchdogEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchServicesServiceWatchdogEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchStatusCarpEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchStatusLogsSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemCertificateAuthorityEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemCertificateAuthorityEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemConsoleEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemCrlEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemCrlEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemCrlRevokedCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemCrlRevokedCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemDNSEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemHostnameEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemNotificationsEmailSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemRestapiAccessListEntryEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemRestapiAccessListEntryEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemRestapiSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemRestapiVersionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemTimezoneEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemTunableEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemTunableEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchSystemWebGuiSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchUserAuthServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchUserAuthServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchUserEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchUserEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchUserGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchUserGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnOpenVpnClientEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnOpenVpnClientEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnOpenVpnClientExportConfigEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnOpenVpnClientExportConfigEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnOpenVpnServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnOpenVpnServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnOpenVpncsoEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnOpenVpncsoEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnWireGuardPeerAllowedIPEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnWireGuardPeerAllowedIPEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnWireGuardPeerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnWireGuardPeerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnWireGuardSettingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnWireGuardTunnelAddressEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnWireGuardTunnelAddressEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnWireGuardTunnelEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpnWireGuardTunnelEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpniPsecPhase1EncryptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpniPsecPhase1EncryptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpniPsecPhase1EndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpniPsecPhase1EndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpniPsecPhase2EncryptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpniPsecPhase2EncryptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpniPsecPhase2EndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PatchVpniPsecPhase2EndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *Ping) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PortForward) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostAuthJwtEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostAuthKeyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostAuthKeyEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostDiagnosticsCommandPromptEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostDiagnosticsHaltSystemEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostDiagnosticsPingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostDiagnosticsRebootEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallNatOneToOneMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallNatOneToOneMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallNatOutboundMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallNatOutboundMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallNatPortForwardEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallNatPortForwardEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallRuleEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallRuleEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallScheduleEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallScheduleEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallScheduleTimeRangeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallScheduleTimeRangeEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallTrafficShaperEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallTrafficShaperEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallTrafficShaperLimiterBandwidthEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallTrafficShaperLimiterBandwidthEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallTrafficShaperLimiterEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallTrafficShaperLimiterEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallTrafficShaperLimiterQueueEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallTrafficShaperLimiterQueueEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallTrafficShaperQueueEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallTrafficShaperQueueEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallVirtualIPApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallVirtualIPEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostFirewallVirtualIPEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostGraphQlEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceBridgeEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceBridgeEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceGreEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceGreEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceLaggEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceLaggEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceVlanEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostInterfaceVlanEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostNetworkInterfaceEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostNetworkInterfaceEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostRoutingApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostRoutingGatewayEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostRoutingGatewayEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostRoutingGatewayGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostRoutingGatewayGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostRoutingGatewayGroupPriorityEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostRoutingGatewayGroupPriorityEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostRoutingStaticRouteEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostRoutingStaticRouteEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeAccountKeyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeAccountKeyEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeAccountKeyRegisterEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeAccountKeyRegisterEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeCertificateActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeCertificateActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeCertificateDomainEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeCertificateDomainEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeCertificateIssueEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeCertificateIssueEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeCertificateRenewEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesAcmeCertificateRenewEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindAccessListEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindAccessListEntryEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindAccessListEntryEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindSyncRemoteHostEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindSyncRemoteHostEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindViewEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindViewEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindZoneEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindZoneEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindZoneRecordEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesBindZoneRecordEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesCronJobEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesCronJobEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSForwarderApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSForwarderHostOverrideAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSForwarderHostOverrideAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSForwarderHostOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSForwarderHostOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverAccessListEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverAccessListNetworkEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverAccessListNetworkEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverDomainOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverDomainOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverHostOverrideAliasEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverHostOverrideAliasEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverHostOverrideEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDNSResolverHostOverrideEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDhcpServerAddressPoolEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDhcpServerAddressPoolEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDhcpServerApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDhcpServerCustomOptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDhcpServerCustomOptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDhcpServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDhcpServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDhcpServerStaticMappingEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesDhcpServerStaticMappingEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesFreeRadiusClientEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesFreeRadiusClientEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesFreeRadiusInterfaceEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesFreeRadiusInterfaceEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesFreeRadiusUserEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesFreeRadiusUserEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyBackendACLEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyBackendACLEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyBackendActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyBackendActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyBackendEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyBackendEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyBackendErrorFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyBackendErrorFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyBackendServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyBackendServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendACLEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendACLEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendActionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendActionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendAddressEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendAddressEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendErrorFileEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxyFrontendErrorFileEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxySettingsDNSResolverEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxySettingsDNSResolverEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxySettingsEmailMailerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesHaProxySettingsEmailMailerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesNtpTimeServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesNtpTimeServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesServiceWatchdogEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesServiceWatchdogEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostServicesWakeOnLanSendEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostStatusServiceEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostStatusServiceEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateAuthorityEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateAuthorityEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateAuthorityGenerateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateAuthorityGenerateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateAuthorityRenewEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateGenerateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateGenerateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateRenewEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateSigningRequestEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateSigningRequestEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateSigningRequestSignEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCertificateSigningRequestSignEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCrlEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCrlEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCrlRevokedCertificateEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemCrlRevokedCertificateEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemPackageEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemPackageEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemRestapiAccessListEntryEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemRestapiAccessListEntryEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemRestapiSettingsSyncEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemTunableEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostSystemTunableEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostUserAuthServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostUserAuthServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostUserEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostUserEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostUserGroupEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostUserGroupEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnOpenVpnClientEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnOpenVpnClientEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnOpenVpnClientExportConfigEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnOpenVpnClientExportConfigEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnOpenVpnClientExportEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnOpenVpnClientExportEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnOpenVpnServerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnOpenVpnServerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnOpenVpncsoEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnOpenVpncsoEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnWireGuardApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnWireGuardPeerAllowedIPEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnWireGuardPeerAllowedIPEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnWireGuardPeerEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnWireGuardPeerEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnWireGuardTunnelAddressEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnWireGuardTunnelAddressEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnWireGuardTunnelEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpnWireGuardTunnelEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpniPsecApplyEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpniPsecPhase1EncryptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpniPsecPhase1EncryptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpniPsecPhase1EndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpniPsecPhase1EndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpniPsecPhase2EncryptionEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpniPsecPhase2EncryptionEndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpniPsecPhase2EndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PostVpniPsecPhase2EndpointResponseData) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallAliasesEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallAliasesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallAliasesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallNatOneToOneMappingsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallNatOneToOneMappingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallNatOneToOneMappingsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallNatOutboundMappingsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallNatOutboundMappingsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallNatOutboundMappingsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallNatPortForwardsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallNatPortForwardsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallNatPortForwardsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallRulesEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallRulesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallRulesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallSchedulesEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallSchedulesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallSchedulesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallTrafficShaperLimitersEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallTrafficShaperLimitersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallTrafficShaperLimitersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallTrafficShapersEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallTrafficShapersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutFirewallTrafficShapersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutInterfaceGroupsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutInterfaceGroupsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutInterfaceGroupsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesAcmeAccountKeysEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesAcmeAccountKeysEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesAcmeAccountKeysEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesAcmeCertificatesEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesAcmeCertificatesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesAcmeCertificatesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindAccessListsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindAccessListsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindAccessListsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindSyncRemoteHostsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindSyncRemoteHostsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindSyncRemoteHostsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindViewsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindViewsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindViewsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindZonesEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindZonesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesBindZonesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesCronJobsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesCronJobsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesCronJobsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSForwarderHostOverridesEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSForwarderHostOverridesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSForwarderHostOverridesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSResolverAccessListsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSResolverAccessListsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSResolverAccessListsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSResolverDomainOverridesEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSResolverDomainOverridesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSResolverDomainOverridesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSResolverHostOverridesEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSResolverHostOverridesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDNSResolverHostOverridesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDhcpServersEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDhcpServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesDhcpServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesFreeRadiusClientsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesFreeRadiusClientsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesFreeRadiusClientsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesFreeRadiusInterfacesEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesFreeRadiusInterfacesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesFreeRadiusInterfacesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesFreeRadiusUsersEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesFreeRadiusUsersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesFreeRadiusUsersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesHaProxyBackendsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesHaProxyBackendsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesHaProxyBackendsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesHaProxyFilesRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesHaProxyFilesResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesHaProxyFilesResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesHaProxyFrontendsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesHaProxyFrontendsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesHaProxyFrontendsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesNtpTimeServersEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesNtpTimeServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesNtpTimeServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesServiceWatchdogsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesServiceWatchdogsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutServicesServiceWatchdogsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutSystemRestapiAccessListEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutSystemRestapiAccessListEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutSystemRestapiAccessListEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutSystemTunablesEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutSystemTunablesEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutSystemTunablesEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutUserAuthServersEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutUserAuthServersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutUserAuthServersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutUserGroupsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutUserGroupsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutUserGroupsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpnOpenVpnClientExportConfigsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpnOpenVpnClientExportConfigsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpnOpenVpnClientExportConfigsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpnWireGuardPeersEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpnWireGuardPeersEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpnWireGuardPeersEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpnWireGuardTunnelsEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpnWireGuardTunnelsEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpnWireGuardTunnelsEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpniPsecPhase1SEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpniPsecPhase1SEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpniPsecPhase1SEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpniPsecPhase2SEndpointRequestItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpniPsecPhase2SEndpointResponse) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *PutVpniPsecPhase2SEndpointResponseDataItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RestapiAccessListEntry) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RestapiKey) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RestapiLog) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RestapiSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RestapiSettingsSync) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RestapiVersion) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *Restapijwt) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RoutingApply) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RoutingGateway) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RoutingGatewayGroup) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RoutingGatewayGroupPrioritiesItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RoutingGatewayGroupPriority) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *RoutingGatewayStatus) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SSH) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *ServerError) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *Service) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *ServiceUnavailableErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *ServiceWatchdog) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *StaticRoute) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *Success) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SystemConsole) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SystemDNS) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SystemHalt) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SystemHostname) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SystemLog) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SystemReboot) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SystemStatus) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SystemTimezone) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SystemTunable) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *SystemVersion) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *Table) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *TrafficShaper) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *TrafficShaperLimiter) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *TrafficShaperLimiterBandwidth) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *TrafficShaperLimiterBandwidthItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *TrafficShaperLimiterQueue) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *TrafficShaperLimiterQueueItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *TrafficShaperQueue) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *TrafficShaperQueueItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *UnauthorizedErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *UnprocessableContentError) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *UnprocessableEntityErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *UnsupportedMediaTypeErrorBody) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *User) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *UserGroup) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *ValidationError) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *VirtualIP) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *VirtualIPApply) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *WakeOnLanSend) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *WebGuiSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *WireGuardApply) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *WireGuardPeer) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *WireGuardPeerAllowedIP) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *WireGuardPeerAllowedipsItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *WireGuardSettings) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *WireGuardTunnel) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *WireGuardTunnelAddress) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *WireGuardTunnelAddressesItem) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
@@ -6,7 +6,6 @@ import (
 	bytes "bytes"
 	context "context"
 	json "encoding/json"
-	errors "errors"
 	fmt "fmt"
 	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
 	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
@@ -17,22 +16,27 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	caller *core.Caller
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
 	options := core.NewRequestOptions(opts...)
 	return &Client{
-		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
 				Client:      options.HTTPClient,
 				MaxAttempts: options.MaxAttempts,
+				Options:     options,
 			},
 		),
-		header: options.ToHeader(),
+	}
+}
+
+// NewClientWithCaller returns a client that issues its calls through the
+// given caller, sharing its options with every other client that does.
+func NewClientWithCaller(caller *core.Caller) *Client {
+	return &Client{
+		caller: caller,
 	}
 }
 
@@ -43,23 +47,20 @@ func (c *Client) GetRoutingApplyEndpoint(
 ) (*pkgclient.GetRoutingApplyEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/apply"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -154,9 +155,11 @@ func (c *Client) GetRoutingApplyEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "GetRoutingApplyEndpoint", Path: "/api/v2/routing/apply"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -176,23 +179,20 @@ func (c *Client) PostRoutingApplyEndpoint(
 ) (*pkgclient.PostRoutingApplyEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/apply"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -287,9 +287,11 @@ func (c *Client) PostRoutingApplyEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "PostRoutingApplyEndpoint", Path: "/api/v2/routing/apply"},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -309,23 +311,20 @@ func (c *Client) GetRoutingGatewayDefaultEndpoint(
 ) (*pkgclient.GetRoutingGatewayDefaultEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/gateway/default"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -420,9 +419,11 @@ func (c *Client) GetRoutingGatewayDefaultEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "GetRoutingGatewayDefaultEndpoint", Path: "/api/v2/routing/gateway/default"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -442,23 +443,20 @@ func (c *Client) PatchRoutingGatewayDefaultEndpoint(
 ) (*pkgclient.PatchRoutingGatewayDefaultEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/gateway/default"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -553,9 +551,11 @@ func (c *Client) PatchRoutingGatewayDefaultEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "PatchRoutingGatewayDefaultEndpoint", Path: "/api/v2/routing/gateway/default", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -576,10 +576,7 @@ func (c *Client) GetRoutingGatewayEndpoint(
 ) (*pkgclient.GetRoutingGatewayEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -593,14 +590,14 @@ func (c *Client) GetRoutingGatewayEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -695,9 +692,11 @@ func (c *Client) GetRoutingGatewayEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "GetRoutingGatewayEndpoint", Path: "/api/v2/routing/gateway"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -717,23 +716,20 @@ func (c *Client) PostRoutingGatewayEndpoint(
 ) (*pkgclient.PostRoutingGatewayEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/gateway"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -828,9 +824,11 @@ func (c *Client) PostRoutingGatewayEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "PostRoutingGatewayEndpoint", Path: "/api/v2/routing/gateway", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -851,10 +849,7 @@ func (c *Client) DeleteRoutingGatewayEndpoint(
 ) (*pkgclient.DeleteRoutingGatewayEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -871,14 +866,14 @@ func (c *Client) DeleteRoutingGatewayEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -973,9 +968,11 @@ func (c *Client) DeleteRoutingGatewayEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "DeleteRoutingGatewayEndpoint", Path: "/api/v2/routing/gateway", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -995,23 +992,20 @@ func (c *Client) PatchRoutingGatewayEndpoint(
 ) (*pkgclient.PatchRoutingGatewayEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/gateway"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1106,9 +1100,11 @@ func (c *Client) PatchRoutingGatewayEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "PatchRoutingGatewayEndpoint", Path: "/api/v2/routing/gateway", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1129,10 +1125,7 @@ func (c *Client) GetRoutingGatewayGroupEndpoint(
 ) (*pkgclient.GetRoutingGatewayGroupEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1146,14 +1139,14 @@ func (c *Client) GetRoutingGatewayGroupEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1248,9 +1241,11 @@ func (c *Client) GetRoutingGatewayGroupEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "GetRoutingGatewayGroupEndpoint", Path: "/api/v2/routing/gateway/group"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1270,23 +1265,20 @@ func (c *Client) PostRoutingGatewayGroupEndpoint(
 ) (*pkgclient.PostRoutingGatewayGroupEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/gateway/group"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1381,9 +1373,11 @@ func (c *Client) PostRoutingGatewayGroupEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "PostRoutingGatewayGroupEndpoint", Path: "/api/v2/routing/gateway/group", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1404,10 +1398,7 @@ func (c *Client) DeleteRoutingGatewayGroupEndpoint(
 ) (*pkgclient.DeleteRoutingGatewayGroupEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1424,14 +1415,14 @@ func (c *Client) DeleteRoutingGatewayGroupEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1526,9 +1517,11 @@ func (c *Client) DeleteRoutingGatewayGroupEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "DeleteRoutingGatewayGroupEndpoint", Path: "/api/v2/routing/gateway/group", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1548,23 +1541,20 @@ func (c *Client) PatchRoutingGatewayGroupEndpoint(
 ) (*pkgclient.PatchRoutingGatewayGroupEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/gateway/group"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1659,9 +1649,11 @@ func (c *Client) PatchRoutingGatewayGroupEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "PatchRoutingGatewayGroupEndpoint", Path: "/api/v2/routing/gateway/group", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -1682,10 +1674,7 @@ func (c *Client) GetRoutingGatewayGroupPrioritiesEndpoint(
 ) (*pkgclient.GetRoutingGatewayGroupPrioritiesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1708,20 +1697,22 @@ func (c *Client) GetRoutingGatewayGroupPrioritiesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1816,9 +1807,11 @@ func (c *Client) GetRoutingGatewayGroupPrioritiesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "GetRoutingGatewayGroupPrioritiesEndpoint", Path: "/api/v2/routing/gateway/group/priorities"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1838,10 +1831,7 @@ func (c *Client) DeleteRoutingGatewayGroupPrioritiesEndpoint(
 ) (*pkgclient.DeleteRoutingGatewayGroupPrioritiesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -1855,20 +1845,22 @@ func (c *Client) DeleteRoutingGatewayGroupPrioritiesEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -1963,9 +1955,11 @@ func (c *Client) DeleteRoutingGatewayGroupPrioritiesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "DeleteRoutingGatewayGroupPrioritiesEndpoint", Path: "/api/v2/routing/gateway/group/priorities", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -1985,10 +1979,7 @@ func (c *Client) GetRoutingGatewayGroupPriorityEndpoint(
 ) (*pkgclient.GetRoutingGatewayGroupPriorityEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2005,14 +1996,14 @@ func (c *Client) GetRoutingGatewayGroupPriorityEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2107,9 +2098,11 @@ func (c *Client) GetRoutingGatewayGroupPriorityEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "GetRoutingGatewayGroupPriorityEndpoint", Path: "/api/v2/routing/gateway/group/priority"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2129,23 +2122,20 @@ func (c *Client) PostRoutingGatewayGroupPriorityEndpoint(
 ) (*pkgclient.PostRoutingGatewayGroupPriorityEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/gateway/group/priority"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2240,9 +2230,11 @@ func (c *Client) PostRoutingGatewayGroupPriorityEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "PostRoutingGatewayGroupPriorityEndpoint", Path: "/api/v2/routing/gateway/group/priority", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -2263,10 +2255,7 @@ func (c *Client) DeleteRoutingGatewayGroupPriorityEndpoint(
 ) (*pkgclient.DeleteRoutingGatewayGroupPriorityEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2286,14 +2275,14 @@ func (c *Client) DeleteRoutingGatewayGroupPriorityEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2388,9 +2377,11 @@ func (c *Client) DeleteRoutingGatewayGroupPriorityEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "DeleteRoutingGatewayGroupPriorityEndpoint", Path: "/api/v2/routing/gateway/group/priority", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2410,23 +2401,20 @@ func (c *Client) PatchRoutingGatewayGroupPriorityEndpoint(
 ) (*pkgclient.PatchRoutingGatewayGroupPriorityEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/gateway/group/priority"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2521,9 +2509,11 @@ func (c *Client) PatchRoutingGatewayGroupPriorityEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "PatchRoutingGatewayGroupPriorityEndpoint", Path: "/api/v2/routing/gateway/group/priority", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -2544,10 +2534,7 @@ func (c *Client) GetRoutingGatewayGroupsEndpoint(
 ) (*pkgclient.GetRoutingGatewayGroupsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2570,20 +2557,22 @@ func (c *Client) GetRoutingGatewayGroupsEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2678,9 +2667,11 @@ func (c *Client) GetRoutingGatewayGroupsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "GetRoutingGatewayGroupsEndpoint", Path: "/api/v2/routing/gateway/groups"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2700,10 +2691,7 @@ func (c *Client) DeleteRoutingGatewayGroupsEndpoint(
 ) (*pkgclient.DeleteRoutingGatewayGroupsEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2717,20 +2705,22 @@ func (c *Client) DeleteRoutingGatewayGroupsEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2825,9 +2815,11 @@ func (c *Client) DeleteRoutingGatewayGroupsEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "DeleteRoutingGatewayGroupsEndpoint", Path: "/api/v2/routing/gateway/groups", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -2847,10 +2839,7 @@ func (c *Client) GetRoutingGatewaysEndpoint(
 ) (*pkgclient.GetRoutingGatewaysEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -2873,20 +2862,22 @@ func (c *Client) GetRoutingGatewaysEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -2981,9 +2972,11 @@ func (c *Client) GetRoutingGatewaysEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "GetRoutingGatewaysEndpoint", Path: "/api/v2/routing/gateways"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3003,10 +2996,7 @@ func (c *Client) DeleteRoutingGatewaysEndpoint(
 ) (*pkgclient.DeleteRoutingGatewaysEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3020,20 +3010,22 @@ func (c *Client) DeleteRoutingGatewaysEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3128,9 +3120,11 @@ func (c *Client) DeleteRoutingGatewaysEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "DeleteRoutingGatewaysEndpoint", Path: "/api/v2/routing/gateways", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3150,10 +3144,7 @@ func (c *Client) GetRoutingStaticRouteEndpoint(
 ) (*pkgclient.GetRoutingStaticRouteEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3167,14 +3158,14 @@ func (c *Client) GetRoutingStaticRouteEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3269,9 +3260,11 @@ func (c *Client) GetRoutingStaticRouteEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "GetRoutingStaticRouteEndpoint", Path: "/api/v2/routing/static_route"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3291,23 +3284,20 @@ func (c *Client) PostRoutingStaticRouteEndpoint(
 ) (*pkgclient.PostRoutingStaticRouteEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/static_route"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3402,9 +3392,11 @@ func (c *Client) PostRoutingStaticRouteEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "PostRoutingStaticRouteEndpoint", Path: "/api/v2/routing/static_route", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPost,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -3425,10 +3417,7 @@ func (c *Client) DeleteRoutingStaticRouteEndpoint(
 ) (*pkgclient.DeleteRoutingStaticRouteEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3445,14 +3434,14 @@ func (c *Client) DeleteRoutingStaticRouteEndpoint(
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3547,9 +3536,11 @@ func (c *Client) DeleteRoutingStaticRouteEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "DeleteRoutingStaticRouteEndpoint", Path: "/api/v2/routing/static_route", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3569,23 +3560,20 @@ func (c *Client) PatchRoutingStaticRouteEndpoint(
 ) (*pkgclient.PatchRoutingStaticRouteEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
 	endpointURL := baseURL + "/" + "api/v2/routing/static_route"
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3680,9 +3668,11 @@ func (c *Client) PatchRoutingStaticRouteEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "PatchRoutingStaticRouteEndpoint", Path: "/api/v2/routing/static_route", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodPatch,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Request:      request,
@@ -3703,10 +3693,7 @@ func (c *Client) GetRoutingStaticRoutesEndpoint(
 ) (*pkgclient.GetRoutingStaticRoutesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3729,20 +3716,22 @@ func (c *Client) GetRoutingStaticRoutesEndpoint(
 		queryParams.Add("sort_flags", fmt.Sprintf("%v", *request.SortFlags))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3837,9 +3826,11 @@ func (c *Client) GetRoutingStaticRoutesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "GetRoutingStaticRoutesEndpoint", Path: "/api/v2/routing/static_routes"},
 			URL:          endpointURL,
 			Method:       http.MethodGet,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
@@ -3859,10 +3850,7 @@ func (c *Client) DeleteRoutingStaticRoutesEndpoint(
 ) (*pkgclient.DeleteRoutingStaticRoutesEndpointResponse, error) {
 	options := core.NewRequestOptions(opts...)
 
-	baseURL := ""
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
+	baseURL, header := c.caller.Snapshot()
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
@@ -3876,20 +3864,22 @@ func (c *Client) DeleteRoutingStaticRoutesEndpoint(
 		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
 	}
 	if request.Query != nil {
-		queryParams.Add("query", fmt.Sprintf("%v", *request.Query))
+		if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {
+			return nil, err
+		}
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
 
-	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers := core.MergeHeaders(header, options.ToHeader())
 
 	errorDecoder := func(statusCode int, body io.Reader) error {
 		raw, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))
 		decoder := json.NewDecoder(bytes.NewReader(raw))
 		switch statusCode {
 		case 400:
@@ -3984,9 +3974,11 @@ func (c *Client) DeleteRoutingStaticRoutesEndpoint(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
+			Endpoint:     core.Endpoint{Service: "Routing", Name: "DeleteRoutingStaticRoutesEndpoint", Path: "/api/v2/routing/static_routes", Deferred: true},
 			URL:          endpointURL,
 			Method:       http.MethodDelete,
 			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
 			Headers:      headers,
 			Client:       options.HTTPClient,
 			Response:     &response,
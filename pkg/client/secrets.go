@@ -0,0 +1,4597 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package pfclientapi
+
+import (
+	fmt "fmt"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+)
+
+// sensitiveFields are the JSON names of the fields that hold secrets, such
+// as passwords, private keys and API keys. String masks them at any depth.
+var sensitiveFields = map[string]bool{
+	"accountkey":                        true,
+	"acmedns_password":                  true,
+	"acmeproxy_password":                true,
+	"active24_token":                    true,
+	"ad_api_key":                        true,
+	"af_api_password":                   true,
+	"akamai_access_token":               true,
+	"akamai_client_secret":              true,
+	"akamai_client_token":               true,
+	"ali_key":                           true,
+	"ali_secret":                        true,
+	"anx_token":                         true,
+	"arvan_token":                       true,
+	"aurora_key":                        true,
+	"aurora_secret":                     true,
+	"auth_pass":                         true,
+	"autodns_password":                  true,
+	"aws_secret_access_key":             true,
+	"azion_password":                    true,
+	"azuredns_clientsecret":             true,
+	"binary_data":                       true,
+	"bookmyname_password":               true,
+	"bunny_api_key":                     true,
+	"cf_key":                            true,
+	"cf_token":                          true,
+	"clouddns_password":                 true,
+	"cloudns_auth_password":             true,
+	"cn_password":                       true,
+	"conoha_password":                   true,
+	"constellix_key":                    true,
+	"constellix_secret":                 true,
+	"cpanel_apitoken":                   true,
+	"curanet_authsecret":                true,
+	"cy_password":                       true,
+	"dd_api_key":                        true,
+	"ddnss_token":                       true,
+	"dedyn_token":                       true,
+	"df_password":                       true,
+	"dh_api_key":                        true,
+	"dnsexit_api_key":                   true,
+	"dnsexit_auth_pass":                 true,
+	"dnshome_subdomainpassword":         true,
+	"dnsimple_oauth_token":              true,
+	"dnsservices_password":              true,
+	"do_api_key":                        true,
+	"do_letoken":                        true,
+	"domeneshop_secret":                 true,
+	"domeneshop_token":                  true,
+	"dp_key":                            true,
+	"dpi_key":                           true,
+	"duckdns_token":                     true,
+	"dyn_password":                      true,
+	"dynu_secret":                       true,
+	"easydns_key":                       true,
+	"easydns_token":                     true,
+	"euserv_password":                   true,
+	"exoscale_api_key":                  true,
+	"exoscale_secret_key":               true,
+	"fornex_api_key":                    true,
+	"freedns_password":                  true,
+	"gandi_livedns_key":                 true,
+	"gcore_key":                         true,
+	"gd_key":                            true,
+	"gd_secret":                         true,
+	"geoscaling_password":               true,
+	"googledomains_access_token":        true,
+	"ha_sync_password":                  true,
+	"haproxy_cookie_dynamic_cookie_key": true,
+	"hash":                              true,
+	"he_password":                       true,
+	"hetzner_token":                     true,
+	"hexonet_password":                  true,
+	"hostingde_apikey":                  true,
+	"huaweicloud_password":              true,
+	"infomaniak_api_token":              true,
+	"internetbs_api_key":                true,
+	"internetbs_api_password":           true,
+	"inwx_password":                     true,
+	"inwx_shared_secret":                true,
+	"ionos_secret":                      true,
+	"ipsecpsk":                          true,
+	"ipv64_token":                       true,
+	"ispc_password":                     true,
+	"jd_access_key_secret":              true,
+	"joker_password":                    true,
+	"kappernetdns_key":                  true,
+	"kappernetdns_secret":               true,
+	"kas_authdata":                      true,
+	"key":                               true,
+	"keypaste":                          true,
+	"kinghost_password":                 true,
+	"knot_key":                          true,
+	"la_key":                            true,
+	"limacity_apikey":                   true,
+	"linode_api_key":                    true,
+	"linode_v4_api_key":                 true,
+	"loopia_password":                   true,
+	"lsw_key":                           true,
+	"lua_key":                           true,
+	"me_key":                            true,
+	"me_secret":                         true,
+	"miab_password":                     true,
+	"misaka_key":                        true,
+	"motp_secret":                       true,
+	"mydnsjp_password":                  true,
+	"namecheap_api_key":                 true,
+	"namecom_token":                     true,
+	"namesilo_key":                      true,
+	"nanelo_token":                      true,
+	"naspassword":                       true,
+	"nc_apikey":                         true,
+	"nederhost_key":                     true,
+	"neodigit_api_token":                true,
+	"netlify_access_token":              true,
+	"nic_clientsecret":                  true,
+	"nic_password":                      true,
+	"ns1_key":                           true,
+	"nsupdate_key":                      true,
+	"nw_api_token":                      true,
+	"oci_cli_key":                       true,
+	"one984hosting_password":            true,
+	"onecom_password":                   true,
+	"online_api_key":                    true,
+	"openprovider_passwordhash":         true,
+	"pass":                              true,
+	"passphrase":                        true,
+	"password":                          true,
+	"pdd_token":                         true,
+	"pdns_token":                        true,
+	"pleskxml_pass":                     true,
+	"pointhq_key":                       true,
+	"porkbun_api_key":                   true,
+	"porkbun_secret_api_key":            true,
+	"pre_shared_key":                    true,
+	"presharedkey":                      true,
+	"privatekey":                        true,
+	"proxy_passwd":                      true,
+	"proxypass":                         true,
+	"prv":                               true,
+	"rackcorp_apisecret":                true,
+	"rackspace_apikey":                  true,
+	"radius_secret":                     true,
+	"rage4_token":                       true,
+	"rcode0_api_token":                  true,
+	"regru_api_password":                true,
+	"scaleway_api_token":                true,
+	"schlundtech_password":              true,
+	"secret":                            true,
+	"selfhostdns_password":              true,
+	"serverauthkey":                     true,
+	"servercow_api_password":            true,
+	"simply_apikey":                     true,
+	"sl_key":                            true,
+	"source_hash_key":                   true,
+	"stats_password":                    true,
+	"tele3_key":                         true,
+	"tele3_secret":                      true,
+	"tencent_secretid":                  true,
+	"tencent_secretkey":                 true,
+	"tls":                               true,
+	"token":                             true,
+	"udr_pass":                          true,
+	"uno_key":                           true,
+	"variomedia_api_token":              true,
+	"veesp_password":                    true,
+	"vercel_token":                      true,
+	"vscale_api_key":                    true,
+	"vultr_api_key":                     true,
+	"webrootftppassword":                true,
+	"west_key":                          true,
+	"world4you_password":                true,
+	"ws_apikey":                         true,
+	"ws_apisecret":                      true,
+	"yc_sa_key_file_pem_b64":            true,
+	"zilore_key":                        true,
+	"zm_key":                            true,
+	"zone_key":                          true,
+}
+
+// Reveal returns the JSON of a, including the secrets that String masks.
+func (a *AcmeAccountKey) Reveal() string {
+	if len(a._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+// Reveal returns the JSON of a, including the secrets that String masks.
+func (a *AcmeCertificate) Reveal() string {
+	if len(a._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+// Reveal returns the JSON of a, including the secrets that String masks.
+func (a *AcmeCertificateADomainlistItem) Reveal() string {
+	if len(a._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+// Reveal returns the JSON of a, including the secrets that String masks.
+func (a *AcmeCertificateDomain) Reveal() string {
+	if len(a._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+// Reveal returns the JSON of a, including the secrets that String masks.
+func (a *AuthServer) Reveal() string {
+	if len(a._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+// Reveal returns the JSON of b, including the secrets that String masks.
+func (b *BindSyncRemoteHost) Reveal() string {
+	if len(b._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(b._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Reveal returns the JSON of c, including the secrets that String masks.
+func (c *Certificate) Reveal() string {
+	if len(c._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Reveal returns the JSON of c, including the secrets that String masks.
+func (c *CertificateAuthority) Reveal() string {
+	if len(c._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Reveal returns the JSON of c, including the secrets that String masks.
+func (c *CertificateAuthorityGenerate) Reveal() string {
+	if len(c._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Reveal returns the JSON of c, including the secrets that String masks.
+func (c *CertificateGenerate) Reveal() string {
+	if len(c._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Reveal returns the JSON of c, including the secrets that String masks.
+func (c *CertificatePkcs12Export) Reveal() string {
+	if len(c._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Reveal returns the JSON of c, including the secrets that String masks.
+func (c *CertificateRevocationList) Reveal() string {
+	if len(c._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Reveal returns the JSON of c, including the secrets that String masks.
+func (c *CertificateRevocationListCertItem) Reveal() string {
+	if len(c._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Reveal returns the JSON of c, including the secrets that String masks.
+func (c *CertificateRevocationListRevokedCertificate) Reveal() string {
+	if len(c._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Reveal returns the JSON of c, including the secrets that String masks.
+func (c *CertificateSigningRequest) Reveal() string {
+	if len(c._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Reveal returns the JSON of c, including the secrets that String masks.
+func (c *CertificateSigningRequestSign) Reveal() string {
+	if len(c._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteAuthKeyEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteAuthKeyEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteAuthKeysEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteAuthKeysEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteFirewallNatOutboundMappingEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteFirewallNatOutboundMappingEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteFirewallNatOutboundMappingsEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteFirewallNatOutboundMappingsEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteFirewallVirtualIPEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteFirewallVirtualIPEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteFirewallVirtualIPsEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteFirewallVirtualIPsEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesAcmeAccountKeyEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesAcmeAccountKeyEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesAcmeAccountKeysEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesAcmeAccountKeysEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesAcmeCertificateDomainEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesAcmeCertificateDomainEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesAcmeCertificateEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesAcmeCertificateEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesAcmeCertificatesEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesAcmeCertificatesEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesBindSyncRemoteHostEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesBindSyncRemoteHostEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesBindSyncRemoteHostsEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesBindSyncRemoteHostsEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesFreeRadiusClientEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesFreeRadiusClientEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesFreeRadiusClientsEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesFreeRadiusClientsEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesFreeRadiusUserEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesFreeRadiusUserEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesFreeRadiusUsersEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesFreeRadiusUsersEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesHaProxyBackendEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesHaProxyBackendEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesHaProxyBackendsEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteServicesHaProxyBackendsEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCertificateAuthoritiesEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCertificateAuthoritiesEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCertificateAuthorityEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCertificateAuthorityEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCertificateEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCertificateEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCertificatesEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCertificatesEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCrLsEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCrLsEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCrlEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCrlEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCrlRevokedCertificateEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteSystemCrlRevokedCertificateEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteUserAuthServerEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteUserAuthServerEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteUserAuthServersEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteUserAuthServersEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteUserEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteUserEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteUsersEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteUsersEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnClientEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnClientEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnClientExportConfigEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnClientExportConfigEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnClientExportConfigsEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnClientExportConfigsEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnClientsEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnClientsEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnServerEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnServerEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnServersEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnOpenVpnServersEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnWireGuardPeerEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnWireGuardPeerEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnWireGuardPeersEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnWireGuardPeersEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnWireGuardTunnelEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnWireGuardTunnelEndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnWireGuardTunnelsEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpnWireGuardTunnelsEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpniPsecPhase1EndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpniPsecPhase1EndpointResponseData) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpniPsecPhase1SEndpointResponse) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of d, including the secrets that String masks.
+func (d *DeleteVpniPsecPhase1SEndpointResponseDataItem) Reveal() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// Reveal returns the JSON of e, including the secrets that String masks.
+func (e *EmailNotificationSettings) Reveal() string {
+	if len(e._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(e._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(e); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", e)
+}
+
+// Reveal returns the JSON of f, including the secrets that String masks.
+func (f *FreeRadiusClient) Reveal() string {
+	if len(f._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Reveal returns the JSON of f, including the secrets that String masks.
+func (f *FreeRadiusUser) Reveal() string {
+	if len(f._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetAuthKeysEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetAuthKeysEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetFirewallNatOutboundMappingEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetFirewallNatOutboundMappingEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetFirewallNatOutboundMappingsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetFirewallNatOutboundMappingsEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetFirewallVirtualIPEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetFirewallVirtualIPEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetFirewallVirtualIPsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetFirewallVirtualIPsEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesAcmeAccountKeyEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesAcmeAccountKeyEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesAcmeAccountKeysEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesAcmeAccountKeysEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesAcmeCertificateDomainEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesAcmeCertificateDomainEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesAcmeCertificateEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesAcmeCertificateEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesAcmeCertificatesEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesAcmeCertificatesEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesBindSyncRemoteHostEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesBindSyncRemoteHostEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesBindSyncRemoteHostsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesBindSyncRemoteHostsEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesFreeRadiusClientEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesFreeRadiusClientEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesFreeRadiusClientsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesFreeRadiusClientsEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesFreeRadiusUserEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesFreeRadiusUserEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesFreeRadiusUsersEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesFreeRadiusUsersEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesHaProxyBackendEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesHaProxyBackendEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesHaProxyBackendsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesHaProxyBackendsEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetServicesNtpSettingsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCertificateAuthoritiesEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCertificateAuthoritiesEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCertificateAuthorityEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCertificateAuthorityEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCertificateEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCertificateEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCertificatesEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCertificatesEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCrLsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCrLsEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCrlEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCrlEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCrlRevokedCertificateEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemCrlRevokedCertificateEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemNotificationsEmailSettingsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetSystemRestapiSettingsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetUserAuthServerEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetUserAuthServerEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetUserAuthServersEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetUserAuthServersEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetUserEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetUserEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetUsersEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetUsersEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnClientEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnClientEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnClientExportConfigEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnClientExportConfigEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnClientExportConfigsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnClientExportConfigsEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnClientsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnClientsEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnServerEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnServerEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnServersEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnOpenVpnServersEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnWireGuardPeerEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnWireGuardPeerEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnWireGuardPeersEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnWireGuardPeersEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnWireGuardTunnelEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnWireGuardTunnelEndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnWireGuardTunnelsEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpnWireGuardTunnelsEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpniPsecPhase1EndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpniPsecPhase1EndpointResponseData) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpniPsecPhase1SEndpointResponse) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of g, including the secrets that String masks.
+func (g *GetVpniPsecPhase1SEndpointResponseDataItem) Reveal() string {
+	if len(g._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(g); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", g)
+}
+
+// Reveal returns the JSON of h, including the secrets that String masks.
+func (h *HaProxyBackend) Reveal() string {
+	if len(h._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(h._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(h); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", h)
+}
+
+// Reveal returns the JSON of i, including the secrets that String masks.
+func (i *IPsecPhase1) Reveal() string {
+	if len(i._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(i._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(i); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", i)
+}
+
+// Reveal returns the JSON of n, including the secrets that String masks.
+func (n *NtpSettings) Reveal() string {
+	if len(n._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(n._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(n); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", n)
+}
+
+// Reveal returns the JSON of o, including the secrets that String masks.
+func (o *OpenVpnClient) Reveal() string {
+	if len(o._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(o._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(o); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", o)
+}
+
+// Reveal returns the JSON of o, including the secrets that String masks.
+func (o *OpenVpnClientExport) Reveal() string {
+	if len(o._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(o._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(o); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", o)
+}
+
+// Reveal returns the JSON of o, including the secrets that String masks.
+func (o *OpenVpnClientExportConfig) Reveal() string {
+	if len(o._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(o._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(o); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", o)
+}
+
+// Reveal returns the JSON of o, including the secrets that String masks.
+func (o *OpenVpnServer) Reveal() string {
+	if len(o._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(o._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(o); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", o)
+}
+
+// Reveal returns the JSON of o, including the secrets that String masks.
+func (o *OutboundNatMapping) Reveal() string {
+	if len(o._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(o._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(o); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", o)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchFirewallNatOutboundMappingEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchFirewallNatOutboundMappingEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchFirewallVirtualIPEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchFirewallVirtualIPEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesAcmeAccountKeyEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesAcmeAccountKeyEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesAcmeCertificateDomainEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesAcmeCertificateDomainEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesAcmeCertificateEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesAcmeCertificateEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesBindSyncRemoteHostEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesBindSyncRemoteHostEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesFreeRadiusClientEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesFreeRadiusClientEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesFreeRadiusUserEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesFreeRadiusUserEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesHaProxyBackendEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesHaProxyBackendEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchServicesNtpSettingsEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchSystemCertificateAuthorityEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchSystemCertificateAuthorityEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchSystemCertificateEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchSystemCertificateEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchSystemCrlEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchSystemCrlEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchSystemCrlRevokedCertificateEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchSystemCrlRevokedCertificateEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchSystemNotificationsEmailSettingsEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchSystemRestapiSettingsEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchUserAuthServerEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchUserAuthServerEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchUserEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchUserEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpnOpenVpnClientEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpnOpenVpnClientEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpnOpenVpnClientExportConfigEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpnOpenVpnClientExportConfigEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpnOpenVpnServerEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpnOpenVpnServerEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpnWireGuardPeerEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpnWireGuardPeerEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpnWireGuardTunnelEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpnWireGuardTunnelEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpniPsecPhase1EndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PatchVpniPsecPhase1EndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostAuthJwtEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostAuthKeyEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostAuthKeyEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostFirewallNatOutboundMappingEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostFirewallNatOutboundMappingEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostFirewallVirtualIPEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostFirewallVirtualIPEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesAcmeAccountKeyEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesAcmeAccountKeyEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesAcmeCertificateDomainEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesAcmeCertificateDomainEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesAcmeCertificateEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesAcmeCertificateEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesBindSyncRemoteHostEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesBindSyncRemoteHostEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesFreeRadiusClientEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesFreeRadiusClientEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesFreeRadiusUserEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesFreeRadiusUserEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesHaProxyBackendEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostServicesHaProxyBackendEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateAuthorityEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateAuthorityEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateAuthorityGenerateEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateAuthorityGenerateEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateGenerateEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateGenerateEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateSigningRequestEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateSigningRequestEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateSigningRequestSignEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCertificateSigningRequestSignEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCrlEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCrlEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCrlRevokedCertificateEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostSystemCrlRevokedCertificateEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostUserAuthServerEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostUserAuthServerEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostUserEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostUserEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnOpenVpnClientEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnOpenVpnClientEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnOpenVpnClientExportConfigEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnOpenVpnClientExportConfigEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnOpenVpnClientExportEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnOpenVpnClientExportEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnOpenVpnServerEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnOpenVpnServerEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnWireGuardPeerEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnWireGuardPeerEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnWireGuardTunnelEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpnWireGuardTunnelEndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpniPsecPhase1EndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PostVpniPsecPhase1EndpointResponseData) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutFirewallNatOutboundMappingsEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutFirewallNatOutboundMappingsEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutFirewallNatOutboundMappingsEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesAcmeAccountKeysEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesAcmeAccountKeysEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesAcmeAccountKeysEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesAcmeCertificatesEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesAcmeCertificatesEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesAcmeCertificatesEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesBindSyncRemoteHostsEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesBindSyncRemoteHostsEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesBindSyncRemoteHostsEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesFreeRadiusClientsEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesFreeRadiusClientsEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesFreeRadiusClientsEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesFreeRadiusUsersEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesFreeRadiusUsersEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesFreeRadiusUsersEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesHaProxyBackendsEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesHaProxyBackendsEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutServicesHaProxyBackendsEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutUserAuthServersEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutUserAuthServersEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutUserAuthServersEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpnOpenVpnClientExportConfigsEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpnOpenVpnClientExportConfigsEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpnOpenVpnClientExportConfigsEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpnWireGuardPeersEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpnWireGuardPeersEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpnWireGuardPeersEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpnWireGuardTunnelsEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpnWireGuardTunnelsEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpnWireGuardTunnelsEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpniPsecPhase1SEndpointRequestItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpniPsecPhase1SEndpointResponse) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of p, including the secrets that String masks.
+func (p *PutVpniPsecPhase1SEndpointResponseDataItem) Reveal() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// Reveal returns the JSON of r, including the secrets that String masks.
+func (r *RestapiKey) Reveal() string {
+	if len(r._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(r._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(r); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", r)
+}
+
+// Reveal returns the JSON of r, including the secrets that String masks.
+func (r *RestapiSettings) Reveal() string {
+	if len(r._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(r._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(r); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", r)
+}
+
+// Reveal returns the JSON of r, including the secrets that String masks.
+func (r *Restapijwt) Reveal() string {
+	if len(r._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(r._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(r); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", r)
+}
+
+// Reveal returns the JSON of u, including the secrets that String masks.
+func (u *User) Reveal() string {
+	if len(u._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(u._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
+
+// Reveal returns the JSON of v, including the secrets that String masks.
+func (v *VirtualIP) Reveal() string {
+	if len(v._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(v._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(v); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// Reveal returns the JSON of w, including the secrets that String masks.
+func (w *WireGuardPeer) Reveal() string {
+	if len(w._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(w._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(w); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", w)
+}
+
+// Reveal returns the JSON of w, including the secrets that String masks.
+func (w *WireGuardTunnel) Reveal() string {
+	if len(w._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(w._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(w); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", w)
+}
@@ -0,0 +1,52 @@
+package pfclientapi_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	pfclientapi "github.com/danielmichaels/go-pfrest/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringMasksSecrets(t *testing.T) {
+	const body = `{"code":200,"data":{"id":0,"publickey":"public","presharedkey":"psk-secret","descr":"","allowedips":[]}}`
+	var response pfclientapi.PostVpnWireGuardPeerEndpointResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &response))
+
+	for _, out := range []string{
+		response.String(),
+		response.Data.String(),
+		fmt.Sprintf("%v", &response),
+		fmt.Sprintf("%+v", response.Data),
+		fmt.Sprint([]*pfclientapi.PostVpnWireGuardPeerEndpointResponseData{response.Data}),
+	} {
+		assert.NotContains(t, out, "psk-secret")
+		assert.Contains(t, out, "public")
+	}
+	assert.Contains(t, response.String(), `"presharedkey": "[REDACTED]"`)
+
+	assert.Contains(t, response.Reveal(), `"presharedkey": "psk-secret"`)
+	assert.Contains(t, response.Data.Reveal(), `"presharedkey": "psk-secret"`)
+	marshaled, err := json.Marshal(response.Data)
+	require.NoError(t, err)
+	assert.Contains(t, string(marshaled), "psk-secret")
+}
+
+func TestStringKeepsUnsetSecrets(t *testing.T) {
+	password := ""
+	user := &pfclientapi.User{Name: pfclientapi.String("admin"), Password: &password}
+	assert.Contains(t, user.String(), `"password": ""`)
+	assert.Contains(t, user.String(), `"name": "admin"`)
+}
+
+func TestStringMasksAPIKeyHash(t *testing.T) {
+	key := &pfclientapi.RestapiKey{
+		Username: pfclientapi.String("admin"),
+		Hash:     pfclientapi.String("5f4dcc3b5aa765d61d8327deb882cf99"),
+	}
+	assert.NotContains(t, key.String(), "5f4dcc3b5aa765d61d8327deb882cf99")
+	assert.Contains(t, key.String(), `"hash": "[REDACTED]"`)
+	assert.Contains(t, key.String(), `"username": "admin"`)
+}
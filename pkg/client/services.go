@@ -4743,11 +4743,11 @@ func (a *AcmeAccountKey) UnmarshalJSON(data []byte) error {
 
 func (a *AcmeAccountKey) String() string {
 	if len(a._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(a._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(a); err == nil {
+	if value, err := core.StringifyRedactedJSON(a, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", a)
@@ -4827,11 +4827,11 @@ func (a *AcmeCertificate) UnmarshalJSON(data []byte) error {
 
 func (a *AcmeCertificate) String() string {
 	if len(a._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(a._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(a); err == nil {
+	if value, err := core.StringifyRedactedJSON(a, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", a)
@@ -5479,11 +5479,11 @@ func (a *AcmeCertificateADomainlistItem) UnmarshalJSON(data []byte) error {
 
 func (a *AcmeCertificateADomainlistItem) String() string {
 	if len(a._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(a._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(a); err == nil {
+	if value, err := core.StringifyRedactedJSON(a, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", a)
@@ -6183,11 +6183,11 @@ func (a *AcmeCertificateDomain) UnmarshalJSON(data []byte) error {
 
 func (a *AcmeCertificateDomain) String() string {
 	if len(a._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(a._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(a); err == nil {
+	if value, err := core.StringifyRedactedJSON(a, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", a)
@@ -6843,11 +6843,11 @@ func (b *BindSyncRemoteHost) UnmarshalJSON(data []byte) error {
 
 func (b *BindSyncRemoteHost) String() string {
 	if len(b._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(b._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(b._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(b); err == nil {
+	if value, err := core.StringifyRedactedJSON(b, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", b)
@@ -8472,11 +8472,11 @@ func (f *FreeRadiusClient) UnmarshalJSON(data []byte) error {
 
 func (f *FreeRadiusClient) String() string {
 	if len(f._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(f._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(f); err == nil {
+	if value, err := core.StringifyRedactedJSON(f, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", f)
@@ -8714,11 +8714,11 @@ func (f *FreeRadiusUser) UnmarshalJSON(data []byte) error {
 
 func (f *FreeRadiusUser) String() string {
 	if len(f._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(f._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(f); err == nil {
+	if value, err := core.StringifyRedactedJSON(f, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", f)
@@ -8938,11 +8938,11 @@ func (h *HaProxyBackend) UnmarshalJSON(data []byte) error {
 
 func (h *HaProxyBackend) String() string {
 	if len(h._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(h._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(h._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(h); err == nil {
+	if value, err := core.StringifyRedactedJSON(h, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", h)
@@ -11155,11 +11155,11 @@ func (n *NtpSettings) UnmarshalJSON(data []byte) error {
 
 func (n *NtpSettings) String() string {
 	if len(n._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(n._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(n._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(n); err == nil {
+	if value, err := core.StringifyRedactedJSON(n, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", n)
@@ -11628,11 +11628,11 @@ func (d *DeleteServicesAcmeAccountKeyEndpointResponse) UnmarshalJSON(data []byte
 
 func (d *DeleteServicesAcmeAccountKeyEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -11667,11 +11667,11 @@ func (d *DeleteServicesAcmeAccountKeyEndpointResponseData) UnmarshalJSON(data []
 
 func (d *DeleteServicesAcmeAccountKeyEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -11706,11 +11706,11 @@ func (d *DeleteServicesAcmeAccountKeysEndpointResponse) UnmarshalJSON(data []byt
 
 func (d *DeleteServicesAcmeAccountKeysEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -11745,11 +11745,11 @@ func (d *DeleteServicesAcmeAccountKeysEndpointResponseDataItem) UnmarshalJSON(da
 
 func (d *DeleteServicesAcmeAccountKeysEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -12087,11 +12087,11 @@ func (d *DeleteServicesAcmeCertificateDomainEndpointResponse) UnmarshalJSON(data
 
 func (d *DeleteServicesAcmeCertificateDomainEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -12707,11 +12707,11 @@ func (d *DeleteServicesAcmeCertificateDomainEndpointResponseData) UnmarshalJSON(
 
 func (d *DeleteServicesAcmeCertificateDomainEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -12803,11 +12803,11 @@ func (d *DeleteServicesAcmeCertificateEndpointResponse) UnmarshalJSON(data []byt
 
 func (d *DeleteServicesAcmeCertificateEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -12856,11 +12856,11 @@ func (d *DeleteServicesAcmeCertificateEndpointResponseData) UnmarshalJSON(data [
 
 func (d *DeleteServicesAcmeCertificateEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -12895,11 +12895,11 @@ func (d *DeleteServicesAcmeCertificatesEndpointResponse) UnmarshalJSON(data []by
 
 func (d *DeleteServicesAcmeCertificatesEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -12948,11 +12948,11 @@ func (d *DeleteServicesAcmeCertificatesEndpointResponseDataItem) UnmarshalJSON(d
 
 func (d *DeleteServicesAcmeCertificatesEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -13509,11 +13509,11 @@ func (d *DeleteServicesBindSyncRemoteHostEndpointResponse) UnmarshalJSON(data []
 
 func (d *DeleteServicesBindSyncRemoteHostEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -13550,11 +13550,11 @@ func (d *DeleteServicesBindSyncRemoteHostEndpointResponseData) UnmarshalJSON(dat
 
 func (d *DeleteServicesBindSyncRemoteHostEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -13589,11 +13589,11 @@ func (d *DeleteServicesBindSyncRemoteHostsEndpointResponse) UnmarshalJSON(data [
 
 func (d *DeleteServicesBindSyncRemoteHostsEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -13630,11 +13630,11 @@ func (d *DeleteServicesBindSyncRemoteHostsEndpointResponseDataItem) UnmarshalJSO
 
 func (d *DeleteServicesBindSyncRemoteHostsEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -17369,11 +17369,11 @@ func (d *DeleteServicesFreeRadiusClientEndpointResponse) UnmarshalJSON(data []by
 
 func (d *DeleteServicesFreeRadiusClientEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -17422,11 +17422,11 @@ func (d *DeleteServicesFreeRadiusClientEndpointResponseData) UnmarshalJSON(data
 
 func (d *DeleteServicesFreeRadiusClientEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -17461,11 +17461,11 @@ func (d *DeleteServicesFreeRadiusClientsEndpointResponse) UnmarshalJSON(data []b
 
 func (d *DeleteServicesFreeRadiusClientsEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -17514,11 +17514,11 @@ func (d *DeleteServicesFreeRadiusClientsEndpointResponseDataItem) UnmarshalJSON(
 
 func (d *DeleteServicesFreeRadiusClientsEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -17823,11 +17823,11 @@ func (d *DeleteServicesFreeRadiusUserEndpointResponse) UnmarshalJSON(data []byte
 
 func (d *DeleteServicesFreeRadiusUserEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -17874,11 +17874,11 @@ func (d *DeleteServicesFreeRadiusUserEndpointResponseData) UnmarshalJSON(data []
 
 func (d *DeleteServicesFreeRadiusUserEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -17913,11 +17913,11 @@ func (d *DeleteServicesFreeRadiusUsersEndpointResponse) UnmarshalJSON(data []byt
 
 func (d *DeleteServicesFreeRadiusUsersEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -17964,11 +17964,11 @@ func (d *DeleteServicesFreeRadiusUsersEndpointResponseDataItem) UnmarshalJSON(da
 
 func (d *DeleteServicesFreeRadiusUsersEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -18644,11 +18644,11 @@ func (d *DeleteServicesHaProxyBackendEndpointResponse) UnmarshalJSON(data []byte
 
 func (d *DeleteServicesHaProxyBackendEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -18787,11 +18787,11 @@ func (d *DeleteServicesHaProxyBackendEndpointResponseData) UnmarshalJSON(data []
 
 func (d *DeleteServicesHaProxyBackendEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -19374,11 +19374,11 @@ func (d *DeleteServicesHaProxyBackendsEndpointResponse) UnmarshalJSON(data []byt
 
 func (d *DeleteServicesHaProxyBackendsEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -19517,11 +19517,11 @@ func (d *DeleteServicesHaProxyBackendsEndpointResponseDataItem) UnmarshalJSON(da
 
 func (d *DeleteServicesHaProxyBackendsEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -22303,11 +22303,11 @@ func (g *GetServicesAcmeAccountKeyEndpointResponse) UnmarshalJSON(data []byte) e
 
 func (g *GetServicesAcmeAccountKeyEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -22342,11 +22342,11 @@ func (g *GetServicesAcmeAccountKeyEndpointResponseData) UnmarshalJSON(data []byt
 
 func (g *GetServicesAcmeAccountKeyEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -22565,11 +22565,11 @@ func (g *GetServicesAcmeAccountKeysEndpointResponse) UnmarshalJSON(data []byte)
 
 func (g *GetServicesAcmeAccountKeysEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -22604,11 +22604,11 @@ func (g *GetServicesAcmeAccountKeysEndpointResponseDataItem) UnmarshalJSON(data
 
 func (g *GetServicesAcmeAccountKeysEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -22946,11 +22946,11 @@ func (g *GetServicesAcmeCertificateDomainEndpointResponse) UnmarshalJSON(data []
 
 func (g *GetServicesAcmeCertificateDomainEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -23566,11 +23566,11 @@ func (g *GetServicesAcmeCertificateDomainEndpointResponseData) UnmarshalJSON(dat
 
 func (g *GetServicesAcmeCertificateDomainEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -23662,11 +23662,11 @@ func (g *GetServicesAcmeCertificateEndpointResponse) UnmarshalJSON(data []byte)
 
 func (g *GetServicesAcmeCertificateEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -23715,11 +23715,11 @@ func (g *GetServicesAcmeCertificateEndpointResponseData) UnmarshalJSON(data []by
 
 func (g *GetServicesAcmeCertificateEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -24074,11 +24074,11 @@ func (g *GetServicesAcmeCertificatesEndpointResponse) UnmarshalJSON(data []byte)
 
 func (g *GetServicesAcmeCertificatesEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -24127,11 +24127,11 @@ func (g *GetServicesAcmeCertificatesEndpointResponseDataItem) UnmarshalJSON(data
 
 func (g *GetServicesAcmeCertificatesEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -24878,11 +24878,11 @@ func (g *GetServicesBindSyncRemoteHostEndpointResponse) UnmarshalJSON(data []byt
 
 func (g *GetServicesBindSyncRemoteHostEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -24919,11 +24919,11 @@ func (g *GetServicesBindSyncRemoteHostEndpointResponseData) UnmarshalJSON(data [
 
 func (g *GetServicesBindSyncRemoteHostEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -25014,11 +25014,11 @@ func (g *GetServicesBindSyncRemoteHostsEndpointResponse) UnmarshalJSON(data []by
 
 func (g *GetServicesBindSyncRemoteHostsEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -25055,11 +25055,11 @@ func (g *GetServicesBindSyncRemoteHostsEndpointResponseDataItem) UnmarshalJSON(d
 
 func (g *GetServicesBindSyncRemoteHostsEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -29932,11 +29932,11 @@ func (g *GetServicesFreeRadiusClientEndpointResponse) UnmarshalJSON(data []byte)
 
 func (g *GetServicesFreeRadiusClientEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -29985,11 +29985,11 @@ func (g *GetServicesFreeRadiusClientEndpointResponseData) UnmarshalJSON(data []b
 
 func (g *GetServicesFreeRadiusClientEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -30080,11 +30080,11 @@ func (g *GetServicesFreeRadiusClientsEndpointResponse) UnmarshalJSON(data []byte
 
 func (g *GetServicesFreeRadiusClientsEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -30133,11 +30133,11 @@ func (g *GetServicesFreeRadiusClientsEndpointResponseDataItem) UnmarshalJSON(dat
 
 func (g *GetServicesFreeRadiusClientsEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -30498,11 +30498,11 @@ func (g *GetServicesFreeRadiusUserEndpointResponse) UnmarshalJSON(data []byte) e
 
 func (g *GetServicesFreeRadiusUserEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -30549,11 +30549,11 @@ func (g *GetServicesFreeRadiusUserEndpointResponseData) UnmarshalJSON(data []byt
 
 func (g *GetServicesFreeRadiusUserEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -30644,11 +30644,11 @@ func (g *GetServicesFreeRadiusUsersEndpointResponse) UnmarshalJSON(data []byte)
 
 func (g *GetServicesFreeRadiusUsersEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -30695,11 +30695,11 @@ func (g *GetServicesFreeRadiusUsersEndpointResponseDataItem) UnmarshalJSON(data
 
 func (g *GetServicesFreeRadiusUsersEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -31526,11 +31526,11 @@ func (g *GetServicesHaProxyBackendEndpointResponse) UnmarshalJSON(data []byte) e
 
 func (g *GetServicesHaProxyBackendEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -31669,11 +31669,11 @@ func (g *GetServicesHaProxyBackendEndpointResponseData) UnmarshalJSON(data []byt
 
 func (g *GetServicesHaProxyBackendEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -32424,11 +32424,11 @@ func (g *GetServicesHaProxyBackendsEndpointResponse) UnmarshalJSON(data []byte)
 
 func (g *GetServicesHaProxyBackendsEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -32567,11 +32567,11 @@ func (g *GetServicesHaProxyBackendsEndpointResponseDataItem) UnmarshalJSON(data
 
 func (g *GetServicesHaProxyBackendsEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -35421,11 +35421,11 @@ func (g *GetServicesNtpSettingsEndpointResponse) UnmarshalJSON(data []byte) erro
 
 func (g *GetServicesNtpSettingsEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -36029,11 +36029,11 @@ func (p *PatchServicesAcmeAccountKeyEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PatchServicesAcmeAccountKeyEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -36068,11 +36068,11 @@ func (p *PatchServicesAcmeAccountKeyEndpointResponseData) UnmarshalJSON(data []b
 
 func (p *PatchServicesAcmeAccountKeyEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -36182,11 +36182,11 @@ func (p *PatchServicesAcmeCertificateDomainEndpointResponse) UnmarshalJSON(data
 
 func (p *PatchServicesAcmeCertificateDomainEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -36802,11 +36802,11 @@ func (p *PatchServicesAcmeCertificateDomainEndpointResponseData) UnmarshalJSON(d
 
 func (p *PatchServicesAcmeCertificateDomainEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -36841,11 +36841,11 @@ func (p *PatchServicesAcmeCertificateEndpointResponse) UnmarshalJSON(data []byte
 
 func (p *PatchServicesAcmeCertificateEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -36894,11 +36894,11 @@ func (p *PatchServicesAcmeCertificateEndpointResponseData) UnmarshalJSON(data []
 
 func (p *PatchServicesAcmeCertificateEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -37158,11 +37158,11 @@ func (p *PatchServicesBindSyncRemoteHostEndpointResponse) UnmarshalJSON(data []b
 
 func (p *PatchServicesBindSyncRemoteHostEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -37199,11 +37199,11 @@ func (p *PatchServicesBindSyncRemoteHostEndpointResponseData) UnmarshalJSON(data
 
 func (p *PatchServicesBindSyncRemoteHostEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -38687,11 +38687,11 @@ func (p *PatchServicesFreeRadiusClientEndpointResponse) UnmarshalJSON(data []byt
 
 func (p *PatchServicesFreeRadiusClientEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -38740,11 +38740,11 @@ func (p *PatchServicesFreeRadiusClientEndpointResponseData) UnmarshalJSON(data [
 
 func (p *PatchServicesFreeRadiusClientEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -38857,11 +38857,11 @@ func (p *PatchServicesFreeRadiusUserEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PatchServicesFreeRadiusUserEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -38908,11 +38908,11 @@ func (p *PatchServicesFreeRadiusUserEndpointResponseData) UnmarshalJSON(data []b
 
 func (p *PatchServicesFreeRadiusUserEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -39125,11 +39125,11 @@ func (p *PatchServicesHaProxyBackendEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PatchServicesHaProxyBackendEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -39268,11 +39268,11 @@ func (p *PatchServicesHaProxyBackendEndpointResponseData) UnmarshalJSON(data []b
 
 func (p *PatchServicesHaProxyBackendEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -40243,11 +40243,11 @@ func (p *PatchServicesNtpSettingsEndpointResponse) UnmarshalJSON(data []byte) er
 
 func (p *PatchServicesNtpSettingsEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -40473,11 +40473,11 @@ func (p *PostServicesAcmeAccountKeyEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PostServicesAcmeAccountKeyEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -40512,11 +40512,11 @@ func (p *PostServicesAcmeAccountKeyEndpointResponseData) UnmarshalJSON(data []by
 
 func (p *PostServicesAcmeAccountKeyEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -40698,11 +40698,11 @@ func (p *PostServicesAcmeCertificateDomainEndpointResponse) UnmarshalJSON(data [
 
 func (p *PostServicesAcmeCertificateDomainEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -41318,11 +41318,11 @@ func (p *PostServicesAcmeCertificateDomainEndpointResponseData) UnmarshalJSON(da
 
 func (p *PostServicesAcmeCertificateDomainEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -41357,11 +41357,11 @@ func (p *PostServicesAcmeCertificateEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PostServicesAcmeCertificateEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -41410,11 +41410,11 @@ func (p *PostServicesAcmeCertificateEndpointResponseData) UnmarshalJSON(data []b
 
 func (p *PostServicesAcmeCertificateEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -41748,11 +41748,11 @@ func (p *PostServicesBindSyncRemoteHostEndpointResponse) UnmarshalJSON(data []by
 
 func (p *PostServicesBindSyncRemoteHostEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -41789,11 +41789,11 @@ func (p *PostServicesBindSyncRemoteHostEndpointResponseData) UnmarshalJSON(data
 
 func (p *PostServicesBindSyncRemoteHostEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -43238,11 +43238,11 @@ func (p *PostServicesFreeRadiusClientEndpointResponse) UnmarshalJSON(data []byte
 
 func (p *PostServicesFreeRadiusClientEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -43291,11 +43291,11 @@ func (p *PostServicesFreeRadiusClientEndpointResponseData) UnmarshalJSON(data []
 
 func (p *PostServicesFreeRadiusClientEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -43408,11 +43408,11 @@ func (p *PostServicesFreeRadiusUserEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PostServicesFreeRadiusUserEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -43459,11 +43459,11 @@ func (p *PostServicesFreeRadiusUserEndpointResponseData) UnmarshalJSON(data []by
 
 func (p *PostServicesFreeRadiusUserEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -43715,11 +43715,11 @@ func (p *PostServicesHaProxyBackendEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PostServicesHaProxyBackendEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -43858,11 +43858,11 @@ func (p *PostServicesHaProxyBackendEndpointResponseData) UnmarshalJSON(data []by
 
 func (p *PostServicesHaProxyBackendEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -44984,11 +44984,11 @@ func (p *PutServicesAcmeAccountKeysEndpointRequestItem) UnmarshalJSON(data []byt
 
 func (p *PutServicesAcmeAccountKeysEndpointRequestItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -45023,11 +45023,11 @@ func (p *PutServicesAcmeAccountKeysEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PutServicesAcmeAccountKeysEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -45062,11 +45062,11 @@ func (p *PutServicesAcmeAccountKeysEndpointResponseDataItem) UnmarshalJSON(data
 
 func (p *PutServicesAcmeAccountKeysEndpointResponseDataItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -45114,11 +45114,11 @@ func (p *PutServicesAcmeCertificatesEndpointRequestItem) UnmarshalJSON(data []by
 
 func (p *PutServicesAcmeCertificatesEndpointRequestItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -45153,11 +45153,11 @@ func (p *PutServicesAcmeCertificatesEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PutServicesAcmeCertificatesEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -45206,11 +45206,11 @@ func (p *PutServicesAcmeCertificatesEndpointResponseDataItem) UnmarshalJSON(data
 
 func (p *PutServicesAcmeCertificatesEndpointResponseDataItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -45354,11 +45354,11 @@ func (p *PutServicesBindSyncRemoteHostsEndpointRequestItem) UnmarshalJSON(data [
 
 func (p *PutServicesBindSyncRemoteHostsEndpointRequestItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -45393,11 +45393,11 @@ func (p *PutServicesBindSyncRemoteHostsEndpointResponse) UnmarshalJSON(data []by
 
 func (p *PutServicesBindSyncRemoteHostsEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -45434,11 +45434,11 @@ func (p *PutServicesBindSyncRemoteHostsEndpointResponseDataItem) UnmarshalJSON(d
 
 func (p *PutServicesBindSyncRemoteHostsEndpointResponseDataItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -46606,11 +46606,11 @@ func (p *PutServicesFreeRadiusClientsEndpointRequestItem) UnmarshalJSON(data []b
 
 func (p *PutServicesFreeRadiusClientsEndpointRequestItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -46645,11 +46645,11 @@ func (p *PutServicesFreeRadiusClientsEndpointResponse) UnmarshalJSON(data []byte
 
 func (p *PutServicesFreeRadiusClientsEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -46698,11 +46698,11 @@ func (p *PutServicesFreeRadiusClientsEndpointResponseDataItem) UnmarshalJSON(dat
 
 func (p *PutServicesFreeRadiusClientsEndpointResponseDataItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -46864,11 +46864,11 @@ func (p *PutServicesFreeRadiusUsersEndpointRequestItem) UnmarshalJSON(data []byt
 
 func (p *PutServicesFreeRadiusUsersEndpointRequestItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -46903,11 +46903,11 @@ func (p *PutServicesFreeRadiusUsersEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PutServicesFreeRadiusUsersEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -46954,11 +46954,11 @@ func (p *PutServicesFreeRadiusUsersEndpointResponseDataItem) UnmarshalJSON(data
 
 func (p *PutServicesFreeRadiusUsersEndpointResponseDataItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -47096,11 +47096,11 @@ func (p *PutServicesHaProxyBackendsEndpointRequestItem) UnmarshalJSON(data []byt
 
 func (p *PutServicesHaProxyBackendsEndpointRequestItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -47135,11 +47135,11 @@ func (p *PutServicesHaProxyBackendsEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PutServicesHaProxyBackendsEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -47278,11 +47278,11 @@ func (p *PutServicesHaProxyBackendsEndpointResponseDataItem) UnmarshalJSON(data
 
 func (p *PutServicesHaProxyBackendsEndpointResponseDataItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -0,0 +1,1282 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	iter "iter"
+)
+
+// AllServicesAcmeAccountKeyRegistrations iterates over every object returned by GetServicesAcmeAccountKeyRegistrationsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesAcmeAccountKeyRegistrations(
+	ctx context.Context,
+	request *pkgclient.GetServicesAcmeAccountKeyRegistrationsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesAcmeAccountKeyRegistrationsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesAcmeAccountKeyRegistrationsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesAcmeAccountKeyRegistrationsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesAcmeAccountKeyRegistrationsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesAcmeAccountKeys iterates over every object returned by GetServicesAcmeAccountKeysEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesAcmeAccountKeys(
+	ctx context.Context,
+	request *pkgclient.GetServicesAcmeAccountKeysEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesAcmeAccountKeysEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesAcmeAccountKeysEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesAcmeAccountKeysEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesAcmeAccountKeysEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesAcmeCertificateIssuances iterates over every object returned by GetServicesAcmeCertificateIssuancesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesAcmeCertificateIssuances(
+	ctx context.Context,
+	request *pkgclient.GetServicesAcmeCertificateIssuancesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesAcmeCertificateIssuancesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesAcmeCertificateIssuancesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesAcmeCertificateIssuancesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesAcmeCertificateIssuancesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesAcmeCertificateRenewals iterates over every object returned by GetServicesAcmeCertificateRenewalsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesAcmeCertificateRenewals(
+	ctx context.Context,
+	request *pkgclient.GetServicesAcmeCertificateRenewalsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesAcmeCertificateRenewalsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesAcmeCertificateRenewalsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesAcmeCertificateRenewalsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesAcmeCertificateRenewalsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesAcmeCertificates iterates over every object returned by GetServicesAcmeCertificatesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesAcmeCertificates(
+	ctx context.Context,
+	request *pkgclient.GetServicesAcmeCertificatesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesAcmeCertificatesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesAcmeCertificatesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesAcmeCertificatesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesAcmeCertificatesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesBindAccessListEntries iterates over every object returned by GetServicesBindAccessListEntriesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesBindAccessListEntries(
+	ctx context.Context,
+	request *pkgclient.GetServicesBindAccessListEntriesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesBindAccessListEntriesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesBindAccessListEntriesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesBindAccessListEntriesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesBindAccessListEntriesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesBindAccessLists iterates over every object returned by GetServicesBindAccessListsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesBindAccessLists(
+	ctx context.Context,
+	request *pkgclient.GetServicesBindAccessListsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesBindAccessListsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesBindAccessListsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesBindAccessListsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesBindAccessListsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesBindSyncRemoteHosts iterates over every object returned by GetServicesBindSyncRemoteHostsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesBindSyncRemoteHosts(
+	ctx context.Context,
+	request *pkgclient.GetServicesBindSyncRemoteHostsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesBindSyncRemoteHostsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesBindSyncRemoteHostsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesBindSyncRemoteHostsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesBindSyncRemoteHostsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesBindViews iterates over every object returned by GetServicesBindViewsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesBindViews(
+	ctx context.Context,
+	request *pkgclient.GetServicesBindViewsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesBindViewsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesBindViewsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesBindViewsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesBindViewsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesBindZones iterates over every object returned by GetServicesBindZonesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesBindZones(
+	ctx context.Context,
+	request *pkgclient.GetServicesBindZonesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesBindZonesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesBindZonesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesBindZonesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesBindZonesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesCronJobs iterates over every object returned by GetServicesCronJobsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesCronJobs(
+	ctx context.Context,
+	request *pkgclient.GetServicesCronJobsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesCronJobsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesCronJobsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesCronJobsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesCronJobsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDNSForwarderHostOverrideAliases iterates over every object returned by GetServicesDNSForwarderHostOverrideAliasesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDNSForwarderHostOverrideAliases(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSForwarderHostOverrideAliasesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDNSForwarderHostOverrideAliasesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDNSForwarderHostOverrideAliasesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDNSForwarderHostOverrideAliasesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDNSForwarderHostOverrideAliasesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDNSForwarderHostOverrides iterates over every object returned by GetServicesDNSForwarderHostOverridesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDNSForwarderHostOverrides(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSForwarderHostOverridesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDNSForwarderHostOverridesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDNSForwarderHostOverridesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDNSForwarderHostOverridesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDNSForwarderHostOverridesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDNSResolverAccessListNetworks iterates over every object returned by GetServicesDNSResolverAccessListNetworksEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDNSResolverAccessListNetworks(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSResolverAccessListNetworksEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDNSResolverAccessListNetworksEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDNSResolverAccessListNetworksEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDNSResolverAccessListNetworksEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDNSResolverAccessListNetworksEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDNSResolverAccessLists iterates over every object returned by GetServicesDNSResolverAccessListsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDNSResolverAccessLists(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSResolverAccessListsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDNSResolverAccessListsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDNSResolverAccessListsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDNSResolverAccessListsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDNSResolverAccessListsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDNSResolverDomainOverrides iterates over every object returned by GetServicesDNSResolverDomainOverridesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDNSResolverDomainOverrides(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSResolverDomainOverridesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDNSResolverDomainOverridesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDNSResolverDomainOverridesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDNSResolverDomainOverridesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDNSResolverDomainOverridesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDNSResolverHostOverrideAliases iterates over every object returned by GetServicesDNSResolverHostOverrideAliasesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDNSResolverHostOverrideAliases(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSResolverHostOverrideAliasesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDNSResolverHostOverrideAliasesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDNSResolverHostOverrideAliasesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDNSResolverHostOverrideAliasesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDNSResolverHostOverrideAliasesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDNSResolverHostOverrides iterates over every object returned by GetServicesDNSResolverHostOverridesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDNSResolverHostOverrides(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSResolverHostOverridesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDNSResolverHostOverridesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDNSResolverHostOverridesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDNSResolverHostOverridesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDNSResolverHostOverridesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDhcpServerAddressPools iterates over every object returned by GetServicesDhcpServerAddressPoolsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDhcpServerAddressPools(
+	ctx context.Context,
+	request *pkgclient.GetServicesDhcpServerAddressPoolsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDhcpServerAddressPoolsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDhcpServerAddressPoolsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDhcpServerAddressPoolsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDhcpServerAddressPoolsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDhcpServerCustomOptions iterates over every object returned by GetServicesDhcpServerCustomOptionsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDhcpServerCustomOptions(
+	ctx context.Context,
+	request *pkgclient.GetServicesDhcpServerCustomOptionsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDhcpServerCustomOptionsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDhcpServerCustomOptionsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDhcpServerCustomOptionsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDhcpServerCustomOptionsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDhcpServerStaticMappings iterates over every object returned by GetServicesDhcpServerStaticMappingsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDhcpServerStaticMappings(
+	ctx context.Context,
+	request *pkgclient.GetServicesDhcpServerStaticMappingsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDhcpServerStaticMappingsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDhcpServerStaticMappingsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDhcpServerStaticMappingsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDhcpServerStaticMappingsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesDhcpServers iterates over every object returned by GetServicesDhcpServersEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesDhcpServers(
+	ctx context.Context,
+	request *pkgclient.GetServicesDhcpServersEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesDhcpServersEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesDhcpServersEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesDhcpServersEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesDhcpServersEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesFreeRadiusClients iterates over every object returned by GetServicesFreeRadiusClientsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesFreeRadiusClients(
+	ctx context.Context,
+	request *pkgclient.GetServicesFreeRadiusClientsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesFreeRadiusClientsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesFreeRadiusClientsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesFreeRadiusClientsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesFreeRadiusClientsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesFreeRadiusInterfaces iterates over every object returned by GetServicesFreeRadiusInterfacesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesFreeRadiusInterfaces(
+	ctx context.Context,
+	request *pkgclient.GetServicesFreeRadiusInterfacesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesFreeRadiusInterfacesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesFreeRadiusInterfacesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesFreeRadiusInterfacesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesFreeRadiusInterfacesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesFreeRadiusUsers iterates over every object returned by GetServicesFreeRadiusUsersEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesFreeRadiusUsers(
+	ctx context.Context,
+	request *pkgclient.GetServicesFreeRadiusUsersEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesFreeRadiusUsersEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesFreeRadiusUsersEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesFreeRadiusUsersEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesFreeRadiusUsersEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyBackendAcLs iterates over every object returned by GetServicesHaProxyBackendAcLsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyBackendAcLs(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyBackendAcLsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyBackendAcLsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyBackendAcLsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyBackendAcLsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyBackendAcLsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyBackendActions iterates over every object returned by GetServicesHaProxyBackendActionsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyBackendActions(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyBackendActionsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyBackendActionsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyBackendActionsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyBackendActionsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyBackendActionsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyBackendErrorFiles iterates over every object returned by GetServicesHaProxyBackendErrorFilesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyBackendErrorFiles(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyBackendErrorFilesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyBackendErrorFilesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyBackendErrorFilesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyBackendErrorFilesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyBackendErrorFilesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyBackendServers iterates over every object returned by GetServicesHaProxyBackendServersEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyBackendServers(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyBackendServersEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyBackendServersEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyBackendServersEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyBackendServersEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyBackendServersEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyBackends iterates over every object returned by GetServicesHaProxyBackendsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyBackends(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyBackendsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyBackendsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyBackendsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyBackendsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyBackendsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyFiles iterates over every object returned by GetServicesHaProxyFiles, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyFiles(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFilesRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyFilesResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyFilesRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyFilesResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyFiles(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyFrontendAcLs iterates over every object returned by GetServicesHaProxyFrontendAcLsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyFrontendAcLs(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendAcLsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyFrontendAcLsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyFrontendAcLsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyFrontendAcLsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyFrontendAcLsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyFrontendActions iterates over every object returned by GetServicesHaProxyFrontendActionsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyFrontendActions(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendActionsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyFrontendActionsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyFrontendActionsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyFrontendActionsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyFrontendActionsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyFrontendAddresses iterates over every object returned by GetServicesHaProxyFrontendAddressesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyFrontendAddresses(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendAddressesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyFrontendAddressesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyFrontendAddressesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyFrontendAddressesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyFrontendAddressesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyFrontendCertificates iterates over every object returned by GetServicesHaProxyFrontendCertificatesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyFrontendCertificates(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendCertificatesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyFrontendCertificatesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyFrontendCertificatesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyFrontendCertificatesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyFrontendCertificatesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyFrontendErrorFiles iterates over every object returned by GetServicesHaProxyFrontendErrorFilesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyFrontendErrorFiles(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendErrorFilesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyFrontendErrorFilesEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyFrontendErrorFilesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyFrontendErrorFilesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyFrontendErrorFilesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxyFrontends iterates over every object returned by GetServicesHaProxyFrontendsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxyFrontends(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxyFrontendsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxyFrontendsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxyFrontendsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxyFrontendsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxySettingsDNSResolvers iterates over every object returned by GetServicesHaProxySettingsDNSResolversEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxySettingsDNSResolvers(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxySettingsDNSResolversEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxySettingsDNSResolversEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxySettingsDNSResolversEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxySettingsDNSResolversEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxySettingsDNSResolversEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesHaProxySettingsEmailMailers iterates over every object returned by GetServicesHaProxySettingsEmailMailersEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesHaProxySettingsEmailMailers(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxySettingsEmailMailersEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesHaProxySettingsEmailMailersEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesHaProxySettingsEmailMailersEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesHaProxySettingsEmailMailersEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesHaProxySettingsEmailMailersEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesNtpTimeServers iterates over every object returned by GetServicesNtpTimeServersEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesNtpTimeServers(
+	ctx context.Context,
+	request *pkgclient.GetServicesNtpTimeServersEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesNtpTimeServersEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesNtpTimeServersEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesNtpTimeServersEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesNtpTimeServersEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServicesServiceWatchdogs iterates over every object returned by GetServicesServiceWatchdogsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServicesServiceWatchdogs(
+	ctx context.Context,
+	request *pkgclient.GetServicesServiceWatchdogsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetServicesServiceWatchdogsEndpointResponseDataItem, error] {
+	var page pkgclient.GetServicesServiceWatchdogsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetServicesServiceWatchdogsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetServicesServiceWatchdogsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
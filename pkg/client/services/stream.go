@@ -0,0 +1,667 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	io "io"
+)
+
+// StreamServicesAcmeAccountKeyRegistrations calls GetServicesAcmeAccountKeyRegistrationsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesAcmeAccountKeyRegistrations(
+	ctx context.Context,
+	request *pkgclient.GetServicesAcmeAccountKeyRegistrationsEndpointRequest,
+	fn func(*pkgclient.GetServicesAcmeAccountKeyRegistrationsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesAcmeAccountKeyRegistrationsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesAcmeAccountKeys calls GetServicesAcmeAccountKeysEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesAcmeAccountKeys(
+	ctx context.Context,
+	request *pkgclient.GetServicesAcmeAccountKeysEndpointRequest,
+	fn func(*pkgclient.GetServicesAcmeAccountKeysEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesAcmeAccountKeysEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesAcmeCertificateIssuances calls GetServicesAcmeCertificateIssuancesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesAcmeCertificateIssuances(
+	ctx context.Context,
+	request *pkgclient.GetServicesAcmeCertificateIssuancesEndpointRequest,
+	fn func(*pkgclient.GetServicesAcmeCertificateIssuancesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesAcmeCertificateIssuancesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesAcmeCertificateRenewals calls GetServicesAcmeCertificateRenewalsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesAcmeCertificateRenewals(
+	ctx context.Context,
+	request *pkgclient.GetServicesAcmeCertificateRenewalsEndpointRequest,
+	fn func(*pkgclient.GetServicesAcmeCertificateRenewalsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesAcmeCertificateRenewalsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesAcmeCertificates calls GetServicesAcmeCertificatesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesAcmeCertificates(
+	ctx context.Context,
+	request *pkgclient.GetServicesAcmeCertificatesEndpointRequest,
+	fn func(*pkgclient.GetServicesAcmeCertificatesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesAcmeCertificatesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesBindAccessListEntries calls GetServicesBindAccessListEntriesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesBindAccessListEntries(
+	ctx context.Context,
+	request *pkgclient.GetServicesBindAccessListEntriesEndpointRequest,
+	fn func(*pkgclient.GetServicesBindAccessListEntriesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesBindAccessListEntriesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesBindAccessLists calls GetServicesBindAccessListsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesBindAccessLists(
+	ctx context.Context,
+	request *pkgclient.GetServicesBindAccessListsEndpointRequest,
+	fn func(*pkgclient.GetServicesBindAccessListsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesBindAccessListsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesBindSyncRemoteHosts calls GetServicesBindSyncRemoteHostsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesBindSyncRemoteHosts(
+	ctx context.Context,
+	request *pkgclient.GetServicesBindSyncRemoteHostsEndpointRequest,
+	fn func(*pkgclient.GetServicesBindSyncRemoteHostsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesBindSyncRemoteHostsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesBindViews calls GetServicesBindViewsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesBindViews(
+	ctx context.Context,
+	request *pkgclient.GetServicesBindViewsEndpointRequest,
+	fn func(*pkgclient.GetServicesBindViewsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesBindViewsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesBindZones calls GetServicesBindZonesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesBindZones(
+	ctx context.Context,
+	request *pkgclient.GetServicesBindZonesEndpointRequest,
+	fn func(*pkgclient.GetServicesBindZonesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesBindZonesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesCronJobs calls GetServicesCronJobsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesCronJobs(
+	ctx context.Context,
+	request *pkgclient.GetServicesCronJobsEndpointRequest,
+	fn func(*pkgclient.GetServicesCronJobsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesCronJobsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDNSForwarderHostOverrideAliases calls GetServicesDNSForwarderHostOverrideAliasesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDNSForwarderHostOverrideAliases(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSForwarderHostOverrideAliasesEndpointRequest,
+	fn func(*pkgclient.GetServicesDNSForwarderHostOverrideAliasesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDNSForwarderHostOverrideAliasesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDNSForwarderHostOverrides calls GetServicesDNSForwarderHostOverridesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDNSForwarderHostOverrides(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSForwarderHostOverridesEndpointRequest,
+	fn func(*pkgclient.GetServicesDNSForwarderHostOverridesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDNSForwarderHostOverridesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDNSResolverAccessListNetworks calls GetServicesDNSResolverAccessListNetworksEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDNSResolverAccessListNetworks(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSResolverAccessListNetworksEndpointRequest,
+	fn func(*pkgclient.GetServicesDNSResolverAccessListNetworksEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDNSResolverAccessListNetworksEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDNSResolverAccessLists calls GetServicesDNSResolverAccessListsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDNSResolverAccessLists(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSResolverAccessListsEndpointRequest,
+	fn func(*pkgclient.GetServicesDNSResolverAccessListsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDNSResolverAccessListsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDNSResolverDomainOverrides calls GetServicesDNSResolverDomainOverridesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDNSResolverDomainOverrides(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSResolverDomainOverridesEndpointRequest,
+	fn func(*pkgclient.GetServicesDNSResolverDomainOverridesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDNSResolverDomainOverridesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDNSResolverHostOverrideAliases calls GetServicesDNSResolverHostOverrideAliasesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDNSResolverHostOverrideAliases(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSResolverHostOverrideAliasesEndpointRequest,
+	fn func(*pkgclient.GetServicesDNSResolverHostOverrideAliasesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDNSResolverHostOverrideAliasesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDNSResolverHostOverrides calls GetServicesDNSResolverHostOverridesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDNSResolverHostOverrides(
+	ctx context.Context,
+	request *pkgclient.GetServicesDNSResolverHostOverridesEndpointRequest,
+	fn func(*pkgclient.GetServicesDNSResolverHostOverridesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDNSResolverHostOverridesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDhcpServerAddressPools calls GetServicesDhcpServerAddressPoolsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDhcpServerAddressPools(
+	ctx context.Context,
+	request *pkgclient.GetServicesDhcpServerAddressPoolsEndpointRequest,
+	fn func(*pkgclient.GetServicesDhcpServerAddressPoolsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDhcpServerAddressPoolsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDhcpServerCustomOptions calls GetServicesDhcpServerCustomOptionsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDhcpServerCustomOptions(
+	ctx context.Context,
+	request *pkgclient.GetServicesDhcpServerCustomOptionsEndpointRequest,
+	fn func(*pkgclient.GetServicesDhcpServerCustomOptionsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDhcpServerCustomOptionsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDhcpServerStaticMappings calls GetServicesDhcpServerStaticMappingsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDhcpServerStaticMappings(
+	ctx context.Context,
+	request *pkgclient.GetServicesDhcpServerStaticMappingsEndpointRequest,
+	fn func(*pkgclient.GetServicesDhcpServerStaticMappingsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDhcpServerStaticMappingsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesDhcpServers calls GetServicesDhcpServersEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesDhcpServers(
+	ctx context.Context,
+	request *pkgclient.GetServicesDhcpServersEndpointRequest,
+	fn func(*pkgclient.GetServicesDhcpServersEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesDhcpServersEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesFreeRadiusClients calls GetServicesFreeRadiusClientsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesFreeRadiusClients(
+	ctx context.Context,
+	request *pkgclient.GetServicesFreeRadiusClientsEndpointRequest,
+	fn func(*pkgclient.GetServicesFreeRadiusClientsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesFreeRadiusClientsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesFreeRadiusInterfaces calls GetServicesFreeRadiusInterfacesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesFreeRadiusInterfaces(
+	ctx context.Context,
+	request *pkgclient.GetServicesFreeRadiusInterfacesEndpointRequest,
+	fn func(*pkgclient.GetServicesFreeRadiusInterfacesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesFreeRadiusInterfacesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesFreeRadiusUsers calls GetServicesFreeRadiusUsersEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesFreeRadiusUsers(
+	ctx context.Context,
+	request *pkgclient.GetServicesFreeRadiusUsersEndpointRequest,
+	fn func(*pkgclient.GetServicesFreeRadiusUsersEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesFreeRadiusUsersEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyBackendAcLs calls GetServicesHaProxyBackendAcLsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyBackendAcLs(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyBackendAcLsEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyBackendAcLsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyBackendAcLsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyBackendActions calls GetServicesHaProxyBackendActionsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyBackendActions(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyBackendActionsEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyBackendActionsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyBackendActionsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyBackendErrorFiles calls GetServicesHaProxyBackendErrorFilesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyBackendErrorFiles(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyBackendErrorFilesEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyBackendErrorFilesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyBackendErrorFilesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyBackendServers calls GetServicesHaProxyBackendServersEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyBackendServers(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyBackendServersEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyBackendServersEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyBackendServersEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyBackends calls GetServicesHaProxyBackendsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyBackends(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyBackendsEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyBackendsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyBackendsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyFiles calls GetServicesHaProxyFiles and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyFiles(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFilesRequest,
+	fn func(*pkgclient.GetServicesHaProxyFilesResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyFiles(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyFrontendAcLs calls GetServicesHaProxyFrontendAcLsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyFrontendAcLs(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendAcLsEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyFrontendAcLsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyFrontendAcLsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyFrontendActions calls GetServicesHaProxyFrontendActionsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyFrontendActions(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendActionsEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyFrontendActionsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyFrontendActionsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyFrontendAddresses calls GetServicesHaProxyFrontendAddressesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyFrontendAddresses(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendAddressesEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyFrontendAddressesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyFrontendAddressesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyFrontendCertificates calls GetServicesHaProxyFrontendCertificatesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyFrontendCertificates(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendCertificatesEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyFrontendCertificatesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyFrontendCertificatesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyFrontendErrorFiles calls GetServicesHaProxyFrontendErrorFilesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyFrontendErrorFiles(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendErrorFilesEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyFrontendErrorFilesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyFrontendErrorFilesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxyFrontends calls GetServicesHaProxyFrontendsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxyFrontends(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxyFrontendsEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxyFrontendsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxyFrontendsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxySettingsDNSResolvers calls GetServicesHaProxySettingsDNSResolversEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxySettingsDNSResolvers(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxySettingsDNSResolversEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxySettingsDNSResolversEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxySettingsDNSResolversEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesHaProxySettingsEmailMailers calls GetServicesHaProxySettingsEmailMailersEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesHaProxySettingsEmailMailers(
+	ctx context.Context,
+	request *pkgclient.GetServicesHaProxySettingsEmailMailersEndpointRequest,
+	fn func(*pkgclient.GetServicesHaProxySettingsEmailMailersEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesHaProxySettingsEmailMailersEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesNtpTimeServers calls GetServicesNtpTimeServersEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesNtpTimeServers(
+	ctx context.Context,
+	request *pkgclient.GetServicesNtpTimeServersEndpointRequest,
+	fn func(*pkgclient.GetServicesNtpTimeServersEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesNtpTimeServersEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServicesServiceWatchdogs calls GetServicesServiceWatchdogsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServicesServiceWatchdogs(
+	ctx context.Context,
+	request *pkgclient.GetServicesServiceWatchdogsEndpointRequest,
+	fn func(*pkgclient.GetServicesServiceWatchdogsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetServicesServiceWatchdogsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
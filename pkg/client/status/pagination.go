@@ -0,0 +1,507 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package status
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	iter "iter"
+)
+
+// AllAuthLogs iterates over every object returned by GetStatusLogsAuthEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllAuthLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsAuthEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusLogsAuthEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusLogsAuthEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusLogsAuthEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusLogsAuthEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllDhcpLogs iterates over every object returned by GetStatusLogsDhcpEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllDhcpLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsDhcpEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusLogsDhcpEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusLogsDhcpEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusLogsDhcpEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusLogsDhcpEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllDhcpServerLeases iterates over every object returned by GetStatusDhcpServerLeasesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllDhcpServerLeases(
+	ctx context.Context,
+	request *pkgclient.GetStatusDhcpServerLeasesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusDhcpServerLeasesEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusDhcpServerLeasesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusDhcpServerLeasesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusDhcpServerLeasesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllFirewallLogs iterates over every object returned by GetStatusLogsFirewallEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllFirewallLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsFirewallEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusLogsFirewallEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusLogsFirewallEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusLogsFirewallEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusLogsFirewallEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllGateways iterates over every object returned by GetStatusGatewaysEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllGateways(
+	ctx context.Context,
+	request *pkgclient.GetStatusGatewaysEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusGatewaysEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusGatewaysEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusGatewaysEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusGatewaysEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllIPsecChildSAs iterates over every object returned by GetStatusIPsecChildSAsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllIPsecChildSAs(
+	ctx context.Context,
+	request *pkgclient.GetStatusIPsecChildSAsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusIPsecChildSAsEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusIPsecChildSAsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusIPsecChildSAsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusIPsecChildSAsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllIPsecSAs iterates over every object returned by GetStatusIPsecSAsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllIPsecSAs(
+	ctx context.Context,
+	request *pkgclient.GetStatusIPsecSAsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusIPsecSAsEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusIPsecSAsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusIPsecSAsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusIPsecSAsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllInterfaces iterates over every object returned by GetStatusInterfacesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllInterfaces(
+	ctx context.Context,
+	request *pkgclient.GetStatusInterfacesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusInterfacesEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusInterfacesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusInterfacesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusInterfacesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllOpenVpnClients iterates over every object returned by GetStatusOpenVpnClientsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllOpenVpnClients(
+	ctx context.Context,
+	request *pkgclient.GetStatusOpenVpnClientsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusOpenVpnClientsEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusOpenVpnClientsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusOpenVpnClientsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusOpenVpnClientsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllOpenVpnLogs iterates over every object returned by GetStatusLogsOpenVpnEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllOpenVpnLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsOpenVpnEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusLogsOpenVpnEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusLogsOpenVpnEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusLogsOpenVpnEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusLogsOpenVpnEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllOpenVpnServerConnections iterates over every object returned by GetStatusOpenVpnServerConnectionsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllOpenVpnServerConnections(
+	ctx context.Context,
+	request *pkgclient.GetStatusOpenVpnServerConnectionsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusOpenVpnServerConnectionsEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusOpenVpnServerConnectionsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusOpenVpnServerConnectionsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusOpenVpnServerConnectionsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllOpenVpnServerRoutes iterates over every object returned by GetStatusOpenVpnServerRoutesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllOpenVpnServerRoutes(
+	ctx context.Context,
+	request *pkgclient.GetStatusOpenVpnServerRoutesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusOpenVpnServerRoutesEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusOpenVpnServerRoutesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusOpenVpnServerRoutesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusOpenVpnServerRoutesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllOpenVpnServers iterates over every object returned by GetStatusOpenVpnServersEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllOpenVpnServers(
+	ctx context.Context,
+	request *pkgclient.GetStatusOpenVpnServersEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusOpenVpnServersEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusOpenVpnServersEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusOpenVpnServersEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusOpenVpnServersEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllPackagesRestapiLogs iterates over every object returned by GetStatusLogsPackagesRestapiEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllPackagesRestapiLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsPackagesRestapiEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusLogsPackagesRestapiEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusLogsPackagesRestapiEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusLogsPackagesRestapiEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusLogsPackagesRestapiEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllServices iterates over every object returned by GetStatusServicesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllServices(
+	ctx context.Context,
+	request *pkgclient.GetStatusServicesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusServicesEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusServicesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusServicesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusServicesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllSystemLogs iterates over every object returned by GetStatusLogsSystemEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllSystemLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsSystemEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetStatusLogsSystemEndpointResponseDataItem, error] {
+	var page pkgclient.GetStatusLogsSystemEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetStatusLogsSystemEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetStatusLogsSystemEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
@@ -0,0 +1,267 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package status
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	io "io"
+)
+
+// StreamAuthLogs calls GetStatusLogsAuthEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamAuthLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsAuthEndpointRequest,
+	fn func(*pkgclient.GetStatusLogsAuthEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusLogsAuthEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamDhcpLogs calls GetStatusLogsDhcpEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamDhcpLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsDhcpEndpointRequest,
+	fn func(*pkgclient.GetStatusLogsDhcpEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusLogsDhcpEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamDhcpServerLeases calls GetStatusDhcpServerLeasesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamDhcpServerLeases(
+	ctx context.Context,
+	request *pkgclient.GetStatusDhcpServerLeasesEndpointRequest,
+	fn func(*pkgclient.GetStatusDhcpServerLeasesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusDhcpServerLeasesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamFirewallLogs calls GetStatusLogsFirewallEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamFirewallLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsFirewallEndpointRequest,
+	fn func(*pkgclient.GetStatusLogsFirewallEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusLogsFirewallEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamGateways calls GetStatusGatewaysEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamGateways(
+	ctx context.Context,
+	request *pkgclient.GetStatusGatewaysEndpointRequest,
+	fn func(*pkgclient.GetStatusGatewaysEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusGatewaysEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamIPsecChildSAs calls GetStatusIPsecChildSAsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamIPsecChildSAs(
+	ctx context.Context,
+	request *pkgclient.GetStatusIPsecChildSAsEndpointRequest,
+	fn func(*pkgclient.GetStatusIPsecChildSAsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusIPsecChildSAsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamIPsecSAs calls GetStatusIPsecSAsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamIPsecSAs(
+	ctx context.Context,
+	request *pkgclient.GetStatusIPsecSAsEndpointRequest,
+	fn func(*pkgclient.GetStatusIPsecSAsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusIPsecSAsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamInterfaces calls GetStatusInterfacesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamInterfaces(
+	ctx context.Context,
+	request *pkgclient.GetStatusInterfacesEndpointRequest,
+	fn func(*pkgclient.GetStatusInterfacesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusInterfacesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamOpenVpnClients calls GetStatusOpenVpnClientsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamOpenVpnClients(
+	ctx context.Context,
+	request *pkgclient.GetStatusOpenVpnClientsEndpointRequest,
+	fn func(*pkgclient.GetStatusOpenVpnClientsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusOpenVpnClientsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamOpenVpnLogs calls GetStatusLogsOpenVpnEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamOpenVpnLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsOpenVpnEndpointRequest,
+	fn func(*pkgclient.GetStatusLogsOpenVpnEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusLogsOpenVpnEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamOpenVpnServerConnections calls GetStatusOpenVpnServerConnectionsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamOpenVpnServerConnections(
+	ctx context.Context,
+	request *pkgclient.GetStatusOpenVpnServerConnectionsEndpointRequest,
+	fn func(*pkgclient.GetStatusOpenVpnServerConnectionsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusOpenVpnServerConnectionsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamOpenVpnServerRoutes calls GetStatusOpenVpnServerRoutesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamOpenVpnServerRoutes(
+	ctx context.Context,
+	request *pkgclient.GetStatusOpenVpnServerRoutesEndpointRequest,
+	fn func(*pkgclient.GetStatusOpenVpnServerRoutesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusOpenVpnServerRoutesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamOpenVpnServers calls GetStatusOpenVpnServersEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamOpenVpnServers(
+	ctx context.Context,
+	request *pkgclient.GetStatusOpenVpnServersEndpointRequest,
+	fn func(*pkgclient.GetStatusOpenVpnServersEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusOpenVpnServersEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamPackagesRestapiLogs calls GetStatusLogsPackagesRestapiEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamPackagesRestapiLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsPackagesRestapiEndpointRequest,
+	fn func(*pkgclient.GetStatusLogsPackagesRestapiEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusLogsPackagesRestapiEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamServices calls GetStatusServicesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamServices(
+	ctx context.Context,
+	request *pkgclient.GetStatusServicesEndpointRequest,
+	fn func(*pkgclient.GetStatusServicesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusServicesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamSystemLogs calls GetStatusLogsSystemEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamSystemLogs(
+	ctx context.Context,
+	request *pkgclient.GetStatusLogsSystemEndpointRequest,
+	fn func(*pkgclient.GetStatusLogsSystemEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetStatusLogsSystemEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
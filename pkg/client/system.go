@@ -903,11 +903,11 @@ func (c *Certificate) UnmarshalJSON(data []byte) error {
 
 func (c *Certificate) String() string {
 	if len(c._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(c._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(c); err == nil {
+	if value, err := core.StringifyRedactedJSON(c, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", c)
@@ -945,11 +945,11 @@ func (c *CertificateAuthority) UnmarshalJSON(data []byte) error {
 
 func (c *CertificateAuthority) String() string {
 	if len(c._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(c._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(c); err == nil {
+	if value, err := core.StringifyRedactedJSON(c, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", c)
@@ -1013,11 +1013,11 @@ func (c *CertificateAuthorityGenerate) UnmarshalJSON(data []byte) error {
 
 func (c *CertificateAuthorityGenerate) String() string {
 	if len(c._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(c._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(c); err == nil {
+	if value, err := core.StringifyRedactedJSON(c, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", c)
@@ -1146,11 +1146,11 @@ func (c *CertificateGenerate) UnmarshalJSON(data []byte) error {
 
 func (c *CertificateGenerate) String() string {
 	if len(c._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(c._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(c); err == nil {
+	if value, err := core.StringifyRedactedJSON(c, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", c)
@@ -1230,11 +1230,11 @@ func (c *CertificatePkcs12Export) UnmarshalJSON(data []byte) error {
 
 func (c *CertificatePkcs12Export) String() string {
 	if len(c._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(c._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(c); err == nil {
+	if value, err := core.StringifyRedactedJSON(c, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", c)
@@ -1340,11 +1340,11 @@ func (c *CertificateRevocationList) UnmarshalJSON(data []byte) error {
 
 func (c *CertificateRevocationList) String() string {
 	if len(c._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(c._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(c); err == nil {
+	if value, err := core.StringifyRedactedJSON(c, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", c)
@@ -1386,11 +1386,11 @@ func (c *CertificateRevocationListCertItem) UnmarshalJSON(data []byte) error {
 
 func (c *CertificateRevocationListCertItem) String() string {
 	if len(c._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(c._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(c); err == nil {
+	if value, err := core.StringifyRedactedJSON(c, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", c)
@@ -1455,11 +1455,11 @@ func (c *CertificateRevocationListRevokedCertificate) UnmarshalJSON(data []byte)
 
 func (c *CertificateRevocationListRevokedCertificate) String() string {
 	if len(c._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(c._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(c); err == nil {
+	if value, err := core.StringifyRedactedJSON(c, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", c)
@@ -1523,11 +1523,11 @@ func (c *CertificateSigningRequest) UnmarshalJSON(data []byte) error {
 
 func (c *CertificateSigningRequest) String() string {
 	if len(c._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(c._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(c); err == nil {
+	if value, err := core.StringifyRedactedJSON(c, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", c)
@@ -1600,11 +1600,11 @@ func (c *CertificateSigningRequestSign) UnmarshalJSON(data []byte) error {
 
 func (c *CertificateSigningRequestSign) String() string {
 	if len(c._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(c._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(c._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(c); err == nil {
+	if value, err := core.StringifyRedactedJSON(c, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", c)
@@ -1719,11 +1719,11 @@ func (e *EmailNotificationSettings) UnmarshalJSON(data []byte) error {
 
 func (e *EmailNotificationSettings) String() string {
 	if len(e._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(e._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(e._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(e); err == nil {
+	if value, err := core.StringifyRedactedJSON(e, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", e)
@@ -1946,11 +1946,11 @@ func (r *RestapiSettings) UnmarshalJSON(data []byte) error {
 
 func (r *RestapiSettings) String() string {
 	if len(r._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(r._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(r._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(r); err == nil {
+	if value, err := core.StringifyRedactedJSON(r, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", r)
@@ -2404,11 +2404,11 @@ func (d *DeleteSystemCertificateAuthoritiesEndpointResponse) UnmarshalJSON(data
 
 func (d *DeleteSystemCertificateAuthoritiesEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -2447,11 +2447,11 @@ func (d *DeleteSystemCertificateAuthoritiesEndpointResponseDataItem) UnmarshalJS
 
 func (d *DeleteSystemCertificateAuthoritiesEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -2543,11 +2543,11 @@ func (d *DeleteSystemCertificateAuthorityEndpointResponse) UnmarshalJSON(data []
 
 func (d *DeleteSystemCertificateAuthorityEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -2586,11 +2586,11 @@ func (d *DeleteSystemCertificateAuthorityEndpointResponseData) UnmarshalJSON(dat
 
 func (d *DeleteSystemCertificateAuthorityEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -2682,11 +2682,11 @@ func (d *DeleteSystemCertificateEndpointResponse) UnmarshalJSON(data []byte) err
 
 func (d *DeleteSystemCertificateEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -2725,11 +2725,11 @@ func (d *DeleteSystemCertificateEndpointResponseData) UnmarshalJSON(data []byte)
 
 func (d *DeleteSystemCertificateEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -2764,11 +2764,11 @@ func (d *DeleteSystemCertificatesEndpointResponse) UnmarshalJSON(data []byte) er
 
 func (d *DeleteSystemCertificatesEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -2807,11 +2807,11 @@ func (d *DeleteSystemCertificatesEndpointResponseDataItem) UnmarshalJSON(data []
 
 func (d *DeleteSystemCertificatesEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -2846,11 +2846,11 @@ func (d *DeleteSystemCrLsEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (d *DeleteSystemCrLsEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -2891,11 +2891,11 @@ func (d *DeleteSystemCrLsEndpointResponseDataItem) UnmarshalJSON(data []byte) er
 
 func (d *DeleteSystemCrLsEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -2987,11 +2987,11 @@ func (d *DeleteSystemCrlEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (d *DeleteSystemCrlEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -3032,11 +3032,11 @@ func (d *DeleteSystemCrlEndpointResponseData) UnmarshalJSON(data []byte) error {
 
 func (d *DeleteSystemCrlEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -3185,11 +3185,11 @@ func (d *DeleteSystemCrlRevokedCertificateEndpointResponse) UnmarshalJSON(data [
 
 func (d *DeleteSystemCrlRevokedCertificateEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -3233,11 +3233,11 @@ func (d *DeleteSystemCrlRevokedCertificateEndpointResponseData) UnmarshalJSON(da
 
 func (d *DeleteSystemCrlRevokedCertificateEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -3971,11 +3971,11 @@ func (g *GetSystemCertificateAuthoritiesEndpointResponse) UnmarshalJSON(data []b
 
 func (g *GetSystemCertificateAuthoritiesEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4014,11 +4014,11 @@ func (g *GetSystemCertificateAuthoritiesEndpointResponseDataItem) UnmarshalJSON(
 
 func (g *GetSystemCertificateAuthoritiesEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4110,11 +4110,11 @@ func (g *GetSystemCertificateAuthorityEndpointResponse) UnmarshalJSON(data []byt
 
 func (g *GetSystemCertificateAuthorityEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4153,11 +4153,11 @@ func (g *GetSystemCertificateAuthorityEndpointResponseData) UnmarshalJSON(data [
 
 func (g *GetSystemCertificateAuthorityEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4249,11 +4249,11 @@ func (g *GetSystemCertificateEndpointResponse) UnmarshalJSON(data []byte) error
 
 func (g *GetSystemCertificateEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4292,11 +4292,11 @@ func (g *GetSystemCertificateEndpointResponseData) UnmarshalJSON(data []byte) er
 
 func (g *GetSystemCertificateEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4387,11 +4387,11 @@ func (g *GetSystemCertificatesEndpointResponse) UnmarshalJSON(data []byte) error
 
 func (g *GetSystemCertificatesEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4430,11 +4430,11 @@ func (g *GetSystemCertificatesEndpointResponseDataItem) UnmarshalJSON(data []byt
 
 func (g *GetSystemCertificatesEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4564,11 +4564,11 @@ func (g *GetSystemCrLsEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (g *GetSystemCrLsEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4609,11 +4609,11 @@ func (g *GetSystemCrLsEndpointResponseDataItem) UnmarshalJSON(data []byte) error
 
 func (g *GetSystemCrLsEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4705,11 +4705,11 @@ func (g *GetSystemCrlEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (g *GetSystemCrlEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4750,11 +4750,11 @@ func (g *GetSystemCrlEndpointResponseData) UnmarshalJSON(data []byte) error {
 
 func (g *GetSystemCrlEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4903,11 +4903,11 @@ func (g *GetSystemCrlRevokedCertificateEndpointResponse) UnmarshalJSON(data []by
 
 func (g *GetSystemCrlRevokedCertificateEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -4951,11 +4951,11 @@ func (g *GetSystemCrlRevokedCertificateEndpointResponseData) UnmarshalJSON(data
 
 func (g *GetSystemCrlRevokedCertificateEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -5068,11 +5068,11 @@ func (g *GetSystemNotificationsEmailSettingsEndpointResponse) UnmarshalJSON(data
 
 func (g *GetSystemNotificationsEmailSettingsEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -5793,11 +5793,11 @@ func (g *GetSystemRestapiSettingsEndpointResponse) UnmarshalJSON(data []byte) er
 
 func (g *GetSystemRestapiSettingsEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -6249,11 +6249,11 @@ func (p *PatchSystemCertificateAuthorityEndpointResponse) UnmarshalJSON(data []b
 
 func (p *PatchSystemCertificateAuthorityEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6292,11 +6292,11 @@ func (p *PatchSystemCertificateAuthorityEndpointResponseData) UnmarshalJSON(data
 
 func (p *PatchSystemCertificateAuthorityEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6331,11 +6331,11 @@ func (p *PatchSystemCertificateEndpointResponse) UnmarshalJSON(data []byte) erro
 
 func (p *PatchSystemCertificateEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6374,11 +6374,11 @@ func (p *PatchSystemCertificateEndpointResponseData) UnmarshalJSON(data []byte)
 
 func (p *PatchSystemCertificateEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6452,11 +6452,11 @@ func (p *PatchSystemCrlEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (p *PatchSystemCrlEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6497,11 +6497,11 @@ func (p *PatchSystemCrlEndpointResponseData) UnmarshalJSON(data []byte) error {
 
 func (p *PatchSystemCrlEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6536,11 +6536,11 @@ func (p *PatchSystemCrlRevokedCertificateEndpointResponse) UnmarshalJSON(data []
 
 func (p *PatchSystemCrlRevokedCertificateEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6584,11 +6584,11 @@ func (p *PatchSystemCrlRevokedCertificateEndpointResponseData) UnmarshalJSON(dat
 
 func (p *PatchSystemCrlRevokedCertificateEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6701,11 +6701,11 @@ func (p *PatchSystemNotificationsEmailSettingsEndpointResponse) UnmarshalJSON(da
 
 func (p *PatchSystemNotificationsEmailSettingsEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -6822,11 +6822,11 @@ func (p *PatchSystemRestapiSettingsEndpointResponse) UnmarshalJSON(data []byte)
 
 func (p *PatchSystemRestapiSettingsEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7052,11 +7052,11 @@ func (p *PostSystemCertificateAuthorityEndpointResponse) UnmarshalJSON(data []by
 
 func (p *PostSystemCertificateAuthorityEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7095,11 +7095,11 @@ func (p *PostSystemCertificateAuthorityEndpointResponseData) UnmarshalJSON(data
 
 func (p *PostSystemCertificateAuthorityEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7134,11 +7134,11 @@ func (p *PostSystemCertificateAuthorityGenerateEndpointResponse) UnmarshalJSON(d
 
 func (p *PostSystemCertificateAuthorityGenerateEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7203,11 +7203,11 @@ func (p *PostSystemCertificateAuthorityGenerateEndpointResponseData) UnmarshalJS
 
 func (p *PostSystemCertificateAuthorityGenerateEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7281,11 +7281,11 @@ func (p *PostSystemCertificateEndpointResponse) UnmarshalJSON(data []byte) error
 
 func (p *PostSystemCertificateEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7324,11 +7324,11 @@ func (p *PostSystemCertificateEndpointResponseData) UnmarshalJSON(data []byte) e
 
 func (p *PostSystemCertificateEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7363,11 +7363,11 @@ func (p *PostSystemCertificateGenerateEndpointResponse) UnmarshalJSON(data []byt
 
 func (p *PostSystemCertificateGenerateEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7434,11 +7434,11 @@ func (p *PostSystemCertificateGenerateEndpointResponseData) UnmarshalJSON(data [
 
 func (p *PostSystemCertificateGenerateEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7512,11 +7512,11 @@ func (p *PostSystemCertificateSigningRequestEndpointResponse) UnmarshalJSON(data
 
 func (p *PostSystemCertificateSigningRequestEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7581,11 +7581,11 @@ func (p *PostSystemCertificateSigningRequestEndpointResponseData) UnmarshalJSON(
 
 func (p *PostSystemCertificateSigningRequestEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7620,11 +7620,11 @@ func (p *PostSystemCertificateSigningRequestSignEndpointResponse) UnmarshalJSON(
 
 func (p *PostSystemCertificateSigningRequestSignEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7675,11 +7675,11 @@ func (p *PostSystemCertificateSigningRequestSignEndpointResponseData) UnmarshalJ
 
 func (p *PostSystemCertificateSigningRequestSignEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7714,11 +7714,11 @@ func (p *PostSystemCrlEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (p *PostSystemCrlEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7759,11 +7759,11 @@ func (p *PostSystemCrlEndpointResponseData) UnmarshalJSON(data []byte) error {
 
 func (p *PostSystemCrlEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7798,11 +7798,11 @@ func (p *PostSystemCrlRevokedCertificateEndpointResponse) UnmarshalJSON(data []b
 
 func (p *PostSystemCrlRevokedCertificateEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -7846,11 +7846,11 @@ func (p *PostSystemCrlRevokedCertificateEndpointResponseData) UnmarshalJSON(data
 
 func (p *PostSystemCrlRevokedCertificateEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -0,0 +1,228 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package system
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	iter "iter"
+)
+
+// AllSystemCertificateAuthorities iterates over every object returned by GetSystemCertificateAuthoritiesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllSystemCertificateAuthorities(
+	ctx context.Context,
+	request *pkgclient.GetSystemCertificateAuthoritiesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetSystemCertificateAuthoritiesEndpointResponseDataItem, error] {
+	var page pkgclient.GetSystemCertificateAuthoritiesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetSystemCertificateAuthoritiesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetSystemCertificateAuthoritiesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllSystemCertificates iterates over every object returned by GetSystemCertificatesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllSystemCertificates(
+	ctx context.Context,
+	request *pkgclient.GetSystemCertificatesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetSystemCertificatesEndpointResponseDataItem, error] {
+	var page pkgclient.GetSystemCertificatesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetSystemCertificatesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetSystemCertificatesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllSystemCrLs iterates over every object returned by GetSystemCrLsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllSystemCrLs(
+	ctx context.Context,
+	request *pkgclient.GetSystemCrLsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetSystemCrLsEndpointResponseDataItem, error] {
+	var page pkgclient.GetSystemCrLsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetSystemCrLsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetSystemCrLsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllSystemPackageAvailable iterates over every object returned by GetSystemPackageAvailableEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllSystemPackageAvailable(
+	ctx context.Context,
+	request *pkgclient.GetSystemPackageAvailableEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetSystemPackageAvailableEndpointResponseDataItem, error] {
+	var page pkgclient.GetSystemPackageAvailableEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetSystemPackageAvailableEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetSystemPackageAvailableEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllSystemPackages iterates over every object returned by GetSystemPackagesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllSystemPackages(
+	ctx context.Context,
+	request *pkgclient.GetSystemPackagesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetSystemPackagesEndpointResponseDataItem, error] {
+	var page pkgclient.GetSystemPackagesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetSystemPackagesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetSystemPackagesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllSystemRestapiAccessList iterates over every object returned by GetSystemRestapiAccessListEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllSystemRestapiAccessList(
+	ctx context.Context,
+	request *pkgclient.GetSystemRestapiAccessListEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetSystemRestapiAccessListEndpointResponseDataItem, error] {
+	var page pkgclient.GetSystemRestapiAccessListEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetSystemRestapiAccessListEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetSystemRestapiAccessListEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllSystemTunables iterates over every object returned by GetSystemTunablesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllSystemTunables(
+	ctx context.Context,
+	request *pkgclient.GetSystemTunablesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetSystemTunablesEndpointResponseDataItem, error] {
+	var page pkgclient.GetSystemTunablesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetSystemTunablesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetSystemTunablesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
@@ -412,11 +412,11 @@ func (a *AuthServer) UnmarshalJSON(data []byte) error {
 
 func (a *AuthServer) String() string {
 	if len(a._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(a._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(a); err == nil {
+	if value, err := core.StringifyRedactedJSON(a, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", a)
@@ -563,11 +563,11 @@ func (u *User) UnmarshalJSON(data []byte) error {
 
 func (u *User) String() string {
 	if len(u._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(u._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(u._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(u); err == nil {
+	if value, err := core.StringifyRedactedJSON(u, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", u)
@@ -725,11 +725,11 @@ func (d *DeleteUserAuthServerEndpointResponse) UnmarshalJSON(data []byte) error
 
 func (d *DeleteUserAuthServerEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -818,11 +818,11 @@ func (d *DeleteUserAuthServerEndpointResponseData) UnmarshalJSON(data []byte) er
 
 func (d *DeleteUserAuthServerEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -857,11 +857,11 @@ func (d *DeleteUserAuthServersEndpointResponse) UnmarshalJSON(data []byte) error
 
 func (d *DeleteUserAuthServersEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -950,11 +950,11 @@ func (d *DeleteUserAuthServersEndpointResponseDataItem) UnmarshalJSON(data []byt
 
 func (d *DeleteUserAuthServersEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -1046,11 +1046,11 @@ func (d *DeleteUserEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (d *DeleteUserEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -1097,11 +1097,11 @@ func (d *DeleteUserEndpointResponseData) UnmarshalJSON(data []byte) error {
 
 func (d *DeleteUserEndpointResponseData) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -1353,11 +1353,11 @@ func (d *DeleteUsersEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (d *DeleteUsersEndpointResponse) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -1404,11 +1404,11 @@ func (d *DeleteUsersEndpointResponseDataItem) UnmarshalJSON(data []byte) error {
 
 func (d *DeleteUsersEndpointResponseDataItem) String() string {
 	if len(d._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(d._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(d); err == nil {
+	if value, err := core.StringifyRedactedJSON(d, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", d)
@@ -1500,11 +1500,11 @@ func (g *GetUserAuthServerEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (g *GetUserAuthServerEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -1593,11 +1593,11 @@ func (g *GetUserAuthServerEndpointResponseData) UnmarshalJSON(data []byte) error
 
 func (g *GetUserAuthServerEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -1688,11 +1688,11 @@ func (g *GetUserAuthServersEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (g *GetUserAuthServersEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -1781,11 +1781,11 @@ func (g *GetUserAuthServersEndpointResponseDataItem) UnmarshalJSON(data []byte)
 
 func (g *GetUserAuthServersEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -1877,11 +1877,11 @@ func (g *GetUserEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (g *GetUserEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -1928,11 +1928,11 @@ func (g *GetUserEndpointResponseData) UnmarshalJSON(data []byte) error {
 
 func (g *GetUserEndpointResponseData) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -2296,11 +2296,11 @@ func (g *GetUsersEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (g *GetUsersEndpointResponse) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -2347,11 +2347,11 @@ func (g *GetUsersEndpointResponseDataItem) UnmarshalJSON(data []byte) error {
 
 func (g *GetUsersEndpointResponseDataItem) String() string {
 	if len(g._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(g._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(g._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(g); err == nil {
+	if value, err := core.StringifyRedactedJSON(g, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", g)
@@ -2386,11 +2386,11 @@ func (p *PatchUserAuthServerEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (p *PatchUserAuthServerEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -2479,11 +2479,11 @@ func (p *PatchUserAuthServerEndpointResponseData) UnmarshalJSON(data []byte) err
 
 func (p *PatchUserAuthServerEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -2518,11 +2518,11 @@ func (p *PatchUserEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (p *PatchUserEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -2569,11 +2569,11 @@ func (p *PatchUserEndpointResponseData) UnmarshalJSON(data []byte) error {
 
 func (p *PatchUserEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -2688,11 +2688,11 @@ func (p *PostUserAuthServerEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (p *PostUserAuthServerEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -2781,11 +2781,11 @@ func (p *PostUserAuthServerEndpointResponseData) UnmarshalJSON(data []byte) erro
 
 func (p *PostUserAuthServerEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -2820,11 +2820,11 @@ func (p *PostUserEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (p *PostUserEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -2871,11 +2871,11 @@ func (p *PostUserEndpointResponseData) UnmarshalJSON(data []byte) error {
 
 func (p *PostUserEndpointResponseData) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -3043,11 +3043,11 @@ func (p *PutUserAuthServersEndpointRequestItem) UnmarshalJSON(data []byte) error
 
 func (p *PutUserAuthServersEndpointRequestItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -3082,11 +3082,11 @@ func (p *PutUserAuthServersEndpointResponse) UnmarshalJSON(data []byte) error {
 
 func (p *PutUserAuthServersEndpointResponse) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -3175,11 +3175,11 @@ func (p *PutUserAuthServersEndpointResponseDataItem) UnmarshalJSON(data []byte)
 
 func (p *PutUserAuthServersEndpointResponseDataItem) String() string {
 	if len(p._rawJSON) > 0 {
-		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+		if value, err := core.StringifyRedactedJSON(p._rawJSON, sensitiveFields); err == nil {
 			return value
 		}
 	}
-	if value, err := core.StringifyJSON(p); err == nil {
+	if value, err := core.StringifyRedactedJSON(p, sensitiveFields); err == nil {
 		return value
 	}
 	return fmt.Sprintf("%#v", p)
@@ -0,0 +1,383 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package vpn
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	iter "iter"
+)
+
+// AllVpnOpenVpnClientExportConfigs iterates over every object returned by GetVpnOpenVpnClientExportConfigsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpnOpenVpnClientExportConfigs(
+	ctx context.Context,
+	request *pkgclient.GetVpnOpenVpnClientExportConfigsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpnOpenVpnClientExportConfigsEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpnOpenVpnClientExportConfigsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpnOpenVpnClientExportConfigsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpnOpenVpnClientExportConfigsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpnOpenVpnClients iterates over every object returned by GetVpnOpenVpnClientsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpnOpenVpnClients(
+	ctx context.Context,
+	request *pkgclient.GetVpnOpenVpnClientsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpnOpenVpnClientsEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpnOpenVpnClientsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpnOpenVpnClientsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpnOpenVpnClientsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpnOpenVpnServers iterates over every object returned by GetVpnOpenVpnServersEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpnOpenVpnServers(
+	ctx context.Context,
+	request *pkgclient.GetVpnOpenVpnServersEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpnOpenVpnServersEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpnOpenVpnServersEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpnOpenVpnServersEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpnOpenVpnServersEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpnOpenVpncsOs iterates over every object returned by GetVpnOpenVpncsOsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpnOpenVpncsOs(
+	ctx context.Context,
+	request *pkgclient.GetVpnOpenVpncsOsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpnOpenVpncsOsEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpnOpenVpncsOsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpnOpenVpncsOsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpnOpenVpncsOsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpnWireGuardPeerAllowedIPs iterates over every object returned by GetVpnWireGuardPeerAllowedIPsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpnWireGuardPeerAllowedIPs(
+	ctx context.Context,
+	request *pkgclient.GetVpnWireGuardPeerAllowedIPsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpnWireGuardPeerAllowedIPsEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpnWireGuardPeerAllowedIPsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpnWireGuardPeerAllowedIPsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpnWireGuardPeerAllowedIPsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpnWireGuardPeers iterates over every object returned by GetVpnWireGuardPeersEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpnWireGuardPeers(
+	ctx context.Context,
+	request *pkgclient.GetVpnWireGuardPeersEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpnWireGuardPeersEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpnWireGuardPeersEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpnWireGuardPeersEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpnWireGuardPeersEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpnWireGuardTunnelAddresses iterates over every object returned by GetVpnWireGuardTunnelAddressesEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpnWireGuardTunnelAddresses(
+	ctx context.Context,
+	request *pkgclient.GetVpnWireGuardTunnelAddressesEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpnWireGuardTunnelAddressesEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpnWireGuardTunnelAddressesEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpnWireGuardTunnelAddressesEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpnWireGuardTunnelAddressesEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpnWireGuardTunnels iterates over every object returned by GetVpnWireGuardTunnelsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpnWireGuardTunnels(
+	ctx context.Context,
+	request *pkgclient.GetVpnWireGuardTunnelsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpnWireGuardTunnelsEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpnWireGuardTunnelsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpnWireGuardTunnelsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpnWireGuardTunnelsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpniPsecPhase1Encryptions iterates over every object returned by GetVpniPsecPhase1EncryptionsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpniPsecPhase1Encryptions(
+	ctx context.Context,
+	request *pkgclient.GetVpniPsecPhase1EncryptionsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpniPsecPhase1EncryptionsEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpniPsecPhase1EncryptionsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpniPsecPhase1EncryptionsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpniPsecPhase1EncryptionsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpniPsecPhase1S iterates over every object returned by GetVpniPsecPhase1SEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpniPsecPhase1S(
+	ctx context.Context,
+	request *pkgclient.GetVpniPsecPhase1SEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpniPsecPhase1SEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpniPsecPhase1SEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpniPsecPhase1SEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpniPsecPhase1SEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpniPsecPhase2Encryptions iterates over every object returned by GetVpniPsecPhase2EncryptionsEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpniPsecPhase2Encryptions(
+	ctx context.Context,
+	request *pkgclient.GetVpniPsecPhase2EncryptionsEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpniPsecPhase2EncryptionsEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpniPsecPhase2EncryptionsEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpniPsecPhase2EncryptionsEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpniPsecPhase2EncryptionsEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+
+// AllVpniPsecPhase2S iterates over every object returned by GetVpniPsecPhase2SEndpoint, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) AllVpniPsecPhase2S(
+	ctx context.Context,
+	request *pkgclient.GetVpniPsecPhase2SEndpointRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.GetVpniPsecPhase2SEndpointResponseDataItem, error] {
+	var page pkgclient.GetVpniPsecPhase2SEndpointRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.GetVpniPsecPhase2SEndpointResponseDataItem, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.GetVpniPsecPhase2SEndpoint(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
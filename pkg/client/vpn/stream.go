@@ -0,0 +1,203 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package vpn
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	io "io"
+)
+
+// StreamVpnOpenVpnClientExportConfigs calls GetVpnOpenVpnClientExportConfigsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpnOpenVpnClientExportConfigs(
+	ctx context.Context,
+	request *pkgclient.GetVpnOpenVpnClientExportConfigsEndpointRequest,
+	fn func(*pkgclient.GetVpnOpenVpnClientExportConfigsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpnOpenVpnClientExportConfigsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpnOpenVpnClients calls GetVpnOpenVpnClientsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpnOpenVpnClients(
+	ctx context.Context,
+	request *pkgclient.GetVpnOpenVpnClientsEndpointRequest,
+	fn func(*pkgclient.GetVpnOpenVpnClientsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpnOpenVpnClientsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpnOpenVpnServers calls GetVpnOpenVpnServersEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpnOpenVpnServers(
+	ctx context.Context,
+	request *pkgclient.GetVpnOpenVpnServersEndpointRequest,
+	fn func(*pkgclient.GetVpnOpenVpnServersEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpnOpenVpnServersEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpnOpenVpncsOs calls GetVpnOpenVpncsOsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpnOpenVpncsOs(
+	ctx context.Context,
+	request *pkgclient.GetVpnOpenVpncsOsEndpointRequest,
+	fn func(*pkgclient.GetVpnOpenVpncsOsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpnOpenVpncsOsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpnWireGuardPeerAllowedIPs calls GetVpnWireGuardPeerAllowedIPsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpnWireGuardPeerAllowedIPs(
+	ctx context.Context,
+	request *pkgclient.GetVpnWireGuardPeerAllowedIPsEndpointRequest,
+	fn func(*pkgclient.GetVpnWireGuardPeerAllowedIPsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpnWireGuardPeerAllowedIPsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpnWireGuardPeers calls GetVpnWireGuardPeersEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpnWireGuardPeers(
+	ctx context.Context,
+	request *pkgclient.GetVpnWireGuardPeersEndpointRequest,
+	fn func(*pkgclient.GetVpnWireGuardPeersEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpnWireGuardPeersEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpnWireGuardTunnelAddresses calls GetVpnWireGuardTunnelAddressesEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpnWireGuardTunnelAddresses(
+	ctx context.Context,
+	request *pkgclient.GetVpnWireGuardTunnelAddressesEndpointRequest,
+	fn func(*pkgclient.GetVpnWireGuardTunnelAddressesEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpnWireGuardTunnelAddressesEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpnWireGuardTunnels calls GetVpnWireGuardTunnelsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpnWireGuardTunnels(
+	ctx context.Context,
+	request *pkgclient.GetVpnWireGuardTunnelsEndpointRequest,
+	fn func(*pkgclient.GetVpnWireGuardTunnelsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpnWireGuardTunnelsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpniPsecPhase1Encryptions calls GetVpniPsecPhase1EncryptionsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpniPsecPhase1Encryptions(
+	ctx context.Context,
+	request *pkgclient.GetVpniPsecPhase1EncryptionsEndpointRequest,
+	fn func(*pkgclient.GetVpniPsecPhase1EncryptionsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpniPsecPhase1EncryptionsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpniPsecPhase1S calls GetVpniPsecPhase1SEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpniPsecPhase1S(
+	ctx context.Context,
+	request *pkgclient.GetVpniPsecPhase1SEndpointRequest,
+	fn func(*pkgclient.GetVpniPsecPhase1SEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpniPsecPhase1SEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpniPsecPhase2Encryptions calls GetVpniPsecPhase2EncryptionsEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpniPsecPhase2Encryptions(
+	ctx context.Context,
+	request *pkgclient.GetVpniPsecPhase2EncryptionsEndpointRequest,
+	fn func(*pkgclient.GetVpniPsecPhase2EncryptionsEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpniPsecPhase2EncryptionsEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+
+// StreamVpniPsecPhase2S calls GetVpniPsecPhase2SEndpoint and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) StreamVpniPsecPhase2S(
+	ctx context.Context,
+	request *pkgclient.GetVpniPsecPhase2SEndpointRequest,
+	fn func(*pkgclient.GetVpniPsecPhase2SEndpointResponseDataItem) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.GetVpniPsecPhase2SEndpoint(ctx, request, append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
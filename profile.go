@@ -0,0 +1,322 @@
+package pfrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/danielmichaels/go-pfrest/pkg/client/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/core"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+	"gopkg.in/yaml.v3"
+)
+
+// Auth modes accepted by [AuthConfig].Mode.
+const (
+	AuthModeBasic  = "basic"
+	AuthModeAPIKey = "api_key"
+	AuthModeJWT    = "jwt"
+	AuthModeBearer = "bearer"
+)
+
+// Config is a set of named connection profiles, typically loaded from a
+// YAML or JSON file:
+//
+//	profiles:
+//	  lab:
+//	    url: https://192.168.1.1
+//	    auth:
+//	      mode: api_key
+//	      file: /run/secrets/lab-api-key
+//	      file_field: api_key
+//	    tls:
+//	      fingerprints: ["sha256/Jt0j0yr9J1Kn1xCkAsNbnJ5dyLUbqBpwYxWwTTWfhK0="]
+//	    timeout: 30s
+//	    retry:
+//	      max_attempts: 3
+type Config struct {
+	Profiles map[string]*Profile `yaml:"profiles" json:"profiles"`
+}
+
+// Profile describes how to connect to a single pfSense firewall.
+type Profile struct {
+	URL     string        `yaml:"url" json:"url"`
+	Auth    AuthConfig    `yaml:"auth" json:"auth"`
+	TLS     TLSConfig     `yaml:"tls" json:"tls"`
+	Timeout time.Duration `yaml:"timeout" json:"timeout"`
+	Retry   RetryConfig   `yaml:"retry" json:"retry"`
+}
+
+// AuthConfig selects an auth mode and where its credentials come from.
+//
+// Credentials are read from the first configured source: an external
+// command (Exec), a secret file (File), environment variables with the
+// given prefix (EnvPrefix), or the literal values in the profile.
+type AuthConfig struct {
+	// Mode is one of "basic", "api_key", "jwt" or "bearer". If empty, it
+	// is inferred from the literal credentials that are set.
+	Mode string `yaml:"mode" json:"mode"`
+
+	Username string `yaml:"username" json:"username"`
+	Password string `yaml:"password" json:"password"`
+	APIKey   string `yaml:"api_key" json:"api_key"`
+	Token    string `yaml:"token" json:"token"`
+
+	EnvPrefix string   `yaml:"env_prefix" json:"env_prefix"`
+	File      string   `yaml:"file" json:"file"`
+	FileField string   `yaml:"file_field" json:"file_field"`
+	Exec      []string `yaml:"exec" json:"exec"`
+}
+
+// RetryConfig configures the retrier.
+type RetryConfig struct {
+	MaxAttempts uint          `yaml:"max_attempts" json:"max_attempts"`
+	MaxDelay    time.Duration `yaml:"max_delay" json:"max_delay"`
+}
+
+// DefaultConfigPath returns the config file used by [LoadProfile]: the
+// value of PFREST_CONFIG if set, otherwise pfrest/config.yaml in the
+// user's config directory (e.g. ~/.config/pfrest/config.yaml).
+func DefaultConfigPath() (string, error) {
+	if path := os.Getenv("PFREST_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "pfrest", "config.yaml"), nil
+}
+
+// LoadConfig reads a YAML or JSON config file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	// YAML is a superset of JSON, so one decoder handles both.
+	config := new(Config)
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
+
+// Profile returns the named profile.
+func (c *Config) Profile(name string) (*Profile, error) {
+	profile, ok := c.Profiles[name]
+	if !ok || profile == nil {
+		return nil, fmt.Errorf("profile %q not found", name)
+	}
+	return profile, nil
+}
+
+// LoadProfile reads the named profile from the file at [DefaultConfigPath].
+func LoadProfile(name string) (*Profile, error) {
+	path, err := DefaultConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return config.Profile(name)
+}
+
+// Options returns the request options that connect to the profile's
+// firewall.
+func (p *Profile) Options() ([]option.RequestOption, error) {
+	if p.URL == "" {
+		return nil, errors.New("profile has no url")
+	}
+	httpClient, err := p.httpClient()
+	if err != nil {
+		return nil, err
+	}
+	opts := []option.RequestOption{
+		option.WithBaseURL(strings.TrimSuffix(p.URL, "/")),
+		option.WithHTTPClient(httpClient),
+	}
+	authenticator, err := p.Auth.authenticator()
+	if err != nil {
+		return nil, err
+	}
+	if authenticator != nil {
+		opts = append(opts, option.WithAuthenticator(authenticator))
+	}
+	if p.Retry.MaxAttempts > 0 {
+		opts = append(opts, option.WithMaxAttempts(p.Retry.MaxAttempts))
+	}
+	if p.Retry.MaxDelay > 0 {
+		opts = append(opts, option.WithMaxRetryDelay(p.Retry.MaxDelay))
+	}
+	return opts, nil
+}
+
+// NewClient returns a client connected to the profile's firewall. Any
+// additional options are applied after the profile's own.
+func (p *Profile) NewClient(opts ...option.RequestOption) (*client.Client, error) {
+	profileOpts, err := p.Options()
+	if err != nil {
+		return nil, err
+	}
+	return client.NewClient(append(profileOpts, opts...)...), nil
+}
+
+// NewClientFromEnv returns a client configured from the environment.
+//
+// If PFREST_PROFILE is set, that profile is loaded from
+// [DefaultConfigPath] first. The following variables then override it.
+// Setting any of the credential variables replaces the profile's
+// credentials, including an exec, file or env_prefix source, so set all
+// that the auth mode needs:
+//
+//	PFREST_URL           base URL, e.g. https://192.168.1.1
+//	PFREST_AUTH_MODE     basic, api_key, jwt or bearer (inferred if unset)
+//	PFREST_USERNAME      username for basic and jwt auth
+//	PFREST_PASSWORD      password for basic and jwt auth
+//	PFREST_API_KEY       API key
+//	PFREST_TOKEN         bearer token
+//	PFREST_INSECURE      skip TLS verification when true
+//	PFREST_CA_FILE       PEM bundle of CAs to trust
+//	PFREST_FINGERPRINTS  comma-separated certificate fingerprints to pin
+//	PFREST_KNOWN_HOSTS   trust-on-first-use known hosts file
+//	PFREST_CERT_FILE     client certificate for mutual TLS
+//	PFREST_KEY_FILE      client key for mutual TLS
+//	PFREST_TIMEOUT       HTTP client timeout, e.g. 30s
+//	PFREST_MAX_ATTEMPTS  maximum number of attempts per request
+func NewClientFromEnv(opts ...option.RequestOption) (*client.Client, error) {
+	profile, err := ProfileFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return profile.NewClient(opts...)
+}
+
+// ProfileFromEnv builds a profile from the variables documented on
+// [NewClientFromEnv].
+func ProfileFromEnv() (*Profile, error) {
+	profile := new(Profile)
+	if name := os.Getenv("PFREST_PROFILE"); name != "" {
+		loaded, err := LoadProfile(name)
+		if err != nil {
+			return nil, err
+		}
+		copied := *loaded
+		profile = &copied
+	}
+
+	setString := func(dst *string, key string) {
+		if value, ok := os.LookupEnv(key); ok {
+			*dst = value
+		}
+	}
+	setString(&profile.URL, "PFREST_URL")
+	setString(&profile.Auth.Mode, "PFREST_AUTH_MODE")
+	setString(&profile.Auth.Username, "PFREST_USERNAME")
+	setString(&profile.Auth.Password, "PFREST_PASSWORD")
+	setString(&profile.Auth.APIKey, "PFREST_API_KEY")
+	setString(&profile.Auth.Token, "PFREST_TOKEN")
+	for _, key := range []string{"PFREST_USERNAME", "PFREST_PASSWORD", "PFREST_API_KEY", "PFREST_TOKEN"} {
+		if os.Getenv(key) != "" {
+			// Credentials in the environment replace the profile's
+			// exec, file or env_prefix source, which would win otherwise.
+			profile.Auth.Exec, profile.Auth.File, profile.Auth.FileField, profile.Auth.EnvPrefix = nil, "", "", ""
+			break
+		}
+	}
+	setString(&profile.TLS.CAFile, "PFREST_CA_FILE")
+	setString(&profile.TLS.KnownHostsFile, "PFREST_KNOWN_HOSTS")
+	setString(&profile.TLS.CertFile, "PFREST_CERT_FILE")
+	setString(&profile.TLS.KeyFile, "PFREST_KEY_FILE")
+	if value := os.Getenv("PFREST_FINGERPRINTS"); value != "" {
+		profile.TLS.Fingerprints = strings.Split(value, ",")
+	}
+
+	if value := os.Getenv("PFREST_INSECURE"); value != "" {
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("PFREST_INSECURE: %w", err)
+		}
+		profile.TLS.InsecureSkipVerify = insecure
+	}
+	if value := os.Getenv("PFREST_TIMEOUT"); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("PFREST_TIMEOUT: %w", err)
+		}
+		profile.Timeout = timeout
+	}
+	if value := os.Getenv("PFREST_MAX_ATTEMPTS"); value != "" {
+		attempts, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("PFREST_MAX_ATTEMPTS: %w", err)
+		}
+		profile.Retry.MaxAttempts = uint(attempts)
+	}
+	return profile, nil
+}
+
+func (p *Profile) httpClient() (*http.Client, error) {
+	transport, err := p.TLS.Transport()
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   p.Timeout,
+	}, nil
+}
+
+// authenticator returns the Authenticator for the configured mode, or nil
+// if no credentials are configured.
+func (a AuthConfig) authenticator() (core.Authenticator, error) {
+	var provider core.CredentialsProvider = core.StaticCredentials{
+		Username: a.Username,
+		Password: a.Password,
+		APIKey:   a.APIKey,
+		Token:    a.Token,
+	}
+	switch {
+	case len(a.Exec) > 0:
+		provider = &core.ExecCredentials{Command: a.Exec[0], Args: a.Exec[1:]}
+	case a.File != "":
+		provider = &core.FileCredentials{Path: a.File, Field: a.FileField}
+	case a.EnvPrefix != "":
+		provider = core.EnvCredentials{Prefix: a.EnvPrefix}
+	}
+
+	mode := a.Mode
+	if mode == "" {
+		switch {
+		case a.APIKey != "" || a.FileField == "api_key":
+			mode = AuthModeAPIKey
+		case a.Token != "" || a.FileField == "token":
+			mode = AuthModeBearer
+		case a.Username != "" || a.Password != "":
+			mode = AuthModeBasic
+		case len(a.Exec) > 0 || a.File != "" || a.EnvPrefix != "":
+			return nil, errors.New("auth mode is required with exec, file or env_prefix credentials")
+		default:
+			return nil, nil
+		}
+	}
+
+	switch mode {
+	case AuthModeBasic:
+		return &core.BasicAuth{Credentials: provider}, nil
+	case AuthModeAPIKey:
+		return &core.APIKeyAuth{Credentials: provider}, nil
+	case AuthModeJWT:
+		return &core.BearerAuth{Source: &core.JWTTokenSource{Credentials: provider}}, nil
+	case AuthModeBearer:
+		return &core.BearerAuth{Source: &core.ProviderToken{Credentials: provider}}, nil
+	}
+	return nil, fmt.Errorf("unknown auth mode %q", mode)
+}
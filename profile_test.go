@@ -0,0 +1,200 @@
+package pfrest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/danielmichaels/go-pfrest/pkg/client/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthConfigMode(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		config AuthConfig
+		want   core.Authenticator
+	}{
+		{"none", AuthConfig{}, nil},
+		{"api key", AuthConfig{APIKey: "key"}, &core.APIKeyAuth{Credentials: core.StaticCredentials{APIKey: "key"}}},
+		{"token", AuthConfig{Token: "token"}, &core.BearerAuth{Source: &core.ProviderToken{Credentials: core.StaticCredentials{Token: "token"}}}},
+		{"username", AuthConfig{Username: "admin"}, &core.BasicAuth{Credentials: core.StaticCredentials{Username: "admin"}}},
+		{"password", AuthConfig{Password: "secret"}, &core.BasicAuth{Credentials: core.StaticCredentials{Password: "secret"}}},
+		{"api key wins", AuthConfig{Username: "admin", APIKey: "key"}, &core.APIKeyAuth{Credentials: core.StaticCredentials{Username: "admin", APIKey: "key"}}},
+		{"file field api key", AuthConfig{File: "creds", FileField: "api_key"}, &core.APIKeyAuth{Credentials: &core.FileCredentials{Path: "creds", Field: "api_key"}}},
+		{"file field token", AuthConfig{File: "creds", FileField: "token"}, &core.BearerAuth{Source: &core.ProviderToken{Credentials: &core.FileCredentials{Path: "creds", Field: "token"}}}},
+		{"jwt", AuthConfig{Mode: AuthModeJWT, Username: "admin", Password: "secret"}, &core.BearerAuth{Source: &core.JWTTokenSource{Credentials: core.StaticCredentials{Username: "admin", Password: "secret"}}}},
+		{"exec", AuthConfig{Mode: AuthModeBasic, Exec: []string{"creds", "lab"}}, &core.BasicAuth{Credentials: &core.ExecCredentials{Command: "creds", Args: []string{"lab"}}}},
+		{"env prefix", AuthConfig{Mode: AuthModeAPIKey, EnvPrefix: "LAB_"}, &core.APIKeyAuth{Credentials: core.EnvCredentials{Prefix: "LAB_"}}},
+	} {
+		authenticator, err := test.config.authenticator()
+		require.NoError(t, err, test.name)
+		assert.Equal(t, test.want, authenticator, test.name)
+	}
+
+	for _, test := range []struct {
+		config AuthConfig
+		err    string
+	}{
+		{AuthConfig{File: "creds"}, "auth mode is required with exec, file or env_prefix credentials"},
+		{AuthConfig{Exec: []string{"creds"}}, "auth mode is required with exec, file or env_prefix credentials"},
+		{AuthConfig{EnvPrefix: "LAB_"}, "auth mode is required with exec, file or env_prefix credentials"},
+		{AuthConfig{Mode: "digest", Username: "admin"}, `unknown auth mode "digest"`},
+	} {
+		_, err := test.config.authenticator()
+		assert.EqualError(t, err, test.err, "%+v", test.config)
+	}
+}
+
+// writeConfig writes a config file and points PFREST_CONFIG at it.
+func writeConfig(t *testing.T, name, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	t.Setenv("PFREST_CONFIG", path)
+	return path
+}
+
+// clearEnv unsets the variables read by ProfileFromEnv for the test.
+func clearEnv(t *testing.T) {
+	t.Helper()
+	for _, key := range []string{
+		"PFREST_CONFIG", "PFREST_PROFILE", "PFREST_URL", "PFREST_AUTH_MODE",
+		"PFREST_USERNAME", "PFREST_PASSWORD", "PFREST_API_KEY", "PFREST_TOKEN",
+		"PFREST_INSECURE", "PFREST_CA_FILE", "PFREST_FINGERPRINTS", "PFREST_KNOWN_HOSTS",
+		"PFREST_CERT_FILE", "PFREST_KEY_FILE", "PFREST_TIMEOUT", "PFREST_MAX_ATTEMPTS",
+	} {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	clearEnv(t)
+	for name, data := range map[string]string{
+		"config.yaml": `
+profiles:
+  lab:
+    url: https://192.168.1.1
+    auth:
+      mode: api_key
+      file: /run/secrets/lab
+      file_field: api_key
+    timeout: 1m30s
+    retry:
+      max_attempts: 3
+      max_delay: 500ms
+`,
+		"config.json": `{"profiles": {"lab": {
+  "url": "https://192.168.1.1",
+  "auth": {"mode": "api_key", "file": "/run/secrets/lab", "file_field": "api_key"},
+  "timeout": "1m30s",
+  "retry": {"max_attempts": 3, "max_delay": "500ms"}
+}}}`,
+	} {
+		writeConfig(t, name, data)
+		profile, err := LoadProfile("lab")
+		require.NoError(t, err, name)
+		assert.Equal(t, &Profile{
+			URL:     "https://192.168.1.1",
+			Auth:    AuthConfig{Mode: AuthModeAPIKey, File: "/run/secrets/lab", FileField: "api_key"},
+			Timeout: 90 * time.Second,
+			Retry:   RetryConfig{MaxAttempts: 3, MaxDelay: 500 * time.Millisecond},
+		}, profile, name)
+
+		_, err = LoadProfile("edge")
+		assert.EqualError(t, err, `profile "edge" not found`)
+	}
+
+	path := writeConfig(t, "bad.yaml", "profiles:\n  lab:\n    timeout: soon\n")
+	_, err := LoadConfig(path)
+	assert.ErrorContains(t, err, path)
+}
+
+func TestProfileFromEnv(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("PFREST_URL", "https://10.0.0.1/")
+	t.Setenv("PFREST_API_KEY", "key")
+	t.Setenv("PFREST_INSECURE", "true")
+	t.Setenv("PFREST_FINGERPRINTS", "sha256/a,sha256/b")
+	t.Setenv("PFREST_TIMEOUT", "45s")
+	t.Setenv("PFREST_MAX_ATTEMPTS", "5")
+
+	profile, err := ProfileFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, &Profile{
+		URL:     "https://10.0.0.1/",
+		Auth:    AuthConfig{APIKey: "key"},
+		TLS:     TLSConfig{InsecureSkipVerify: true, Fingerprints: []string{"sha256/a", "sha256/b"}},
+		Timeout: 45 * time.Second,
+		Retry:   RetryConfig{MaxAttempts: 5},
+	}, profile)
+
+	for key, value := range map[string]string{
+		"PFREST_INSECURE":     "maybe",
+		"PFREST_TIMEOUT":      "45",
+		"PFREST_MAX_ATTEMPTS": "-1",
+	} {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			_, err := ProfileFromEnv()
+			assert.ErrorContains(t, err, key+": ")
+		})
+	}
+}
+
+func TestProfileFromEnvOverridesCredentials(t *testing.T) {
+	clearEnv(t)
+	writeConfig(t, "config.yaml", `
+profiles:
+  lab:
+    url: https://192.168.1.1
+    auth:
+      mode: jwt
+      username: admin
+      exec: [vault-pfsense-creds, lab]
+    timeout: 30s
+  keyed:
+    url: https://192.168.1.2
+    auth:
+      file: /run/secrets/keyed
+      file_field: api_key
+`)
+	t.Setenv("PFREST_PROFILE", "lab")
+
+	profile, err := ProfileFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vault-pfsense-creds", "lab"}, profile.Auth.Exec, "without credential variables the profile's source is kept")
+
+	t.Setenv("PFREST_PASSWORD", "from-env")
+	profile, err = ProfileFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, AuthConfig{Mode: AuthModeJWT, Username: "admin", Password: "from-env"}, profile.Auth)
+	assert.Equal(t, 30*time.Second, profile.Timeout, "the rest of the profile is kept")
+	authenticator, err := profile.Auth.authenticator()
+	require.NoError(t, err)
+	source := authenticator.(*core.BearerAuth).Source.(*core.JWTTokenSource)
+	credentials, err := source.Credentials.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, core.Credentials{Username: "admin", Password: "from-env"}, credentials)
+
+	t.Setenv("PFREST_PROFILE", "keyed")
+	t.Setenv("PFREST_PASSWORD", "")
+	t.Setenv("PFREST_API_KEY", "key-from-env")
+	profile, err = ProfileFromEnv()
+	require.NoError(t, err)
+	authenticator, err = profile.Auth.authenticator()
+	require.NoError(t, err)
+	assert.Equal(t, &core.APIKeyAuth{Credentials: core.StaticCredentials{APIKey: "key-from-env"}}, authenticator,
+		"the mode is inferred from the variables once the file is replaced")
+
+	t.Setenv("PFREST_AUTH_MODE", AuthModeBearer)
+	t.Setenv("PFREST_API_KEY", "")
+	t.Setenv("PFREST_TOKEN", "token-from-env")
+	profile, err = ProfileFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, AuthConfig{Mode: AuthModeBearer, Token: "token-from-env"}, profile.Auth)
+}
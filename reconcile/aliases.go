@@ -0,0 +1,220 @@
+package reconcile
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	pfclientapi "github.com/danielmichaels/go-pfrest/pkg/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+)
+
+// Aliases makes the firewall's aliases match desired, pairing them by
+// Name. Aliases that differ in type, description, addresses or details
+// are updated, missing ones are created, and unmanaged ones are kept or
+// deleted according to opts.Policy. A desired alias without a Type keeps
+// the current one.
+//
+// The plan is written to opts.Output before it is applied, and the
+// filter is reloaded once at the end. The plan is returned even if
+// applying it fails.
+func Aliases(ctx context.Context, c *client.Client, desired []*pfclientapi.FirewallAlias, opts Options) (*Plan, error) {
+	want := make(map[string]bool, len(desired))
+	for i, alias := range desired {
+		if alias == nil || alias.Name == nil || *alias.Name == "" {
+			return nil, fmt.Errorf("reconcile: desired alias %d has no name", i)
+		}
+		if want[*alias.Name] {
+			return nil, fmt.Errorf("reconcile: desired alias %q is listed more than once", *alias.Name)
+		}
+		want[*alias.Name] = true
+	}
+
+	var current []*pfclientapi.GetFirewallAliasesEndpointResponseDataItem
+	for alias, err := range c.Firewall.AllFirewallAliases(ctx, nil, opts.RequestOptions...) {
+		if err != nil {
+			return nil, fmt.Errorf("reconcile: list aliases: %w", err)
+		}
+		current = append(current, alias)
+	}
+
+	plan, err := planAliases(c, current, desired, opts.Policy)
+	if err != nil {
+		return nil, err
+	}
+	return plan, execute(ctx, c, plan, opts)
+}
+
+// planAliases computes the changes that turn current into desired:
+// creates first, so that updated aliases can nest new ones, then updates,
+// then deletes. pfSense IDs are positions in a list, so deletes go from
+// the highest ID down to keep the remaining IDs valid.
+func planAliases(
+	c *client.Client,
+	current []*pfclientapi.GetFirewallAliasesEndpointResponseDataItem,
+	desired []*pfclientapi.FirewallAlias,
+	policy Policy,
+) (*Plan, error) {
+	existing := make(map[string]*pfclientapi.GetFirewallAliasesEndpointResponseDataItem, len(current))
+	for _, alias := range current {
+		if alias.Name == nil {
+			continue
+		}
+		if alias.ID == nil {
+			return nil, fmt.Errorf("reconcile: alias %q has no ID", *alias.Name)
+		}
+		existing[*alias.Name] = alias
+	}
+
+	plan := &Plan{Kind: "alias"}
+	var updates, deletes []Change
+	for _, alias := range desired {
+		have, ok := existing[*alias.Name]
+		if !ok {
+			plan.Changes = append(plan.Changes, createAlias(c, alias))
+			continue
+		}
+		delete(existing, *alias.Name)
+		if diffs := diffAlias(have, alias); len(diffs) > 0 {
+			updates = append(updates, updateAlias(c, *have.ID, alias, diffs))
+		} else {
+			plan.Unchanged++
+		}
+	}
+	for name, alias := range existing {
+		if policy != Prune {
+			plan.Unmanaged++
+			continue
+		}
+		deletes = append(deletes, deleteAlias(c, name, *alias.ID))
+	}
+	slices.SortFunc(deletes, func(a, b Change) int {
+		return cmp.Compare(b.ID, a.ID)
+	})
+
+	plan.Changes = append(plan.Changes, updates...)
+	plan.Changes = append(plan.Changes, deletes...)
+	return plan, nil
+}
+
+// diffAlias returns the fields of want that differ from have. A missing
+// description equals an empty one, and trailing empty details are
+// ignored, since pfSense stores one detail per address.
+func diffAlias(have *pfclientapi.GetFirewallAliasesEndpointResponseDataItem, want *pfclientapi.FirewallAlias) []Diff {
+	var diffs []Diff
+	if want.Type != nil && (have.Type == nil || *have.Type != *want.Type) {
+		var old pfclientapi.FirewallAliasType
+		if have.Type != nil {
+			old = *have.Type
+		}
+		diffs = append(diffs, Diff{Field: "type", Old: strconv.Quote(string(old)), New: strconv.Quote(string(*want.Type))})
+	}
+	if deref(have.Descr) != deref(want.Descr) {
+		diffs = append(diffs, Diff{Field: "descr", Old: strconv.Quote(deref(have.Descr)), New: strconv.Quote(deref(want.Descr))})
+	}
+	if !slices.Equal(have.Address, want.Address) && (len(have.Address) > 0 || len(want.Address) > 0) {
+		diffs = append(diffs, Diff{Field: "address", Old: quoteList(have.Address), New: quoteList(want.Address)})
+	}
+	if haveDetail, wantDetail := trimDetail(have.Detail), trimDetail(want.Detail); !slices.Equal(haveDetail, wantDetail) {
+		diffs = append(diffs, Diff{Field: "detail", Old: quoteList(haveDetail), New: quoteList(wantDetail)})
+	}
+	return diffs
+}
+
+func createAlias(c *client.Client, alias *pfclientapi.FirewallAlias) Change {
+	var diffs []Diff
+	if alias.Type != nil {
+		diffs = append(diffs, Diff{Field: "type", New: strconv.Quote(string(*alias.Type))})
+	}
+	if deref(alias.Descr) != "" {
+		diffs = append(diffs, Diff{Field: "descr", New: strconv.Quote(*alias.Descr)})
+	}
+	if len(alias.Address) > 0 {
+		diffs = append(diffs, Diff{Field: "address", New: quoteList(alias.Address)})
+	}
+	if detail := trimDetail(alias.Detail); len(detail) > 0 {
+		diffs = append(diffs, Diff{Field: "detail", New: quoteList(detail)})
+	}
+	return Change{
+		Action: Create,
+		Name:   *alias.Name,
+		Diffs:  diffs,
+		apply: func(ctx context.Context, opts []option.RequestOption) error {
+			_, err := c.Firewall.PostFirewallAliasEndpoint(ctx, &pfclientapi.PostFirewallAliasEndpointRequest{
+				Name:    alias.Name,
+				Type:    alias.Type,
+				Descr:   alias.Descr,
+				Address: alias.Address,
+				Detail:  alias.Detail,
+			}, opts...)
+			return err
+		},
+	}
+}
+
+func updateAlias(c *client.Client, id int, alias *pfclientapi.FirewallAlias, diffs []Diff) Change {
+	return Change{
+		Action: Update,
+		Name:   *alias.Name,
+		ID:     id,
+		Diffs:  diffs,
+		apply: func(ctx context.Context, opts []option.RequestOption) error {
+			// Send an empty description and one detail per address, so
+			// that clearing them in the desired state clears them here.
+			// An alias without addresses needs both lists sent empty.
+			descr := deref(alias.Descr)
+			detail := alias.Detail
+			if len(detail) < len(alias.Address) {
+				detail = append(slices.Clone(detail), make([]string, len(alias.Address)-len(detail))...)
+			}
+			if len(alias.Address) == 0 {
+				opts = append(slices.Clone(opts), sendEmpty("address", "detail"))
+			}
+			_, err := c.Firewall.PatchFirewallAliasEndpoint(ctx, &pfclientapi.PatchFirewallAliasEndpointRequest{
+				ID:      id,
+				Type:    alias.Type,
+				Descr:   &descr,
+				Address: alias.Address,
+				Detail:  detail,
+			}, opts...)
+			return err
+		},
+	}
+}
+
+func deleteAlias(c *client.Client, name string, id int) Change {
+	return Change{
+		Action: Delete,
+		Name:   name,
+		ID:     id,
+		apply: func(ctx context.Context, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallAliasEndpoint(ctx, &pfclientapi.DeleteFirewallAliasEndpointRequest{
+				ID: pfclientapi.String(strconv.Itoa(id)),
+			}, opts...)
+			return err
+		},
+	}
+}
+
+// trimDetail drops trailing empty details.
+func trimDetail(detail []string) []string {
+	end := len(detail)
+	for end > 0 && detail[end-1] == "" {
+		end--
+	}
+	return detail[:end]
+}
+
+func quoteList(values []string) string {
+	return fmt.Sprintf("%q", values)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
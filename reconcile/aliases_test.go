@@ -0,0 +1,186 @@
+package reconcile_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	pfclientapi "github.com/danielmichaels/go-pfrest/pkg/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+	"github.com/danielmichaels/go-pfrest/reconcile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type alias struct {
+	ID      int      `json:"id"`
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Descr   string   `json:"descr"`
+	Address []string `json:"address"`
+	Detail  []string `json:"detail"`
+}
+
+// fakeFirewall stores aliases the way pfSense does: an alias's ID is its
+// position in the list.
+type fakeFirewall struct {
+	mu       sync.Mutex
+	aliases  []alias
+	requests []string
+	applies  int
+}
+
+func (f *fakeFirewall) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r.Method+" "+r.URL.RequestURI())
+
+	switch r.Method + " " + r.URL.Path {
+	case "GET /api/v2/firewall/aliases":
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		end := len(f.aliases)
+		if limit > 0 {
+			end = min(end, offset+limit)
+		}
+		page := []alias{}
+		for id := offset; id < end; id++ {
+			item := f.aliases[id]
+			item.ID = id
+			page = append(page, item)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"code": 200, "data": page})
+		return
+	case "POST /api/v2/firewall/alias":
+		var item alias
+		_ = json.NewDecoder(r.Body).Decode(&item)
+		f.aliases = append(f.aliases, item)
+	case "PATCH /api/v2/firewall/alias":
+		// Like pfSense, only the fields in the body change.
+		body, _ := io.ReadAll(r.Body)
+		var id struct {
+			ID int `json:"id"`
+		}
+		_ = json.Unmarshal(body, &id)
+		item := f.aliases[id.ID]
+		_ = json.Unmarshal(body, &item)
+		item.Name = f.aliases[id.ID].Name
+		f.aliases[id.ID] = item
+	case "DELETE /api/v2/firewall/alias":
+		id, _ := strconv.Atoi(r.URL.Query().Get("id"))
+		f.aliases = append(f.aliases[:id], f.aliases[id+1:]...)
+	case "POST /api/v2/firewall/apply":
+		f.applies++
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+	_, _ = w.Write([]byte(`{"code":200,"data":{}}`))
+}
+
+func (f *fakeFirewall) names() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var names []string
+	for _, item := range f.aliases {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func newAlias(name string, aliasType pfclientapi.FirewallAliasType, descr string, address ...string) *pfclientapi.FirewallAlias {
+	return &pfclientapi.FirewallAlias{
+		Name:    pfclientapi.String(name),
+		Type:    &aliasType,
+		Descr:   pfclientapi.String(descr),
+		Address: address,
+	}
+}
+
+func TestAliases(t *testing.T) {
+	firewall := &fakeFirewall{aliases: []alias{
+		{Name: "old_a", Type: "host", Address: []string{"10.0.0.1"}, Detail: []string{""}},
+		{Name: "web", Type: "host", Descr: "web servers", Address: []string{"10.0.1.1"}, Detail: []string{""}},
+		{Name: "old_b", Type: "port", Address: []string{"22"}},
+		{Name: "db", Type: "host", Descr: "old", Address: []string{"10.0.2.1"}},
+	}}
+	server := httptest.NewServer(firewall)
+	defer server.Close()
+	c := client.NewClient(option.WithBaseURL(server.URL), option.WithMaxAttempts(1))
+
+	desired := []*pfclientapi.FirewallAlias{
+		newAlias("web", pfclientapi.FirewallAliasTypeHost, "web servers", "10.0.1.1"),
+		newAlias("db", pfclientapi.FirewallAliasTypeHost, "databases", "10.0.2.1", "10.0.2.2"),
+		newAlias("ssh", pfclientapi.FirewallAliasTypePort, "", "22"),
+	}
+
+	var out bytes.Buffer
+	plan, err := reconcile.Aliases(context.Background(), c, desired, reconcile.Options{DryRun: true, Output: &out})
+	require.NoError(t, err)
+	assert.Equal(t, `alias plan: 1 to create, 1 to update, 0 to delete, 1 unchanged, 2 unmanaged kept
+  + ssh
+      type:    "port"
+      address: ["22"]
+  ~ db
+      descr:   "old" -> "databases"
+      address: ["10.0.2.1"] -> ["10.0.2.1" "10.0.2.2"]
+`, out.String())
+	assert.Equal(t, out.String(), plan.String())
+	assert.Equal(t, 0, firewall.applies, "a dry run changes nothing")
+
+	out.Reset()
+	plan, err = reconcile.Aliases(context.Background(), c, desired, reconcile.Options{Policy: reconcile.Prune, Output: &out})
+	require.NoError(t, err)
+	assert.Equal(t, 2, plan.Count(reconcile.Delete))
+	assert.Equal(t, []string{"web", "db", "ssh"}, firewall.names())
+	assert.Equal(t, 1, firewall.applies, "the filter is reloaded once")
+	for _, request := range firewall.requests {
+		assert.NotContains(t, request, "apply=true")
+	}
+
+	out.Reset()
+	plan, err = reconcile.Aliases(context.Background(), c, desired, reconcile.Options{Policy: reconcile.Prune, Output: &out})
+	require.NoError(t, err)
+	assert.True(t, plan.Empty())
+	assert.Equal(t, 3, plan.Unchanged)
+	assert.Equal(t, 1, firewall.applies, "an empty plan applies nothing")
+}
+
+func TestAliasesEmptiesAddresses(t *testing.T) {
+	firewall := &fakeFirewall{aliases: []alias{
+		{Name: "blocked", Type: "host", Descr: "blocklist", Address: []string{"10.0.0.1", "10.0.0.2"}, Detail: []string{"a", "b"}},
+	}}
+	server := httptest.NewServer(firewall)
+	defer server.Close()
+	c := client.NewClient(option.WithBaseURL(server.URL), option.WithMaxAttempts(1))
+	desired := []*pfclientapi.FirewallAlias{newAlias("blocked", pfclientapi.FirewallAliasTypeHost, "blocklist")}
+
+	var out bytes.Buffer
+	plan, err := reconcile.Aliases(context.Background(), c, desired, reconcile.Options{Output: &out})
+	require.NoError(t, err)
+	assert.Equal(t, 1, plan.Count(reconcile.Update))
+	assert.Contains(t, out.String(), `address: ["10.0.0.1" "10.0.0.2"] -> []`)
+	assert.Empty(t, firewall.aliases[0].Address)
+	assert.Empty(t, firewall.aliases[0].Detail)
+
+	plan, err = reconcile.Aliases(context.Background(), c, desired, reconcile.Options{Output: &out})
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), "an emptied alias converges: %s", plan)
+}
+
+func TestAliasesInvalidDesiredState(t *testing.T) {
+	c := client.NewClient(option.WithBaseURL("http://127.0.0.1:0"))
+
+	_, err := reconcile.Aliases(context.Background(), c, []*pfclientapi.FirewallAlias{{}}, reconcile.Options{})
+	assert.EqualError(t, err, "reconcile: desired alias 0 has no name")
+
+	web := newAlias("web", pfclientapi.FirewallAliasTypeHost, "")
+	_, err = reconcile.Aliases(context.Background(), c, []*pfclientapi.FirewallAlias{web, web}, reconcile.Options{})
+	assert.EqualError(t, err, `reconcile: desired alias "web" is listed more than once`)
+}
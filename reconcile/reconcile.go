@@ -0,0 +1,212 @@
+// Package reconcile makes a firewall's configuration match a desired
+// state, such as one kept in Git.
+//
+// Each reconciler lists what is on the firewall, pairs it with the desired
+// objects by name, and computes a [Plan] of creates, updates and deletes.
+// Fields the server manages, such as IDs, are never compared. The plan is
+// printed before it is applied, and all of its changes are applied with a
+// single reload of the affected subsystem.
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	pfrest "github.com/danielmichaels/go-pfrest"
+	"github.com/danielmichaels/go-pfrest/pkg/client/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+)
+
+// Policy decides what happens to objects on the firewall that are not in
+// the desired state.
+type Policy int
+
+const (
+	// Keep leaves unmanaged objects alone.
+	Keep Policy = iota
+	// Prune deletes unmanaged objects.
+	Prune
+)
+
+// Options configures a reconciler.
+type Options struct {
+	// Policy decides what happens to unmanaged objects. The default is
+	// Keep.
+	Policy Policy
+	// DryRun computes and prints the plan without applying it.
+	DryRun bool
+	// Output receives the plan before it is applied. It defaults to
+	// os.Stdout; use io.Discard to silence it.
+	Output io.Writer
+	// Wait blocks until pfSense reports the changes as applied; see
+	// pfrest.ApplyAndWait.
+	Wait bool
+	// RequestOptions are passed to every request.
+	RequestOptions []option.RequestOption
+}
+
+// Action is what a [Change] does to an object.
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// symbol returns the marker for the action in a printed plan.
+func (a Action) symbol() string {
+	switch a {
+	case Create:
+		return "+"
+	case Update:
+		return "~"
+	case Delete:
+		return "-"
+	default:
+		return "?"
+	}
+}
+
+// Diff is a field whose value differs between the firewall and the
+// desired state. Old is empty for creates.
+type Diff struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// Change is a single step of a [Plan].
+type Change struct {
+	Action Action
+	// Name identifies the object.
+	Name string
+	// ID is the object's current ID on the firewall, for updates and
+	// deletes.
+	ID int
+	// Diffs lists the fields a create sets or an update changes.
+	Diffs []Diff
+
+	apply func(ctx context.Context, opts []option.RequestOption) error
+}
+
+// Plan is the list of changes that makes a firewall match the desired
+// state, in the order they are applied.
+type Plan struct {
+	// Kind is the kind of object the plan changes, e.g. "alias".
+	Kind    string
+	Changes []Change
+	// Unchanged counts the objects that already match.
+	Unchanged int
+	// Unmanaged counts the objects not in the desired state that are
+	// kept.
+	Unmanaged int
+}
+
+// Empty reports whether the plan changes nothing.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Count returns the number of changes with the given action.
+func (p *Plan) Count(action Action) int {
+	var n int
+	for _, change := range p.Changes {
+		if change.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// String returns the plan in a human-readable form, e.g.
+//
+//	alias plan: 1 to create, 1 to update, 0 to delete, 4 unchanged
+//	  + web_servers
+//	      type:    "host"
+//	      address: ["10.0.0.1" "10.0.0.2"]
+//	  ~ db_servers
+//	      descr:   "old" -> "new"
+func (p *Plan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s plan: %d to create, %d to update, %d to delete, %d unchanged",
+		p.Kind, p.Count(Create), p.Count(Update), p.Count(Delete), p.Unchanged)
+	if p.Unmanaged > 0 {
+		fmt.Fprintf(&b, ", %d unmanaged kept", p.Unmanaged)
+	}
+	b.WriteByte('\n')
+
+	for _, change := range p.Changes {
+		fmt.Fprintf(&b, "  %s %s\n", change.Action.symbol(), change.Name)
+		width := 0
+		for _, diff := range change.Diffs {
+			width = max(width, len(diff.Field))
+		}
+		for _, diff := range change.Diffs {
+			label := diff.Field + ":" + strings.Repeat(" ", width-len(diff.Field))
+			if change.Action == Create {
+				fmt.Fprintf(&b, "      %s %s\n", label, diff.New)
+			} else {
+				fmt.Fprintf(&b, "      %s %s -> %s\n", label, diff.Old, diff.New)
+			}
+		}
+	}
+	return b.String()
+}
+
+// execute prints the plan and, unless it is a dry run, makes its changes
+// as part of one change set and applies them.
+func execute(ctx context.Context, c *client.Client, plan *Plan, opts Options) error {
+	output := opts.Output
+	if output == nil {
+		output = os.Stdout
+	}
+	if _, err := io.WriteString(output, plan.String()); err != nil {
+		return err
+	}
+	if opts.DryRun || plan.Empty() {
+		return nil
+	}
+
+	changes := pfrest.NewChangeSet(c)
+	requestOpts := append(slices.Clone(opts.RequestOptions), changes.Option())
+	var errs []error
+	for _, change := range plan.Changes {
+		if err := change.apply(ctx, requestOpts); err != nil {
+			errs = append(errs, fmt.Errorf("reconcile: %s %s %q: %w", change.Action, plan.Kind, change.Name, err))
+			break
+		}
+	}
+	// Apply the changes made before a failure too: they are already saved,
+	// so the next run finds nothing to do for them and would never apply
+	// them.
+	commit := changes.Commit
+	if opts.Wait {
+		commit = changes.CommitAndWait
+	}
+	if err := commit(ctx, opts.RequestOptions...); err != nil {
+		errs = append(errs, fmt.Errorf("reconcile: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// sendEmpty returns a request option that adds the named fields to the
+// JSON body as empty lists where the request leaves them out. The
+// generated requests omit empty lists, which pfSense reads as "leave
+// unchanged", so clearing a list needs it sent explicitly.
+func sendEmpty(fields ...string) option.RequestOption {
+	return option.WithBodyRewriter(func(body map[string]json.RawMessage) error {
+		for _, field := range fields {
+			if _, ok := body[field]; !ok {
+				body[field] = json.RawMessage("[]")
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,375 @@
+package pfrest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+)
+
+// ErrNotSupported is returned by the methods of a [Resource] that the API
+// has no endpoint for, such as ReplaceAll for nested objects.
+var ErrNotSupported = errors.New("pfrest: not supported by the API")
+
+// ID identifies an object on the firewall. pfSense identifies most objects
+// by their position in a list, and some by name, such as the interface
+// "wan". Objects nested in another, such as a DHCP server's static
+// mappings, are also identified by their parent's ID.
+type ID struct {
+	// Parent is the ID of the object this one is nested in, or empty.
+	Parent string
+	// Value is the ID of the object itself.
+	Value string
+}
+
+// IntID returns the ID of a top-level object at position n.
+func IntID(n int) ID {
+	return ID{Value: strconv.Itoa(n)}
+}
+
+// String returns the ID in the form "value", or "parent/value" for nested
+// objects.
+func (id ID) String() string {
+	if id.Parent == "" {
+		return id.Value
+	}
+	return id.Parent + "/" + id.Value
+}
+
+// Object is a value of type T stored on the firewall under ID.
+type Object[T any] struct {
+	ID    ID
+	Value T
+}
+
+// Resource is the uniform create, read, update and delete interface for
+// one kind of object, such as firewall rules or WireGuard peers, whatever
+// the names and ID types of the underlying endpoints. T is the model
+// type, e.g. *pfclientapi.FirewallRule.
+//
+// The adapters for every model, such as [FirewallRules], are generated;
+// [Resources] lists them all.
+type Resource[T any] interface {
+	// Kind returns the name of the model, e.g. "FirewallRule".
+	Kind() string
+	// List returns every object. Nested objects are returned for all
+	// parents.
+	List(ctx context.Context, opts ...option.RequestOption) ([]Object[T], error)
+	// Get returns the object with the given ID.
+	Get(ctx context.Context, id ID, opts ...option.RequestOption) (Object[T], error)
+	// Create adds object.Value and returns it as stored, with its new
+	// ID. object.ID.Parent names the parent of a nested object; the rest
+	// of object.ID is ignored.
+	Create(ctx context.Context, object Object[T], opts ...option.RequestOption) (Object[T], error)
+	// Update replaces the fields set in object.Value of the object with
+	// object.ID, and returns it as stored.
+	Update(ctx context.Context, object Object[T], opts ...option.RequestOption) (Object[T], error)
+	// Delete removes the object with the given ID.
+	Delete(ctx context.Context, id ID, opts ...option.RequestOption) error
+	// ReplaceAll replaces every object with values. It returns
+	// ErrNotSupported for models the API cannot replace in bulk.
+	ReplaceAll(ctx context.Context, values []T, opts ...option.RequestOption) error
+}
+
+// resource implements Resource on top of the generated endpoints. Each
+// function returns the endpoint's response data, which is converted to
+// Object[T] through JSON, since the endpoints of a model each have their
+// own, nearly identical, struct types. list and replaceAll are nil if the
+// API has no such endpoint.
+type resource[T any] struct {
+	kind string
+	// nested reports whether objects are identified by their parent too.
+	nested bool
+
+	list       func(ctx context.Context, opts []option.RequestOption) ([]any, error)
+	get        func(ctx context.Context, id ID, opts []option.RequestOption) (any, error)
+	create     func(ctx context.Context, object Object[T], opts []option.RequestOption) (any, error)
+	update     func(ctx context.Context, object Object[T], opts []option.RequestOption) (any, error)
+	delete     func(ctx context.Context, id ID, opts []option.RequestOption) error
+	replaceAll func(ctx context.Context, values []T, opts []option.RequestOption) error
+}
+
+func (r *resource[T]) Kind() string {
+	return r.kind
+}
+
+func (r *resource[T]) List(ctx context.Context, opts ...option.RequestOption) ([]Object[T], error) {
+	if r.list == nil {
+		return nil, fmt.Errorf("list %s: %w", r.kind, ErrNotSupported)
+	}
+	items, err := r.list(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]Object[T], 0, len(items))
+	for _, item := range items {
+		object, err := objectFrom[T](item, ID{})
+		if err != nil {
+			return nil, fmt.Errorf("list %s: %w", r.kind, err)
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+func (r *resource[T]) Get(ctx context.Context, id ID, opts ...option.RequestOption) (Object[T], error) {
+	if err := r.checkID(id); err != nil {
+		return Object[T]{}, err
+	}
+	data, err := r.get(ctx, id, opts)
+	if err != nil {
+		return Object[T]{}, err
+	}
+	return r.object(data, id)
+}
+
+func (r *resource[T]) Create(ctx context.Context, object Object[T], opts ...option.RequestOption) (Object[T], error) {
+	if r.nested && object.ID.Parent == "" {
+		return Object[T]{}, fmt.Errorf("pfrest: creating a %s needs a parent ID", r.kind)
+	}
+	data, err := r.create(ctx, object, opts)
+	if err != nil {
+		return Object[T]{}, err
+	}
+	return r.object(data, ID{Parent: object.ID.Parent})
+}
+
+func (r *resource[T]) Update(ctx context.Context, object Object[T], opts ...option.RequestOption) (Object[T], error) {
+	if err := r.checkID(object.ID); err != nil {
+		return Object[T]{}, err
+	}
+	data, err := r.update(ctx, object, opts)
+	if err != nil {
+		return Object[T]{}, err
+	}
+	return r.object(data, object.ID)
+}
+
+func (r *resource[T]) Delete(ctx context.Context, id ID, opts ...option.RequestOption) error {
+	if err := r.checkID(id); err != nil {
+		return err
+	}
+	return r.delete(ctx, id, opts)
+}
+
+func (r *resource[T]) ReplaceAll(ctx context.Context, values []T, opts ...option.RequestOption) error {
+	if r.replaceAll == nil {
+		return fmt.Errorf("replace %s: %w", r.kind, ErrNotSupported)
+	}
+	return r.replaceAll(ctx, values, opts)
+}
+
+// checkID reports whether id identifies an object of this resource.
+func (r *resource[T]) checkID(id ID) error {
+	switch {
+	case id.Value == "":
+		return fmt.Errorf("pfrest: %s ID is empty", r.kind)
+	case r.nested && id.Parent == "":
+		return fmt.Errorf("pfrest: %s ID %s has no parent", r.kind, id)
+	}
+	return nil
+}
+
+func (r *resource[T]) object(data any, id ID) (Object[T], error) {
+	object, err := objectFrom[T](data, id)
+	if err != nil {
+		return Object[T]{}, fmt.Errorf("%s %s: %w", r.kind, id, err)
+	}
+	return object, nil
+}
+
+// objectFrom converts response data to an Object, taking its ID from the
+// data's id and parent_id fields, or from id where the data has none.
+func objectFrom[T any](data any, id ID) (Object[T], error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return Object[T]{}, err
+	}
+	if bytes.Equal(raw, []byte("null")) {
+		return Object[T]{}, errors.New("response has no data")
+	}
+	var ids struct {
+		ID       json.RawMessage `json:"id"`
+		ParentID json.RawMessage `json:"parent_id"`
+	}
+	if err := json.Unmarshal(raw, &ids); err != nil {
+		return Object[T]{}, err
+	}
+	if value, ok := idString(ids.ID); ok {
+		id.Value = value
+	}
+	if parent, ok := idString(ids.ParentID); ok {
+		id.Parent = parent
+	}
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return Object[T]{}, err
+	}
+	return Object[T]{ID: id, Value: value}, nil
+}
+
+// idString returns the JSON number or string in raw as a string.
+func idString(raw json.RawMessage) (string, bool) {
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if len(raw) == 0 || decoder.Decode(&value) != nil {
+		return "", false
+	}
+	switch value := value.(type) {
+	case json.Number:
+		return value.String(), true
+	case string:
+		return value, true
+	default:
+		return "", false
+	}
+}
+
+// ints returns the parts of id as numbers, for the generated requests,
+// which declare their ID fields int. pfSense identifies some objects by
+// name, such as the interface "wan": parts that are not numbers are
+// returned as 0, and the returned options send them in the JSON body as
+// strings instead. Empty parts are 0.
+func (id ID) ints(opts []option.RequestOption) (parent, value int, _ []option.RequestOption) {
+	names := make(map[string]string)
+	if id.Parent != "" {
+		var err error
+		if parent, err = strconv.Atoi(id.Parent); err != nil {
+			names["parent_id"] = id.Parent
+		}
+	}
+	if id.Value != "" {
+		var err error
+		if value, err = strconv.Atoi(id.Value); err != nil {
+			names["id"] = id.Value
+		}
+	}
+	if len(names) == 0 {
+		return parent, value, opts
+	}
+	return parent, value, append(slices.Clip(opts), sendIDs(names))
+}
+
+// sendIDs returns a request option that sets the named fields of the JSON
+// body to the given strings.
+func sendIDs(ids map[string]string) option.RequestOption {
+	return option.WithBodyRewriter(func(fields map[string]json.RawMessage) error {
+		for field, id := range ids {
+			data, err := json.Marshal(id)
+			if err != nil {
+				return err
+			}
+			fields[field] = data
+		}
+		return nil
+	})
+}
+
+// collect gathers the items of a paginated list.
+func collect[I any](items iter.Seq2[I, error]) ([]any, error) {
+	var all []any
+	for item, err := range items {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
+
+// anys returns items as a slice of any.
+func anys[I any](items []I) []any {
+	all := make([]any, len(items))
+	for i, item := range items {
+		all[i] = item
+	}
+	return all
+}
+
+// Erase adapts a Resource to one whose values are of type any, so that
+// resources of different models can be handled alike, e.g. by [Resources].
+// Values passed to the result are converted to T through JSON unless they
+// already are of type T, so decoded JSON such as a map[string]any works.
+func Erase[T any](r Resource[T]) Resource[any] {
+	return erased[T]{r}
+}
+
+type erased[T any] struct {
+	r Resource[T]
+}
+
+func (e erased[T]) Kind() string {
+	return e.r.Kind()
+}
+
+func (e erased[T]) List(ctx context.Context, opts ...option.RequestOption) ([]Object[any], error) {
+	objects, err := e.r.List(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	erased := make([]Object[any], len(objects))
+	for i, object := range objects {
+		erased[i] = Object[any]{ID: object.ID, Value: object.Value}
+	}
+	return erased, nil
+}
+
+func (e erased[T]) Get(ctx context.Context, id ID, opts ...option.RequestOption) (Object[any], error) {
+	object, err := e.r.Get(ctx, id, opts...)
+	return Object[any]{ID: object.ID, Value: object.Value}, err
+}
+
+func (e erased[T]) Create(ctx context.Context, object Object[any], opts ...option.RequestOption) (Object[any], error) {
+	value, err := e.value(object.Value)
+	if err != nil {
+		return Object[any]{}, err
+	}
+	created, err := e.r.Create(ctx, Object[T]{ID: object.ID, Value: value}, opts...)
+	return Object[any]{ID: created.ID, Value: created.Value}, err
+}
+
+func (e erased[T]) Update(ctx context.Context, object Object[any], opts ...option.RequestOption) (Object[any], error) {
+	value, err := e.value(object.Value)
+	if err != nil {
+		return Object[any]{}, err
+	}
+	updated, err := e.r.Update(ctx, Object[T]{ID: object.ID, Value: value}, opts...)
+	return Object[any]{ID: updated.ID, Value: updated.Value}, err
+}
+
+func (e erased[T]) Delete(ctx context.Context, id ID, opts ...option.RequestOption) error {
+	return e.r.Delete(ctx, id, opts...)
+}
+
+func (e erased[T]) ReplaceAll(ctx context.Context, values []any, opts ...option.RequestOption) error {
+	typed := make([]T, len(values))
+	for i, value := range values {
+		var err error
+		if typed[i], err = e.value(value); err != nil {
+			return err
+		}
+	}
+	return e.r.ReplaceAll(ctx, typed, opts...)
+}
+
+func (e erased[T]) value(value any) (T, error) {
+	if typed, ok := value.(T); ok {
+		return typed, nil
+	}
+	var typed T
+	raw, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(raw, &typed)
+	}
+	if err != nil {
+		return typed, fmt.Errorf("pfrest: %T is not a %s: %w", value, e.r.Kind(), err)
+	}
+	return typed, nil
+}
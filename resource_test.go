@@ -0,0 +1,174 @@
+package pfrest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// apiRequest is a request received by an apiServer.
+type apiRequest struct {
+	Method string
+	URI    string
+	Body   map[string]any
+}
+
+// apiServer answers every request with data, and records the requests.
+type apiServer struct {
+	mu       sync.Mutex
+	data     any
+	requests []apiRequest
+}
+
+func (s *apiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	request := apiRequest{Method: r.Method, URI: r.URL.RequestURI()}
+	if body, _ := io.ReadAll(r.Body); len(body) > 0 {
+		_ = json.Unmarshal(body, &request.Body)
+	}
+	s.requests = append(s.requests, request)
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": 200, "status": "ok", "data": s.data})
+}
+
+func newAPIServer(t *testing.T, data any) (*apiServer, *client.Client) {
+	t.Helper()
+	api := &apiServer{data: data}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return api, client.NewClient(option.WithBaseURL(server.URL), option.WithMaxAttempts(1))
+}
+
+func TestObjectFrom(t *testing.T) {
+	for _, test := range []struct {
+		data any
+		id   ID
+		want ID
+	}{
+		{map[string]any{"id": 3}, ID{}, ID{Value: "3"}},
+		{map[string]any{"id": "wan"}, ID{}, ID{Value: "wan"}},
+		{map[string]any{"id": 0, "parent_id": "lan"}, ID{}, ID{Parent: "lan", Value: "0"}},
+		{map[string]any{"id": 2, "parent_id": 1}, ID{Parent: "9"}, ID{Parent: "1", Value: "2"}},
+		{map[string]any{"descr": "no ID"}, ID{Parent: "lan", Value: "4"}, ID{Parent: "lan", Value: "4"}},
+		{map[string]any{"id": nil}, ID{Value: "4"}, ID{Value: "4"}},
+	} {
+		object, err := objectFrom[*pkgclient.NetworkInterface](test.data, test.id)
+		require.NoError(t, err, test.data)
+		assert.Equal(t, test.want, object.ID, test.data)
+	}
+
+	object, err := objectFrom[*pkgclient.NetworkInterface](map[string]any{"id": "wan", "descr": "WAN"}, ID{})
+	require.NoError(t, err)
+	assert.Equal(t, "WAN", *object.Value.Descr)
+
+	_, err = objectFrom[*pkgclient.NetworkInterface](nil, ID{})
+	assert.EqualError(t, err, "response has no data")
+}
+
+func TestResourceCheckID(t *testing.T) {
+	api, c := newAPIServer(t, map[string]any{"id": 0})
+	mappings := DhcpServerStaticMappings(c)
+	ctx := context.Background()
+
+	_, err := mappings.Get(ctx, ID{Value: "0"})
+	assert.EqualError(t, err, "pfrest: DhcpServerStaticMapping ID 0 has no parent")
+	_, err = mappings.Update(ctx, Object[*pkgclient.DhcpServerStaticMapping]{ID: ID{Parent: "lan"}})
+	assert.EqualError(t, err, "pfrest: DhcpServerStaticMapping ID is empty")
+	assert.EqualError(t, mappings.Delete(ctx, ID{Value: "0"}), "pfrest: DhcpServerStaticMapping ID 0 has no parent")
+	_, err = mappings.Create(ctx, Object[*pkgclient.DhcpServerStaticMapping]{})
+	assert.EqualError(t, err, "pfrest: creating a DhcpServerStaticMapping needs a parent ID")
+	assert.Empty(t, api.requests, "invalid IDs are not sent")
+
+	object, err := mappings.Get(ctx, ID{Parent: "lan", Value: "0"})
+	require.NoError(t, err)
+	assert.Equal(t, ID{Parent: "lan", Value: "0"}, object.ID, "the parent is kept when the data has none")
+	require.Len(t, api.requests, 1)
+	assert.Equal(t, "/api/v2/services/dhcp_server/static_mapping?id=0&parent_id=lan", api.requests[0].URI)
+}
+
+func TestResourceStringIDs(t *testing.T) {
+	api, c := newAPIServer(t, map[string]any{"id": "wan", "descr": "Uplink"})
+	interfaces := NetworkInterfaces(c)
+	ctx := context.Background()
+
+	object, err := interfaces.Get(ctx, ID{Value: "wan"})
+	require.NoError(t, err)
+	assert.Equal(t, ID{Value: "wan"}, object.ID)
+
+	object.Value.Descr = pkgclient.String("Uplink")
+	updated, err := interfaces.Update(ctx, object)
+	require.NoError(t, err)
+	assert.Equal(t, ID{Value: "wan"}, updated.ID)
+
+	require.Len(t, api.requests, 2)
+	assert.Equal(t, "/api/v2/interface?id=wan", api.requests[0].URI)
+	assert.Equal(t, "PATCH", api.requests[1].Method)
+	assert.Equal(t, "wan", api.requests[1].Body["id"], "a name is sent as it is")
+	assert.Equal(t, "Uplink", api.requests[1].Body["descr"])
+
+	api.data = map[string]any{"id": 1, "parent_id": "lan", "mac": "00:11:22:33:44:55"}
+	mappings := DhcpServerStaticMappings(c)
+	_, err = mappings.Update(ctx, Object[*pkgclient.DhcpServerStaticMapping]{
+		ID:    ID{Parent: "lan", Value: "1"},
+		Value: &pkgclient.DhcpServerStaticMapping{Mac: pkgclient.String("00:11:22:33:44:55")},
+	})
+	require.NoError(t, err)
+	body := api.requests[2].Body
+	assert.Equal(t, "lan", body["parent_id"])
+	assert.Equal(t, float64(1), body["id"], "numeric IDs are sent as numbers")
+}
+
+func TestEraseConvertsValues(t *testing.T) {
+	api, c := newAPIServer(t, map[string]any{"id": 3, "parent_id": "lan", "mac": "00:11:22:33:44:55"})
+	mappings := Erase(DhcpServerStaticMappings(c))
+	ctx := context.Background()
+
+	created, err := mappings.Create(ctx, Object[any]{
+		ID:    ID{Parent: "lan"},
+		Value: map[string]any{"mac": "00:11:22:33:44:55", "ipaddr": "192.0.2.10"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ID{Parent: "lan", Value: "3"}, created.ID)
+	mapping, ok := created.Value.(*pkgclient.DhcpServerStaticMapping)
+	require.True(t, ok, "%T", created.Value)
+	assert.Equal(t, "00:11:22:33:44:55", *mapping.Mac)
+
+	require.Len(t, api.requests, 1)
+	body := api.requests[0].Body
+	assert.Equal(t, "POST", api.requests[0].Method)
+	assert.Equal(t, "lan", body["parent_id"])
+	assert.Equal(t, "192.0.2.10", body["ipaddr"])
+
+	_, err = mappings.Update(ctx, Object[any]{ID: ID{Parent: "lan", Value: "3"}, Value: map[string]any{"mac": 42}})
+	assert.ErrorContains(t, err, "pfrest: map[string]interface {} is not a DhcpServerStaticMapping")
+	assert.Len(t, api.requests, 1, "values that don't convert are not sent")
+}
+
+func TestResourceNotSupported(t *testing.T) {
+	api, c := newAPIServer(t, nil)
+	ctx := context.Background()
+
+	err := NetworkInterfaces(c).ReplaceAll(ctx, nil)
+	assert.True(t, errors.Is(err, ErrNotSupported), "%v", err)
+	assert.EqualError(t, err, "replace NetworkInterface: pfrest: not supported by the API")
+
+	err = Resources(c)["DhcpServerStaticMapping"].ReplaceAll(ctx, []any{map[string]any{}})
+	assert.True(t, errors.Is(err, ErrNotSupported), "%v", err)
+
+	_, err = AcmeCertificateActions(c).List(ctx)
+	assert.True(t, errors.Is(err, ErrNotSupported), "%v", err)
+	assert.EqualError(t, err, "list AcmeCertificateAction: pfrest: not supported by the API")
+
+	assert.Empty(t, api.requests)
+}
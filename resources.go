@@ -0,0 +1,3538 @@
+// Code generated by fernpatch. DO NOT EDIT.
+
+package pfrest
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	client "github.com/danielmichaels/go-pfrest/pkg/client/client"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+)
+
+// Resources returns the adapter of every model, keyed by its kind, e.g.
+// "FirewallRule", for tooling that works on all of them alike.
+func Resources(c *client.Client) map[string]Resource[any] {
+	return map[string]Resource[any]{
+		"AcmeAccountKey":        Erase(AcmeAccountKeys(c)),
+		"AcmeCertificateAction": Erase(AcmeCertificateActions(c)),
+		"AcmeCertificateDomain": Erase(AcmeCertificateDomains(c)),
+		"AcmeCertificate":       Erase(AcmeCertificates(c)),
+		"AuthServer":            Erase(AuthServers(c)),
+		"BindAccessListEntry":   Erase(BindAccessListEntries(c)),
+		"BindAccessList":        Erase(BindAccessLists(c)),
+		"BindSyncRemoteHost":    Erase(BindSyncRemoteHosts(c)),
+		"BindView":              Erase(BindViews(c)),
+		"BindZoneRecord":        Erase(BindZoneRecords(c)),
+		"BindZone":              Erase(BindZones(c)),
+		"CertificateAuthority":  Erase(CertificateAuthorities(c)),
+		"CertificateRevocationListRevokedCertificate": Erase(CertificateRevocationListRevokedCertificates(c)),
+		"CertificateRevocationList":                   Erase(CertificateRevocationLists(c)),
+		"Certificate":                                 Erase(Certificates(c)),
+		"CronJob":                                     Erase(CronJobs(c)),
+		"DNSForwarderHostOverrideAlias":               Erase(DNSForwarderHostOverrideAliases(c)),
+		"DNSForwarderHostOverride":                    Erase(DNSForwarderHostOverrides(c)),
+		"DNSResolverAccessListNetwork":                Erase(DNSResolverAccessListNetworks(c)),
+		"DNSResolverAccessList":                       Erase(DNSResolverAccessLists(c)),
+		"DNSResolverDomainOverride":                   Erase(DNSResolverDomainOverrides(c)),
+		"DNSResolverHostOverrideAlias":                Erase(DNSResolverHostOverrideAliases(c)),
+		"DNSResolverHostOverride":                     Erase(DNSResolverHostOverrides(c)),
+		"DhcpServerAddressPool":                       Erase(DhcpServerAddressPools(c)),
+		"DhcpServerCustomOption":                      Erase(DhcpServerCustomOptions(c)),
+		"DhcpServerStaticMapping":                     Erase(DhcpServerStaticMappings(c)),
+		"DhcpServer":                                  Erase(DhcpServers(c)),
+		"FirewallAlias":                               Erase(FirewallAliases(c)),
+		"FirewallRule":                                Erase(FirewallRules(c)),
+		"FirewallScheduleTimeRange":                   Erase(FirewallScheduleTimeRanges(c)),
+		"FirewallSchedule":                            Erase(FirewallSchedules(c)),
+		"FreeRadiusClient":                            Erase(FreeRadiusClients(c)),
+		"FreeRadiusInterface":                         Erase(FreeRadiusInterfaces(c)),
+		"FreeRadiusUser":                              Erase(FreeRadiusUsers(c)),
+		"HaProxyBackendACL":                           Erase(HaProxyBackendACLs(c)),
+		"HaProxyBackendAction":                        Erase(HaProxyBackendActions(c)),
+		"HaProxyBackendErrorFile":                     Erase(HaProxyBackendErrorFiles(c)),
+		"HaProxyBackendServer":                        Erase(HaProxyBackendServers(c)),
+		"HaProxyBackend":                              Erase(HaProxyBackends(c)),
+		"HaProxyDNSResolver":                          Erase(HaProxyDNSResolvers(c)),
+		"HaProxyEmailMailer":                          Erase(HaProxyEmailMailers(c)),
+		"HaProxyFile":                                 Erase(HaProxyFiles(c)),
+		"HaProxyFrontendACL":                          Erase(HaProxyFrontendACLs(c)),
+		"HaProxyFrontendAction":                       Erase(HaProxyFrontendActions(c)),
+		"HaProxyFrontendAddress":                      Erase(HaProxyFrontendAddresses(c)),
+		"HaProxyFrontendCertificate":                  Erase(HaProxyFrontendCertificates(c)),
+		"HaProxyFrontendErrorFile":                    Erase(HaProxyFrontendErrorFiles(c)),
+		"HaProxyFrontend":                             Erase(HaProxyFrontends(c)),
+		"IPsecPhase1Encryption":                       Erase(IPsecPhase1Encryptions(c)),
+		"IPsecPhase1":                                 Erase(IPsecPhase1s(c)),
+		"IPsecPhase2Encryption":                       Erase(IPsecPhase2Encryptions(c)),
+		"IPsecPhase2":                                 Erase(IPsecPhase2s(c)),
+		"InterfaceBridge":                             Erase(InterfaceBridges(c)),
+		"InterfaceGre":                                Erase(InterfaceGres(c)),
+		"InterfaceGroup":                              Erase(InterfaceGroups(c)),
+		"InterfaceLagg":                               Erase(InterfaceLaggs(c)),
+		"InterfaceVlan":                               Erase(InterfaceVlans(c)),
+		"NetworkInterface":                            Erase(NetworkInterfaces(c)),
+		"NtpTimeServer":                               Erase(NtpTimeServers(c)),
+		"OneToOneNatMapping":                          Erase(OneToOneNatMappings(c)),
+		"OpenVpnClientExportConfig":                   Erase(OpenVpnClientExportConfigs(c)),
+		"OpenVpnClientSpecificOverride":               Erase(OpenVpnClientSpecificOverrides(c)),
+		"OpenVpnClient":                               Erase(OpenVpnClients(c)),
+		"OpenVpnServer":                               Erase(OpenVpnServers(c)),
+		"OutboundNatMapping":                          Erase(OutboundNatMappings(c)),
+		"PortForward":                                 Erase(PortForwards(c)),
+		"RestapiAccessListEntry":                      Erase(RestapiAccessListEntries(c)),
+		"RoutingGatewayGroupPriority":                 Erase(RoutingGatewayGroupPriorities(c)),
+		"RoutingGatewayGroup":                         Erase(RoutingGatewayGroups(c)),
+		"RoutingGateway":                              Erase(RoutingGateways(c)),
+		"ServiceWatchdog":                             Erase(ServiceWatchdogs(c)),
+		"StaticRoute":                                 Erase(StaticRoutes(c)),
+		"SystemTunable":                               Erase(SystemTunables(c)),
+		"TrafficShaperLimiterBandwidth":               Erase(TrafficShaperLimiterBandwidths(c)),
+		"TrafficShaperLimiterQueue":                   Erase(TrafficShaperLimiterQueues(c)),
+		"TrafficShaperLimiter":                        Erase(TrafficShaperLimiters(c)),
+		"TrafficShaperQueue":                          Erase(TrafficShaperQueues(c)),
+		"TrafficShaper":                               Erase(TrafficShapers(c)),
+		"UserGroup":                                   Erase(UserGroups(c)),
+		"User":                                        Erase(Users(c)),
+		"VirtualIP":                                   Erase(VirtualIPs(c)),
+		"WireGuardPeerAllowedIP":                      Erase(WireGuardPeerAllowedIPs(c)),
+		"WireGuardPeer":                               Erase(WireGuardPeers(c)),
+		"WireGuardTunnelAddress":                      Erase(WireGuardTunnelAddresses(c)),
+		"WireGuardTunnel":                             Erase(WireGuardTunnels(c)),
+	}
+}
+
+// AcmeAccountKeys returns the Resource for AcmeAccountKey objects, served by /api/v2/services/acme/account_key.
+func AcmeAccountKeys(c *client.Client) Resource[*pkgclient.AcmeAccountKey] {
+	return &resource[*pkgclient.AcmeAccountKey]{
+		kind: "AcmeAccountKey",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesAcmeAccountKeys(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesAcmeAccountKeyEndpoint(ctx, &pkgclient.GetServicesAcmeAccountKeyEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.AcmeAccountKey], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesAcmeAccountKeyEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.AcmeAccountKey], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesAcmeAccountKeyEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesAcmeAccountKeyEndpoint(ctx, &pkgclient.DeleteServicesAcmeAccountKeyEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.AcmeAccountKey, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesAcmeAccountKeysEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesAcmeAccountKeysEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// AcmeCertificateActions returns the Resource for AcmeCertificateAction objects, served by /api/v2/services/acme/certificate/action.
+// AcmeCertificateAction objects are nested, so their IDs have a Parent.
+func AcmeCertificateActions(c *client.Client) Resource[*pkgclient.AcmeCertificateAction] {
+	return &resource[*pkgclient.AcmeCertificateAction]{
+		kind:   "AcmeCertificateAction",
+		nested: true,
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesAcmeCertificateActionEndpoint(ctx, &pkgclient.GetServicesAcmeCertificateActionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.AcmeCertificateAction], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesAcmeCertificateActionEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.AcmeCertificateAction], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesAcmeCertificateActionEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesAcmeCertificateActionEndpoint(ctx, &pkgclient.DeleteServicesAcmeCertificateActionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// AcmeCertificateDomains returns the Resource for AcmeCertificateDomain objects, served by /api/v2/services/acme/certificate/domain.
+// AcmeCertificateDomain objects are nested, so their IDs have a Parent.
+func AcmeCertificateDomains(c *client.Client) Resource[*pkgclient.AcmeCertificateDomain] {
+	return &resource[*pkgclient.AcmeCertificateDomain]{
+		kind:   "AcmeCertificateDomain",
+		nested: true,
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesAcmeCertificateDomainEndpoint(ctx, &pkgclient.GetServicesAcmeCertificateDomainEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.AcmeCertificateDomain], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesAcmeCertificateDomainEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.AcmeCertificateDomain], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesAcmeCertificateDomainEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesAcmeCertificateDomainEndpoint(ctx, &pkgclient.DeleteServicesAcmeCertificateDomainEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// AcmeCertificates returns the Resource for AcmeCertificate objects, served by /api/v2/services/acme/certificate.
+func AcmeCertificates(c *client.Client) Resource[*pkgclient.AcmeCertificate] {
+	return &resource[*pkgclient.AcmeCertificate]{
+		kind: "AcmeCertificate",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesAcmeCertificates(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesAcmeCertificateEndpoint(ctx, &pkgclient.GetServicesAcmeCertificateEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.AcmeCertificate], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesAcmeCertificateEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.AcmeCertificate], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesAcmeCertificateEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesAcmeCertificateEndpoint(ctx, &pkgclient.DeleteServicesAcmeCertificateEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.AcmeCertificate, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesAcmeCertificatesEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesAcmeCertificatesEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// AuthServers returns the Resource for AuthServer objects, served by /api/v2/user/auth_server.
+func AuthServers(c *client.Client) Resource[*pkgclient.AuthServer] {
+	return &resource[*pkgclient.AuthServer]{
+		kind: "AuthServer",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.User.AllUserAuthServers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.User.GetUserAuthServerEndpoint(ctx, &pkgclient.GetUserAuthServerEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.AuthServer], opts []option.RequestOption) (any, error) {
+			response, err := c.User.PostUserAuthServerEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.AuthServer], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.User.PatchUserAuthServerEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.User.DeleteUserAuthServerEndpoint(ctx, &pkgclient.DeleteUserAuthServerEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.AuthServer, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutUserAuthServersEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.User.PutUserAuthServersEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// BindAccessListEntries returns the Resource for BindAccessListEntry objects, served by /api/v2/services/bind/access_list/entry.
+// BindAccessListEntry objects are nested, so their IDs have a Parent.
+func BindAccessListEntries(c *client.Client) Resource[*pkgclient.BindAccessListEntry] {
+	return &resource[*pkgclient.BindAccessListEntry]{
+		kind:   "BindAccessListEntry",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesBindAccessListEntries(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesBindAccessListEntryEndpoint(ctx, &pkgclient.GetServicesBindAccessListEntryEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.BindAccessListEntry], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesBindAccessListEntryEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.BindAccessListEntry], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesBindAccessListEntryEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesBindAccessListEntryEndpoint(ctx, &pkgclient.DeleteServicesBindAccessListEntryEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// BindAccessLists returns the Resource for BindAccessList objects, served by /api/v2/services/bind/access_list.
+func BindAccessLists(c *client.Client) Resource[*pkgclient.BindAccessList] {
+	return &resource[*pkgclient.BindAccessList]{
+		kind: "BindAccessList",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesBindAccessLists(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesBindAccessListEndpoint(ctx, &pkgclient.GetServicesBindAccessListEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.BindAccessList], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesBindAccessListEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.BindAccessList], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesBindAccessListEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesBindAccessListEndpoint(ctx, &pkgclient.DeleteServicesBindAccessListEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.BindAccessList, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesBindAccessListsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesBindAccessListsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// BindSyncRemoteHosts returns the Resource for BindSyncRemoteHost objects, served by /api/v2/services/bind/sync/remote_host.
+func BindSyncRemoteHosts(c *client.Client) Resource[*pkgclient.BindSyncRemoteHost] {
+	return &resource[*pkgclient.BindSyncRemoteHost]{
+		kind: "BindSyncRemoteHost",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesBindSyncRemoteHosts(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesBindSyncRemoteHostEndpoint(ctx, &pkgclient.GetServicesBindSyncRemoteHostEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.BindSyncRemoteHost], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesBindSyncRemoteHostEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.BindSyncRemoteHost], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesBindSyncRemoteHostEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesBindSyncRemoteHostEndpoint(ctx, &pkgclient.DeleteServicesBindSyncRemoteHostEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.BindSyncRemoteHost, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesBindSyncRemoteHostsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesBindSyncRemoteHostsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// BindViews returns the Resource for BindView objects, served by /api/v2/services/bind/view.
+func BindViews(c *client.Client) Resource[*pkgclient.BindView] {
+	return &resource[*pkgclient.BindView]{
+		kind: "BindView",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesBindViews(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesBindViewEndpoint(ctx, &pkgclient.GetServicesBindViewEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.BindView], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesBindViewEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.BindView], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesBindViewEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesBindViewEndpoint(ctx, &pkgclient.DeleteServicesBindViewEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.BindView, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesBindViewsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesBindViewsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// BindZoneRecords returns the Resource for BindZoneRecord objects, served by /api/v2/services/bind/zone/record.
+// BindZoneRecord objects are nested, so their IDs have a Parent.
+func BindZoneRecords(c *client.Client) Resource[*pkgclient.BindZoneRecord] {
+	return &resource[*pkgclient.BindZoneRecord]{
+		kind:   "BindZoneRecord",
+		nested: true,
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesBindZoneRecordEndpoint(ctx, &pkgclient.GetServicesBindZoneRecordEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.BindZoneRecord], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesBindZoneRecordEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.BindZoneRecord], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesBindZoneRecordEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesBindZoneRecordEndpoint(ctx, &pkgclient.DeleteServicesBindZoneRecordEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// BindZones returns the Resource for BindZone objects, served by /api/v2/services/bind/zone.
+func BindZones(c *client.Client) Resource[*pkgclient.BindZone] {
+	return &resource[*pkgclient.BindZone]{
+		kind: "BindZone",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesBindZones(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesBindZoneEndpoint(ctx, &pkgclient.GetServicesBindZoneEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.BindZone], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesBindZoneEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.BindZone], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesBindZoneEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesBindZoneEndpoint(ctx, &pkgclient.DeleteServicesBindZoneEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.BindZone, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesBindZonesEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesBindZonesEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// CertificateAuthorities returns the Resource for CertificateAuthority objects, served by /api/v2/system/certificate_authority.
+func CertificateAuthorities(c *client.Client) Resource[*pkgclient.CertificateAuthority] {
+	return &resource[*pkgclient.CertificateAuthority]{
+		kind: "CertificateAuthority",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.System.AllSystemCertificateAuthorities(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.System.GetSystemCertificateAuthorityEndpoint(ctx, &pkgclient.GetSystemCertificateAuthorityEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.CertificateAuthority], opts []option.RequestOption) (any, error) {
+			response, err := c.System.PostSystemCertificateAuthorityEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.CertificateAuthority], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.System.PatchSystemCertificateAuthorityEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.System.DeleteSystemCertificateAuthorityEndpoint(ctx, &pkgclient.DeleteSystemCertificateAuthorityEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// CertificateRevocationListRevokedCertificates returns the Resource for CertificateRevocationListRevokedCertificate objects, served by /api/v2/system/crl/revoked_certificate.
+// CertificateRevocationListRevokedCertificate objects are nested, so their IDs have a Parent.
+func CertificateRevocationListRevokedCertificates(c *client.Client) Resource[*pkgclient.CertificateRevocationListRevokedCertificate] {
+	return &resource[*pkgclient.CertificateRevocationListRevokedCertificate]{
+		kind:   "CertificateRevocationListRevokedCertificate",
+		nested: true,
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.System.GetSystemCrlRevokedCertificateEndpoint(ctx, &pkgclient.GetSystemCrlRevokedCertificateEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.CertificateRevocationListRevokedCertificate], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.System.PostSystemCrlRevokedCertificateEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.CertificateRevocationListRevokedCertificate], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.System.PatchSystemCrlRevokedCertificateEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.System.DeleteSystemCrlRevokedCertificateEndpoint(ctx, &pkgclient.DeleteSystemCrlRevokedCertificateEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// CertificateRevocationLists returns the Resource for CertificateRevocationList objects, served by /api/v2/system/crl.
+func CertificateRevocationLists(c *client.Client) Resource[*pkgclient.CertificateRevocationList] {
+	return &resource[*pkgclient.CertificateRevocationList]{
+		kind: "CertificateRevocationList",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.System.AllSystemCrLs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.System.GetSystemCrlEndpoint(ctx, &pkgclient.GetSystemCrlEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.CertificateRevocationList], opts []option.RequestOption) (any, error) {
+			response, err := c.System.PostSystemCrlEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.CertificateRevocationList], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.System.PatchSystemCrlEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.System.DeleteSystemCrlEndpoint(ctx, &pkgclient.DeleteSystemCrlEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// Certificates returns the Resource for Certificate objects, served by /api/v2/system/certificate.
+func Certificates(c *client.Client) Resource[*pkgclient.Certificate] {
+	return &resource[*pkgclient.Certificate]{
+		kind: "Certificate",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.System.AllSystemCertificates(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.System.GetSystemCertificateEndpoint(ctx, &pkgclient.GetSystemCertificateEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.Certificate], opts []option.RequestOption) (any, error) {
+			response, err := c.System.PostSystemCertificateEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.Certificate], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.System.PatchSystemCertificateEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.System.DeleteSystemCertificateEndpoint(ctx, &pkgclient.DeleteSystemCertificateEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// CronJobs returns the Resource for CronJob objects, served by /api/v2/services/cron/job.
+func CronJobs(c *client.Client) Resource[*pkgclient.CronJob] {
+	return &resource[*pkgclient.CronJob]{
+		kind: "CronJob",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesCronJobs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesCronJobEndpoint(ctx, &pkgclient.GetServicesCronJobEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.CronJob], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesCronJobEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.CronJob], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesCronJobEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesCronJobEndpoint(ctx, &pkgclient.DeleteServicesCronJobEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.CronJob, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesCronJobsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesCronJobsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// DNSForwarderHostOverrideAliases returns the Resource for DNSForwarderHostOverrideAlias objects, served by /api/v2/services/dns_forwarder/host_override/alias.
+// DNSForwarderHostOverrideAlias objects are nested, so their IDs have a Parent.
+func DNSForwarderHostOverrideAliases(c *client.Client) Resource[*pkgclient.DNSForwarderHostOverrideAlias] {
+	return &resource[*pkgclient.DNSForwarderHostOverrideAlias]{
+		kind:   "DNSForwarderHostOverrideAlias",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDNSForwarderHostOverrideAliases(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDNSForwarderHostOverrideAliasEndpoint(ctx, &pkgclient.GetServicesDNSForwarderHostOverrideAliasEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DNSForwarderHostOverrideAlias], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesDNSForwarderHostOverrideAliasEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DNSForwarderHostOverrideAlias], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDNSForwarderHostOverrideAliasEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDNSForwarderHostOverrideAliasEndpoint(ctx, &pkgclient.DeleteServicesDNSForwarderHostOverrideAliasEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// DNSForwarderHostOverrides returns the Resource for DNSForwarderHostOverride objects, served by /api/v2/services/dns_forwarder/host_override.
+func DNSForwarderHostOverrides(c *client.Client) Resource[*pkgclient.DNSForwarderHostOverride] {
+	return &resource[*pkgclient.DNSForwarderHostOverride]{
+		kind: "DNSForwarderHostOverride",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDNSForwarderHostOverrides(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDNSForwarderHostOverrideEndpoint(ctx, &pkgclient.GetServicesDNSForwarderHostOverrideEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DNSForwarderHostOverride], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesDNSForwarderHostOverrideEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DNSForwarderHostOverride], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDNSForwarderHostOverrideEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDNSForwarderHostOverrideEndpoint(ctx, &pkgclient.DeleteServicesDNSForwarderHostOverrideEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.DNSForwarderHostOverride, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesDNSForwarderHostOverridesEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesDNSForwarderHostOverridesEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// DNSResolverAccessListNetworks returns the Resource for DNSResolverAccessListNetwork objects, served by /api/v2/services/dns_resolver/access_list/network.
+// DNSResolverAccessListNetwork objects are nested, so their IDs have a Parent.
+func DNSResolverAccessListNetworks(c *client.Client) Resource[*pkgclient.DNSResolverAccessListNetwork] {
+	return &resource[*pkgclient.DNSResolverAccessListNetwork]{
+		kind:   "DNSResolverAccessListNetwork",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDNSResolverAccessListNetworks(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDNSResolverAccessListNetworkEndpoint(ctx, &pkgclient.GetServicesDNSResolverAccessListNetworkEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DNSResolverAccessListNetwork], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesDNSResolverAccessListNetworkEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DNSResolverAccessListNetwork], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDNSResolverAccessListNetworkEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDNSResolverAccessListNetworkEndpoint(ctx, &pkgclient.DeleteServicesDNSResolverAccessListNetworkEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// DNSResolverAccessLists returns the Resource for DNSResolverAccessList objects, served by /api/v2/services/dns_resolver/access_list.
+func DNSResolverAccessLists(c *client.Client) Resource[*pkgclient.DNSResolverAccessList] {
+	return &resource[*pkgclient.DNSResolverAccessList]{
+		kind: "DNSResolverAccessList",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDNSResolverAccessLists(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDNSResolverAccessListEndpoint(ctx, &pkgclient.GetServicesDNSResolverAccessListEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DNSResolverAccessList], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesDNSResolverAccessListEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DNSResolverAccessList], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDNSResolverAccessListEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDNSResolverAccessListEndpoint(ctx, &pkgclient.DeleteServicesDNSResolverAccessListEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.DNSResolverAccessList, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesDNSResolverAccessListsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesDNSResolverAccessListsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// DNSResolverDomainOverrides returns the Resource for DNSResolverDomainOverride objects, served by /api/v2/services/dns_resolver/domain_override.
+func DNSResolverDomainOverrides(c *client.Client) Resource[*pkgclient.DNSResolverDomainOverride] {
+	return &resource[*pkgclient.DNSResolverDomainOverride]{
+		kind: "DNSResolverDomainOverride",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDNSResolverDomainOverrides(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDNSResolverDomainOverrideEndpoint(ctx, &pkgclient.GetServicesDNSResolverDomainOverrideEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DNSResolverDomainOverride], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesDNSResolverDomainOverrideEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DNSResolverDomainOverride], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDNSResolverDomainOverrideEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDNSResolverDomainOverrideEndpoint(ctx, &pkgclient.DeleteServicesDNSResolverDomainOverrideEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.DNSResolverDomainOverride, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesDNSResolverDomainOverridesEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesDNSResolverDomainOverridesEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// DNSResolverHostOverrideAliases returns the Resource for DNSResolverHostOverrideAlias objects, served by /api/v2/services/dns_resolver/host_override/alias.
+// DNSResolverHostOverrideAlias objects are nested, so their IDs have a Parent.
+func DNSResolverHostOverrideAliases(c *client.Client) Resource[*pkgclient.DNSResolverHostOverrideAlias] {
+	return &resource[*pkgclient.DNSResolverHostOverrideAlias]{
+		kind:   "DNSResolverHostOverrideAlias",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDNSResolverHostOverrideAliases(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDNSResolverHostOverrideAliasEndpoint(ctx, &pkgclient.GetServicesDNSResolverHostOverrideAliasEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DNSResolverHostOverrideAlias], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesDNSResolverHostOverrideAliasEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DNSResolverHostOverrideAlias], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDNSResolverHostOverrideAliasEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDNSResolverHostOverrideAliasEndpoint(ctx, &pkgclient.DeleteServicesDNSResolverHostOverrideAliasEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// DNSResolverHostOverrides returns the Resource for DNSResolverHostOverride objects, served by /api/v2/services/dns_resolver/host_override.
+func DNSResolverHostOverrides(c *client.Client) Resource[*pkgclient.DNSResolverHostOverride] {
+	return &resource[*pkgclient.DNSResolverHostOverride]{
+		kind: "DNSResolverHostOverride",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDNSResolverHostOverrides(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDNSResolverHostOverrideEndpoint(ctx, &pkgclient.GetServicesDNSResolverHostOverrideEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DNSResolverHostOverride], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesDNSResolverHostOverrideEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DNSResolverHostOverride], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDNSResolverHostOverrideEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDNSResolverHostOverrideEndpoint(ctx, &pkgclient.DeleteServicesDNSResolverHostOverrideEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.DNSResolverHostOverride, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesDNSResolverHostOverridesEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesDNSResolverHostOverridesEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// DhcpServerAddressPools returns the Resource for DhcpServerAddressPool objects, served by /api/v2/services/dhcp_server/address_pool.
+// DhcpServerAddressPool objects are nested, so their IDs have a Parent.
+func DhcpServerAddressPools(c *client.Client) Resource[*pkgclient.DhcpServerAddressPool] {
+	return &resource[*pkgclient.DhcpServerAddressPool]{
+		kind:   "DhcpServerAddressPool",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDhcpServerAddressPools(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDhcpServerAddressPoolEndpoint(ctx, &pkgclient.GetServicesDhcpServerAddressPoolEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DhcpServerAddressPool], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesDhcpServerAddressPoolEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DhcpServerAddressPool], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDhcpServerAddressPoolEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDhcpServerAddressPoolEndpoint(ctx, &pkgclient.DeleteServicesDhcpServerAddressPoolEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// DhcpServerCustomOptions returns the Resource for DhcpServerCustomOption objects, served by /api/v2/services/dhcp_server/custom_option.
+// DhcpServerCustomOption objects are nested, so their IDs have a Parent.
+func DhcpServerCustomOptions(c *client.Client) Resource[*pkgclient.DhcpServerCustomOption] {
+	return &resource[*pkgclient.DhcpServerCustomOption]{
+		kind:   "DhcpServerCustomOption",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDhcpServerCustomOptions(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDhcpServerCustomOptionEndpoint(ctx, &pkgclient.GetServicesDhcpServerCustomOptionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DhcpServerCustomOption], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesDhcpServerCustomOptionEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DhcpServerCustomOption], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDhcpServerCustomOptionEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDhcpServerCustomOptionEndpoint(ctx, &pkgclient.DeleteServicesDhcpServerCustomOptionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// DhcpServerStaticMappings returns the Resource for DhcpServerStaticMapping objects, served by /api/v2/services/dhcp_server/static_mapping.
+// DhcpServerStaticMapping objects are nested, so their IDs have a Parent.
+func DhcpServerStaticMappings(c *client.Client) Resource[*pkgclient.DhcpServerStaticMapping] {
+	return &resource[*pkgclient.DhcpServerStaticMapping]{
+		kind:   "DhcpServerStaticMapping",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDhcpServerStaticMappings(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDhcpServerStaticMappingEndpoint(ctx, &pkgclient.GetServicesDhcpServerStaticMappingEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DhcpServerStaticMapping], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesDhcpServerStaticMappingEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DhcpServerStaticMapping], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDhcpServerStaticMappingEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDhcpServerStaticMappingEndpoint(ctx, &pkgclient.DeleteServicesDhcpServerStaticMappingEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// DhcpServers returns the Resource for DhcpServer objects, served by /api/v2/services/dhcp_server.
+func DhcpServers(c *client.Client) Resource[*pkgclient.DhcpServer] {
+	return &resource[*pkgclient.DhcpServer]{
+		kind: "DhcpServer",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesDhcpServers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesDhcpServerEndpoint(ctx, &pkgclient.GetServicesDhcpServerEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.DhcpServer], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesDhcpServerEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.DhcpServer], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesDhcpServerEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesDhcpServerEndpoint(ctx, &pkgclient.DeleteServicesDhcpServerEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.DhcpServer, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesDhcpServersEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesDhcpServersEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// FirewallAliases returns the Resource for FirewallAlias objects, served by /api/v2/firewall/alias.
+func FirewallAliases(c *client.Client) Resource[*pkgclient.FirewallAlias] {
+	return &resource[*pkgclient.FirewallAlias]{
+		kind: "FirewallAlias",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallAliases(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallAliasEndpoint(ctx, &pkgclient.GetFirewallAliasEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.FirewallAlias], opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.PostFirewallAliasEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.FirewallAlias], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallAliasEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallAliasEndpoint(ctx, &pkgclient.DeleteFirewallAliasEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.FirewallAlias, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutFirewallAliasesEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Firewall.PutFirewallAliasesEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// FirewallRules returns the Resource for FirewallRule objects, served by /api/v2/firewall/rule.
+func FirewallRules(c *client.Client) Resource[*pkgclient.FirewallRule] {
+	return &resource[*pkgclient.FirewallRule]{
+		kind: "FirewallRule",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallRules(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallRuleEndpoint(ctx, &pkgclient.GetFirewallRuleEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.FirewallRule], opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.PostFirewallRuleEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.FirewallRule], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallRuleEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallRuleEndpoint(ctx, &pkgclient.DeleteFirewallRuleEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.FirewallRule, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutFirewallRulesEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Firewall.PutFirewallRulesEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// FirewallScheduleTimeRanges returns the Resource for FirewallScheduleTimeRange objects, served by /api/v2/firewall/schedule/time_range.
+// FirewallScheduleTimeRange objects are nested, so their IDs have a Parent.
+func FirewallScheduleTimeRanges(c *client.Client) Resource[*pkgclient.FirewallScheduleTimeRange] {
+	return &resource[*pkgclient.FirewallScheduleTimeRange]{
+		kind:   "FirewallScheduleTimeRange",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallScheduleTimeRanges(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallScheduleTimeRangeEndpoint(ctx, &pkgclient.GetFirewallScheduleTimeRangeEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.FirewallScheduleTimeRange], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Firewall.PostFirewallScheduleTimeRangeEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.FirewallScheduleTimeRange], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallScheduleTimeRangeEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallScheduleTimeRangeEndpoint(ctx, &pkgclient.DeleteFirewallScheduleTimeRangeEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// FirewallSchedules returns the Resource for FirewallSchedule objects, served by /api/v2/firewall/schedule.
+func FirewallSchedules(c *client.Client) Resource[*pkgclient.FirewallSchedule] {
+	return &resource[*pkgclient.FirewallSchedule]{
+		kind: "FirewallSchedule",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallSchedules(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallScheduleEndpoint(ctx, &pkgclient.GetFirewallScheduleEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.FirewallSchedule], opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.PostFirewallScheduleEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.FirewallSchedule], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallScheduleEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallScheduleEndpoint(ctx, &pkgclient.DeleteFirewallScheduleEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.FirewallSchedule, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutFirewallSchedulesEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Firewall.PutFirewallSchedulesEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// FreeRadiusClients returns the Resource for FreeRadiusClient objects, served by /api/v2/services/freeradius/client.
+func FreeRadiusClients(c *client.Client) Resource[*pkgclient.FreeRadiusClient] {
+	return &resource[*pkgclient.FreeRadiusClient]{
+		kind: "FreeRadiusClient",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesFreeRadiusClients(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesFreeRadiusClientEndpoint(ctx, &pkgclient.GetServicesFreeRadiusClientEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.FreeRadiusClient], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesFreeRadiusClientEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.FreeRadiusClient], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesFreeRadiusClientEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesFreeRadiusClientEndpoint(ctx, &pkgclient.DeleteServicesFreeRadiusClientEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.FreeRadiusClient, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesFreeRadiusClientsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesFreeRadiusClientsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// FreeRadiusInterfaces returns the Resource for FreeRadiusInterface objects, served by /api/v2/services/freeradius/interface.
+func FreeRadiusInterfaces(c *client.Client) Resource[*pkgclient.FreeRadiusInterface] {
+	return &resource[*pkgclient.FreeRadiusInterface]{
+		kind: "FreeRadiusInterface",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesFreeRadiusInterfaces(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesFreeRadiusInterfaceEndpoint(ctx, &pkgclient.GetServicesFreeRadiusInterfaceEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.FreeRadiusInterface], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesFreeRadiusInterfaceEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.FreeRadiusInterface], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesFreeRadiusInterfaceEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesFreeRadiusInterfaceEndpoint(ctx, &pkgclient.DeleteServicesFreeRadiusInterfaceEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.FreeRadiusInterface, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesFreeRadiusInterfacesEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesFreeRadiusInterfacesEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// FreeRadiusUsers returns the Resource for FreeRadiusUser objects, served by /api/v2/services/freeradius/user.
+func FreeRadiusUsers(c *client.Client) Resource[*pkgclient.FreeRadiusUser] {
+	return &resource[*pkgclient.FreeRadiusUser]{
+		kind: "FreeRadiusUser",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesFreeRadiusUsers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesFreeRadiusUserEndpoint(ctx, &pkgclient.GetServicesFreeRadiusUserEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.FreeRadiusUser], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesFreeRadiusUserEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.FreeRadiusUser], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesFreeRadiusUserEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesFreeRadiusUserEndpoint(ctx, &pkgclient.DeleteServicesFreeRadiusUserEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.FreeRadiusUser, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesFreeRadiusUsersEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesFreeRadiusUsersEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyBackendACLs returns the Resource for HaProxyBackendACL objects, served by /api/v2/services/haproxy/backend/acl.
+// HaProxyBackendACL objects are nested, so their IDs have a Parent.
+func HaProxyBackendACLs(c *client.Client) Resource[*pkgclient.HaProxyBackendACL] {
+	return &resource[*pkgclient.HaProxyBackendACL]{
+		kind:   "HaProxyBackendACL",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyBackendAcLs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyBackendACLEndpoint(ctx, &pkgclient.GetServicesHaProxyBackendACLEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyBackendACL], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesHaProxyBackendACLEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyBackendACL], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyBackendACLEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyBackendACLEndpoint(ctx, &pkgclient.DeleteServicesHaProxyBackendACLEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyBackendActions returns the Resource for HaProxyBackendAction objects, served by /api/v2/services/haproxy/backend/action.
+// HaProxyBackendAction objects are nested, so their IDs have a Parent.
+func HaProxyBackendActions(c *client.Client) Resource[*pkgclient.HaProxyBackendAction] {
+	return &resource[*pkgclient.HaProxyBackendAction]{
+		kind:   "HaProxyBackendAction",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyBackendActions(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyBackendActionEndpoint(ctx, &pkgclient.GetServicesHaProxyBackendActionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyBackendAction], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesHaProxyBackendActionEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyBackendAction], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyBackendActionEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyBackendActionEndpoint(ctx, &pkgclient.DeleteServicesHaProxyBackendActionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyBackendErrorFiles returns the Resource for HaProxyBackendErrorFile objects, served by /api/v2/services/haproxy/backend/error_file.
+// HaProxyBackendErrorFile objects are nested, so their IDs have a Parent.
+func HaProxyBackendErrorFiles(c *client.Client) Resource[*pkgclient.HaProxyBackendErrorFile] {
+	return &resource[*pkgclient.HaProxyBackendErrorFile]{
+		kind:   "HaProxyBackendErrorFile",
+		nested: true,
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyBackendErrorFileEndpoint(ctx, &pkgclient.GetServicesHaProxyBackendErrorFileEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyBackendErrorFile], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesHaProxyBackendErrorFileEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyBackendErrorFile], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyBackendErrorFileEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyBackendErrorFileEndpoint(ctx, &pkgclient.DeleteServicesHaProxyBackendErrorFileEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyBackendServers returns the Resource for HaProxyBackendServer objects, served by /api/v2/services/haproxy/backend/server.
+// HaProxyBackendServer objects are nested, so their IDs have a Parent.
+func HaProxyBackendServers(c *client.Client) Resource[*pkgclient.HaProxyBackendServer] {
+	return &resource[*pkgclient.HaProxyBackendServer]{
+		kind:   "HaProxyBackendServer",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyBackendServers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyBackendServerEndpoint(ctx, &pkgclient.GetServicesHaProxyBackendServerEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyBackendServer], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesHaProxyBackendServerEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyBackendServer], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyBackendServerEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyBackendServerEndpoint(ctx, &pkgclient.DeleteServicesHaProxyBackendServerEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyBackends returns the Resource for HaProxyBackend objects, served by /api/v2/services/haproxy/backend.
+func HaProxyBackends(c *client.Client) Resource[*pkgclient.HaProxyBackend] {
+	return &resource[*pkgclient.HaProxyBackend]{
+		kind: "HaProxyBackend",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyBackends(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyBackendEndpoint(ctx, &pkgclient.GetServicesHaProxyBackendEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyBackend], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesHaProxyBackendEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyBackend], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyBackendEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyBackendEndpoint(ctx, &pkgclient.DeleteServicesHaProxyBackendEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.HaProxyBackend, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesHaProxyBackendsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesHaProxyBackendsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyDNSResolvers returns the Resource for HaProxyDNSResolver objects, served by /api/v2/services/haproxy/settings/dns_resolver.
+func HaProxyDNSResolvers(c *client.Client) Resource[*pkgclient.HaProxyDNSResolver] {
+	return &resource[*pkgclient.HaProxyDNSResolver]{
+		kind: "HaProxyDNSResolver",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxySettingsDNSResolvers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxySettingsDNSResolverEndpoint(ctx, &pkgclient.GetServicesHaProxySettingsDNSResolverEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyDNSResolver], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesHaProxySettingsDNSResolverEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyDNSResolver], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxySettingsDNSResolverEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxySettingsDNSResolverEndpoint(ctx, &pkgclient.DeleteServicesHaProxySettingsDNSResolverEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyEmailMailers returns the Resource for HaProxyEmailMailer objects, served by /api/v2/services/haproxy/settings/email_mailer.
+func HaProxyEmailMailers(c *client.Client) Resource[*pkgclient.HaProxyEmailMailer] {
+	return &resource[*pkgclient.HaProxyEmailMailer]{
+		kind: "HaProxyEmailMailer",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxySettingsEmailMailers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxySettingsEmailMailerEndpoint(ctx, &pkgclient.GetServicesHaProxySettingsEmailMailerEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyEmailMailer], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesHaProxySettingsEmailMailerEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyEmailMailer], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxySettingsEmailMailerEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxySettingsEmailMailerEndpoint(ctx, &pkgclient.DeleteServicesHaProxySettingsEmailMailerEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyFiles returns the Resource for HaProxyFile objects, served by /api/v2/services/haproxy/file.
+func HaProxyFiles(c *client.Client) Resource[*pkgclient.HaProxyFile] {
+	return &resource[*pkgclient.HaProxyFile]{
+		kind: "HaProxyFile",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyFiles(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyFileEndpoint(ctx, &pkgclient.GetServicesHaProxyFileEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyFile], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesHaProxyFileEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyFile], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyFileEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyFileEndpoint(ctx, &pkgclient.DeleteServicesHaProxyFileEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.HaProxyFile, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesHaProxyFilesRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesHaProxyFiles(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyFrontendACLs returns the Resource for HaProxyFrontendACL objects, served by /api/v2/services/haproxy/frontend/acl.
+// HaProxyFrontendACL objects are nested, so their IDs have a Parent.
+func HaProxyFrontendACLs(c *client.Client) Resource[*pkgclient.HaProxyFrontendACL] {
+	return &resource[*pkgclient.HaProxyFrontendACL]{
+		kind:   "HaProxyFrontendACL",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyFrontendAcLs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyFrontendACLEndpoint(ctx, &pkgclient.GetServicesHaProxyFrontendACLEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontendACL], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesHaProxyFrontendACLEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontendACL], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyFrontendACLEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyFrontendACLEndpoint(ctx, &pkgclient.DeleteServicesHaProxyFrontendACLEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyFrontendActions returns the Resource for HaProxyFrontendAction objects, served by /api/v2/services/haproxy/frontend/action.
+// HaProxyFrontendAction objects are nested, so their IDs have a Parent.
+func HaProxyFrontendActions(c *client.Client) Resource[*pkgclient.HaProxyFrontendAction] {
+	return &resource[*pkgclient.HaProxyFrontendAction]{
+		kind:   "HaProxyFrontendAction",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyFrontendActions(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyFrontendActionEndpoint(ctx, &pkgclient.GetServicesHaProxyFrontendActionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontendAction], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesHaProxyFrontendActionEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontendAction], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyFrontendActionEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyFrontendActionEndpoint(ctx, &pkgclient.DeleteServicesHaProxyFrontendActionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyFrontendAddresses returns the Resource for HaProxyFrontendAddress objects, served by /api/v2/services/haproxy/frontend/address.
+// HaProxyFrontendAddress objects are nested, so their IDs have a Parent.
+func HaProxyFrontendAddresses(c *client.Client) Resource[*pkgclient.HaProxyFrontendAddress] {
+	return &resource[*pkgclient.HaProxyFrontendAddress]{
+		kind:   "HaProxyFrontendAddress",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyFrontendAddresses(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyFrontendAddressEndpoint(ctx, &pkgclient.GetServicesHaProxyFrontendAddressEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontendAddress], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesHaProxyFrontendAddressEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontendAddress], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyFrontendAddressEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyFrontendAddressEndpoint(ctx, &pkgclient.DeleteServicesHaProxyFrontendAddressEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyFrontendCertificates returns the Resource for HaProxyFrontendCertificate objects, served by /api/v2/services/haproxy/frontend/certificate.
+// HaProxyFrontendCertificate objects are nested, so their IDs have a Parent.
+func HaProxyFrontendCertificates(c *client.Client) Resource[*pkgclient.HaProxyFrontendCertificate] {
+	return &resource[*pkgclient.HaProxyFrontendCertificate]{
+		kind:   "HaProxyFrontendCertificate",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyFrontendCertificates(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyFrontendCertificateEndpoint(ctx, &pkgclient.GetServicesHaProxyFrontendCertificateEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontendCertificate], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesHaProxyFrontendCertificateEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontendCertificate], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyFrontendCertificateEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyFrontendCertificateEndpoint(ctx, &pkgclient.DeleteServicesHaProxyFrontendCertificateEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyFrontendErrorFiles returns the Resource for HaProxyFrontendErrorFile objects, served by /api/v2/services/haproxy/frontend/error_file.
+// HaProxyFrontendErrorFile objects are nested, so their IDs have a Parent.
+func HaProxyFrontendErrorFiles(c *client.Client) Resource[*pkgclient.HaProxyFrontendErrorFile] {
+	return &resource[*pkgclient.HaProxyFrontendErrorFile]{
+		kind:   "HaProxyFrontendErrorFile",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyFrontendErrorFiles(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyFrontendErrorFileEndpoint(ctx, &pkgclient.GetServicesHaProxyFrontendErrorFileEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontendErrorFile], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Services.PostServicesHaProxyFrontendErrorFileEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontendErrorFile], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyFrontendErrorFileEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyFrontendErrorFileEndpoint(ctx, &pkgclient.DeleteServicesHaProxyFrontendErrorFileEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// HaProxyFrontends returns the Resource for HaProxyFrontend objects, served by /api/v2/services/haproxy/frontend.
+func HaProxyFrontends(c *client.Client) Resource[*pkgclient.HaProxyFrontend] {
+	return &resource[*pkgclient.HaProxyFrontend]{
+		kind: "HaProxyFrontend",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesHaProxyFrontends(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesHaProxyFrontendEndpoint(ctx, &pkgclient.GetServicesHaProxyFrontendEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontend], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesHaProxyFrontendEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.HaProxyFrontend], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesHaProxyFrontendEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesHaProxyFrontendEndpoint(ctx, &pkgclient.DeleteServicesHaProxyFrontendEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.HaProxyFrontend, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesHaProxyFrontendsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesHaProxyFrontendsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// IPsecPhase1Encryptions returns the Resource for IPsecPhase1Encryption objects, served by /api/v2/vpn/ipsec/phase1/encryption.
+// IPsecPhase1Encryption objects are nested, so their IDs have a Parent.
+func IPsecPhase1Encryptions(c *client.Client) Resource[*pkgclient.IPsecPhase1Encryption] {
+	return &resource[*pkgclient.IPsecPhase1Encryption]{
+		kind:   "IPsecPhase1Encryption",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpniPsecPhase1Encryptions(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpniPsecPhase1EncryptionEndpoint(ctx, &pkgclient.GetVpniPsecPhase1EncryptionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.IPsecPhase1Encryption], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Vpn.PostVpniPsecPhase1EncryptionEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.IPsecPhase1Encryption], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpniPsecPhase1EncryptionEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpniPsecPhase1EncryptionEndpoint(ctx, &pkgclient.DeleteVpniPsecPhase1EncryptionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// IPsecPhase1s returns the Resource for IPsecPhase1 objects, served by /api/v2/vpn/ipsec/phase1.
+func IPsecPhase1s(c *client.Client) Resource[*pkgclient.IPsecPhase1] {
+	return &resource[*pkgclient.IPsecPhase1]{
+		kind: "IPsecPhase1",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpniPsecPhase1S(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpniPsecPhase1Endpoint(ctx, &pkgclient.GetVpniPsecPhase1EndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.IPsecPhase1], opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.PostVpniPsecPhase1Endpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.IPsecPhase1], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpniPsecPhase1Endpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpniPsecPhase1Endpoint(ctx, &pkgclient.DeleteVpniPsecPhase1EndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.IPsecPhase1, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutVpniPsecPhase1SEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Vpn.PutVpniPsecPhase1SEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// IPsecPhase2Encryptions returns the Resource for IPsecPhase2Encryption objects, served by /api/v2/vpn/ipsec/phase2/encryption.
+// IPsecPhase2Encryption objects are nested, so their IDs have a Parent.
+func IPsecPhase2Encryptions(c *client.Client) Resource[*pkgclient.IPsecPhase2Encryption] {
+	return &resource[*pkgclient.IPsecPhase2Encryption]{
+		kind:   "IPsecPhase2Encryption",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpniPsecPhase2Encryptions(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpniPsecPhase2EncryptionEndpoint(ctx, &pkgclient.GetVpniPsecPhase2EncryptionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.IPsecPhase2Encryption], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Vpn.PostVpniPsecPhase2EncryptionEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.IPsecPhase2Encryption], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpniPsecPhase2EncryptionEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpniPsecPhase2EncryptionEndpoint(ctx, &pkgclient.DeleteVpniPsecPhase2EncryptionEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// IPsecPhase2s returns the Resource for IPsecPhase2 objects, served by /api/v2/vpn/ipsec/phase2.
+func IPsecPhase2s(c *client.Client) Resource[*pkgclient.IPsecPhase2] {
+	return &resource[*pkgclient.IPsecPhase2]{
+		kind: "IPsecPhase2",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpniPsecPhase2S(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpniPsecPhase2Endpoint(ctx, &pkgclient.GetVpniPsecPhase2EndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.IPsecPhase2], opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.PostVpniPsecPhase2Endpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.IPsecPhase2], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpniPsecPhase2Endpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpniPsecPhase2Endpoint(ctx, &pkgclient.DeleteVpniPsecPhase2EndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.IPsecPhase2, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutVpniPsecPhase2SEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Vpn.PutVpniPsecPhase2SEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// InterfaceBridges returns the Resource for InterfaceBridge objects, served by /api/v2/interface/bridge.
+func InterfaceBridges(c *client.Client) Resource[*pkgclient.InterfaceBridge] {
+	return &resource[*pkgclient.InterfaceBridge]{
+		kind: "InterfaceBridge",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Interface.AllInterfaceBridges(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.GetInterfaceBridgeEndpoint(ctx, &pkgclient.GetInterfaceBridgeEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.InterfaceBridge], opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.PostInterfaceBridgeEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.InterfaceBridge], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Interface.PatchInterfaceBridgeEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Interface.DeleteInterfaceBridgeEndpoint(ctx, &pkgclient.DeleteInterfaceBridgeEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// InterfaceGres returns the Resource for InterfaceGre objects, served by /api/v2/interface/gre.
+func InterfaceGres(c *client.Client) Resource[*pkgclient.InterfaceGre] {
+	return &resource[*pkgclient.InterfaceGre]{
+		kind: "InterfaceGre",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Interface.AllInterfaceGrEs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.GetInterfaceGreEndpoint(ctx, &pkgclient.GetInterfaceGreEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.InterfaceGre], opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.PostInterfaceGreEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.InterfaceGre], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Interface.PatchInterfaceGreEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Interface.DeleteInterfaceGreEndpoint(ctx, &pkgclient.DeleteInterfaceGreEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// InterfaceGroups returns the Resource for InterfaceGroup objects, served by /api/v2/interface/group.
+func InterfaceGroups(c *client.Client) Resource[*pkgclient.InterfaceGroup] {
+	return &resource[*pkgclient.InterfaceGroup]{
+		kind: "InterfaceGroup",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Interface.AllInterfaceGroups(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.GetInterfaceGroupEndpoint(ctx, &pkgclient.GetInterfaceGroupEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.InterfaceGroup], opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.PostInterfaceGroupEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.InterfaceGroup], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Interface.PatchInterfaceGroupEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Interface.DeleteInterfaceGroupEndpoint(ctx, &pkgclient.DeleteInterfaceGroupEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.InterfaceGroup, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutInterfaceGroupsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Interface.PutInterfaceGroupsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// InterfaceLaggs returns the Resource for InterfaceLagg objects, served by /api/v2/interface/lagg.
+func InterfaceLaggs(c *client.Client) Resource[*pkgclient.InterfaceLagg] {
+	return &resource[*pkgclient.InterfaceLagg]{
+		kind: "InterfaceLagg",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Interface.AllInterfaceLagGs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.GetInterfaceLaggEndpoint(ctx, &pkgclient.GetInterfaceLaggEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.InterfaceLagg], opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.PostInterfaceLaggEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.InterfaceLagg], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Interface.PatchInterfaceLaggEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Interface.DeleteInterfaceLaggEndpoint(ctx, &pkgclient.DeleteInterfaceLaggEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// InterfaceVlans returns the Resource for InterfaceVlan objects, served by /api/v2/interface/vlan.
+func InterfaceVlans(c *client.Client) Resource[*pkgclient.InterfaceVlan] {
+	return &resource[*pkgclient.InterfaceVlan]{
+		kind: "InterfaceVlan",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Interface.AllInterfaceVlaNs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.GetInterfaceVlanEndpoint(ctx, &pkgclient.GetInterfaceVlanEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.InterfaceVlan], opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.PostInterfaceVlanEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.InterfaceVlan], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Interface.PatchInterfaceVlanEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Interface.DeleteInterfaceVlanEndpoint(ctx, &pkgclient.DeleteInterfaceVlanEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// NetworkInterfaces returns the Resource for NetworkInterface objects, served by /api/v2/interface.
+func NetworkInterfaces(c *client.Client) Resource[*pkgclient.NetworkInterface] {
+	return &resource[*pkgclient.NetworkInterface]{
+		kind: "NetworkInterface",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Interface.AllNetworkInterfaces(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.GetNetworkInterfaceEndpoint(ctx, &pkgclient.GetNetworkInterfaceEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.NetworkInterface], opts []option.RequestOption) (any, error) {
+			response, err := c.Interface.PostNetworkInterfaceEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.NetworkInterface], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Interface.PatchNetworkInterfaceEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Interface.DeleteNetworkInterfaceEndpoint(ctx, &pkgclient.DeleteNetworkInterfaceEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// NtpTimeServers returns the Resource for NtpTimeServer objects, served by /api/v2/services/ntp/time_server.
+func NtpTimeServers(c *client.Client) Resource[*pkgclient.NtpTimeServer] {
+	return &resource[*pkgclient.NtpTimeServer]{
+		kind: "NtpTimeServer",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesNtpTimeServers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesNtpTimeServerEndpoint(ctx, &pkgclient.GetServicesNtpTimeServerEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.NtpTimeServer], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesNtpTimeServerEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.NtpTimeServer], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesNtpTimeServerEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesNtpTimeServerEndpoint(ctx, &pkgclient.DeleteServicesNtpTimeServerEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.NtpTimeServer, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesNtpTimeServersEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesNtpTimeServersEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// OneToOneNatMappings returns the Resource for OneToOneNatMapping objects, served by /api/v2/firewall/nat/one_to_one/mapping.
+func OneToOneNatMappings(c *client.Client) Resource[*pkgclient.OneToOneNatMapping] {
+	return &resource[*pkgclient.OneToOneNatMapping]{
+		kind: "OneToOneNatMapping",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallNatOneToOneMappings(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallNatOneToOneMappingEndpoint(ctx, &pkgclient.GetFirewallNatOneToOneMappingEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.OneToOneNatMapping], opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.PostFirewallNatOneToOneMappingEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.OneToOneNatMapping], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallNatOneToOneMappingEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallNatOneToOneMappingEndpoint(ctx, &pkgclient.DeleteFirewallNatOneToOneMappingEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.OneToOneNatMapping, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutFirewallNatOneToOneMappingsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Firewall.PutFirewallNatOneToOneMappingsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// OpenVpnClientExportConfigs returns the Resource for OpenVpnClientExportConfig objects, served by /api/v2/vpn/openvpn/client_export/config.
+func OpenVpnClientExportConfigs(c *client.Client) Resource[*pkgclient.OpenVpnClientExportConfig] {
+	return &resource[*pkgclient.OpenVpnClientExportConfig]{
+		kind: "OpenVpnClientExportConfig",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpnOpenVpnClientExportConfigs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpnOpenVpnClientExportConfigEndpoint(ctx, &pkgclient.GetVpnOpenVpnClientExportConfigEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.OpenVpnClientExportConfig], opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.PostVpnOpenVpnClientExportConfigEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.OpenVpnClientExportConfig], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpnOpenVpnClientExportConfigEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpnOpenVpnClientExportConfigEndpoint(ctx, &pkgclient.DeleteVpnOpenVpnClientExportConfigEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.OpenVpnClientExportConfig, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutVpnOpenVpnClientExportConfigsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Vpn.PutVpnOpenVpnClientExportConfigsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// OpenVpnClientSpecificOverrides returns the Resource for OpenVpnClientSpecificOverride objects, served by /api/v2/vpn/openvpn/cso.
+func OpenVpnClientSpecificOverrides(c *client.Client) Resource[*pkgclient.OpenVpnClientSpecificOverride] {
+	return &resource[*pkgclient.OpenVpnClientSpecificOverride]{
+		kind: "OpenVpnClientSpecificOverride",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpnOpenVpncsOs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpnOpenVpncsoEndpoint(ctx, &pkgclient.GetVpnOpenVpncsoEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.OpenVpnClientSpecificOverride], opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.PostVpnOpenVpncsoEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.OpenVpnClientSpecificOverride], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpnOpenVpncsoEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpnOpenVpncsoEndpoint(ctx, &pkgclient.DeleteVpnOpenVpncsoEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// OpenVpnClients returns the Resource for OpenVpnClient objects, served by /api/v2/vpn/openvpn/client.
+func OpenVpnClients(c *client.Client) Resource[*pkgclient.OpenVpnClient] {
+	return &resource[*pkgclient.OpenVpnClient]{
+		kind: "OpenVpnClient",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpnOpenVpnClients(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpnOpenVpnClientEndpoint(ctx, &pkgclient.GetVpnOpenVpnClientEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.OpenVpnClient], opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.PostVpnOpenVpnClientEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.OpenVpnClient], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpnOpenVpnClientEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpnOpenVpnClientEndpoint(ctx, &pkgclient.DeleteVpnOpenVpnClientEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// OpenVpnServers returns the Resource for OpenVpnServer objects, served by /api/v2/vpn/openvpn/server.
+func OpenVpnServers(c *client.Client) Resource[*pkgclient.OpenVpnServer] {
+	return &resource[*pkgclient.OpenVpnServer]{
+		kind: "OpenVpnServer",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpnOpenVpnServers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpnOpenVpnServerEndpoint(ctx, &pkgclient.GetVpnOpenVpnServerEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.OpenVpnServer], opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.PostVpnOpenVpnServerEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.OpenVpnServer], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpnOpenVpnServerEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpnOpenVpnServerEndpoint(ctx, &pkgclient.DeleteVpnOpenVpnServerEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// OutboundNatMappings returns the Resource for OutboundNatMapping objects, served by /api/v2/firewall/nat/outbound/mapping.
+func OutboundNatMappings(c *client.Client) Resource[*pkgclient.OutboundNatMapping] {
+	return &resource[*pkgclient.OutboundNatMapping]{
+		kind: "OutboundNatMapping",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallNatOutboundMappings(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallNatOutboundMappingEndpoint(ctx, &pkgclient.GetFirewallNatOutboundMappingEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.OutboundNatMapping], opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.PostFirewallNatOutboundMappingEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.OutboundNatMapping], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallNatOutboundMappingEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallNatOutboundMappingEndpoint(ctx, &pkgclient.DeleteFirewallNatOutboundMappingEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.OutboundNatMapping, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutFirewallNatOutboundMappingsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Firewall.PutFirewallNatOutboundMappingsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// PortForwards returns the Resource for PortForward objects, served by /api/v2/firewall/nat/port_forward.
+func PortForwards(c *client.Client) Resource[*pkgclient.PortForward] {
+	return &resource[*pkgclient.PortForward]{
+		kind: "PortForward",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallNatPortForwards(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallNatPortForwardEndpoint(ctx, &pkgclient.GetFirewallNatPortForwardEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.PortForward], opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.PostFirewallNatPortForwardEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.PortForward], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallNatPortForwardEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallNatPortForwardEndpoint(ctx, &pkgclient.DeleteFirewallNatPortForwardEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.PortForward, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutFirewallNatPortForwardsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Firewall.PutFirewallNatPortForwardsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// RestapiAccessListEntries returns the Resource for RestapiAccessListEntry objects, served by /api/v2/system/restapi/access_list/entry.
+func RestapiAccessListEntries(c *client.Client) Resource[*pkgclient.RestapiAccessListEntry] {
+	return &resource[*pkgclient.RestapiAccessListEntry]{
+		kind: "RestapiAccessListEntry",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.System.AllSystemRestapiAccessList(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.System.GetSystemRestapiAccessListEntryEndpoint(ctx, &pkgclient.GetSystemRestapiAccessListEntryEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.RestapiAccessListEntry], opts []option.RequestOption) (any, error) {
+			response, err := c.System.PostSystemRestapiAccessListEntryEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.RestapiAccessListEntry], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.System.PatchSystemRestapiAccessListEntryEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.System.DeleteSystemRestapiAccessListEntryEndpoint(ctx, &pkgclient.DeleteSystemRestapiAccessListEntryEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.RestapiAccessListEntry, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutSystemRestapiAccessListEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.System.PutSystemRestapiAccessListEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// RoutingGatewayGroupPriorities returns the Resource for RoutingGatewayGroupPriority objects, served by /api/v2/routing/gateway/group/priority.
+// RoutingGatewayGroupPriority objects are nested, so their IDs have a Parent.
+func RoutingGatewayGroupPriorities(c *client.Client) Resource[*pkgclient.RoutingGatewayGroupPriority] {
+	return &resource[*pkgclient.RoutingGatewayGroupPriority]{
+		kind:   "RoutingGatewayGroupPriority",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Routing.AllRoutingGatewayGroupPriorities(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Routing.GetRoutingGatewayGroupPriorityEndpoint(ctx, &pkgclient.GetRoutingGatewayGroupPriorityEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.RoutingGatewayGroupPriority], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Routing.PostRoutingGatewayGroupPriorityEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.RoutingGatewayGroupPriority], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Routing.PatchRoutingGatewayGroupPriorityEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Routing.DeleteRoutingGatewayGroupPriorityEndpoint(ctx, &pkgclient.DeleteRoutingGatewayGroupPriorityEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// RoutingGatewayGroups returns the Resource for RoutingGatewayGroup objects, served by /api/v2/routing/gateway/group.
+func RoutingGatewayGroups(c *client.Client) Resource[*pkgclient.RoutingGatewayGroup] {
+	return &resource[*pkgclient.RoutingGatewayGroup]{
+		kind: "RoutingGatewayGroup",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Routing.AllRoutingGatewayGroups(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Routing.GetRoutingGatewayGroupEndpoint(ctx, &pkgclient.GetRoutingGatewayGroupEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.RoutingGatewayGroup], opts []option.RequestOption) (any, error) {
+			response, err := c.Routing.PostRoutingGatewayGroupEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.RoutingGatewayGroup], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Routing.PatchRoutingGatewayGroupEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Routing.DeleteRoutingGatewayGroupEndpoint(ctx, &pkgclient.DeleteRoutingGatewayGroupEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// RoutingGateways returns the Resource for RoutingGateway objects, served by /api/v2/routing/gateway.
+func RoutingGateways(c *client.Client) Resource[*pkgclient.RoutingGateway] {
+	return &resource[*pkgclient.RoutingGateway]{
+		kind: "RoutingGateway",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Routing.AllRoutingGateways(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Routing.GetRoutingGatewayEndpoint(ctx, &pkgclient.GetRoutingGatewayEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.RoutingGateway], opts []option.RequestOption) (any, error) {
+			response, err := c.Routing.PostRoutingGatewayEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.RoutingGateway], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Routing.PatchRoutingGatewayEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Routing.DeleteRoutingGatewayEndpoint(ctx, &pkgclient.DeleteRoutingGatewayEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// ServiceWatchdogs returns the Resource for ServiceWatchdog objects, served by /api/v2/services/service_watchdog.
+func ServiceWatchdogs(c *client.Client) Resource[*pkgclient.ServiceWatchdog] {
+	return &resource[*pkgclient.ServiceWatchdog]{
+		kind: "ServiceWatchdog",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Services.AllServicesServiceWatchdogs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Services.GetServicesServiceWatchdogEndpoint(ctx, &pkgclient.GetServicesServiceWatchdogEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.ServiceWatchdog], opts []option.RequestOption) (any, error) {
+			response, err := c.Services.PostServicesServiceWatchdogEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.ServiceWatchdog], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Services.PatchServicesServiceWatchdogEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Services.DeleteServicesServiceWatchdogEndpoint(ctx, &pkgclient.DeleteServicesServiceWatchdogEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.ServiceWatchdog, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutServicesServiceWatchdogsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Services.PutServicesServiceWatchdogsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// StaticRoutes returns the Resource for StaticRoute objects, served by /api/v2/routing/static_route.
+func StaticRoutes(c *client.Client) Resource[*pkgclient.StaticRoute] {
+	return &resource[*pkgclient.StaticRoute]{
+		kind: "StaticRoute",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Routing.AllRoutingStaticRoutes(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Routing.GetRoutingStaticRouteEndpoint(ctx, &pkgclient.GetRoutingStaticRouteEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.StaticRoute], opts []option.RequestOption) (any, error) {
+			response, err := c.Routing.PostRoutingStaticRouteEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.StaticRoute], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Routing.PatchRoutingStaticRouteEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Routing.DeleteRoutingStaticRouteEndpoint(ctx, &pkgclient.DeleteRoutingStaticRouteEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// SystemTunables returns the Resource for SystemTunable objects, served by /api/v2/system/tunable.
+func SystemTunables(c *client.Client) Resource[*pkgclient.SystemTunable] {
+	return &resource[*pkgclient.SystemTunable]{
+		kind: "SystemTunable",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.System.AllSystemTunables(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.System.GetSystemTunableEndpoint(ctx, &pkgclient.GetSystemTunableEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.SystemTunable], opts []option.RequestOption) (any, error) {
+			response, err := c.System.PostSystemTunableEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.SystemTunable], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.System.PatchSystemTunableEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.System.DeleteSystemTunableEndpoint(ctx, &pkgclient.DeleteSystemTunableEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.SystemTunable, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutSystemTunablesEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.System.PutSystemTunablesEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// TrafficShaperLimiterBandwidths returns the Resource for TrafficShaperLimiterBandwidth objects, served by /api/v2/firewall/traffic_shaper/limiter/bandwidth.
+// TrafficShaperLimiterBandwidth objects are nested, so their IDs have a Parent.
+func TrafficShaperLimiterBandwidths(c *client.Client) Resource[*pkgclient.TrafficShaperLimiterBandwidth] {
+	return &resource[*pkgclient.TrafficShaperLimiterBandwidth]{
+		kind:   "TrafficShaperLimiterBandwidth",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallTrafficShaperLimiterBandwidths(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallTrafficShaperLimiterBandwidthEndpoint(ctx, &pkgclient.GetFirewallTrafficShaperLimiterBandwidthEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.TrafficShaperLimiterBandwidth], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Firewall.PostFirewallTrafficShaperLimiterBandwidthEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.TrafficShaperLimiterBandwidth], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallTrafficShaperLimiterBandwidthEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallTrafficShaperLimiterBandwidthEndpoint(ctx, &pkgclient.DeleteFirewallTrafficShaperLimiterBandwidthEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// TrafficShaperLimiterQueues returns the Resource for TrafficShaperLimiterQueue objects, served by /api/v2/firewall/traffic_shaper/limiter/queue.
+// TrafficShaperLimiterQueue objects are nested, so their IDs have a Parent.
+func TrafficShaperLimiterQueues(c *client.Client) Resource[*pkgclient.TrafficShaperLimiterQueue] {
+	return &resource[*pkgclient.TrafficShaperLimiterQueue]{
+		kind:   "TrafficShaperLimiterQueue",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallTrafficShaperLimiterQueues(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallTrafficShaperLimiterQueueEndpoint(ctx, &pkgclient.GetFirewallTrafficShaperLimiterQueueEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.TrafficShaperLimiterQueue], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Firewall.PostFirewallTrafficShaperLimiterQueueEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.TrafficShaperLimiterQueue], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallTrafficShaperLimiterQueueEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallTrafficShaperLimiterQueueEndpoint(ctx, &pkgclient.DeleteFirewallTrafficShaperLimiterQueueEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// TrafficShaperLimiters returns the Resource for TrafficShaperLimiter objects, served by /api/v2/firewall/traffic_shaper/limiter.
+func TrafficShaperLimiters(c *client.Client) Resource[*pkgclient.TrafficShaperLimiter] {
+	return &resource[*pkgclient.TrafficShaperLimiter]{
+		kind: "TrafficShaperLimiter",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallTrafficShaperLimiters(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallTrafficShaperLimiterEndpoint(ctx, &pkgclient.GetFirewallTrafficShaperLimiterEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.TrafficShaperLimiter], opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.PostFirewallTrafficShaperLimiterEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.TrafficShaperLimiter], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallTrafficShaperLimiterEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallTrafficShaperLimiterEndpoint(ctx, &pkgclient.DeleteFirewallTrafficShaperLimiterEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.TrafficShaperLimiter, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutFirewallTrafficShaperLimitersEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Firewall.PutFirewallTrafficShaperLimitersEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// TrafficShaperQueues returns the Resource for TrafficShaperQueue objects, served by /api/v2/firewall/traffic_shaper/queue.
+// TrafficShaperQueue objects are nested, so their IDs have a Parent.
+func TrafficShaperQueues(c *client.Client) Resource[*pkgclient.TrafficShaperQueue] {
+	return &resource[*pkgclient.TrafficShaperQueue]{
+		kind:   "TrafficShaperQueue",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallTrafficShaperQueues(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallTrafficShaperQueueEndpoint(ctx, &pkgclient.GetFirewallTrafficShaperQueueEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.TrafficShaperQueue], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Firewall.PostFirewallTrafficShaperQueueEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.TrafficShaperQueue], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallTrafficShaperQueueEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallTrafficShaperQueueEndpoint(ctx, &pkgclient.DeleteFirewallTrafficShaperQueueEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// TrafficShapers returns the Resource for TrafficShaper objects, served by /api/v2/firewall/traffic_shaper.
+func TrafficShapers(c *client.Client) Resource[*pkgclient.TrafficShaper] {
+	return &resource[*pkgclient.TrafficShaper]{
+		kind: "TrafficShaper",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallTrafficShapers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallTrafficShaperEndpoint(ctx, &pkgclient.GetFirewallTrafficShaperEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.TrafficShaper], opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.PostFirewallTrafficShaperEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.TrafficShaper], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallTrafficShaperEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallTrafficShaperEndpoint(ctx, &pkgclient.DeleteFirewallTrafficShaperEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.TrafficShaper, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutFirewallTrafficShapersEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Firewall.PutFirewallTrafficShapersEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// UserGroups returns the Resource for UserGroup objects, served by /api/v2/user/group.
+func UserGroups(c *client.Client) Resource[*pkgclient.UserGroup] {
+	return &resource[*pkgclient.UserGroup]{
+		kind: "UserGroup",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.User.AllUserGroups(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.User.GetUserGroupEndpoint(ctx, &pkgclient.GetUserGroupEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.UserGroup], opts []option.RequestOption) (any, error) {
+			response, err := c.User.PostUserGroupEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.UserGroup], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.User.PatchUserGroupEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.User.DeleteUserGroupEndpoint(ctx, &pkgclient.DeleteUserGroupEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.UserGroup, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutUserGroupsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.User.PutUserGroupsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// Users returns the Resource for User objects, served by /api/v2/user.
+func Users(c *client.Client) Resource[*pkgclient.User] {
+	return &resource[*pkgclient.User]{
+		kind: "User",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.User.AllUsers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.User.GetUserEndpoint(ctx, &pkgclient.GetUserEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.User], opts []option.RequestOption) (any, error) {
+			response, err := c.User.PostUserEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.User], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.User.PatchUserEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.User.DeleteUserEndpoint(ctx, &pkgclient.DeleteUserEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// VirtualIPs returns the Resource for VirtualIP objects, served by /api/v2/firewall/virtual_ip.
+func VirtualIPs(c *client.Client) Resource[*pkgclient.VirtualIP] {
+	return &resource[*pkgclient.VirtualIP]{
+		kind: "VirtualIP",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Firewall.AllFirewallVirtualIPs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.GetFirewallVirtualIPEndpoint(ctx, &pkgclient.GetFirewallVirtualIPEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.VirtualIP], opts []option.RequestOption) (any, error) {
+			response, err := c.Firewall.PostFirewallVirtualIPEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.VirtualIP], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Firewall.PatchFirewallVirtualIPEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Firewall.DeleteFirewallVirtualIPEndpoint(ctx, &pkgclient.DeleteFirewallVirtualIPEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+	}
+}
+
+// WireGuardPeerAllowedIPs returns the Resource for WireGuardPeerAllowedIP objects, served by /api/v2/vpn/wireguard/peer/allowed_ip.
+// WireGuardPeerAllowedIP objects are nested, so their IDs have a Parent.
+func WireGuardPeerAllowedIPs(c *client.Client) Resource[*pkgclient.WireGuardPeerAllowedIP] {
+	return &resource[*pkgclient.WireGuardPeerAllowedIP]{
+		kind:   "WireGuardPeerAllowedIP",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpnWireGuardPeerAllowedIPs(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpnWireGuardPeerAllowedIPEndpoint(ctx, &pkgclient.GetVpnWireGuardPeerAllowedIPEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.WireGuardPeerAllowedIP], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Vpn.PostVpnWireGuardPeerAllowedIPEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.WireGuardPeerAllowedIP], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpnWireGuardPeerAllowedIPEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpnWireGuardPeerAllowedIPEndpoint(ctx, &pkgclient.DeleteVpnWireGuardPeerAllowedIPEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// WireGuardPeers returns the Resource for WireGuardPeer objects, served by /api/v2/vpn/wireguard/peer.
+func WireGuardPeers(c *client.Client) Resource[*pkgclient.WireGuardPeer] {
+	return &resource[*pkgclient.WireGuardPeer]{
+		kind: "WireGuardPeer",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpnWireGuardPeers(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpnWireGuardPeerEndpoint(ctx, &pkgclient.GetVpnWireGuardPeerEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.WireGuardPeer], opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.PostVpnWireGuardPeerEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.WireGuardPeer], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpnWireGuardPeerEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpnWireGuardPeerEndpoint(ctx, &pkgclient.DeleteVpnWireGuardPeerEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.WireGuardPeer, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutVpnWireGuardPeersEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Vpn.PutVpnWireGuardPeersEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
+
+// WireGuardTunnelAddresses returns the Resource for WireGuardTunnelAddress objects, served by /api/v2/vpn/wireguard/tunnel/address.
+// WireGuardTunnelAddress objects are nested, so their IDs have a Parent.
+func WireGuardTunnelAddresses(c *client.Client) Resource[*pkgclient.WireGuardTunnelAddress] {
+	return &resource[*pkgclient.WireGuardTunnelAddress]{
+		kind:   "WireGuardTunnelAddress",
+		nested: true,
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpnWireGuardTunnelAddresses(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpnWireGuardTunnelAddressEndpoint(ctx, &pkgclient.GetVpnWireGuardTunnelAddressEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.WireGuardTunnelAddress], opts []option.RequestOption) (any, error) {
+			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+			response, err := c.Vpn.PostVpnWireGuardTunnelAddressEndpoint(ctx, object.Value.ToPost(parentID), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.WireGuardTunnelAddress], opts []option.RequestOption) (any, error) {
+			parentID, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpnWireGuardTunnelAddressEndpoint(ctx, object.Value.ToPatch(parentID, id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpnWireGuardTunnelAddressEndpoint(ctx, &pkgclient.DeleteVpnWireGuardTunnelAddressEndpointRequest{ID: &id.Value, ParentID: &id.Parent}, opts...)
+			return err
+		},
+	}
+}
+
+// WireGuardTunnels returns the Resource for WireGuardTunnel objects, served by /api/v2/vpn/wireguard/tunnel.
+func WireGuardTunnels(c *client.Client) Resource[*pkgclient.WireGuardTunnel] {
+	return &resource[*pkgclient.WireGuardTunnel]{
+		kind: "WireGuardTunnel",
+		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.Vpn.AllVpnWireGuardTunnels(ctx, nil, opts...))
+		},
+		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.GetVpnWireGuardTunnelEndpoint(ctx, &pkgclient.GetVpnWireGuardTunnelEndpointRequest{ID: &id.Value}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.WireGuardTunnel], opts []option.RequestOption) (any, error) {
+			response, err := c.Vpn.PostVpnWireGuardTunnelEndpoint(ctx, object.Value.ToPost(), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.WireGuardTunnel], opts []option.RequestOption) (any, error) {
+			_, id, opts := object.ID.ints(opts)
+			response, err := c.Vpn.PatchVpnWireGuardTunnelEndpoint(ctx, object.Value.ToPatch(id), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.Vpn.DeleteVpnWireGuardTunnelEndpoint(ctx, &pkgclient.DeleteVpnWireGuardTunnelEndpointRequest{ID: &id.Value}, opts...)
+			return err
+		},
+		replaceAll: func(ctx context.Context, values []*pkgclient.WireGuardTunnel, opts []option.RequestOption) error {
+			request := make([]*pkgclient.PutVpnWireGuardTunnelsEndpointRequestItem, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.Vpn.PutVpnWireGuardTunnelsEndpoint(ctx, request, opts...)
+			return err
+		},
+	}
+}
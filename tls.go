@@ -1,10 +1,28 @@
 package pfrest
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
 )
 
+// TLSClient returns an HTTP client that skips certificate verification when
+// skipVerify is true. Prefer [PinnedTLSClient] or [KnownHostsTLSClient],
+// which also accept self-signed certificates but still detect a changed one.
 func TLSClient(skipVerify bool) *http.Client {
 	if !skipVerify {
 		return http.DefaultClient
@@ -17,3 +35,403 @@ func TLSClient(skipVerify bool) *http.Client {
 		},
 	}
 }
+
+// PinnedTLSClient returns an HTTP client that only accepts servers whose
+// leaf certificate matches one of the given SHA-256 fingerprints. Each
+// fingerprint is either of the certificate or of its public key (SPKI), in
+// one of the forms
+//
+//	sha256/<base64>          as printed by curl --pinnedpubkey
+//	AB:CD:...                as printed by openssl x509 -fingerprint -sha256
+//	abcd...                  plain hex
+//
+// The certificate chain is not verified, so self-signed certificates work.
+// Pinning the public key keeps working across certificate renewals that
+// reuse the key.
+func PinnedTLSClient(fingerprints ...string) (*http.Client, error) {
+	if len(fingerprints) == 0 {
+		return nil, errors.New("no fingerprints to pin")
+	}
+	return TLSConfig{Fingerprints: fingerprints}.Client()
+}
+
+// KnownHostsTLSClient returns an HTTP client that trusts each server's
+// certificate on first use. The public key fingerprint seen on the first
+// connection to a host is appended to the file at path; later connections
+// to that host fail with a [*CertificateMismatchError] if it changes.
+func KnownHostsTLSClient(path string) (*http.Client, error) {
+	return TLSConfig{KnownHostsFile: path}.Client()
+}
+
+// TLSConfig configures how the server certificate is verified and which
+// client certificate, if any, is presented.
+//
+// By default certificates are verified against the system roots, or
+// against CAFile if set. When Fingerprints or KnownHostsFile is set, the
+// server is instead identified by its certificate fingerprint; if CAFile is
+// also set, the chain must verify against it as well, unless
+// InsecureSkipVerify is set.
+type TLSConfig struct {
+	// InsecureSkipVerify disables certificate chain verification. Pins in
+	// Fingerprints and KnownHostsFile are still enforced.
+	InsecureSkipVerify bool `yaml:"insecure_skip_verify" json:"insecure_skip_verify"`
+	// CAFile is a PEM bundle of CAs to trust instead of the system pool.
+	CAFile string `yaml:"ca_file" json:"ca_file"`
+	// Fingerprints pins the server certificate, as described on
+	// [PinnedTLSClient].
+	Fingerprints []string `yaml:"fingerprints" json:"fingerprints"`
+	// KnownHostsFile enables trust on first use, as described on
+	// [KnownHostsTLSClient].
+	KnownHostsFile string `yaml:"known_hosts_file" json:"known_hosts_file"`
+	// CertFile and KeyFile are a PEM client certificate and key for mutual
+	// TLS.
+	CertFile string `yaml:"cert_file" json:"cert_file"`
+	KeyFile  string `yaml:"key_file" json:"key_file"`
+}
+
+// Client returns an HTTP client using the TLS configuration.
+func (t TLSConfig) Client() (*http.Client, error) {
+	transport, err := t.Transport()
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+// Transport returns a copy of http.DefaultTransport using the TLS
+// configuration.
+func (t TLSConfig) Transport() (*http.Transport, error) {
+	return t.transport(http.DefaultTransport.(*http.Transport).Clone())
+}
+
+// transport configures transport, a copy of http.DefaultTransport, with
+// the TLS configuration.
+func (t TLSConfig) transport(transport *http.Transport) (*http.Transport, error) {
+	config, err := t.Config()
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = config
+	if len(t.Fingerprints) == 0 && t.KnownHostsFile == "" {
+		return transport, nil
+	}
+
+	verifier := &fingerprintVerifier{roots: config.RootCAs}
+	if t.InsecureSkipVerify {
+		// Skipping verification must not turn pinning off, only the CA
+		// check.
+		verifier.roots = nil
+	}
+	for _, fingerprint := range t.Fingerprints {
+		pin, err := parseFingerprint(fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		verifier.pins = append(verifier.pins, pin)
+	}
+	if t.KnownHostsFile != "" {
+		verifier.knownHosts = &knownHosts{path: t.KnownHostsFile}
+	}
+	// The fingerprint check needs the host and port being dialled, which
+	// tls.Config.VerifyConnection does not see for IP addresses, so the
+	// transport does its own TLS handshake. Through a proxy, net/http
+	// would make that handshake itself, or check the pins against the
+	// proxy's certificate, so the dial also tunnels through the proxy.
+	proxy := transport.Proxy
+	transport.Proxy = nil
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	transport.DialTLSContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dialProxy(ctx, dialer, proxy, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		return verifier.handshake(ctx, conn, addr, config)
+	}
+	return transport, nil
+}
+
+// dialProxy connects to addr, tunnelling through the proxy that proxy
+// chooses for it, if any, with an HTTP CONNECT request.
+func dialProxy(ctx context.Context, dialer *net.Dialer, proxy func(*http.Request) (*url.URL, error), network, addr string) (net.Conn, error) {
+	var proxyURL *url.URL
+	if proxy != nil {
+		var err error
+		request := &http.Request{Method: http.MethodGet, URL: &url.URL{Scheme: "https", Host: addr}, Header: http.Header{}}
+		if proxyURL, err = proxy(request.WithContext(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	if proxyURL == nil {
+		return dialer.DialContext(ctx, network, addr)
+	}
+
+	proxyAddr := proxyURL.Host
+	if proxyURL.Port() == "" {
+		port := "80"
+		if proxyURL.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddr = net.JoinHostPort(proxyURL.Hostname(), port)
+	}
+	conn, err := dialer.DialContext(ctx, network, proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	connect := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		connect.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(user.Username()+":"+password)))
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+	if err := connect.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	// The server speaks only after the TLS client hello, so the reader
+	// buffers nothing past the response.
+	response, err := http.ReadResponse(bufio.NewReader(conn), connect)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s: CONNECT %s: %s", proxyURL.Redacted(), addr, response.Status)
+	}
+	return conn, nil
+}
+
+// Config returns the crypto/tls configuration for certificate authorities,
+// client certificates and InsecureSkipVerify. Fingerprint checks are only
+// applied by [TLSConfig.Transport].
+func (t TLSConfig) Config() (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: t.InsecureSkipVerify, //nolint:gosec // user-configured for self-signed pfSense certs
+	}
+	if t.CAFile != "" {
+		pem, err := os.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: no certificates found", t.CAFile)
+		}
+		config.RootCAs = pool
+	}
+	if t.CertFile != "" || t.KeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+	return config, nil
+}
+
+// CertificateMismatchError is returned when a server presents a certificate
+// that does not match its pinned or previously recorded fingerprint.
+type CertificateMismatchError struct {
+	// Host is the host and port that was dialled.
+	Host string
+	// Fingerprint is the SPKI fingerprint of the presented certificate.
+	Fingerprint string
+	// KnownHostsFile is the file holding the recorded fingerprint, if the
+	// mismatch was detected by trust on first use.
+	KnownHostsFile string
+}
+
+func (e *CertificateMismatchError) Error() string {
+	if e.KnownHostsFile != "" {
+		return fmt.Sprintf("certificate for %s has changed to %s; if this is expected, remove its entry from %s",
+			e.Host, e.Fingerprint, e.KnownHostsFile)
+	}
+	return fmt.Sprintf("certificate for %s (%s) does not match any pinned fingerprint", e.Host, e.Fingerprint)
+}
+
+// CertificateError reports that e rejects the server's certificate, so
+// that the default retry policy doesn't retry the request.
+func (e *CertificateMismatchError) CertificateError() bool {
+	return true
+}
+
+// fingerprintVerifier identifies servers by certificate fingerprint.
+type fingerprintVerifier struct {
+	roots      *x509.CertPool
+	pins       [][]byte
+	knownHosts *knownHosts
+}
+
+// handshake completes a TLS handshake over conn, a connection to addr,
+// that verifies the server's fingerprint in place of the usual chain
+// verification.
+func (v *fingerprintVerifier) handshake(ctx context.Context, conn net.Conn, addr string, config *tls.Config) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	config = config.Clone()
+	if config.ServerName == "" {
+		config.ServerName = host
+	}
+	if len(config.NextProtos) == 0 {
+		config.NextProtos = []string{"h2", "http/1.1"}
+	}
+	config.InsecureSkipVerify = true //nolint:gosec // replaced by VerifyConnection below
+	serverName := config.ServerName
+	config.VerifyConnection = func(state tls.ConnectionState) error {
+		return v.verify(addr, serverName, state)
+	}
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+func (v *fingerprintVerifier) verify(addr, serverName string, state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	leaf := state.PeerCertificates[0]
+	if v.roots != nil {
+		intermediates := x509.NewCertPool()
+		for _, certificate := range state.PeerCertificates[1:] {
+			intermediates.AddCert(certificate)
+		}
+		_, err := leaf.Verify(x509.VerifyOptions{
+			DNSName:       serverName,
+			Roots:         v.roots,
+			Intermediates: intermediates,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	spki := sha256.Sum256(leaf.RawSubjectPublicKeyInfo)
+	if len(v.pins) > 0 {
+		cert := sha256.Sum256(leaf.Raw)
+		matched := false
+		for _, pin := range v.pins {
+			if bytes.Equal(pin, spki[:]) || bytes.Equal(pin, cert[:]) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return &CertificateMismatchError{Host: addr, Fingerprint: formatFingerprint(spki[:])}
+		}
+	}
+	if v.knownHosts != nil {
+		return v.knownHosts.check(addr, formatFingerprint(spki[:]))
+	}
+	return nil
+}
+
+// knownHosts is a trust on first use store of SPKI fingerprints. Each line
+// of the file holds a host:port and a fingerprint in the sha256/<base64>
+// form; blank lines and lines starting with # are ignored.
+type knownHosts struct {
+	path string
+	mu   sync.Mutex
+}
+
+// check compares the fingerprint against the one recorded for host,
+// recording it if there is none. The file is re-read on every call, so
+// entries removed by hand take effect on the next connection.
+func (k *knownHosts) check(host, fingerprint string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	known, err := k.lookup(host)
+	if err != nil {
+		return err
+	}
+	if known == "" {
+		return k.record(host, fingerprint)
+	}
+	if known != fingerprint {
+		return &CertificateMismatchError{Host: host, Fingerprint: fingerprint, KnownHostsFile: k.path}
+	}
+	return nil
+}
+
+func (k *knownHosts) lookup(host string) (string, error) {
+	f, err := os.Open(k.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == host {
+			return fields[1], nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+func (k *knownHosts) record(host, fingerprint string) error {
+	f, err := os.OpenFile(k.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(f, "%s %s\n", host, fingerprint); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// parseFingerprint decodes a SHA-256 fingerprint in any of the forms
+// accepted by PinnedTLSClient.
+func parseFingerprint(fingerprint string) ([]byte, error) {
+	fingerprint = strings.TrimSpace(fingerprint)
+	var (
+		pin []byte
+		err error
+	)
+	if encoded, ok := strings.CutPrefix(fingerprint, "sha256/"); ok {
+		pin, err = base64.StdEncoding.DecodeString(encoded)
+	} else {
+		pin, err = hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+	}
+	if err != nil || len(pin) != sha256.Size {
+		return nil, fmt.Errorf("invalid SHA-256 fingerprint %q", fingerprint)
+	}
+	return pin, nil
+}
+
+func formatFingerprint(sum []byte) string {
+	return "sha256/" + base64.StdEncoding.EncodeToString(sum)
+}
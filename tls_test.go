@@ -0,0 +1,301 @@
+package pfrest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/danielmichaels/go-pfrest/pkg/client/client"
+	"github.com/danielmichaels/go-pfrest/pkg/client/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFingerprint(t *testing.T) {
+	sum := sha256.Sum256([]byte("pfSense"))
+	colons := strings.ToUpper(hex.EncodeToString(sum[:]))
+	var pairs []string
+	for i := 0; i < len(colons); i += 2 {
+		pairs = append(pairs, colons[i:i+2])
+	}
+
+	for _, fingerprint := range []string{
+		formatFingerprint(sum[:]),
+		strings.Join(pairs, ":"),
+		hex.EncodeToString(sum[:]),
+		"  " + hex.EncodeToString(sum[:]) + "\n",
+	} {
+		pin, err := parseFingerprint(fingerprint)
+		require.NoError(t, err, fingerprint)
+		assert.Equal(t, sum[:], pin, fingerprint)
+	}
+
+	for _, fingerprint := range []string{"", "sha256/not-base64!", "abcd", "sha1/" + hex.EncodeToString(sum[:])} {
+		_, err := parseFingerprint(fingerprint)
+		assert.Error(t, err, fingerprint)
+	}
+}
+
+// newTLSServer returns a test server with a self-signed certificate that
+// doesn't log the handshakes the tests make fail.
+func newTLSServer() *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	return server
+}
+
+// serverFingerprints returns the SPKI and certificate fingerprints of the
+// test server's certificate.
+func serverFingerprints(server *httptest.Server) (spki, cert string) {
+	certificate := server.Certificate()
+	spkiSum := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+	certSum := sha256.Sum256(certificate.Raw)
+	return formatFingerprint(spkiSum[:]), hex.EncodeToString(certSum[:])
+}
+
+// otherFingerprint is a valid fingerprint that matches no certificate.
+var otherFingerprint = strings.Repeat("00", sha256.Size)
+
+func get(t *testing.T, config TLSConfig, url string) error {
+	t.Helper()
+	client, err := config.Client()
+	require.NoError(t, err)
+	response, err := client.Get(url)
+	if err == nil {
+		response.Body.Close()
+	}
+	return err
+}
+
+func TestTLSConfigPins(t *testing.T) {
+	server := newTLSServer()
+	defer server.Close()
+	spki, cert := serverFingerprints(server)
+
+	assert.NoError(t, get(t, TLSConfig{Fingerprints: []string{spki}}, server.URL), "SPKI pin")
+	assert.NoError(t, get(t, TLSConfig{Fingerprints: []string{otherFingerprint, cert}}, server.URL), "certificate pin")
+
+	for _, config := range []TLSConfig{
+		{Fingerprints: []string{otherFingerprint}},
+		{Fingerprints: []string{otherFingerprint}, InsecureSkipVerify: true},
+	} {
+		err := get(t, config, server.URL)
+		var mismatch *CertificateMismatchError
+		require.True(t, errors.As(err, &mismatch), "%+v: %v", config, err)
+		assert.Equal(t, strings.TrimPrefix(server.URL, "https://"), mismatch.Host)
+		assert.Equal(t, spki, mismatch.Fingerprint)
+		assert.Empty(t, mismatch.KnownHostsFile)
+	}
+
+	_, err := TLSConfig{Fingerprints: []string{"nope"}}.Transport()
+	assert.EqualError(t, err, `invalid SHA-256 fingerprint "nope"`)
+}
+
+func TestTLSConfigMismatchIsNotRetried(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	var connections atomic.Int32
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	httpClient, err := TLSConfig{Fingerprints: []string{otherFingerprint}}.Client()
+	require.NoError(t, err)
+	c := client.NewClient(
+		option.WithBaseURL(server.URL),
+		option.WithHTTPClient(httpClient),
+		option.WithMaxAttempts(3),
+		option.WithRetryBaseDelay(time.Millisecond),
+	)
+	_, err = c.Status.GetStatusSystemEndpoint(context.Background())
+	var mismatch *CertificateMismatchError
+	require.True(t, errors.As(err, &mismatch), "%v", err)
+	assert.Equal(t, int32(1), connections.Load(), "a rejected certificate is not retried")
+}
+
+// connectProxy is an HTTP proxy that tunnels CONNECT requests, recording
+// their targets.
+type connectProxy struct {
+	mu      sync.Mutex
+	targets []string
+}
+
+func (p *connectProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodConnect {
+		http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+		return
+	}
+	p.mu.Lock()
+	p.targets = append(p.targets, r.Host)
+	p.mu.Unlock()
+	upstream, err := net.Dial("tcp", r.Host)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer upstream.Close()
+	w.WriteHeader(http.StatusOK)
+	conn, buffered, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	go io.Copy(upstream, buffered) //nolint:errcheck
+	_, _ = io.Copy(conn, upstream)
+}
+
+func TestTLSConfigPinsThroughProxy(t *testing.T) {
+	server := newTLSServer()
+	defer server.Close()
+	spki, _ := serverFingerprints(server)
+	host := strings.TrimPrefix(server.URL, "https://")
+	proxy := new(connectProxy)
+	proxyServer := httptest.NewServer(proxy)
+	defer proxyServer.Close()
+	proxyURL, err := url.Parse(proxyServer.URL)
+	require.NoError(t, err)
+
+	get := func(config TLSConfig) error {
+		transport, err := config.transport(&http.Transport{Proxy: http.ProxyURL(proxyURL)})
+		require.NoError(t, err)
+		defer transport.CloseIdleConnections()
+		response, err := (&http.Client{Transport: transport}).Get(server.URL)
+		if err == nil {
+			response.Body.Close()
+		}
+		return err
+	}
+
+	assert.NoError(t, get(TLSConfig{Fingerprints: []string{spki}}))
+	for _, config := range []TLSConfig{
+		{Fingerprints: []string{otherFingerprint}},
+		{Fingerprints: []string{otherFingerprint}, InsecureSkipVerify: true},
+		{KnownHostsFile: writeKnownHosts(t, host+" "+formatFingerprint(make([]byte, sha256.Size))), InsecureSkipVerify: true},
+	} {
+		err := get(config)
+		var mismatch *CertificateMismatchError
+		require.True(t, errors.As(err, &mismatch), "%+v: %v", config, err)
+		assert.Equal(t, host, mismatch.Host, "the firewall is checked, not the proxy")
+	}
+
+	proxy.mu.Lock()
+	defer proxy.mu.Unlock()
+	assert.Equal(t, []string{host, host, host, host}, proxy.targets, "every connection goes through the proxy")
+}
+
+// writeKnownHosts writes a known hosts file holding lines.
+func writeKnownHosts(t *testing.T, lines ...string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "known_hosts")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o600))
+	return path
+}
+
+func TestTLSConfigKnownHosts(t *testing.T) {
+	server := newTLSServer()
+	defer server.Close()
+	spki, _ := serverFingerprints(server)
+	host := strings.TrimPrefix(server.URL, "https://")
+	path := filepath.Join(t.TempDir(), "known_hosts")
+	config := TLSConfig{KnownHostsFile: path}
+
+	require.NoError(t, get(t, config, server.URL), "first use records the host")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, host+" "+spki+"\n", string(data))
+
+	require.NoError(t, get(t, config, server.URL), "the recorded fingerprint matches")
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(data), "\n"), "a known host is recorded once")
+
+	changed := "# pinned by hand\n\n" + host + " sha256/" + strings.Repeat("A", 43) + "=\n"
+	require.NoError(t, os.WriteFile(path, []byte(changed), 0o600))
+	for _, config := range []TLSConfig{config, {KnownHostsFile: path, InsecureSkipVerify: true}} {
+		err = get(t, config, server.URL)
+		var mismatch *CertificateMismatchError
+		require.True(t, errors.As(err, &mismatch), "%v", err)
+		assert.Equal(t, path, mismatch.KnownHostsFile)
+		assert.Equal(t, spki, mismatch.Fingerprint)
+	}
+}
+
+func TestTLSConfigCAAndPin(t *testing.T) {
+	server := newTLSServer()
+	defer server.Close()
+	spki, _ := serverFingerprints(server)
+
+	dir := t.TempDir()
+	serverCA := filepath.Join(dir, "server-ca.pem")
+	writePEM(t, serverCA, server.Certificate().Raw)
+	otherCA := filepath.Join(dir, "other-ca.pem")
+	writePEM(t, otherCA, selfSignedCertificate(t))
+
+	assert.NoError(t, get(t, TLSConfig{CAFile: serverCA, Fingerprints: []string{spki}}, server.URL))
+	assert.NoError(t, get(t, TLSConfig{CAFile: serverCA}, server.URL))
+
+	var mismatch *CertificateMismatchError
+	err := get(t, TLSConfig{CAFile: serverCA, Fingerprints: []string{otherFingerprint}}, server.URL)
+	assert.True(t, errors.As(err, &mismatch), "the pin is checked after the chain: %v", err)
+
+	err = get(t, TLSConfig{CAFile: otherCA, Fingerprints: []string{spki}}, server.URL)
+	var unknownAuthority x509.UnknownAuthorityError
+	assert.True(t, errors.As(err, &unknownAuthority), "the chain is checked despite a matching pin: %v", err)
+
+	assert.NoError(t, get(t, TLSConfig{CAFile: otherCA, Fingerprints: []string{spki}, InsecureSkipVerify: true}, server.URL),
+		"InsecureSkipVerify skips only the chain")
+
+	_, err = TLSConfig{CAFile: filepath.Join(dir, "missing.pem")}.Config()
+	assert.Error(t, err)
+}
+
+func writePEM(t *testing.T, path string, der []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+}
+
+// selfSignedCertificate returns a CA certificate that signed nothing the
+// tests serve.
+func selfSignedCertificate(t *testing.T) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "other CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return der
+}
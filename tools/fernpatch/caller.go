@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strings"
+)
+
+var (
+	baseURLBlock = regexp.MustCompile(`(?m)^(\s*)baseURL := ""\n\s*if c\.baseURL != "" \{\n\s*baseURL = c\.baseURL\n\s*\}\n`)
+	clientFields = regexp.MustCompile(`(?m)^\s*(baseURL\s+string|header\s+http\.Header|baseURL:\s+options\.BaseURL,|header:\s+options\.ToHeader\(\),)\n`)
+	httpImport   = regexp.MustCompile(`(?m)^\s*http "net/http"\n`)
+)
+
+// pkgclientImport imports the models package, whose error decoder the root
+// client passes to its caller.
+const pkgclientImport = `pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"`
+
+// shareCaller rewrites a generated client so that it reads the base URL
+// and headers from its *core.Caller at call time rather than copying them
+// at construction. Both are read from one snapshot, so a concurrent Apply
+// can't pair the base URL of one configuration with the headers of another. Sub-clients gain a NewClientWithCaller constructor, and
+// the root client builds a single caller and passes it to every sub-client.
+func shareCaller(src []byte) ([]byte, error) {
+	out := string(src)
+	out = baseURLBlock.ReplaceAllString(out, "${1}baseURL, header := c.caller.Snapshot()\n")
+	out = strings.ReplaceAll(out, "c.header.Clone()", "header")
+	// Clients patched before the snapshot read each separately.
+	out = strings.ReplaceAll(out, "baseURL := c.caller.BaseURL()\n", "baseURL, header := c.caller.Snapshot()\n")
+	out = strings.ReplaceAll(out, "core.MergeHeaders(c.caller.Header(),", "core.MergeHeaders(header,")
+	out = clientFields.ReplaceAllString(out, "")
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", out, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	services := subClientFields(file)
+	newClient := funcDecl(file, "NewClient")
+	if newClient == nil {
+		return nil, fmt.Errorf("no NewClient function")
+	}
+	start, end := fset.Position(newClient.Pos()).Offset, fset.Position(newClient.End()).Offset
+	switch {
+	case len(services) > 0:
+		out = out[:start] + rootNewClient(services) + out[end:]
+		if !strings.Contains(out, pkgclientImport) {
+			out = strings.Replace(out, "import (\n", "import (\n\t"+pkgclientImport+"\n", 1)
+		}
+	case funcDecl(file, "NewClientWithCaller") == nil:
+		out = out[:end] + subClientWithCaller + out[end:]
+	}
+
+	if !strings.Contains(out, "http.") {
+		out = httpImport.ReplaceAllString(out, "")
+	}
+	return format.Source([]byte(out))
+}
+
+// shareCallerTest rewrites the generated client tests to read the base URL
+// and headers from the shared caller.
+func shareCallerTest(src []byte) ([]byte, error) {
+	out := bytes.ReplaceAll(src, []byte("c.baseURL"), []byte("c.caller.BaseURL()"))
+	out = bytes.ReplaceAll(out, []byte("c.header."), []byte("c.caller.Header()."))
+	return format.Source(out)
+}
+
+const subClientWithCaller = `
+
+// NewClientWithCaller returns a client that issues its calls through the
+// given caller, sharing its options with every other client that does.
+func NewClientWithCaller(caller *core.Caller) *Client {
+	return &Client{
+		caller: caller,
+	}
+}`
+
+// subClientField is a field of the root client holding a sub-client.
+type subClientField struct {
+	Name string // e.g. Firewall
+	Pkg  string // e.g. firewall
+}
+
+// subClientFields returns the sub-client fields of the file's Client
+// struct, e.g. Firewall *firewall.Client.
+func subClientFields(file *ast.File) []subClientField {
+	var fields []subClientField
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.TypeSpec)
+		if !ok || spec.Name.Name != "Client" {
+			return true
+		}
+		structType, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+		for _, field := range structType.Fields.List {
+			star, ok := field.Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			sel, ok := star.X.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Client" {
+				continue
+			}
+			pkg, ok := sel.X.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			for _, name := range field.Names {
+				fields = append(fields, subClientField{Name: name.Name, Pkg: pkg.Name})
+			}
+		}
+		return false
+	})
+	return fields
+}
+
+func funcDecl(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func rootNewClient(services []subClientField) string {
+	var buf strings.Builder
+	buf.WriteString(`func NewClient(opts ...option.RequestOption) *Client {
+	options := core.NewRequestOptions(opts...)
+	caller := core.NewCaller(
+		&core.CallerParams{
+			Client:       options.HTTPClient,
+			MaxAttempts:  options.MaxAttempts,
+			Options:      options,
+			ErrorDecoder: pkgclient.DecodeError,
+		},
+	)
+	return &Client{
+		caller: caller,
+`)
+	for _, service := range services {
+		fmt.Fprintf(&buf, "\t\t%s: %s.NewClientWithCaller(caller),\n", service.Name, service.Pkg)
+	}
+	buf.WriteString("\t}\n}")
+	return buf.String()
+}
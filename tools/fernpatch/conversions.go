@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"path/filepath"
+	"strings"
+)
+
+// conversionsFile is the file, relative to the pkg/client directory,
+// holding the generated conversions between models and the request and
+// response types of their endpoints.
+const conversionsFile = "conversions.go"
+
+// readOnlyFields are the JSON names of the fields pfSense maintains
+// itself. Conversions to requests drop them.
+var readOnlyFields = map[string]bool{
+	"tracker":      true,
+	"created_time": true,
+	"created_by":   true,
+	"updated_time": true,
+	"updated_by":   true,
+}
+
+// copiedField is a field that a conversion copies.
+type copiedField struct {
+	Name     string
+	ReadOnly bool
+}
+
+// generateConversions writes, for every model in dir that has a
+// pfrest.Resource adapter, ToPost, ToPatch and ToPutItem methods building
+// its requests, and ToModel methods on the response types holding it.
+func generateConversions(dir string) error {
+	models, structs, err := findResourceModels(dir)
+	if err != nil || models == nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	buf.WriteString("\npackage pfclientapi\n")
+	for _, m := range models {
+		model := structs[m.Model]
+		post, err := newRequestConversion(structs, m.Model, m.Post+"Request", "ParentID")
+		if err != nil {
+			return err
+		}
+		patch, err := newRequestConversion(structs, m.Model, m.Patch+"Request", "ParentID", "ID")
+		if err != nil {
+			return err
+		}
+		receiver := strings.ToLower(m.Model[:1])
+
+		fmt.Fprintf(&buf, `
+// ToPost returns a request that creates a copy of %[1]s. Fields that
+// pfSense maintains itself, such as Tracker, are dropped, and slices and
+// pointers are shared with %[1]s.
+func (%[1]s *%[2]s) ToPost(%[3]s) *%[4]s {
+	if %[1]s == nil {
+		return &%[4]s{%[5]s}
+	}
+	return &%[4]s{
+%[6]s	}
+}
+`, receiver, m.Model, post.params, m.Post+"Request", post.ids, post.idFields+copyFields(receiver, post.fields, false))
+
+		fmt.Fprintf(&buf, `
+// ToPatch returns a request that overwrites the object identified by
+// %[7]s with %[1]s. Fields that pfSense maintains itself, such as
+// Tracker, are dropped, and slices and pointers are shared with %[1]s.
+func (%[1]s *%[2]s) ToPatch(%[3]s) *%[4]s {
+	if %[1]s == nil {
+		return &%[4]s{%[5]s}
+	}
+	return &%[4]s{
+%[6]s	}
+}
+`, receiver, m.Model, patch.params, m.Patch+"Request", patch.ids, patch.idFields+copyFields(receiver, patch.fields, false), patch.names)
+
+		if m.PutItem != "" {
+			fmt.Fprintf(&buf, `
+// ToPutItem returns %[1]s as an item of a request that replaces all
+// objects at once. Fields that pfSense maintains itself, such as Tracker,
+// are dropped, and slices and pointers are shared with %[1]s.
+func (%[1]s *%[2]s) ToPutItem() *%[3]s {
+	if %[1]s == nil {
+		return nil
+	}
+	return &%[3]s{
+%[4]s	}
+}
+`, receiver, m.Model, m.PutItem, copyFields(receiver, sharedFields(model, structs[m.PutItem]), false))
+		}
+
+		for _, source := range responseTypes(m, structs) {
+			r := strings.ToLower(source[:1])
+			fmt.Fprintf(&buf, `
+// ToModel copies %[1]s into a new %[3]s, sharing its slices and pointers.
+func (%[1]s *%[2]s) ToModel() *%[3]s {
+	if %[1]s == nil {
+		return nil
+	}
+	return &%[3]s{
+%[4]s	}
+}
+`, r, source, m.Model, copyFields(r, sharedFields(structs[source], model), true))
+		}
+	}
+	return writeGenerated(filepath.Join(dir, conversionsFile), buf.Bytes())
+}
+
+// requestConversion is the signature and body of a conversion from a
+// model to a request that also identifies the object.
+type requestConversion struct {
+	params string // e.g. "parentID, id int"
+	ids    string // e.g. "ParentID: parentID, ID: id"
+	// idFields are the same key-value pairs, one per line.
+	idFields string
+	names    string // e.g. "parentID and id"
+	fields   []copiedField
+}
+
+// idParams are the parameter names of the ID fields of requests.
+var idParams = map[string]string{"ParentID": "parentID", "ID": "id"}
+
+// newRequestConversion returns the conversion from model to request,
+// taking the request's ID fields among ids as parameters.
+func newRequestConversion(structs map[string]*ast.StructType, model, request string, ids ...string) (requestConversion, error) {
+	target, ok := structs[request]
+	if !ok {
+		return requestConversion{}, fmt.Errorf("%s: no request type %s", model, request)
+	}
+	var (
+		conversion requestConversion
+		params     []string
+		values     []string
+		names      []string
+	)
+	for _, id := range ids {
+		typ := fieldType(target, id)
+		if typ == nil {
+			continue
+		}
+		param := idParams[id]
+		if n := len(params); n > 0 && strings.HasSuffix(params[n-1], " "+types.ExprString(typ)) {
+			params[n-1] = strings.Replace(params[n-1], " ", ", "+param+" ", 1)
+		} else {
+			params = append(params, param+" "+types.ExprString(typ))
+		}
+		values = append(values, id+": "+param)
+		conversion.idFields += fmt.Sprintf("\t\t%s: %s,\n", id, param)
+		names = append(names, param)
+	}
+	conversion.params = strings.Join(params, ", ")
+	conversion.ids = strings.Join(values, ", ")
+	conversion.names = strings.Join(names, " and ")
+	conversion.fields = sharedFields(structs[model], target)
+	return conversion, nil
+}
+
+// responseTypes returns the types of the response data of m's endpoints
+// that hold a single object, and the item type of its list endpoint.
+func responseTypes(m resourceModel, structs map[string]*ast.StructType) []string {
+	var names []string
+	for _, method := range []string{m.Get, m.Post, m.Patch} {
+		if _, ok := structs[method+"ResponseData"]; ok {
+			names = append(names, method+"ResponseData")
+		}
+	}
+	if m.List != nil {
+		if _, ok := structs[m.List.Item]; ok {
+			names = append(names, m.List.Item)
+		}
+	}
+	return names
+}
+
+// sharedFields returns the exported JSON fields of from that to has too,
+// with the same type.
+func sharedFields(from, to *ast.StructType) []copiedField {
+	var fields []copiedField
+	for _, field := range from.Fields.List {
+		name := jsonName(field)
+		if name == "" {
+			continue
+		}
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			typ := fieldType(to, ident.Name)
+			if typ == nil || types.ExprString(typ) != types.ExprString(field.Type) {
+				continue
+			}
+			fields = append(fields, copiedField{Name: ident.Name, ReadOnly: readOnlyFields[name]})
+		}
+	}
+	return fields
+}
+
+// copyFields returns the key-value pairs of a composite literal copying
+// fields from the from variable, skipping read-only fields unless
+// readOnly is set.
+func copyFields(from string, fields []copiedField, readOnly bool) string {
+	var buf strings.Builder
+	for _, field := range fields {
+		if field.ReadOnly && !readOnly {
+			continue
+		}
+		fmt.Fprintf(&buf, "\t\t%s: %s.%s,\n", field.Name, from, field.Name)
+	}
+	return buf.String()
+}
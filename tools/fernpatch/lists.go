@@ -0,0 +1,163 @@
+package main
+
+import (
+	"go/ast"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// listEndpoint is a sub-client method whose response holds a Data array.
+type listEndpoint struct {
+	Method string // e.g. GetFirewallRulesEndpoint
+	Name   string // e.g. FirewallRules, see listName
+	Item   string // e.g. GetFirewallRulesEndpointResponseDataItem
+	// Request reports whether the method takes a request argument.
+	Request bool
+	// Paginated reports whether the request has Limit and Offset fields.
+	Paginated bool
+}
+
+// generateListFiles writes a file named name to every sub-client directory
+// in dir, holding the source generated for the list endpoints accepted by
+// include. The file is removed from sub-clients without such endpoints.
+func generateListFiles(
+	dir string,
+	name string,
+	include func(listEndpoint) bool,
+	source func(pkg string, endpoints []listEndpoint) []byte,
+) error {
+	files, err := parseFernFiles(dir)
+	if err != nil {
+		return err
+	}
+	types := structTypes(files)
+
+	clients, err := filepath.Glob(filepath.Join(dir, "*", "client.go"))
+	if err != nil {
+		return err
+	}
+	for _, client := range clients {
+		clientDir := filepath.Dir(client)
+		clientFiles, err := parseFernFiles(clientDir)
+		if err != nil {
+			return err
+		}
+		var (
+			pkg       string
+			endpoints []listEndpoint
+		)
+		for _, file := range clientFiles {
+			pkg = file.Name.Name
+			for _, decl := range file.Decls {
+				if endpoint, ok := listMethod(decl, types, serviceName(pkg)); ok && include(endpoint) {
+					endpoints = append(endpoints, endpoint)
+				}
+			}
+		}
+		path := filepath.Join(clientDir, name)
+		if len(endpoints) == 0 {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+		sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Name < endpoints[j].Name })
+		if err := writeGenerated(path, source(pkg, endpoints)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listMethod reports whether decl is a sub-client Get method whose
+// response holds a Data array.
+func listMethod(decl ast.Decl, types map[string]*ast.StructType, service string) (listEndpoint, bool) {
+	fn, ok := decl.(*ast.FuncDecl)
+	if !ok || fn.Recv == nil || !strings.HasPrefix(fn.Name.Name, "Get") {
+		return listEndpoint{}, false
+	}
+	method := fn.Name.Name
+	response, ok := types[method+"Response"]
+	if !ok {
+		return listEndpoint{}, false
+	}
+	data, ok := fieldType(response, "Data").(*ast.ArrayType)
+	if !ok {
+		return listEndpoint{}, false
+	}
+	star, ok := data.Elt.(*ast.StarExpr)
+	if !ok {
+		return listEndpoint{}, false
+	}
+	item, ok := star.X.(*ast.Ident)
+	if !ok {
+		return listEndpoint{}, false
+	}
+	endpoint := listEndpoint{
+		Method: method,
+		Name:   listName(method, service),
+		Item:   item.Name,
+	}
+	for _, param := range fn.Type.Params.List {
+		for _, ident := range param.Names {
+			endpoint.Request = endpoint.Request || ident.Name == "request"
+		}
+	}
+	if request, ok := types[method+"Request"]; ok && endpoint.Request {
+		endpoint.Paginated = fieldType(request, "Limit") != nil && fieldType(request, "Offset") != nil
+	}
+	return endpoint, true
+}
+
+// listName derives the name of the iterated objects from the
+// endpoint's method name, e.g. GetFirewallRulesEndpoint to FirewallRules.
+// Status endpoints report on other subsystems, so the Status prefix is
+// dropped, and log endpoints are named after their log, e.g.
+// GetStatusLogsFirewallEndpoint to FirewallLogs.
+func listName(method, service string) string {
+	name := strings.TrimSuffix(strings.TrimPrefix(method, "Get"), "Endpoint")
+	if service == "Status" {
+		name = strings.TrimPrefix(name, "Status")
+	}
+	if log, ok := strings.CutPrefix(name, "Logs"); ok && log != "" {
+		name = log + "Logs"
+	}
+	return name
+}
+
+// structTypes indexes the struct types declared in files by name.
+func structTypes(files []*ast.File) map[string]*ast.StructType {
+	types := make(map[string]*ast.StructType)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					types[typeSpec.Name.Name] = structType
+				}
+			}
+		}
+	}
+	return types
+}
+
+// fieldType returns the type of the named field, or nil if there is none.
+func fieldType(structType *ast.StructType, name string) ast.Expr {
+	for _, field := range structType.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return field.Type
+			}
+		}
+	}
+	return nil
+}
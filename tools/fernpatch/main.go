@@ -0,0 +1,189 @@
+// Command fernpatch applies post-generation fixes to the Fern Go SDK output.
+//
+// Fern's Go generator only forwards the HTTP client and retry attempts from
+// the request options into core.Caller, and it doesn't tell the caller which
+// endpoint is being invoked. fernpatch threads the full *core.RequestOptions
+// and a core.Endpoint through so that hand-maintained runtime features in
+// pkg/client/core can use them, and makes the root client share a single
+// core.Caller with every sub-client. It also generates accessors that Fern
+// doesn't, such as RawJSON on every model, Reveal on models holding
+// secrets (whose String methods it makes mask them) and the All*
+// pagination and Stream* methods of list endpoints, the ToPost, ToPatch,
+// ToPutItem and ToModel conversions between models and the types of their
+// endpoints, and the pfrest.Resource adapters in the module root. Every
+// patch is idempotent, so it is safe to run repeatedly after `fern
+// generate`.
+//
+// Usage:
+//
+//	go run ./tools/fernpatch pkg/client
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	packageLine     = regexp.MustCompile(`^package (\w+)$`)
+	methodLine      = regexp.MustCompile(`^func \(c \*Client\) (\w+)\(`)
+	callParamsLine  = regexp.MustCompile(`^(\s*)&core\.CallParams\{$`)
+	endpointLine    = regexp.MustCompile(`^\s*Endpoint:\s+core\.Endpoint\{`)
+	endpointURLLine = regexp.MustCompile(`^\s*endpointURL := baseURL \+ "/" \+ "([^"]*)"$`)
+	deferredDoc     = regexp.MustCompile(`\*\*Applies immediately\*\*: No<`)
+	maxAttemptsLine = regexp.MustCompile(`^(\s*)MaxAttempts:\s+options\.MaxAttempts,$`)
+	apiErrorLine    = regexp.MustCompile(`^(\s*)apiError := core\.NewAPIError\(statusCode, errors\.New\(string\(raw\)\)\)$`)
+	queryParamLine  = regexp.MustCompile(`^(\s*)queryParams\.Add\("query", fmt\.Sprintf\("%v", \*request\.Query\)\)$`)
+	errorsImport    = regexp.MustCompile(`(?m)^\s*errors "errors"\n`)
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: fernpatch <pkg/client dir>")
+		os.Exit(2)
+	}
+	for pattern, patches := range map[string][]func([]byte) ([]byte, error){
+		"client.go":      {patch, shareCaller},
+		"client_test.go": {shareCallerTest},
+	} {
+		files, err := filepath.Glob(filepath.Join(os.Args[1], "*", pattern))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		for _, file := range files {
+			if err := patchFile(file, patches...); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", file, err)
+				os.Exit(1)
+			}
+		}
+	}
+	for _, generate := range []func(string) error{generateRawJSON, generateSecrets, generatePagination, generateStreams, generateResources, generateConversions} {
+		if err := generate(os.Args[1]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func patchFile(path string, patches ...func([]byte) ([]byte, error)) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	out := src
+	for _, patch := range patches {
+		if out, err = patch(out); err != nil {
+			return err
+		}
+	}
+	if bytes.Equal(src, out) {
+		return nil
+	}
+	return os.WriteFile(path, out, 0o644)
+}
+
+// patch rewrites the generated core.CallerParams and core.CallParams
+// literals and error decoders:
+//
+//   - both literals forward the request options, keyed off the MaxAttempts field;
+//   - core.CallParams names the endpoint, e.g. Firewall.GetFirewallRulesEndpoint,
+//     its path and, for mutations that pfSense documents as not applying
+//     immediately, that they are deferred;
+//   - error decoders wrap the response in a *pfclientapi.Error rather than
+//     an opaque string error;
+//   - the Query field of list requests is expanded into one query parameter
+//     per filter instead of being sent as a literal "query" parameter.
+func patch(src []byte) ([]byte, error) {
+	var (
+		lines    = strings.Split(string(src), "\n")
+		patched  = make([]string, 0, len(lines))
+		service  string
+		method   string
+		path     string
+		deferred bool
+	)
+	for i, line := range lines {
+		if m := apiErrorLine.FindStringSubmatch(line); m != nil {
+			line = m[1] + "apiError := core.NewAPIError(statusCode, pkgclient.NewError(statusCode, raw))"
+		}
+		if m := queryParamLine.FindStringSubmatch(line); m != nil {
+			patched = append(patched,
+				m[1]+"if err := core.AddQueryFilters(queryParams, *request.Query); err != nil {",
+				m[1]+"\treturn nil, err",
+				m[1]+"}",
+			)
+			continue
+		}
+		if endpointLine.MatchString(line) {
+			// Regenerated below, so that older patches gain new fields.
+			continue
+		}
+		patched = append(patched, line)
+		rest := lines[i+1:]
+		if m := packageLine.FindStringSubmatch(line); m != nil {
+			service = serviceName(m[1])
+			continue
+		}
+		if m := methodLine.FindStringSubmatch(line); m != nil {
+			method, path = m[1], ""
+			// The doc comment directly above the method says whether its
+			// changes wait for the subsystem's apply endpoint.
+			deferred = i > 0 && deferredDoc.MatchString(lines[i-1]) && !strings.HasPrefix(method, "Get")
+			continue
+		}
+		if m := endpointURLLine.FindStringSubmatch(line); m != nil {
+			path = "/" + m[1]
+			continue
+		}
+		if m := callParamsLine.FindStringSubmatch(line); m != nil {
+			if method == "" {
+				continue
+			}
+			endpoint := fmt.Sprintf("Service: %q, Name: %q, Path: %q", service, method, path)
+			if deferred {
+				endpoint += ", Deferred: true"
+			}
+			patched = append(patched, fmt.Sprintf("%s\tEndpoint: core.Endpoint{%s},", m[1], endpoint))
+			continue
+		}
+		if m := maxAttemptsLine.FindStringSubmatch(line); m != nil {
+			if literalHas(rest, "Options:") {
+				continue
+			}
+			patched = append(patched, m[1]+"Options: options,")
+		}
+	}
+	out := strings.Join(patched, "\n")
+	if !strings.Contains(out, "errors.") {
+		out = errorsImport.ReplaceAllString(out, "")
+	}
+	return format.Source([]byte(out))
+}
+
+// serviceName maps a generated package name to the name of the matching
+// field on the root client, e.g. interface_ to Interface.
+func serviceName(pkg string) string {
+	pkg = strings.TrimSuffix(pkg, "_")
+	return strings.ToUpper(pkg[:1]) + pkg[1:]
+}
+
+// literalHas reports whether the remainder of the current composite literal
+// already sets the given key.
+func literalHas(lines []string, key string) bool {
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "}") {
+			return false
+		}
+		if strings.HasPrefix(trimmed, key) {
+			return true
+		}
+	}
+	return false
+}
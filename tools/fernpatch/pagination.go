@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// paginationFile is the file, relative to each sub-client directory,
+// holding the generated All* iterators.
+const paginationFile = "pagination.go"
+
+// generatePagination writes an All* iterator for every list endpoint of
+// every sub-client in dir that takes a Limit and an Offset.
+func generatePagination(dir string) error {
+	return generateListFiles(dir, paginationFile, func(e listEndpoint) bool { return e.Paginated }, paginationSource)
+}
+
+func paginationSource(pkg string, endpoints []listEndpoint) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, `
+package %s
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	iter "iter"
+)
+`, pkg)
+	for _, e := range endpoints {
+		fmt.Fprintf(&buf, `
+// All%[1]s iterates over every object returned by %[2]s, a page at
+// a time. The request's Limit sets the page size and its Offset where to
+// start; see option.WithPageSize and option.WithPrefetch.
+func (c *Client) All%[1]s(
+	ctx context.Context,
+	request *pkgclient.%[2]sRequest,
+	opts ...option.RequestOption,
+) iter.Seq2[*pkgclient.%[3]s, error] {
+	var page pkgclient.%[2]sRequest
+	if request != nil {
+		page = *request
+	}
+	return core.Paginate(
+		ctx,
+		core.PageParams{
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+			Options: core.NewRequestOptions(opts...),
+		},
+		func(ctx context.Context, limit, offset int) ([]*pkgclient.%[3]s, error) {
+			request := page
+			request.Limit, request.Offset = &limit, &offset
+			response, err := c.%[2]s(ctx, &request, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+	)
+}
+`, e.Name, e.Method, e.Item)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// generatedHeader marks the files written by fernpatch. Such files are
+// skipped when parsing the Fern output.
+const generatedHeader = "// Code generated by fernpatch. DO NOT EDIT.\n"
+
+// rawJSONFile is the file, relative to the pkg/client directory, holding
+// the generated RawJSON accessors.
+const rawJSONFile = "raw_json.go"
+
+// generateRawJSON writes a RawJSON accessor for every model in dir that
+// keeps the JSON it was decoded from in an unexported _rawJSON field.
+func generateRawJSON(dir string) error {
+	pkg, types, err := rawJSONTypes(dir)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, "\npackage %s\n\nimport json \"encoding/json\"\n", pkg)
+	for _, name := range types {
+		fmt.Fprintf(&buf, `
+// RawJSON returns the JSON this value was decoded from, including fields
+// missing from the API definition, or nil if it was not decoded from JSON.
+func (r *%[1]s) RawJSON() json.RawMessage {
+	if r == nil {
+		return nil
+	}
+	return r._rawJSON
+}
+`, name)
+	}
+	return writeGenerated(filepath.Join(dir, rawJSONFile), buf.Bytes())
+}
+
+// rawJSONTypes returns the package name and the sorted names of the struct
+// types in dir that have a _rawJSON field.
+func rawJSONTypes(dir string) (string, []string, error) {
+	files, err := parseFernFiles(dir)
+	if err != nil {
+		return "", nil, err
+	}
+	var (
+		pkg   string
+		types []string
+	)
+	for _, file := range files {
+		pkg = file.Name.Name
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if structType, ok := spec.Type.(*ast.StructType); ok && fieldType(structType, "_rawJSON") != nil {
+				types = append(types, spec.Name.Name)
+			}
+			return false
+		})
+	}
+	sort.Strings(types)
+	return pkg, types, nil
+}
+
+// parseFernFiles parses the non-test Go files in dir that were written by
+// Fern rather than by fernpatch.
+func parseFernFiles(dir string) ([]*ast.File, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		fset  = token.NewFileSet()
+		files []*ast.File
+	)
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(src, []byte(generatedHeader)) {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// writeGenerated formats src and writes it to path, leaving the file
+// untouched if it is already up to date.
+func writeGenerated(path string, src []byte) error {
+	out, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if existing, err := os.ReadFile(path); err == nil && bytes.Equal(existing, out) {
+		return nil
+	}
+	return os.WriteFile(path, out, 0o644)
+}
@@ -0,0 +1,418 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// resourcesFile is the file, relative to the module root, holding the
+// generated pfrest.Resource adapters.
+const resourcesFile = "resources.go"
+
+// endpointTypeName matches the names of the per-endpoint request and
+// response types, which are not models.
+var endpointTypeName = regexp.MustCompile(`^(Get|Post|Patch|Put|Delete)[A-Z]`)
+
+// resourceModel is a model with Get, Post, Patch and Delete endpoints on
+// one path, e.g. FirewallRule on /api/v2/firewall/rule.
+type resourceModel struct {
+	Service string // e.g. Firewall, the field of the root client
+	Path    string // e.g. /api/v2/firewall/rule
+	Model   string // e.g. FirewallRule
+	Name    string // e.g. FirewallRules, the adapter's constructor
+	Get     string // e.g. GetFirewallRuleEndpoint
+	Post    string
+	Patch   string
+	Delete  string
+	// Nested reports whether objects are identified by a parent ID too.
+	Nested bool
+	// List is the list endpoint on the plural path, if any.
+	List *listEndpoint
+	// Put is the bulk replacement endpoint on the plural path and the
+	// item type of its request, if any.
+	Put, PutItem string
+}
+
+// generateResources writes a pfrest.Resource adapter for every model in
+// dir that can be created, read, updated and deleted, to the module root
+// two levels above dir.
+func generateResources(dir string) error {
+	models, _, err := findResourceModels(dir)
+	if err != nil || models == nil {
+		return err
+	}
+	return writeGenerated(filepath.Join(dir, "..", "..", resourcesFile), resourcesSource(models))
+}
+
+// findResourceModels returns the models of every sub-client in dir,
+// sorted by name, and the struct types of dir.
+func findResourceModels(dir string) ([]resourceModel, map[string]*ast.StructType, error) {
+	files, err := parseFernFiles(dir)
+	if err != nil || len(files) == 0 {
+		return nil, nil, err
+	}
+	types := structTypes(files)
+
+	clients, err := filepath.Glob(filepath.Join(dir, "*", "client.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+	var models []resourceModel
+	for _, client := range clients {
+		clientFiles, err := parseFernFiles(filepath.Dir(client))
+		if err != nil {
+			return nil, nil, err
+		}
+		found, err := resourceModels(clientFiles, types)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", client, err)
+		}
+		models = append(models, found...)
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].Name < models[j].Name })
+	return models, types, nil
+}
+
+// resourceModels finds the models served by the sub-client in files.
+func resourceModels(files []*ast.File, types map[string]*ast.StructType) ([]resourceModel, error) {
+	var (
+		service string
+		methods = make(map[string]map[string]*ast.FuncDecl) // path -> verb -> method
+	)
+	for _, file := range files {
+		service = serviceName(file.Name.Name)
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil {
+				continue
+			}
+			verb := endpointTypeName.FindStringSubmatch(fn.Name.Name)
+			path := endpointPath(fn)
+			if verb == nil || path == "" {
+				continue
+			}
+			if methods[path] == nil {
+				methods[path] = make(map[string]*ast.FuncDecl)
+			}
+			methods[path][verb[1]] = fn
+		}
+	}
+
+	var models []resourceModel
+	for path, verbs := range methods {
+		if verbs["Get"] == nil || verbs["Post"] == nil || verbs["Patch"] == nil || verbs["Delete"] == nil {
+			continue
+		}
+		m := resourceModel{
+			Service: service,
+			Path:    path,
+			Get:     verbs["Get"].Name.Name,
+			Post:    verbs["Post"].Name.Name,
+			Patch:   verbs["Patch"].Name.Name,
+			Delete:  verbs["Delete"].Name.Name,
+		}
+		get, del := types[m.Get+"Request"], types[m.Delete+"Request"]
+		if get == nil || del == nil || !isStringPointer(fieldType(get, "ID")) || !isStringPointer(fieldType(del, "ID")) {
+			continue
+		}
+		m.Nested = isStringPointer(fieldType(get, "ParentID")) && isStringPointer(fieldType(del, "ParentID"))
+
+		model, err := modelOf(m.Get, types)
+		if err != nil {
+			return nil, err
+		}
+		m.Model, m.Name = model, plural(model)
+
+		for _, pluralPath := range pluralPaths(path) {
+			if fn := methods[pluralPath]["Get"]; fn != nil {
+				if list, ok := listMethod(fn, types, service); ok {
+					m.List = &list
+				}
+			}
+			if fn := methods[pluralPath]["Put"]; fn != nil {
+				if item := bulkItem(fn); item != "" {
+					m.Put, m.PutItem = fn.Name.Name, item
+				}
+			}
+		}
+		models = append(models, m)
+	}
+	return models, nil
+}
+
+// endpointPath returns the Path of the core.Endpoint literal in fn, or ""
+// if there is none.
+func endpointPath(fn *ast.FuncDecl) string {
+	var path string
+	ast.Inspect(fn, func(node ast.Node) bool {
+		lit, ok := node.(*ast.CompositeLit)
+		if !ok {
+			return path == ""
+		}
+		if sel, ok := lit.Type.(*ast.SelectorExpr); !ok || sel.Sel.Name != "Endpoint" {
+			return true
+		}
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if key, isIdent := kv.Key.(*ast.Ident); !ok || !isIdent || key.Name != "Path" {
+				continue
+			}
+			if value, ok := kv.Value.(*ast.BasicLit); ok {
+				path, _ = strconv.Unquote(value.Value)
+			}
+		}
+		return false
+	})
+	return path
+}
+
+// pluralPaths returns the paths the list and bulk endpoints of the objects
+// on path may have, e.g. /api/v2/firewall/rules for /api/v2/firewall/rule.
+// A list's entries are served by the list's own path.
+func pluralPaths(path string) []string {
+	paths := []string{path + "s", path + "es"}
+	if trimmed, ok := strings.CutSuffix(path, "y"); ok {
+		paths = append(paths, trimmed+"ies")
+	}
+	if list, ok := strings.CutSuffix(path, "/entry"); ok {
+		paths = append(paths, list)
+	}
+	return paths
+}
+
+// modelOf returns the model whose JSON fields, apart from id and
+// parent_id, are those of the data returned by the Get method. Several
+// models may have the same fields: nested item types are ignored, and the
+// model whose name ends the method's is preferred, e.g. StaticRoute for
+// GetRoutingStaticRouteEndpoint.
+func modelOf(get string, types map[string]*ast.StructType) (string, error) {
+	data, ok := types[get+"ResponseData"]
+	if !ok {
+		return "", fmt.Errorf("%s: no response data type", get)
+	}
+	fields := jsonFields(data)
+
+	var candidates []string
+	for name, structType := range types {
+		if !endpointTypeName.MatchString(name) && jsonFields(structType) == fields {
+			candidates = append(candidates, name)
+		}
+	}
+	sort.Strings(candidates)
+	candidates = preferred(candidates, func(name string) bool { return !strings.HasSuffix(name, "Item") })
+	resource := strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(get, "Get"), "Endpoint"))
+	candidates = preferred(candidates, func(name string) bool { return strings.HasSuffix(resource, strings.ToLower(name)) })
+	if len(candidates) != 1 {
+		return "", fmt.Errorf("%s: model is one of %v", get, candidates)
+	}
+	return candidates[0], nil
+}
+
+// preferred returns the names accepted by prefer, or all of them if none
+// is.
+func preferred(names []string, prefer func(string) bool) []string {
+	var accepted []string
+	for _, name := range names {
+		if prefer(name) {
+			accepted = append(accepted, name)
+		}
+	}
+	if len(accepted) == 0 {
+		return names
+	}
+	return accepted
+}
+
+// jsonFields returns the sorted JSON names of a struct's fields, apart
+// from id and parent_id, joined by commas.
+func jsonFields(structType *ast.StructType) string {
+	var names []string
+	for _, field := range structType.Fields.List {
+		if name := jsonName(field); name != "" && name != "id" && name != "parent_id" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// bulkItem returns the item type of a method taking a request of type
+// []*pkgclient.Item, or "" if it takes something else.
+func bulkItem(fn *ast.FuncDecl) string {
+	for _, param := range fn.Type.Params.List {
+		for _, ident := range param.Names {
+			if ident.Name != "request" {
+				continue
+			}
+			array, ok := param.Type.(*ast.ArrayType)
+			if !ok {
+				return ""
+			}
+			star, ok := array.Elt.(*ast.StarExpr)
+			if !ok {
+				return ""
+			}
+			if sel, ok := star.X.(*ast.SelectorExpr); ok {
+				return sel.Sel.Name
+			}
+		}
+	}
+	return ""
+}
+
+func isStringPointer(typ ast.Expr) bool {
+	star, ok := typ.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "string"
+}
+
+// plural returns the English plural of a model name, e.g. FirewallAliases
+// for FirewallAlias and RoutingGatewayGroupPriorities for
+// RoutingGatewayGroupPriority.
+func plural(name string) string {
+	switch {
+	case strings.HasSuffix(name, "y") && !strings.ContainsAny(name[len(name)-2:len(name)-1], "aeiou"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"),
+		strings.HasSuffix(name, "ch"), strings.HasSuffix(name, "sh"):
+		return name + "es"
+	default:
+		return name + "s"
+	}
+}
+
+func resourcesSource(models []resourceModel) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	buf.WriteString(`
+package pfrest
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	client "github.com/danielmichaels/go-pfrest/pkg/client/client"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+)
+
+// Resources returns the adapter of every model, keyed by its kind, e.g.
+// "FirewallRule", for tooling that works on all of them alike.
+func Resources(c *client.Client) map[string]Resource[any] {
+	return map[string]Resource[any]{
+`)
+	for _, m := range models {
+		fmt.Fprintf(&buf, "\t\t%q: Erase(%s(c)),\n", m.Model, m.Name)
+	}
+	buf.WriteString("\t}\n}\n")
+
+	for _, m := range models {
+		args := map[string]string{
+			"Model":   m.Model,
+			"Name":    m.Name,
+			"Path":    m.Path,
+			"Service": m.Service,
+			"Get":     m.Get,
+			"Post":    m.Post,
+			"Patch":   m.Patch,
+			"Delete":  m.Delete,
+		}
+		expand := func(s string) string {
+			for key, value := range args {
+				s = strings.ReplaceAll(s, "{"+key+"}", value)
+			}
+			return s
+		}
+
+		args["GetID"] = "ID: &id.Value"
+		args["PostArgs"] = ""
+		args["PatchIDs"], args["PatchArgs"] = "_, id", "id"
+		doc, nested, create := "", "", ""
+		if m.Nested {
+			args["GetID"] += ", ParentID: &id.Parent"
+			args["PostArgs"] = "parentID"
+			args["PatchIDs"], args["PatchArgs"] = "parentID, id", "parentID, id"
+			doc = "\n// {Model} objects are nested, so their IDs have a Parent."
+			nested = "\t\tnested: true,\n"
+			create = `			parentID, _, opts := ID{Parent: object.ID.Parent}.ints(opts)
+`
+		}
+		buf.WriteString(expand(`
+// {Name} returns the Resource for {Model} objects, served by {Path}.` + doc + `
+func {Name}(c *client.Client) Resource[*pkgclient.{Model}] {
+	return &resource[*pkgclient.{Model}]{
+		kind: "{Model}",
+` + nested))
+		if list := m.List; list != nil {
+			args["List"], args["ListName"], args["ListRequest"] = list.Method, list.Name, list.Method+"Request"
+			switch {
+			case list.Paginated:
+				buf.WriteString(expand(`		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			return collect(c.{Service}.All{ListName}(ctx, nil, opts...))
+		},
+`))
+			default:
+				request := ""
+				if list.Request {
+					request = expand("&pkgclient.{ListRequest}{}, ")
+				}
+				buf.WriteString(expand(`		list: func(ctx context.Context, opts []option.RequestOption) ([]any, error) {
+			response, err := c.{Service}.{List}(ctx, ` + request + `opts...)
+			if err != nil {
+				return nil, err
+			}
+			return anys(response.Data), nil
+		},
+`))
+			}
+		}
+		buf.WriteString(expand(`		get: func(ctx context.Context, id ID, opts []option.RequestOption) (any, error) {
+			response, err := c.{Service}.{Get}(ctx, &pkgclient.{Get}Request{{GetID}}, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		create: func(ctx context.Context, object Object[*pkgclient.{Model}], opts []option.RequestOption) (any, error) {
+` + create + `			response, err := c.{Service}.{Post}(ctx, object.Value.ToPost({PostArgs}), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		update: func(ctx context.Context, object Object[*pkgclient.{Model}], opts []option.RequestOption) (any, error) {
+			{PatchIDs}, opts := object.ID.ints(opts)
+			response, err := c.{Service}.{Patch}(ctx, object.Value.ToPatch({PatchArgs}), opts...)
+			if err != nil {
+				return nil, err
+			}
+			return response.Data, nil
+		},
+		delete: func(ctx context.Context, id ID, opts []option.RequestOption) error {
+			_, err := c.{Service}.{Delete}(ctx, &pkgclient.{Delete}Request{{GetID}}, opts...)
+			return err
+		},
+`))
+		if m.Put != "" {
+			args["Put"], args["PutItem"] = m.Put, m.PutItem
+			buf.WriteString(expand(`		replaceAll: func(ctx context.Context, values []*pkgclient.{Model}, opts []option.RequestOption) error {
+			request := make([]*pkgclient.{PutItem}, len(values))
+			for i, value := range values {
+				request[i] = value.ToPutItem()
+			}
+			_, err := c.{Service}.{Put}(ctx, request, opts...)
+			return err
+		},
+`))
+		}
+		buf.WriteString("\t}\n}\n")
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/danielmichaels/go-pfrest/pkg/client/core"
+)
+
+// secretsFile is the file, relative to the pkg/client directory, holding
+// the sensitive field names and the generated Reveal methods.
+const secretsFile = "secrets.go"
+
+var (
+	stringMethodLine = regexp.MustCompile(`^func \(\w+ \*(\w+)\) String\(\) string \{$`)
+	stringifyCall    = regexp.MustCompile(`core\.StringifyJSON\(([\w.]+)\)`)
+	redactedCall     = regexp.MustCompile(`core\.StringifyRedactedJSON\(([\w.]+), sensitiveFields\)`)
+)
+
+// isSecretField reports whether the field with the given JSON name and Go
+// type holds a secret. Only string fields are considered, which rules out
+// flags such as use_token; the names are matched by core.IsSecretField,
+// which the SDK also uses to redact request and response bodies.
+func isSecretField(name string, typ ast.Expr) bool {
+	return isStringType(typ) && core.IsSecretField(name)
+}
+
+func isStringType(typ ast.Expr) bool {
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		return typ.Name == "string"
+	case *ast.StarExpr:
+		return isStringType(typ.X)
+	case *ast.ArrayType:
+		return isStringType(typ.Elt)
+	}
+	return false
+}
+
+// jsonName returns the JSON name of a struct field, or "" if it has none.
+func jsonName(field *ast.Field) string {
+	if field.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	name, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// namedTypes returns the names of the types that typ refers to, through
+// pointers, slices and maps.
+func namedTypes(typ ast.Expr) []string {
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		return []string{typ.Name}
+	case *ast.StarExpr:
+		return namedTypes(typ.X)
+	case *ast.ArrayType:
+		return namedTypes(typ.Elt)
+	case *ast.MapType:
+		return append(namedTypes(typ.Key), namedTypes(typ.Value)...)
+	}
+	return nil
+}
+
+// secretTypes returns the JSON names of all secret fields, and the set of
+// struct types whose JSON may contain one, directly or through a nested
+// type.
+func secretTypes(types map[string]*ast.StructType) ([]string, map[string]bool) {
+	names := make(map[string]bool)
+	direct := make(map[string]bool)
+	for typeName, structType := range types {
+		for _, field := range structType.Fields.List {
+			if name := jsonName(field); name != "" && isSecretField(name, field.Type) {
+				names[name] = true
+				direct[typeName] = true
+			}
+		}
+	}
+
+	memo := make(map[string]bool)
+	var contains func(name string, visiting map[string]bool) bool
+	contains = func(name string, visiting map[string]bool) bool {
+		if result, ok := memo[name]; ok {
+			return result
+		}
+		structType, ok := types[name]
+		if !ok || visiting[name] {
+			return false
+		}
+		if direct[name] {
+			memo[name] = true
+			return true
+		}
+		visiting[name] = true
+		defer delete(visiting, name)
+		for _, field := range structType.Fields.List {
+			for _, nested := range namedTypes(field.Type) {
+				if contains(nested, visiting) {
+					memo[name] = true
+					return true
+				}
+			}
+		}
+		memo[name] = false
+		return false
+	}
+	secret := make(map[string]bool)
+	for name := range types {
+		if contains(name, make(map[string]bool)) {
+			secret[name] = true
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted, secret
+}
+
+// generateSecrets writes the names of the secret fields of the models in
+// dir and a Reveal method for every model that may hold one, and makes
+// the String methods of those models mask them.
+func generateSecrets(dir string) error {
+	files, err := parseFernFiles(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	types := structTypes(files)
+	names, secret := secretTypes(types)
+
+	var revealed []string
+	for name := range secret {
+		if fieldType(types[name], "_rawJSON") != nil {
+			revealed = append(revealed, name)
+		}
+	}
+	sort.Strings(revealed)
+
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, "\npackage %s\n\nimport (\n\tfmt \"fmt\"\n\tcore \"github.com/danielmichaels/go-pfrest/pkg/client/core\"\n)\n", files[0].Name.Name)
+	buf.WriteString(`
+// sensitiveFields are the JSON names of the fields that hold secrets, such
+// as passwords, private keys and API keys. String masks them at any depth.
+var sensitiveFields = map[string]bool{
+`)
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: true,\n", name)
+	}
+	buf.WriteString("}\n")
+	for _, name := range revealed {
+		receiver := strings.ToLower(name[:1])
+		fmt.Fprintf(&buf, `
+// Reveal returns the JSON of %[2]s, including the secrets that String masks.
+func (%[2]s *%[1]s) Reveal() string {
+	if len(%[2]s._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(%[2]s._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(%[2]s); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%%#v", %[2]s)
+}
+`, name, receiver)
+	}
+	if err := writeGenerated(filepath.Join(dir, secretsFile), buf.Bytes()); err != nil {
+		return err
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || filepath.Base(path) == secretsFile {
+			continue
+		}
+		if err := patchFile(path, func(src []byte) ([]byte, error) {
+			return maskStrings(src, secret), nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maskStrings rewrites the String methods of the secret types to mask
+// their secrets, and those of other types to print their JSON verbatim.
+func maskStrings(src []byte, secret map[string]bool) []byte {
+	if bytes.HasPrefix(src, []byte(generatedHeader)) {
+		return src
+	}
+	lines := strings.Split(string(src), "\n")
+	var typeName string
+	for i, line := range lines {
+		if m := stringMethodLine.FindStringSubmatch(line); m != nil {
+			typeName = m[1]
+			continue
+		}
+		if line == "}" {
+			typeName = ""
+			continue
+		}
+		if typeName == "" {
+			continue
+		}
+		if secret[typeName] {
+			lines[i] = stringifyCall.ReplaceAllString(line, "core.StringifyRedactedJSON($1, sensitiveFields)")
+		} else {
+			lines[i] = redactedCall.ReplaceAllString(line, "core.StringifyJSON($1)")
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
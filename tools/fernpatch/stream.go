@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// streamFile is the file, relative to each sub-client directory, holding
+// the generated Stream* methods.
+const streamFile = "stream.go"
+
+// generateStreams writes a Stream* method for every list endpoint of every
+// sub-client in dir.
+func generateStreams(dir string) error {
+	return generateListFiles(dir, streamFile, func(listEndpoint) bool { return true }, streamSource)
+}
+
+func streamSource(pkg string, endpoints []listEndpoint) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, `
+package %s
+
+import (
+	context "context"
+	pkgclient "github.com/danielmichaels/go-pfrest/pkg/client"
+	core "github.com/danielmichaels/go-pfrest/pkg/client/core"
+	option "github.com/danielmichaels/go-pfrest/pkg/client/option"
+	io "io"
+)
+`, pkg)
+	for _, e := range endpoints {
+		var param, arg string
+		if e.Request {
+			param = fmt.Sprintf("\n\trequest *pkgclient.%sRequest,", e.Method)
+			arg = " request,"
+		}
+		fmt.Fprintf(&buf, `
+// Stream%[1]s calls %[2]s and passes each object in the
+// response to fn as soon as it is decoded, without holding the whole list
+// in memory. Use core.Send to deliver the objects on a channel instead.
+func (c *Client) Stream%[1]s(
+	ctx context.Context,%[4]s
+	fn func(*pkgclient.%[3]s) error,
+	opts ...option.RequestOption,
+) error {
+	decode := option.WithResponseDecoder(func(body io.Reader) error {
+		return core.DecodeData(body, fn)
+	})
+	_, err := c.%[2]s(ctx,%[5]s append(opts[:len(opts):len(opts)], decode)...)
+	return err
+}
+`, e.Name, e.Method, e.Item, param, arg)
+	}
+	return buf.Bytes()
+}